@@ -52,6 +52,9 @@ func ingestData(port int) {
 	ingestHasSourceAt(ctx, gqlclient)
 	ingestIsVulnerability(ctx, gqlclient)
 	ingestVEXStatement(ctx, gqlclient)
+	ingestVulnMetadata(ctx, gqlclient)
+	ingestVulnAffected(ctx, gqlclient)
+	ingestCertifyEOL(ctx, gqlclient)
 	time := time.Now().Sub(start)
 	logger.Infof("Ingesting test data into backend server took %v", time)
 }
@@ -388,6 +391,7 @@ func ingestVulnerability(ctx context.Context, client graphql.Client) {
 		cve           *model.CVEInputSpec
 		osv           *model.OSVInputSpec
 		ghsa          *model.GHSAInputSpec
+		noVuln        bool
 		vulnerability model.VulnerabilityMetaDataInput
 	}{{
 		name: "cve openssl",
@@ -520,9 +524,31 @@ func ingestVulnerability(ctx context.Context, client graphql.Client) {
 			Origin:         "Demo ingestion",
 			Collector:      "Demo ingestion",
 		},
+	}, {
+		name: "no vulnerability django",
+		pkg: &model.PkgInputSpec{
+			Type:      "pypi",
+			Namespace: &djangoNs,
+			Name:      "django",
+		},
+		noVuln: true,
+		vulnerability: model.VulnerabilityMetaDataInput{
+			TimeScanned:    time.Now(),
+			DbUri:          "MITRE",
+			DbVersion:      "v1.3.0",
+			ScannerUri:     "osv.dev",
+			ScannerVersion: "0.0.14",
+			Origin:         "Demo ingestion",
+			Collector:      "Demo ingestion",
+		},
 	}}
 	for _, ingest := range ingestVulnerabilities {
-		if ingest.cve != nil {
+		if ingest.noVuln {
+			_, err := model.CertifyNoKnownVuln(context.Background(), client, *ingest.pkg, ingest.vulnerability)
+			if err != nil {
+				logger.Errorf("Error in ingesting: %v\n", err)
+			}
+		} else if ingest.cve != nil {
 			_, err := model.CertifyCVE(context.Background(), client, *ingest.pkg, *ingest.cve, ingest.vulnerability)
 			if err != nil {
 				logger.Errorf("Error in ingesting: %v\n", err)
@@ -539,7 +565,7 @@ func ingestVulnerability(ctx context.Context, client graphql.Client) {
 				logger.Errorf("Error in ingesting: %v\n", err)
 			}
 		} else {
-			fmt.Printf("input missing for cve, osv or ghsa")
+			fmt.Printf("input missing for cve, osv, ghsa or noVuln")
 		}
 	}
 }
@@ -978,6 +1004,7 @@ func ingestVEXStatement(ctx context.Context, client graphql.Client) {
 		name         string
 		pkg          *model.PkgInputSpec
 		artifact     *model.ArtifactInputSpec
+		osv          *model.OSVInputSpec
 		cve          *model.CVEInputSpec
 		ghsa         *model.GHSAInputSpec
 		vexStatement model.VexStatementInputSpec
@@ -995,10 +1022,12 @@ func ingestVEXStatement(ctx context.Context, client graphql.Client) {
 			CveId: "CVE-2019-13110",
 		},
 		vexStatement: model.VexStatementInputSpec{
-			Justification: "this package is not vulnerable to this CVE",
-			KnownSince:    time.Now(),
-			Origin:        "Demo ingestion",
-			Collector:     "Demo ingestion",
+			Status:           model.VexStatusNotAffected,
+			VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+			Statement:        "this package is not vulnerable to this CVE",
+			KnownSince:       time.Now(),
+			Origin:           "Demo ingestion",
+			Collector:        "Demo ingestion",
 		},
 	}, {
 		name: "this package is not vulnerable to this GHSA",
@@ -1013,10 +1042,32 @@ func ingestVEXStatement(ctx context.Context, client graphql.Client) {
 			GhsaId: "GHSA-h45f-rjvw-2rv2",
 		},
 		vexStatement: model.VexStatementInputSpec{
-			Justification: "this package is not vulnerable to this GHSA",
-			KnownSince:    time.Now(),
-			Origin:        "Demo ingestion",
-			Collector:     "Demo ingestion",
+			Status:           model.VexStatusNotAffected,
+			VexJustification: model.VexJustificationComponentNotPresent,
+			Statement:        "this package is not vulnerable to this GHSA",
+			KnownSince:       time.Now(),
+			Origin:           "Demo ingestion",
+			Collector:        "Demo ingestion",
+		},
+	}, {
+		name: "this package is affected by this OSV",
+		pkg: &model.PkgInputSpec{
+			Type:       "conan",
+			Namespace:  &opensslNs,
+			Name:       "openssl",
+			Version:    &opensslVersion,
+			Qualifiers: []model.PackageQualifierInputSpec{{Key: "user", Value: "bincrafters"}, {Key: "channel", Value: "stable"}},
+		},
+		osv: &model.OSVInputSpec{
+			OsvId: "CVE-2019-13110",
+		},
+		vexStatement: model.VexStatementInputSpec{
+			Status:           model.VexStatusAffected,
+			VexJustification: model.VexJustificationNotProvided,
+			ActionStatement:  "this package should be upgraded to fix this OSV",
+			KnownSince:       time.Now(),
+			Origin:           "Demo ingestion",
+			Collector:        "Demo ingestion",
 		},
 	}, {
 		name: "this artifact is not vulnerable to this CVE",
@@ -1029,10 +1080,12 @@ func ingestVEXStatement(ctx context.Context, client graphql.Client) {
 			CveId: "CVE-2018-43610",
 		},
 		vexStatement: model.VexStatementInputSpec{
-			Justification: "this artifact is not vulnerable to this CVE",
-			KnownSince:    time.Now(),
-			Origin:        "Demo ingestion",
-			Collector:     "Demo ingestion",
+			Status:           model.VexStatusNotAffected,
+			VexJustification: model.VexJustificationVulnerableCodeNotPresent,
+			Statement:        "this artifact is not vulnerable to this CVE",
+			KnownSince:       time.Now(),
+			Origin:           "Demo ingestion",
+			Collector:        "Demo ingestion",
 		},
 	}, {
 		name: "this artifact is not vulnerable to this GHSA",
@@ -1044,15 +1097,22 @@ func ingestVEXStatement(ctx context.Context, client graphql.Client) {
 			GhsaId: "GHSA-hj5f-4gvw-4rv2",
 		},
 		vexStatement: model.VexStatementInputSpec{
-			Justification: "this artifact is not vulnerable to this GHSA",
-			KnownSince:    time.Now(),
-			Origin:        "Demo ingestion",
-			Collector:     "Demo ingestion",
+			Status:           model.VexStatusNotAffected,
+			VexJustification: model.VexJustificationInlineMitigationsAlreadyExist,
+			Statement:        "this artifact is not vulnerable to this GHSA",
+			KnownSince:       time.Now(),
+			Origin:           "Demo ingestion",
+			Collector:        "Demo ingestion",
 		},
 	}}
 	for _, ingest := range ingestCertifyBad {
 		if ingest.pkg != nil {
-			if ingest.cve != nil {
+			if ingest.osv != nil {
+				_, err := model.VexPackageAndOsv(context.Background(), client, *ingest.pkg, *ingest.osv, ingest.vexStatement)
+				if err != nil {
+					logger.Errorf("Error in ingesting: %v\n", err)
+				}
+			} else if ingest.cve != nil {
 				_, err := model.VexPackageAndCve(context.Background(), client, *ingest.pkg, *ingest.cve, ingest.vexStatement)
 				if err != nil {
 					logger.Errorf("Error in ingesting: %v\n", err)
@@ -1063,10 +1123,15 @@ func ingestVEXStatement(ctx context.Context, client graphql.Client) {
 					logger.Errorf("Error in ingesting: %v\n", err)
 				}
 			} else {
-				fmt.Printf("input missing for cve or ghsa")
+				fmt.Printf("input missing for osv, cve or ghsa")
 			}
 		} else if ingest.artifact != nil {
-			if ingest.cve != nil {
+			if ingest.osv != nil {
+				_, err := model.VexArtifactAndOsv(context.Background(), client, *ingest.artifact, *ingest.osv, ingest.vexStatement)
+				if err != nil {
+					logger.Errorf("Error in ingesting: %v\n", err)
+				}
+			} else if ingest.cve != nil {
 				_, err := model.VexArtifactAndCve(context.Background(), client, *ingest.artifact, *ingest.cve, ingest.vexStatement)
 				if err != nil {
 					logger.Errorf("Error in ingesting: %v\n", err)
@@ -1077,10 +1142,273 @@ func ingestVEXStatement(ctx context.Context, client graphql.Client) {
 					logger.Errorf("Error in ingesting: %v\n", err)
 				}
 			} else {
-				fmt.Printf("input missing for cve or ghsa")
+				fmt.Printf("input missing for osv, cve or ghsa")
 			}
 		} else {
 			fmt.Printf("input missing for package or artifact")
 		}
 	}
 }
+
+func ingestVulnMetadata(ctx context.Context, client graphql.Client) {
+	logger := logging.FromContext(ctx)
+
+	published := time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	ingestVulnMetadata := []struct {
+		name         string
+		osv          *model.OSVInputSpec
+		cve          *model.CVEInputSpec
+		ghsa         *model.GHSAInputSpec
+		vulnMetadata model.VulnMetadataInputSpec
+	}{{
+		name: "critical CVE",
+		cve: &model.CVEInputSpec{
+			Year:  "2019",
+			CveId: "CVE-2019-13110",
+		},
+		vulnMetadata: model.VulnMetadataInputSpec{
+			Summary: "An integer overflow leads to an out-of-bounds read",
+			Scores: []model.VulnScoreInputSpec{{
+				ScoreType: model.VulnScoreTypeCvssV3,
+				Vector:    "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+				Score:     9.8,
+			}, {
+				ScoreType: model.VulnScoreTypeEpss,
+				Score:     0.0123,
+			}},
+			CweIds:     []string{"CWE-190"},
+			References: []string{"https://nvd.nist.gov/vuln/detail/CVE-2019-13110"},
+			Published:  &published,
+			Origin:     "Demo ingestion",
+			Collector:  "Demo ingestion",
+		},
+	}, {
+		name: "medium GHSA",
+		ghsa: &model.GHSAInputSpec{
+			GhsaId: "GHSA-h45f-rjvw-2rv2",
+		},
+		vulnMetadata: model.VulnMetadataInputSpec{
+			Summary: "Cross-site scripting in the admin interface",
+			Scores: []model.VulnScoreInputSpec{{
+				ScoreType: model.VulnScoreTypeCvssV3,
+				Vector:    "CVSS:3.0/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N",
+				Score:     6.1,
+			}},
+			CweIds:     []string{"CWE-79"},
+			References: []string{},
+			Origin:     "Demo ingestion",
+			Collector:  "Demo ingestion",
+		},
+	}, {
+		name: "OSV with CVSS v2 score",
+		osv: &model.OSVInputSpec{
+			OsvId: "CVE-2019-13110",
+		},
+		vulnMetadata: model.VulnMetadataInputSpec{
+			Summary: "An integer overflow leads to an out-of-bounds read",
+			Scores: []model.VulnScoreInputSpec{{
+				ScoreType: model.VulnScoreTypeCvssV2,
+				Vector:    "AV:N/AC:L/Au:N/C:P/I:P/A:P",
+				Score:     7.5,
+			}},
+			CweIds:     []string{},
+			References: []string{},
+			Published:  &published,
+			Origin:     "Demo ingestion",
+			Collector:  "Demo ingestion",
+		},
+	}}
+	for _, ingest := range ingestVulnMetadata {
+		var err error
+		if ingest.osv != nil {
+			_, err = model.VulnMetadataOSV(context.Background(), client, *ingest.osv, ingest.vulnMetadata)
+		} else if ingest.cve != nil {
+			_, err = model.VulnMetadataCVE(context.Background(), client, *ingest.cve, ingest.vulnMetadata)
+		} else if ingest.ghsa != nil {
+			_, err = model.VulnMetadataGHSA(context.Background(), client, *ingest.ghsa, ingest.vulnMetadata)
+		} else {
+			fmt.Printf("input missing for osv, cve or ghsa")
+			continue
+		}
+		if err != nil {
+			logger.Errorf("Error in ingesting: %v\n", err)
+		}
+	}
+}
+
+func ingestVulnAffected(ctx context.Context, client graphql.Client) {
+	logger := logging.FromContext(ctx)
+
+	ns := ""
+	version := "1.11.1"
+	goNs := "golang.org/x"
+	goVersion := "v0.7.0"
+	opensslNs := "ubuntu"
+	opensslVersion := "1.1.1f-1ubuntu2.16"
+
+	ingestVulnAffected := []struct {
+		name         string
+		pkg          model.PkgInputSpec
+		osv          *model.OSVInputSpec
+		cve          *model.CVEInputSpec
+		ghsa         *model.GHSAInputSpec
+		vulnAffected model.VulnAffectedInputSpec
+	}{{
+		name: "django before 1.11.22",
+		pkg: model.PkgInputSpec{
+			Type:      "pypi",
+			Namespace: &ns,
+			Name:      "django",
+			Version:   &version,
+		},
+		ghsa: &model.GHSAInputSpec{
+			GhsaId: "GHSA-h45f-rjvw-2rv2",
+		},
+		vulnAffected: model.VulnAffectedInputSpec{
+			Ranges: []model.AffectedRangeInputSpec{{
+				RangeType: model.AffectedRangeTypeEcosystem,
+				Events: []model.AffectedEventInputSpec{{
+					EventType: model.AffectedEventTypeIntroduced,
+					Version:   "0",
+				}, {
+					EventType: model.AffectedEventTypeFixed,
+					Version:   "1.11.22",
+				}},
+			}},
+			Versions:  []string{},
+			Origin:    "Demo ingestion",
+			Collector: "Demo ingestion",
+		},
+	}, {
+		name: "golang.org/x/net before v0.7.0",
+		pkg: model.PkgInputSpec{
+			Type:      "golang",
+			Namespace: &goNs,
+			Name:      "net",
+			Version:   &goVersion,
+		},
+		osv: &model.OSVInputSpec{
+			OsvId: "GO-2023-1571",
+		},
+		vulnAffected: model.VulnAffectedInputSpec{
+			Ranges: []model.AffectedRangeInputSpec{{
+				RangeType: model.AffectedRangeTypeSemver,
+				Events: []model.AffectedEventInputSpec{{
+					EventType: model.AffectedEventTypeIntroduced,
+					Version:   "0",
+				}, {
+					EventType: model.AffectedEventTypeFixed,
+					Version:   "0.7.0",
+				}},
+			}},
+			Versions:  []string{},
+			Origin:    "Demo ingestion",
+			Collector: "Demo ingestion",
+		},
+	}, {
+		name: "openssl in ubuntu",
+		pkg: model.PkgInputSpec{
+			Type:      "deb",
+			Namespace: &opensslNs,
+			Name:      "openssl",
+			Version:   &opensslVersion,
+		},
+		cve: &model.CVEInputSpec{
+			Year:  "2023",
+			CveId: "CVE-2023-0286",
+		},
+		vulnAffected: model.VulnAffectedInputSpec{
+			Ranges: []model.AffectedRangeInputSpec{{
+				RangeType: model.AffectedRangeTypeEcosystem,
+				Events: []model.AffectedEventInputSpec{{
+					EventType: model.AffectedEventTypeIntroduced,
+					Version:   "0",
+				}, {
+					EventType: model.AffectedEventTypeFixed,
+					Version:   "1.1.1f-1ubuntu2.17",
+				}},
+			}},
+			Versions:  []string{},
+			Origin:    "Demo ingestion",
+			Collector: "Demo ingestion",
+		},
+	}}
+	for _, ingest := range ingestVulnAffected {
+		var err error
+		if ingest.osv != nil {
+			_, err = model.VulnAffectedOSV(context.Background(), client, ingest.pkg, *ingest.osv, ingest.vulnAffected)
+		} else if ingest.cve != nil {
+			_, err = model.VulnAffectedCVE(context.Background(), client, ingest.pkg, *ingest.cve, ingest.vulnAffected)
+		} else if ingest.ghsa != nil {
+			_, err = model.VulnAffectedGHSA(context.Background(), client, ingest.pkg, *ingest.ghsa, ingest.vulnAffected)
+		} else {
+			fmt.Printf("input missing for osv, cve or ghsa")
+			continue
+		}
+		if err != nil {
+			logger.Errorf("Error in ingesting: %v\n", err)
+		}
+	}
+}
+
+func ingestCertifyEOL(ctx context.Context, client graphql.Client) {
+	logger := logging.FromContext(ctx)
+
+	opensslNs := "openssl.org"
+	opensslVersion := "3.0.3"
+	djangoNs := ""
+	djangoVersion := "1.11.1"
+	opensslEOL := time.Date(2026, 9, 7, 0, 0, 0, 0, time.UTC)
+	djangoEOL := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	djangoSupport := time.Date(2017, 12, 2, 0, 0, 0, 0, time.UTC)
+
+	ingestCertifyEOL := []struct {
+		name       string
+		pkg        model.PkgInputSpec
+		certifyEOL model.CertifyEOLInputSpec
+	}{{
+		name: "openssl 3.0 is EOL",
+		pkg: model.PkgInputSpec{
+			Type:      "conan",
+			Namespace: &opensslNs,
+			Name:      "openssl",
+			Version:   &opensslVersion,
+		},
+		certifyEOL: model.CertifyEOLInputSpec{
+			Product:     "openssl",
+			Cycle:       "3.0",
+			Status:      model.SupportStatusEol,
+			Eol:         &opensslEOL,
+			Latest:      "3.0.17",
+			TimeChecked: time.Now(),
+			Origin:      "Demo ingestion",
+			Collector:   "Demo ingestion",
+		},
+	}, {
+		name: "django 1.11 is EOL",
+		pkg: model.PkgInputSpec{
+			Type:      "pypi",
+			Namespace: &djangoNs,
+			Name:      "django",
+			Version:   &djangoVersion,
+		},
+		certifyEOL: model.CertifyEOLInputSpec{
+			Product:     "django",
+			Cycle:       "1.11",
+			Status:      model.SupportStatusEol,
+			Eol:         &djangoEOL,
+			Support:     &djangoSupport,
+			Latest:      "1.11.29",
+			TimeChecked: time.Now(),
+			Origin:      "Demo ingestion",
+			Collector:   "Demo ingestion",
+		},
+	}}
+	for _, ingest := range ingestCertifyEOL {
+		_, err := model.CertifyEOL(context.Background(), client, ingest.pkg, ingest.certifyEOL)
+		if err != nil {
+			logger.Errorf("Error in ingesting: %v\n", err)
+		}
+	}
+}
@@ -18,17 +18,20 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
-	"github.com/guacsec/guac/pkg/assembler/graphdb"
+	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/certifier"
 	"github.com/guacsec/guac/pkg/certifier/certify"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/certifier/eol"
+	"github.com/guacsec/guac/pkg/certifier/malicious"
 	"github.com/guacsec/guac/pkg/certifier/osv"
+	"github.com/guacsec/guac/pkg/certifier/schedule"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
-	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -41,10 +44,15 @@ var certifierCmd = &cobra.Command{
 		logger := logging.FromContext(ctx)
 
 		opts, err := validateCertifierFlags(
-			viper.GetString("gdbuser"),
-			viper.GetString("gdbpass"),
-			viper.GetString("gdbaddr"),
-			viper.GetString("realm"),
+			viper.GetString("gql-endpoint"),
+			viper.GetString("osv-db-path"),
+			viper.GetString("malicious-feed-path"),
+			viper.GetString("eol-dataset-path"),
+			viper.GetDuration("certifier-ttl"),
+			viper.GetInt("certifier-batch-size"),
+			viper.GetDuration("certifier-interval"),
+			viper.GetBool("certifier-poll"),
+			viper.GetString("certifier-state-path"),
 		)
 
 		if err != nil {
@@ -53,17 +61,50 @@ var certifierCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		if err := certify.RegisterCertifier(osv.NewOSVCertificationParser, certifier.CertifierOSV); err != nil {
+		osvCertifier := osv.NewOSVCertificationParser
+		if opts.osvDBPath != "" {
+			db, err := osv.LoadOSVDatabase(opts.osvDBPath)
+			if err != nil {
+				logger.Fatalf("unable to load OSV database: %v", err)
+			}
+			logger.Infof("using local OSV database %s, version %s", opts.osvDBPath, db.Version())
+			osvCertifier = func() certifier.Certifier {
+				return osv.NewOfflineOSVCertificationParser(db)
+			}
+		}
+		if err := certify.RegisterCertifier(osvCertifier, certifier.CertifierOSV); err != nil {
 			logger.Fatalf("unable to register certifier: %w", err)
 		}
-
-		authToken := graphdb.CreateAuthTokenWithUsernameAndPassword(opts.user, opts.pass, opts.realm)
-		client, err := graphdb.NewGraphClient(opts.dbAddr, authToken)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			os.Exit(1)
+		if opts.maliciousFeedPath != "" {
+			feed, err := malicious.LoadFeed(opts.maliciousFeedPath)
+			if err != nil {
+				logger.Fatalf("unable to load malicious package feed: %v", err)
+			}
+			logger.Infof("using malicious package feed %s, version %s", opts.maliciousFeedPath, feed.Version())
+			maliciousCertifier := func() certifier.Certifier {
+				return malicious.NewMaliciousCertifier(feed)
+			}
+			if err := certify.RegisterCertifier(maliciousCertifier, certifier.CertifierMalicious); err != nil {
+				logger.Fatalf("unable to register certifier: %w", err)
+			}
+		}
+		if opts.eolDatasetPath != "" {
+			dataset, err := eol.LoadDataset(opts.eolDatasetPath)
+			if err != nil {
+				logger.Fatalf("unable to load end of life dataset: %v", err)
+			}
+			logger.Infof("using end of life dataset %s, version %s", opts.eolDatasetPath, dataset.Version())
+			eolCertifier := func() certifier.Certifier {
+				return eol.NewEOLCertifier(dataset)
+			}
+			if err := certify.RegisterCertifier(eolCertifier, certifier.CertifierEOL); err != nil {
+				logger.Fatalf("unable to register certifier: %w", err)
+			}
 		}
 
+		httpClient := http.Client{}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
+
 		processorFunc, err := getProcessor(ctx)
 		if err != nil {
 			logger.Errorf("error: %v", err)
@@ -81,7 +122,7 @@ var certifierCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		packageQueryFunc, err := getPackageQuery(client)
+		packageQueryFunc, err := getPackageQuery(gqlclient)
 		if err != nil {
 			logger.Errorf("error: %v", err)
 			os.Exit(1)
@@ -127,7 +168,17 @@ var certifierCmd = &cobra.Command{
 			return false
 		}
 
-		if err := certify.Certify(ctx, packageQueryFunc(), emit, errHandler); err != nil {
+		if opts.schedule.TTL > 0 {
+			trackers := map[certifier.CertifierType]certifier.ScanTracker{
+				certifier.CertifierOSV:       root_package.NewVulnScanTracker(gqlclient),
+				certifier.CertifierMalicious: root_package.NewPackageScanTracker(),
+				certifier.CertifierEOL:       root_package.NewPackageScanTracker(),
+			}
+			err = schedule.Schedule(ctx, packageQueryFunc(), trackers, emit, errHandler, opts.schedule)
+		} else {
+			err = certify.Certify(ctx, packageQueryFunc(), emit, errHandler)
+		}
+		if err != nil {
 			logger.Fatal(err)
 		}
 		if gotErr {
@@ -138,17 +189,24 @@ var certifierCmd = &cobra.Command{
 	},
 }
 
-func validateCertifierFlags(user string, pass string, dbAddr string, realm string) (options, error) {
+func validateCertifierFlags(graphqlEndpoint string, osvDBPath string, maliciousFeedPath string, eolDatasetPath string, ttl time.Duration, batchSize int, interval time.Duration, poll bool, statePath string) (options, error) {
 	var opts options
-	opts.user = user
-	opts.pass = pass
-	opts.dbAddr = dbAddr
-	opts.realm = realm
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.osvDBPath = osvDBPath
+	opts.maliciousFeedPath = maliciousFeedPath
+	opts.eolDatasetPath = eolDatasetPath
+	opts.schedule = schedule.Options{
+		TTL:       ttl,
+		BatchSize: batchSize,
+		Interval:  interval,
+		Poll:      poll,
+		StatePath: statePath,
+	}
 
 	return opts, nil
 }
 
-func getPackageQuery(client neo4j.Driver) (func() certifier.QueryComponents, error) {
+func getPackageQuery(client graphql.Client) (func() certifier.QueryComponents, error) {
 	return func() certifier.QueryComponents {
 		packageQuery := root_package.NewPackageQuery(client)
 		return packageQuery
@@ -28,6 +28,7 @@ import (
 	"github.com/guacsec/guac/pkg/assembler"
 	"github.com/guacsec/guac/pkg/assembler/clients/helpers"
 	"github.com/guacsec/guac/pkg/assembler/graphdb"
+	"github.com/guacsec/guac/pkg/certifier/schedule"
 	"github.com/guacsec/guac/pkg/collectsub/datasource"
 	"github.com/guacsec/guac/pkg/handler/collector"
 	"github.com/guacsec/guac/pkg/handler/collector/file"
@@ -59,6 +60,18 @@ type options struct {
 
 	// gql endpoint
 	graphqlEndpoint string
+
+	// path to a local copy of the OSV database
+	osvDBPath string
+
+	// path to a local feed of malicious packages
+	maliciousFeedPath string
+
+	// path to a local end of life dataset
+	eolDatasetPath string
+
+	// scheduling of the certification of stale components
+	schedule schedule.Options
 }
 
 var exampleCmd = &cobra.Command{
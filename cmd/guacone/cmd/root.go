@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/guacsec/guac/pkg/logging"
 
@@ -47,6 +48,18 @@ var flags = struct {
 
 	// graphQL client flags
 	graphqlEndpoint string
+
+	// certifier flags
+	osvDBPath         string
+	maliciousFeedPath string
+	eolDatasetPath    string
+
+	// certifier scheduling flags
+	certifierTTL       time.Duration
+	certifierBatchSize int
+	certifierInterval  time.Duration
+	certifierPoll      bool
+	certifierStatePath string
 }{}
 
 var cfgFile string
@@ -73,10 +86,24 @@ func init() {
 	// graphql client flags
 	persistentFlags.StringVar(&flags.graphqlEndpoint, "gql-endpoint", "http://localhost:8080/query", "endpoint used to connect to graphQL server")
 
+	// certifier flags
+	persistentFlags.StringVar(&flags.osvDBPath, "osv-db-path", "", "path to a local copy of the OSV database (the per-ecosystem all.zip dumps), osv.dev is queried if empty")
+	persistentFlags.StringVar(&flags.maliciousFeedPath, "malicious-feed-path", "", "path to a feed of malicious packages (OSV MAL- entries, JSON or CSV denylists of purls and digests), packages are not checked if empty")
+	persistentFlags.StringVar(&flags.eolDatasetPath, "eol-dataset-path", "", "path to an end of life dataset (JSON release cycles in the endoflife.date format), support status is not checked if empty")
+
+	// certifier scheduling flags
+	persistentFlags.DurationVar(&flags.certifierTTL, "certifier-ttl", 0, "only certify components never certified or certified longer ago than this duration (e.g. 24h), all components are certified on each run if 0")
+	persistentFlags.IntVar(&flags.certifierBatchSize, "certifier-batch-size", 0, "number of stale components certified at once when scheduling, all at once if 0")
+	persistentFlags.DurationVar(&flags.certifierInterval, "certifier-interval", time.Minute, "time waited between batches of stale components, and between checks for stale components when polling")
+	persistentFlags.BoolVar(&flags.certifierPoll, "certifier-poll", false, "keep checking for stale components instead of exiting once they are certified")
+	persistentFlags.StringVar(&flags.certifierStatePath, "certifier-state-path", "", "file persisting when components were certified, so that restarts do not certify them again")
+
 	flagNames := []string{"gdbaddr", "gdbuser", "gdbpass", "realm",
 		"verifier-keyPath", "verifier-keyID",
 		"csub-addr", "csub-listen-port",
 		"gql-backend", "gql-port", "gql-debug", "gql-endpoint",
+		"osv-db-path", "malicious-feed-path", "eol-dataset-path",
+		"certifier-ttl", "certifier-batch-size", "certifier-interval", "certifier-poll", "certifier-state-path",
 	}
 	for _, name := range flagNames {
 		if flag := persistentFlags.Lookup(name); flag != nil {
@@ -18,15 +18,17 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	sc "github.com/guacsec/guac/pkg/certifier/components/source_artifact"
 
 	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/schedule"
 	"github.com/guacsec/guac/pkg/certifier/scorecard"
 
-	"github.com/guacsec/guac/pkg/assembler/graphdb"
+	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/certifier/certify"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
@@ -42,10 +44,12 @@ var scorecardCmd = &cobra.Command{
 		logger := logging.FromContext(ctx)
 
 		opts, err := validateScorecardFlags(
-			viper.GetString("gdbuser"),
-			viper.GetString("gdbpass"),
-			viper.GetString("gdbaddr"),
-			viper.GetString("realm"),
+			viper.GetString("gql-endpoint"),
+			viper.GetDuration("certifier-ttl"),
+			viper.GetInt("certifier-batch-size"),
+			viper.GetDuration("certifier-interval"),
+			viper.GetBool("certifier-poll"),
+			viper.GetString("certifier-state-path"),
 		)
 
 		if err != nil {
@@ -61,12 +65,8 @@ var scorecardCmd = &cobra.Command{
 			_ = cmd.Help()
 			os.Exit(1)
 		}
-		authToken := graphdb.CreateAuthTokenWithUsernameAndPassword(opts.user, opts.pass, opts.realm)
-		client, err := graphdb.NewGraphClient(opts.dbAddr, authToken)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			os.Exit(1)
-		}
+		httpClient := http.Client{}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
 		// running and getting the scorecard checks
 		scorecardCertifier, err := scorecard.NewScorecardCertifier(scorecardRunner)
@@ -78,7 +78,7 @@ var scorecardCmd = &cobra.Command{
 		}
 
 		// scorecard certifier is the certifier that gets the scorecard data from the graph
-		query, err := sc.NewCertifier(client)
+		query, err := sc.NewCertifier(gqlclient)
 
 		if err != nil {
 			fmt.Printf("unable to create scorecard certifier: %v\n", err)
@@ -149,7 +149,15 @@ var scorecardCmd = &cobra.Command{
 			return false
 		}
 
-		if err := certify.Certify(ctx, query, emit, errHandler); err != nil {
+		if opts.schedule.TTL > 0 {
+			trackers := map[certifier.CertifierType]certifier.ScanTracker{
+				certifier.CertifierScorecard: sc.NewScorecardScanTracker(gqlclient),
+			}
+			err = schedule.Schedule(ctx, query, trackers, emit, errHandler, opts.schedule)
+		} else {
+			err = certify.Certify(ctx, query, emit, errHandler)
+		}
+		if err != nil {
 			logger.Fatal(err)
 		}
 		if gotErr {
@@ -160,12 +168,16 @@ var scorecardCmd = &cobra.Command{
 	},
 }
 
-func validateScorecardFlags(user string, pass string, dbAddr string, realm string) (options, error) {
+func validateScorecardFlags(graphqlEndpoint string, ttl time.Duration, batchSize int, interval time.Duration, poll bool, statePath string) (options, error) {
 	var opts options
-	opts.user = user
-	opts.pass = pass
-	opts.dbAddr = dbAddr
-	opts.realm = realm
+	opts.graphqlEndpoint = graphqlEndpoint
+	opts.schedule = schedule.Options{
+		TTL:       ttl,
+		BatchSize: batchSize,
+		Interval:  interval,
+		Poll:      poll,
+		StatePath: statePath,
+	}
 
 	return opts, nil
 }
@@ -19,11 +19,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"sync"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/assembler"
-	"github.com/guacsec/guac/pkg/assembler/graphdb"
 	"github.com/guacsec/guac/pkg/certifier"
 	"github.com/guacsec/guac/pkg/certifier/certify"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
@@ -32,7 +33,6 @@ import (
 	"github.com/guacsec/guac/pkg/handler/processor"
 	parser_common "github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/guacsec/guac/pkg/logging"
-	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -45,10 +45,7 @@ var certifierCmd = &cobra.Command{
 		logger := logging.FromContext(ctx)
 
 		opts, err := validateCertifierFlags(
-			viper.GetString("gdbuser"),
-			viper.GetString("gdbpass"),
-			viper.GetString("gdbaddr"),
-			viper.GetString("realm"),
+			viper.GetString("gql-endpoint"),
 			viper.GetString("natsaddr"),
 		)
 
@@ -62,12 +59,8 @@ var certifierCmd = &cobra.Command{
 			logger.Fatalf("unable to register certifier: %w", err)
 		}
 
-		authToken := graphdb.CreateAuthTokenWithUsernameAndPassword(opts.user, opts.pass, opts.realm)
-		client, err := graphdb.NewGraphClient(opts.dbAddr, authToken)
-		if err != nil {
-			logger.Errorf("error: %v", err)
-			os.Exit(1)
-		}
+		httpClient := http.Client{}
+		gqlclient := graphql.NewClient(opts.graphqlEndpoint, &httpClient)
 
 		// initialize jetstream
 		// TODO: pass in credentials file for NATS secure login
@@ -129,7 +122,7 @@ var certifierCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		packageQueryFunc, err := getPackageQuery(client)
+		packageQueryFunc, err := getPackageQuery(gqlclient)
 		if err != nil {
 			logger.Errorf("error: %v", err)
 			os.Exit(1)
@@ -183,12 +176,9 @@ var certifierCmd = &cobra.Command{
 	},
 }
 
-func validateCertifierFlags(user string, pass string, dbAddr string, realm string, natsAddr string) (options, error) {
+func validateCertifierFlags(graphqlEndpoint string, natsAddr string) (options, error) {
 	var opts options
-	opts.user = user
-	opts.pass = pass
-	opts.dbAddr = dbAddr
-	opts.realm = realm
+	opts.graphqlEndpoint = graphqlEndpoint
 	opts.natsAddr = natsAddr
 
 	return opts, nil
@@ -200,7 +190,7 @@ func getCertifierPublish(ctx context.Context) (func(*processor.Document) error,
 	}, nil
 }
 
-func getPackageQuery(client neo4j.Driver) (func() certifier.QueryComponents, error) {
+func getPackageQuery(client graphql.Client) (func() certifier.QueryComponents, error) {
 	return func() certifier.QueryComponents {
 		packageQuery := root_package.NewPackageQuery(client)
 		return packageQuery
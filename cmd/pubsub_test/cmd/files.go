@@ -47,6 +47,8 @@ type options struct {
 	path string
 	// nats
 	natsAddr string
+	// graphql
+	graphqlEndpoint string
 }
 
 var filesCmd = &cobra.Command{
@@ -37,6 +37,9 @@ var flags = struct {
 
 	// nats
 	natsAddr string
+
+	// graphQL client flags
+	graphqlEndpoint string
 }{}
 
 func init() {
@@ -47,7 +50,8 @@ func init() {
 	persistentFlags.StringVar(&flags.gdbpass, "gdbpass", "", "neo4j password credential to connect to graph db")
 	persistentFlags.StringVar(&flags.realm, "realm", "neo4j", "realm to connect to graph db")
 	persistentFlags.StringVar(&flags.natsAddr, "natsaddr", "nats://127.0.0.1:4222", "address to connect to NATs Server")
-	flagNames := []string{"gdbaddr", "gdbuser", "gdbpass", "realm", "natsaddr"}
+	persistentFlags.StringVar(&flags.graphqlEndpoint, "gql-endpoint", "http://localhost:8080/query", "endpoint used to connect to graphQL server")
+	flagNames := []string{"gdbaddr", "gdbuser", "gdbpass", "realm", "natsaddr", "gql-endpoint"}
 	for _, name := range flagNames {
 		if flag := persistentFlags.Lookup(name); flag != nil {
 			if err := viper.BindPFlag(name, flag); err != nil {
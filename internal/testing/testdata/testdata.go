@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"reflect"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -44,6 +45,42 @@ var (
 	//go:embed exampledata/alpine-small-spdx.json
 	SpdxExampleAlpine []byte
 
+	// alpine-small-spdx.json as an SPDX 2.3 document
+	//go:embed exampledata/alpine-small-spdx-2.3.json
+	SpdxExampleAlpine23 []byte
+
+	// alpine-small-spdx.json serialised as YAML
+	//go:embed exampledata/alpine-small-spdx.yaml
+	SpdxExampleAlpineYAML []byte
+
+	// alpine-small-spdx.json serialised as tag-value
+	//go:embed exampledata/alpine-small-spdx.spdx
+	SpdxExampleAlpineTagValue []byte
+
+	// SPDX document covering the relationships mapped to GUAC evidence
+	//go:embed exampledata/spdx-relationships.json
+	SpdxRelationshipsExample []byte
+
+	// SPDX 3 JSON-LD document with software, build and security profile elements
+	//go:embed exampledata/spdx3-example.json
+	Spdx3Example []byte
+
+	// OpenVEX document with a statement for each status
+	//go:embed exampledata/openvex-example.json
+	OpenVEXExample []byte
+
+	// CSAF VEX document with products in every status
+	//go:embed exampledata/csaf-vex.json
+	CsafVexExample []byte
+
+	// OSV entry of the GitHub advisory database
+	//go:embed exampledata/osv-ghsa.json
+	OsvGhsaExample []byte
+
+	// OSV entry of the OpenSSF malicious packages database
+	//go:embed exampledata/osv-malicious.json
+	OsvMaliciousExample []byte
+
 	// Invalid types for field spdxVersion
 	//go:embed exampledata/invalid-spdx.json
 	SpdxInvalidExample []byte
@@ -149,6 +186,55 @@ var (
 		}
 	}`
 
+	ite6SLSAv1 = `
+	{
+		"_type": "https://in-toto.io/Statement/v1",
+		"subject": [{"name": "helloworld", "digest": {"sha256": "5678..."}}],
+		"predicateType": "https://slsa.dev/provenance/v1",
+		"predicate": {
+			"buildDefinition": {
+				"buildType": "https://slsa-framework.github.io/github-actions-buildtypes/workflow/v1",
+				"externalParameters": {
+					"workflow": {
+						"ref": "refs/heads/master",
+						"repository": "https://github.com/curl/curl-docker",
+						"path": ".github/workflows/build.yaml"
+					}
+				},
+				"resolvedDependencies": [
+					{
+						"uri": "git+https://github.com/curl/curl-docker@master",
+						"digest": { "sha1": "d6525c840a62b398424a78d792f457477135d0cf" }
+					}, {
+						"name": "github_hosted_vm:ubuntu-18.04:20210123.1",
+						"digest": { "sha1": "d6525c840a62b398424a78d792f457477135d0cf" }
+					}
+				]
+			},
+			"runDetails": {
+				"builder": { "id": "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@refs/tags/v1.7.0" },
+				"metadata": {
+					"invocationId": "https://github.com/curl/curl-docker/actions/runs/1/attempts/1",
+					"startedOn": "2023-01-01T12:34:56Z",
+					"finishedOn": "2023-01-01T12:40:00Z"
+				},
+				"byproducts": [
+					{ "name": "build.log", "digest": { "sha256": "abcd..." } }
+				]
+			}
+		}
+	}`
+
+	Ite6SLSAv1Doc = processor.Document{
+		Blob:   []byte(ite6SLSAv1),
+		Type:   processor.DocumentITE6SLSA,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: "TestCollector",
+			Source:    "TestSource",
+		},
+	}
+
 	b64ITE6SLSA    = base64.StdEncoding.EncodeToString([]byte(ite6SLSA))
 	Ite6Payload, _ = json.Marshal(dsse.Envelope{
 		PayloadType: "https://in-toto.io/Statement/v0.1",
@@ -276,6 +362,114 @@ var (
 		},
 	}
 
+	slsaStartTime, _ = time.Parse(time.RFC3339, "2020-08-19T08:38:00Z")
+	slsaCurlSrc      = &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "github.com/curl",
+		Name:      "curl-docker",
+		Tag:       strP("master"),
+	}
+	slsaV1StartTime, _   = time.Parse(time.RFC3339, "2023-01-01T12:34:56Z")
+	slsaV1FinishTime, _  = time.Parse(time.RFC3339, "2023-01-01T12:40:00Z")
+	slsaMaterialArtifact = &model.ArtifactInputSpec{
+		Algorithm: "sha1",
+		Digest:    "d6525c840a62b398424a78d792f457477135d0cf",
+	}
+
+	SlsaPreds = assembler.IngestPredicates{
+		IsOccurence: []assembler.IsOccurenceIngest{
+			{
+				Src:      slsaCurlSrc,
+				Artifact: slsaMaterialArtifact,
+				IsOccurence: &model.IsOccurrenceInputSpec{
+					Justification: "from SLSA definition of materials",
+				},
+			},
+		},
+		HasSlsa: []assembler.HasSlsaIngest{
+			{
+				Artifact: &model.ArtifactInputSpec{
+					Algorithm: "sha256",
+					Digest:    "5678...",
+				},
+				Materials: []model.PackageSourceOrArtifactInput{
+					{Source: slsaCurlSrc},
+					{Artifact: slsaMaterialArtifact},
+				},
+				Builder: &model.BuilderInputSpec{
+					Uri: "https://github.com/Attestations/GitHubHostedActions@v1",
+				},
+				HasSlsa: &model.SLSAInputSpec{
+					BuildType: "https://github.com/Attestations/GitHubActionsWorkflow@v1",
+					SlsaPredicate: []model.SLSAPredicateInputSpec{
+						{Key: "buildType", Value: "https://github.com/Attestations/GitHubActionsWorkflow@v1"},
+						{Key: "builder.id", Value: "https://github.com/Attestations/GitHubHostedActions@v1"},
+						{Key: "invocation.configSource.digest.sha1", Value: "d6525c840a62b398424a78d792f457477135d0cf"},
+						{Key: "invocation.configSource.entryPoint", Value: "build.yaml:maketgz"},
+						{Key: "invocation.configSource.uri", Value: "git+https://github.com/curl/curl-docker@master"},
+						{Key: "materials.0.digest.sha1", Value: "d6525c840a62b398424a78d792f457477135d0cf"},
+						{Key: "materials.0.uri", Value: "git+https://github.com/curl/curl-docker@master"},
+						{Key: "materials.1.digest.sha1", Value: "d6525c840a62b398424a78d792f457477135d0cf"},
+						{Key: "materials.1.uri", Value: "github_hosted_vm:ubuntu-18.04:20210123.1"},
+						{Key: "metadata.buildStartedOn", Value: "2020-08-19T08:38:00Z"},
+						{Key: "metadata.completeness.environment", Value: "true"},
+					},
+					SlsaVersion: "https://slsa.dev/provenance/v0.2",
+					StartedOn:   slsaStartTime,
+				},
+			},
+		},
+	}
+
+	SlsaV1Preds = assembler.IngestPredicates{
+		IsOccurence: []assembler.IsOccurenceIngest{
+			{
+				Src:      slsaCurlSrc,
+				Artifact: slsaMaterialArtifact,
+				IsOccurence: &model.IsOccurrenceInputSpec{
+					Justification: "from SLSA definition of materials",
+				},
+			},
+		},
+		HasSlsa: []assembler.HasSlsaIngest{
+			{
+				Artifact: &model.ArtifactInputSpec{
+					Algorithm: "sha256",
+					Digest:    "5678...",
+				},
+				Materials: []model.PackageSourceOrArtifactInput{
+					{Source: slsaCurlSrc},
+					{Artifact: slsaMaterialArtifact},
+				},
+				Builder: &model.BuilderInputSpec{
+					Uri: "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@refs/tags/v1.7.0",
+				},
+				HasSlsa: &model.SLSAInputSpec{
+					BuildType: "https://slsa-framework.github.io/github-actions-buildtypes/workflow/v1",
+					SlsaPredicate: []model.SLSAPredicateInputSpec{
+						{Key: "buildDefinition.buildType", Value: "https://slsa-framework.github.io/github-actions-buildtypes/workflow/v1"},
+						{Key: "buildDefinition.externalParameters.workflow.path", Value: ".github/workflows/build.yaml"},
+						{Key: "buildDefinition.externalParameters.workflow.ref", Value: "refs/heads/master"},
+						{Key: "buildDefinition.externalParameters.workflow.repository", Value: "https://github.com/curl/curl-docker"},
+						{Key: "buildDefinition.resolvedDependencies.0.digest.sha1", Value: "d6525c840a62b398424a78d792f457477135d0cf"},
+						{Key: "buildDefinition.resolvedDependencies.0.uri", Value: "git+https://github.com/curl/curl-docker@master"},
+						{Key: "buildDefinition.resolvedDependencies.1.digest.sha1", Value: "d6525c840a62b398424a78d792f457477135d0cf"},
+						{Key: "buildDefinition.resolvedDependencies.1.name", Value: "github_hosted_vm:ubuntu-18.04:20210123.1"},
+						{Key: "runDetails.builder.id", Value: "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_generic_slsa3.yml@refs/tags/v1.7.0"},
+						{Key: "runDetails.byproducts.0.digest.sha256", Value: "abcd..."},
+						{Key: "runDetails.byproducts.0.name", Value: "build.log"},
+						{Key: "runDetails.metadata.finishedOn", Value: "2023-01-01T12:40:00Z"},
+						{Key: "runDetails.metadata.invocationId", Value: "https://github.com/curl/curl-docker/actions/runs/1/attempts/1"},
+						{Key: "runDetails.metadata.startedOn", Value: "2023-01-01T12:34:56Z"},
+					},
+					SlsaVersion: "https://slsa.dev/provenance/v1",
+					StartedOn:   slsaV1StartTime,
+					FinishedOn:  slsaV1FinishTime,
+				},
+			},
+		},
+	}
+
 	// SPDX Testdata
 
 	topLevelPack, _       = asmhelpers.PurlToPkg("pkg:guac/oci/gcr.io/google-containers/alpine-latest")
@@ -406,206 +600,605 @@ var (
 		},
 	}
 
+	SpdxHasSBOM = []assembler.HasSBOMIngest{
+		{
+			Pkg: topLevelPack,
+			HasSBOM: &model.HasSBOMInputSpec{
+				Uri: "https://anchore.com/syft/image/alpine-latest-e78eca08-d9f4-49c7-97e0-6d4b9bfa99c2",
+			},
+		},
+	}
+
 	SpdxIngestionPredicates = assembler.IngestPredicates{
 		IsDependency: SpdxDeps,
 		IsOccurence:  SpdxOccurences,
+		HasSBOM:      SpdxHasSBOM,
 	}
 
-	// CycloneDX Testdata
+	spdxRelHelloPack, _     = asmhelpers.PurlToPkg("pkg:golang/github.com/example/hello@v1.0.0")
+	spdxRelHelloForkPack, _ = asmhelpers.PurlToPkg("pkg:golang/github.com/fork/hello@v1.0.0")
+	spdxRelGoPack, _        = asmhelpers.PurlToPkg("pkg:golang/stdlib@1.20.1")
+	spdxRelYamlPack, _      = asmhelpers.PurlToPkg("pkg:golang/gopkg.in/yaml.v3@v3.0.1")
+	spdxRelBinaryPack, _    = asmhelpers.PurlToPkg(asmhelpers.GuacFilePurl("sha256", "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9", strP("/usr/bin/hello")))
+	spdxRelBinaryArtifact   = &model.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9",
+	}
+	spdxRelCopyPack, _  = asmhelpers.PurlToPkg(asmhelpers.GuacFilePurl("sha1", "8f9e8d7c6b5a49382716f5e4d3c2b1a098765432", strP("/opt/hello/bin/hello")))
+	spdxRelCopyArtifact = &model.ArtifactInputSpec{
+		Algorithm: "sha1",
+		Digest:    "8f9e8d7c6b5a49382716f5e4d3c2b1a098765432",
+	}
+	spdxRelKnownSince, _ = time.Parse(time.RFC3339, "2023-03-01T10:00:00Z")
 
-	cdxTopLevelPack = assembler.PackageNode{
-		Name:    "gcr.io/distroless/static:nonroot",
-		Digest:  []string{"sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388"},
-		Version: "sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388",
-		Purl:    "pkg:oci/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?repository_url=gcr.io/distroless/static&tag=nonroot",
-		Tags:    []string{"container"},
-		CPEs:    nil,
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
+	spdx3HelloPack, _   = asmhelpers.PurlToPkg("pkg:golang/github.com/example/hello@v1.0.0")
+	spdx3YamlPack, _    = asmhelpers.PurlToPkg("pkg:golang/gopkg.in/yaml.v3@v3.0.1")
+	spdx3GoPack, _      = asmhelpers.PurlToPkg("pkg:golang/stdlib@1.22.2")
+	spdx3BinaryPack, _  = asmhelpers.PurlToPkg(asmhelpers.GuacFilePurl("sha256", "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9", strP("/usr/bin/hello")))
+	spdx3BinaryArtifact = &model.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9",
+	}
+	spdx3HelloSrc = &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "github.com/example",
+		Name:      "hello",
+		Tag:       strP("v1.0.0"),
 	}
+	spdx3Created, _    = time.Parse(time.RFC3339, "2024-05-02T12:00:00Z")
+	spdx3BuildStart, _ = time.Parse(time.RFC3339, "2024-05-02T11:50:00Z")
+	spdx3BuildEnd, _   = time.Parse(time.RFC3339, "2024-05-02T11:55:00Z")
+	spdx3Published, _  = time.Parse(time.RFC3339, "2024-05-03T09:00:00Z")
 
-	cdxTzdataPack = assembler.PackageNode{
-		Name:    "tzdata",
-		Digest:  nil,
-		Version: "2021a-1+deb11u6",
-		Purl:    "pkg:deb/debian/tzdata@2021a-1+deb11u6?arch=all&distro=debian-11",
-		CPEs: []string{
-			"cpe:2.3:a:tzdata:tzdata:2021a-1\\+deb11u6:*:*:*:*:*:*:*"},
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
+	Spdx3IngestionPredicates = assembler.IngestPredicates{
+		IsDependency: []assembler.IsDependencyIngest{
+			{
+				Pkg:          spdx3HelloPack,
+				DepPkg:       spdx3YamlPack,
+				IsDependency: &model.IsDependencyInputSpec{Justification: "Derived from SPDX dependsOn relationship"},
 			},
-		),
+			{
+				Pkg:          spdx3HelloPack,
+				DepPkg:       spdx3GoPack,
+				IsDependency: &model.IsDependencyInputSpec{Justification: "Derived from SPDX usesTool relationship with build scope"},
+			},
+		},
+		IsOccurence: []assembler.IsOccurenceIngest{
+			{
+				Pkg:         spdx3HelloPack,
+				Artifact:    spdx3BinaryArtifact,
+				IsOccurence: &model.IsOccurrenceInputSpec{Justification: "Derived from SPDX generates relationship"},
+			},
+			{
+				Pkg:         spdx3BinaryPack,
+				Artifact:    spdx3BinaryArtifact,
+				IsOccurence: &model.IsOccurrenceInputSpec{Justification: "spdx file with checksum"},
+			},
+		},
+		HasSBOM: []assembler.HasSBOMIngest{
+			{
+				Pkg:     spdx3HelloPack,
+				HasSBOM: &model.HasSBOMInputSpec{Uri: "https://example.com/spdx3/hello-1.0.0"},
+			},
+		},
+		HasSourceAt: []assembler.HasSourceAtIngest{
+			{
+				Pkg:          spdx3HelloPack,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				Src:          spdx3HelloSrc,
+				HasSourceAt: &model.HasSourceAtInputSpec{
+					KnownSince:    spdx3Created,
+					Justification: "spdx package download location",
+				},
+			},
+			{
+				Pkg:          spdx3YamlPack,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				Src: &model.SourceInputSpec{
+					Type:      "git",
+					Namespace: "github.com/go-yaml",
+					Name:      "yaml",
+					Tag:       strP("v3.0.1"),
+				},
+				HasSourceAt: &model.HasSourceAtInputSpec{
+					KnownSince:    spdx3Created,
+					Justification: "spdx package source info",
+				},
+			},
+		},
+		HasSlsa: []assembler.HasSlsaIngest{
+			{
+				Artifact: spdx3BinaryArtifact,
+				Materials: []model.PackageSourceOrArtifactInput{
+					{Source: spdx3HelloSrc},
+					{Package: spdx3YamlPack},
+				},
+				Builder: &model.BuilderInputSpec{Uri: "https://example.com/spdx3/agent/builder"},
+				HasSlsa: &model.SLSAInputSpec{
+					BuildType: "https://example.com/buildtypes/go-build@v1",
+					SlsaPredicate: []model.SLSAPredicateInputSpec{
+						{Key: "build_buildEndTime", Value: "2024-05-02T11:55:00Z"},
+						{Key: "build_buildId", Value: "build-42"},
+						{Key: "build_buildStartTime", Value: "2024-05-02T11:50:00Z"},
+						{Key: "build_buildType", Value: "https://example.com/buildtypes/go-build@v1"},
+						{Key: "build_configSourceEntrypoint.0", Value: "Makefile"},
+						{Key: "build_configSourceUri.0", Value: "git+https://github.com/example/hello@v1.0.0"},
+						{Key: "build_parameter.GOOS", Value: "linux"},
+					},
+					SlsaVersion: "https://spdx.org/rdf/3.0.1/terms/Build/Build",
+					StartedOn:   spdx3BuildStart,
+					FinishedOn:  spdx3BuildEnd,
+				},
+			},
+		},
+		Vex: []assembler.VexIngest{
+			{
+				Pkg: spdx3HelloPack,
+				CVE: &model.CVEInputSpec{Year: "2022", CveId: "CVE-2022-28948"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusNotAffected,
+					VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+					Statement:        "the YAML decoder is never used on untrusted input",
+					KnownSince:       spdx3Published,
+				},
+			},
+			{
+				Artifact: spdx3BinaryArtifact,
+				GHSA:     &model.GHSAInputSpec{GhsaId: "GHSA-hp87-p4gw-j4gq"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusAffected,
+					VexJustification: model.VexJustificationNotProvided,
+					ActionStatement:  "upgrade gopkg.in/yaml.v3 to v3.0.2",
+					KnownSince:       spdx3Created,
+				},
+			},
+		},
 	}
 
-	cdxNetbasePack = assembler.PackageNode{
-		Name:    "netbase",
-		Digest:  nil,
-		Version: "6.3",
-		Purl:    "pkg:deb/debian/netbase@6.3?arch=all&distro=debian-11",
-		CPEs: []string{
-			"cpe:2.3:a:netbase:netbase:6.3:*:*:*:*:*:*:*"},
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
+	openVEXOpensslPack, _ = asmhelpers.PurlToPkg("pkg:apk/wolfi/openssl@3.0.8-r3?arch=x86_64")
+	openVEXWolfiPack, _   = asmhelpers.PurlToPkg("pkg:oci/wolfi-base@sha256%3Ae4b9d8e3a7f0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a?repository_url=cgr.dev%2Fchainguard")
+	openVEXNetPack, _     = asmhelpers.PurlToPkg("pkg:golang/golang.org/x/net@v0.7.0")
+	openVEXDocTime, _     = time.Parse(time.RFC3339, "2023-01-09T00:02:03.647787998Z")
+	openVEXTime, _        = time.Parse(time.RFC3339, "2023-04-20T10:00:00Z")
+	openVEXUpdated, _     = time.Parse(time.RFC3339, "2023-05-01T08:30:00Z")
+
+	OpenVEXIngestionPredicates = assembler.IngestPredicates{
+		Vex: []assembler.VexIngest{
+			{
+				Pkg: openVEXOpensslPack,
+				CVE: &model.CVEInputSpec{Year: "2023", CveId: "CVE-2023-1255"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusNotAffected,
+					VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+					Statement:        "the affected AES-XTS cipher is only available on 64 bit ARM",
+					KnownSince:       openVEXTime,
+				},
 			},
-		),
+			{
+				Artifact: &model.ArtifactInputSpec{
+					Algorithm: "sha256",
+					Digest:    "3a4d3f28d5e0a8e2b8b9f1c0e7f6d5c4b3a29180706f5e4d3c2b1a0918273645",
+				},
+				CVE: &model.CVEInputSpec{Year: "2023", CveId: "CVE-2023-1255"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusNotAffected,
+					VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+					Statement:        "the affected AES-XTS cipher is only available on 64 bit ARM",
+					KnownSince:       openVEXTime,
+				},
+			},
+			{
+				Pkg:  openVEXWolfiPack,
+				GHSA: &model.GHSAInputSpec{GhsaId: "GHSA-jfhm-5ghh-2f97"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusAffected,
+					VexJustification: model.VexJustificationNotProvided,
+					StatusNotes:      "reachable through certificate verification",
+					ActionStatement:  "Upgrade openssl to 3.1.0-r1 or later",
+					KnownSince:       openVEXDocTime,
+				},
+			},
+			{
+				Pkg: openVEXNetPack,
+				CVE: &model.CVEInputSpec{Year: "2022", CveId: "CVE-2022-41723"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusFixed,
+					VexJustification: model.VexJustificationNotProvided,
+					KnownSince:       openVEXUpdated,
+				},
+			},
+			{
+				Pkg: openVEXNetPack,
+				OSV: &model.OSVInputSpec{OsvId: "GO-2023-1840"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusUnderInvestigation,
+					VexJustification: model.VexJustificationNotProvided,
+					KnownSince:       openVEXDocTime,
+				},
+			},
+		},
 	}
 
-	cdxBasefilesPack = assembler.PackageNode{
-		Name:    "base-files",
-		Digest:  nil,
-		Version: "11.1+deb11u5",
-		Purl:    "pkg:deb/debian/base-files@11.1+deb11u5?arch=amd64&distro=debian-11",
-		CPEs: []string{
-			"cpe:2.3:a:base-files:base-files:11.1\\+deb11u5:*:*:*:*:*:*:*"},
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
+	csafOpenssl1Pack, _  = asmhelpers.PurlToPkg("pkg:rpm/example/openssl@3.0.7-1?arch=x86_64")
+	csafOpenssl2Pack, _  = asmhelpers.PurlToPkg("pkg:rpm/example/openssl@3.0.7-2?arch=x86_64")
+	csafTLSProxyPack, _  = asmhelpers.PurlToPkg("pkg:golang/example.com/tls-proxy@v2.4.0")
+	csafMailPack, _      = asmhelpers.PurlToPkg("pkg:npm/example-mail@1.0.0")
+	csafOpenssl2Artifact = &model.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "9c6d1e3f1b2a4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5",
 	}
+	csafCVE            = &model.CVEInputSpec{Year: "2023", CveId: "CVE-2023-0286"}
+	csafReleaseDate, _ = time.Parse(time.RFC3339, "2023-03-15T12:30:00Z")
+	csafVulnPublished  = time.Date(2023, 2, 7, 0, 0, 0, 0, time.UTC)
 
-	CycloneDXNodes = []assembler.GuacNode{cdxTopLevelPack, cdxBasefilesPack, cdxNetbasePack, cdxTzdataPack}
-	CyloneDXEdges  = []assembler.GuacEdge{
-		assembler.DependsOnEdge{
-			PackageDependency: cdxBasefilesPack,
-			PackageNode:       cdxTopLevelPack,
+	CsafVexIngestionPredicates = assembler.IngestPredicates{
+		IsOccurence: []assembler.IsOccurenceIngest{
+			{
+				Pkg:         csafOpenssl2Pack,
+				Artifact:    csafOpenssl2Artifact,
+				IsOccurence: &model.IsOccurrenceInputSpec{Justification: "csaf product with hash"},
+			},
 		},
-		assembler.DependsOnEdge{
-			PackageDependency: cdxNetbasePack,
-			PackageNode:       cdxTopLevelPack,
+		IsVuln: []assembler.IsVulnIngest{
+			{
+				OSV:    &model.OSVInputSpec{OsvId: "GHSA-x4qr-2fvf-3mr5"},
+				CVE:    csafCVE,
+				IsVuln: &model.IsVulnerabilityInputSpec{Justification: "from CSAF vulnerability ids"},
+			},
 		},
-		assembler.DependsOnEdge{
-			PackageDependency: cdxTzdataPack,
-			PackageNode:       cdxTopLevelPack,
+		CertifyVuln: []assembler.CertifyVulnIngest{
+			{
+				Pkg: csafOpenssl1Pack,
+				CVE: csafCVE,
+				VulnData: &model.VulnerabilityMetaDataInput{
+					TimeScanned: csafReleaseDate,
+					Origin:      "https://psirt.example.com/EXAMPLE-VEX-2023-0001",
+				},
+			},
 		},
-	}
-
-	// CycloneDX Testdata with package dependencies
-	cdxTopQuarkusPack = assembler.PackageNode{
-		Name:    "getting-started",
-		Version: "1.0.0-SNAPSHOT",
-		Purl:    "pkg:maven/org.acme/getting-started@1.0.0-SNAPSHOT?type=jar",
-		Tags:    []string{"library"},
-		CPEs:    nil,
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
+		Vex: []assembler.VexIngest{
+			{
+				Pkg: csafTLSProxyPack,
+				CVE: csafCVE,
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusNotAffected,
+					VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+					Statement:        "CRL checking is not enabled",
+					KnownSince:       csafReleaseDate,
+				},
 			},
-		),
-	}
-
-	cdxResteasyPack = assembler.PackageNode{
-		Name:    "quarkus-resteasy-reactive",
-		Digest:  nil,
-		Version: "2.13.4.Final",
-		Purl:    "pkg:maven/io.quarkus/quarkus-resteasy-reactive@2.13.4.Final?type=jar",
-		CPEs:    nil,
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
+			{
+				Pkg: csafOpenssl2Pack,
+				CVE: csafCVE,
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusFixed,
+					VexJustification: model.VexJustificationNotProvided,
+					ActionStatement:  "Update openssl to 3.0.7-2",
+					KnownSince:       csafReleaseDate,
+				},
 			},
-		),
+			{
+				Artifact: csafOpenssl2Artifact,
+				CVE:      csafCVE,
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusFixed,
+					VexJustification: model.VexJustificationNotProvided,
+					ActionStatement:  "Update openssl to 3.0.7-2",
+					KnownSince:       csafReleaseDate,
+				},
+			},
+			{
+				Pkg: csafMailPack,
+				CVE: csafCVE,
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusUnderInvestigation,
+					VexJustification: model.VexJustificationNotProvided,
+					KnownSince:       csafReleaseDate,
+				},
+			},
+		},
+		VulnMetadata: []assembler.VulnMetadataIngest{
+			{
+				CVE: csafCVE,
+				VulnMetadata: &model.VulnMetadataInputSpec{
+					Summary: "A type confusion vulnerability in the processing of X.400 addresses inside an X.509 GeneralName " +
+						"may allow an attacker to read memory contents or cause a denial of service.",
+					Scores: []model.VulnScoreInputSpec{{
+						ScoreType: model.VulnScoreTypeCvssV3,
+						Vector:    "CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:H/I:N/A:H",
+						Score:     7.4,
+					}},
+					CweIds:     []string{"CWE-843"},
+					References: []string{"https://www.cve.org/CVERecord?id=CVE-2023-0286"},
+					Published:  &csafVulnPublished,
+					Modified:   &csafReleaseDate,
+				},
+			},
+		},
 	}
 
-	cdxReactiveCommonPack = assembler.PackageNode{
-		Name:    "quarkus-resteasy-reactive-common",
-		Digest:  nil,
-		Version: "2.13.4.Final",
-		Purl:    "pkg:maven/io.quarkus/quarkus-resteasy-reactive-common@2.13.4.Final?type=jar",
-		CPEs:    nil,
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
+	osvLog4jPack, _    = asmhelpers.OSVToPkg("Maven", "org.apache.logging.log4j:log4j-core")
+	osvPaxLoggingPack  = &model.PkgInputSpec{Type: "maven", Namespace: strP("org.ops4j.pax.logging"), Name: "pax-logging-log4j2"}
+	osvGhsa            = &model.GHSAInputSpec{GhsaId: "GHSA-7rjr-3q55-vv33"}
+	osvGhsaPublished   = time.Date(2021, 12, 14, 18, 1, 28, 0, time.UTC)
+	osvGhsaModified    = time.Date(2023, 3, 8, 19, 41, 38, 0, time.UTC)
+	osvUAParserPack, _ = asmhelpers.OSVToPkg("npm", "ua-parser-js")
+	osvTyposquatPack   = &model.PkgInputSpec{Type: "npm", Namespace: strP("@evil"), Name: "typosquat"}
+	osvMalicious       = &model.OSVInputSpec{OsvId: "MAL-2022-4691"}
+	osvMaliciousBad    = &model.CertifyBadInputSpec{Justification: "malicious package (MAL-2022-4691): Malicious code in ua-parser-js (npm)"}
+	osvMalPublished    = time.Date(2022, 6, 20, 20, 16, 11, 0, time.UTC)
+	osvMalModified     = time.Date(2023, 5, 17, 10, 12, 2, 0, time.UTC)
+
+	OsvGhsaIngestionPredicates = assembler.IngestPredicates{
+		IsVuln: []assembler.IsVulnIngest{
+			{
+				OSV:    &model.OSVInputSpec{OsvId: "GHSA-7rjr-3q55-vv33"},
+				GHSA:   osvGhsa,
+				IsVuln: &model.IsVulnerabilityInputSpec{Justification: "from OSV aliases"},
 			},
-		),
+			{
+				OSV:    &model.OSVInputSpec{OsvId: "GHSA-7rjr-3q55-vv33"},
+				CVE:    &model.CVEInputSpec{Year: "2021", CveId: "CVE-2021-45046"},
+				IsVuln: &model.IsVulnerabilityInputSpec{Justification: "from OSV aliases"},
+			},
+		},
+		VulnMetadata: []assembler.VulnMetadataIngest{
+			{
+				GHSA: osvGhsa,
+				VulnMetadata: &model.VulnMetadataInputSpec{
+					Summary: "Incomplete fix for Apache Log4j vulnerability",
+					Scores: []model.VulnScoreInputSpec{{
+						ScoreType: model.VulnScoreTypeCvssV3,
+						Vector:    "CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:C/C:H/I:H/A:H",
+						Score:     9,
+					}},
+					CweIds: []string{"CWE-502", "CWE-917"},
+					References: []string{
+						"https://nvd.nist.gov/vuln/detail/CVE-2021-45046",
+						"https://logging.apache.org/log4j/2.x/security.html",
+					},
+					Published: &osvGhsaPublished,
+					Modified:  &osvGhsaModified,
+				},
+			},
+		},
+		VulnAffected: []assembler.VulnAffectedIngest{
+			{
+				Pkg:  osvLog4jPack,
+				GHSA: osvGhsa,
+				VulnAffected: &model.VulnAffectedInputSpec{
+					Ranges: []model.AffectedRangeInputSpec{
+						{
+							RangeType: model.AffectedRangeTypeEcosystem,
+							Events: []model.AffectedEventInputSpec{
+								{EventType: model.AffectedEventTypeIntroduced, Version: "2.13.0"},
+								{EventType: model.AffectedEventTypeFixed, Version: "2.16.0"},
+							},
+						},
+						{
+							RangeType: model.AffectedRangeTypeEcosystem,
+							Events: []model.AffectedEventInputSpec{
+								{EventType: model.AffectedEventTypeIntroduced, Version: "0"},
+								{EventType: model.AffectedEventTypeFixed, Version: "2.12.2"},
+							},
+						},
+					},
+				},
+			},
+			{
+				Pkg:  osvPaxLoggingPack,
+				GHSA: osvGhsa,
+				VulnAffected: &model.VulnAffectedInputSpec{
+					Versions: []string{"1.11.10", "1.11.11"},
+				},
+			},
+		},
 	}
 
-	CycloneDXQuarkusNodes = []assembler.GuacNode{cdxTopQuarkusPack, cdxResteasyPack, cdxReactiveCommonPack}
-	CyloneDXQuarkusEdges  = []assembler.GuacEdge{
-		assembler.DependsOnEdge{
-			PackageDependency: cdxResteasyPack,
-			PackageNode:       cdxTopQuarkusPack,
+	OsvMaliciousIngestionPredicates = assembler.IngestPredicates{
+		VulnMetadata: []assembler.VulnMetadataIngest{
+			{
+				OSV: osvMalicious,
+				VulnMetadata: &model.VulnMetadataInputSpec{
+					Summary:    "Malicious code in ua-parser-js (npm)",
+					References: []string{"https://github.com/advisories/GHSA-pjwm-rvh2-c87w"},
+					Published:  &osvMalPublished,
+					Modified:   &osvMalModified,
+				},
+			},
 		},
-		assembler.DependsOnEdge{
-			PackageDependency: cdxReactiveCommonPack,
-			PackageNode:       cdxTopQuarkusPack,
+		VulnAffected: []assembler.VulnAffectedIngest{
+			{
+				Pkg: osvUAParserPack,
+				OSV: osvMalicious,
+				VulnAffected: &model.VulnAffectedInputSpec{
+					Versions: []string{"0.7.29", "0.8.0", "1.0.0"},
+				},
+			},
+			{
+				Pkg: osvTyposquatPack,
+				OSV: osvMalicious,
+				VulnAffected: &model.VulnAffectedInputSpec{
+					Ranges: []model.AffectedRangeInputSpec{{
+						RangeType: model.AffectedRangeTypeSemver,
+						Events: []model.AffectedEventInputSpec{
+							{EventType: model.AffectedEventTypeIntroduced, Version: "0"},
+						},
+					}},
+				},
+			},
 		},
-		assembler.DependsOnEdge{
-			PackageDependency: cdxReactiveCommonPack,
-			PackageNode:       cdxResteasyPack,
+		CertifyBad: []assembler.CertifyBadIngest{
+			{
+				Pkg:          &model.PkgInputSpec{Type: "npm", Namespace: osvUAParserPack.Namespace, Name: "ua-parser-js", Version: strP("0.7.29")},
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				CertifyBad:   osvMaliciousBad,
+			},
+			{
+				Pkg:          &model.PkgInputSpec{Type: "npm", Namespace: osvUAParserPack.Namespace, Name: "ua-parser-js", Version: strP("0.8.0")},
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				CertifyBad:   osvMaliciousBad,
+			},
+			{
+				Pkg:          &model.PkgInputSpec{Type: "npm", Namespace: osvUAParserPack.Namespace, Name: "ua-parser-js", Version: strP("1.0.0")},
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				CertifyBad:   osvMaliciousBad,
+			},
+			{
+				Pkg:          osvTyposquatPack,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
+				CertifyBad:   osvMaliciousBad,
+			},
 		},
 	}
 
-	cdxWebAppPackage = assembler.PackageNode{
-		Name:    "web-app",
-		Digest:  nil,
-		Version: "1.0.0",
-		Purl:    "pkg:npm/web-app@1.0.0",
-		Tags:    []string{"application"},
-		CPEs:    nil,
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
+	SpdxRelationshipsIngestionPredicates = assembler.IngestPredicates{
+		IsDependency: []assembler.IsDependencyIngest{
+			{
+				Pkg:          spdxRelHelloPack,
+				DepPkg:       spdxRelYamlPack,
+				IsDependency: &model.IsDependencyInputSpec{Justification: "Derived from SPDX DEPENDENCY_OF relationship"},
 			},
-		),
-	}
-	cdxBootstrapPackage = assembler.PackageNode{
-		Name:    "bootstrap",
-		Digest:  nil,
-		Version: "4.0.0-beta.2",
-		Purl:    "pkg:npm/bootstrap@4.0.0-beta.2",
-		CPEs:    nil,
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
+			{
+				Pkg:          spdxRelHelloPack,
+				DepPkg:       spdxRelGoPack,
+				IsDependency: &model.IsDependencyInputSpec{Justification: "Derived from SPDX BUILD_TOOL_OF relationship"},
 			},
-		),
+		},
+		IsOccurence: []assembler.IsOccurenceIngest{
+			{
+				Pkg:         spdxRelHelloPack,
+				Artifact:    spdxRelBinaryArtifact,
+				IsOccurence: &model.IsOccurrenceInputSpec{Justification: "Derived from SPDX GENERATED_FROM relationship"},
+			},
+			{
+				Pkg:         spdxRelBinaryPack,
+				Artifact:    spdxRelBinaryArtifact,
+				IsOccurence: &model.IsOccurrenceInputSpec{Justification: "spdx file with checksum"},
+			},
+			{
+				Pkg:         spdxRelCopyPack,
+				Artifact:    spdxRelCopyArtifact,
+				IsOccurence: &model.IsOccurrenceInputSpec{Justification: "spdx file with checksum"},
+			},
+		},
+		HasSourceAt: []assembler.HasSourceAtIngest{
+			{
+				Pkg:          spdxRelHelloPack,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				Src: &model.SourceInputSpec{
+					Type:      "git",
+					Namespace: "github.com/example",
+					Name:      "hello",
+					Tag:       strP("v1.0.0"),
+				},
+				HasSourceAt: &model.HasSourceAtInputSpec{
+					KnownSince:    spdxRelKnownSince,
+					Justification: "spdx package download location",
+				},
+			},
+			{
+				Pkg:          spdxRelYamlPack,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				Src: &model.SourceInputSpec{
+					Type:      "git",
+					Namespace: "github.com/go-yaml",
+					Name:      "yaml",
+					Tag:       strP("v3.0.1"),
+				},
+				HasSourceAt: &model.HasSourceAtInputSpec{
+					KnownSince:    spdxRelKnownSince,
+					Justification: "spdx package source info",
+				},
+			},
+		},
+		HasSBOM: []assembler.HasSBOMIngest{
+			{
+				Pkg:     spdxRelHelloPack,
+				HasSBOM: &model.HasSBOMInputSpec{Uri: "https://example.com/spdx/hello-world-1.0.0"},
+			},
+		},
+		HashEqual: []assembler.HashEqualIngest{
+			{
+				Artifact:      spdxRelCopyArtifact,
+				EqualArtifact: spdxRelBinaryArtifact,
+				HashEqual:     &model.HashEqualInputSpec{Justification: "Derived from SPDX COPY_OF relationship"},
+			},
+		},
+		CertifyPkg: []assembler.CertifyPkgIngest{
+			{
+				Pkg:        spdxRelHelloForkPack,
+				DepPkg:     spdxRelHelloPack,
+				CertifyPkg: &model.CertifyPkgInputSpec{Justification: "Derived from SPDX VARIANT_OF relationship"},
+			},
+		},
 	}
 
-	NpmMissingDependsOnCycloneDXNodes = []assembler.GuacNode{
-		cdxWebAppPackage,
-		cdxBootstrapPackage,
+	// CycloneDX Testdata
+
+	cdxTopLevelPack, _       = asmhelpers.PurlToPkg("pkg:oci/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?repository_url=gcr.io/distroless/static&tag=nonroot")
+	cdxTopLevelArtifact      = &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388"}
+	cdxBasefilesPack, _      = asmhelpers.PurlToPkg("pkg:deb/debian/base-files@11.1+deb11u5?arch=amd64&distro=debian-11")
+	cdxNetbasePack, _        = asmhelpers.PurlToPkg("pkg:deb/debian/netbase@6.3?arch=all&distro=debian-11")
+	cdxTzdataPack, _         = asmhelpers.PurlToPkg("pkg:deb/debian/tzdata@2021a-1+deb11u6?arch=all&distro=debian-11")
+	cdxTopQuarkusPack, _     = asmhelpers.PurlToPkg("pkg:maven/org.acme/getting-started@1.0.0-SNAPSHOT?type=jar")
+	cdxResteasyPack, _       = asmhelpers.PurlToPkg("pkg:maven/io.quarkus/quarkus-resteasy-reactive@2.13.4.Final?type=jar")
+	cdxReactiveCommonPack, _ = asmhelpers.PurlToPkg("pkg:maven/io.quarkus/quarkus-resteasy-reactive-common@2.13.4.Final?type=jar")
+	cdxWebAppPackage, _      = asmhelpers.PurlToPkg("pkg:npm/web-app@1.0.0")
+	cdxBootstrapPackage, _   = asmhelpers.PurlToPkg("pkg:npm/bootstrap@4.0.0-beta.2")
+
+	cdxTopLevelIsDep = &model.IsDependencyInputSpec{
+		Justification: "top-level package GUAC heuristic connecting to each file/package",
 	}
-	NpmMissingDependsOnCycloneDXEdges = []assembler.GuacEdge{
-		assembler.DependsOnEdge{
-			PackageDependency: cdxBootstrapPackage,
-			PackageNode:       cdxWebAppPackage,
+	cdxDependencyIsDep = &model.IsDependencyInputSpec{
+		Justification: "CDX BOM Dependency",
+	}
+	cdxIsOccJustify = &model.IsOccurrenceInputSpec{
+		Justification: "cdx package with checksum",
+	}
+
+	CdxIngestionPredicates = assembler.IngestPredicates{
+		IsDependency: []assembler.IsDependencyIngest{
+			{Pkg: cdxTopLevelPack, DepPkg: cdxBasefilesPack, IsDependency: cdxTopLevelIsDep},
+			{Pkg: cdxTopLevelPack, DepPkg: cdxNetbasePack, IsDependency: cdxTopLevelIsDep},
+			{Pkg: cdxTopLevelPack, DepPkg: cdxTzdataPack, IsDependency: cdxTopLevelIsDep},
+		},
+		IsOccurence: []assembler.IsOccurenceIngest{
+			{Pkg: cdxTopLevelPack, Artifact: cdxTopLevelArtifact, IsOccurence: cdxIsOccJustify},
 		},
 	}
 
-	cdxQuarkusParentPackage = assembler.PackageNode{
-		Name:    "quarkus-parent",
-		Digest:  nil,
-		Version: "999-SNAPSHOT",
-		Purl:    "pkg:maven/io.quarkus/quarkus-parent@999-SNAPSHOT?type=pom",
-		Tags:    []string{"library"},
-		CPEs:    nil,
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
+	CdxQuarkusIngestionPredicates = assembler.IngestPredicates{
+		IsDependency: []assembler.IsDependencyIngest{
+			{Pkg: cdxTopQuarkusPack, DepPkg: cdxResteasyPack, IsDependency: cdxDependencyIsDep},
+			{Pkg: cdxResteasyPack, DepPkg: cdxReactiveCommonPack, IsDependency: cdxDependencyIsDep},
+		},
+		IsOccurence: []assembler.IsOccurenceIngest{
+			{
+				Pkg:         cdxResteasyPack,
+				Artifact:    &model.ArtifactInputSpec{Algorithm: "md5", Digest: "bf39044af8c6ba66fc3beb034bc82ae8"},
+				IsOccurence: cdxIsOccJustify,
 			},
-		),
+			{
+				Pkg:         cdxResteasyPack,
+				Artifact:    &model.ArtifactInputSpec{Algorithm: "sha3-512", Digest: "615e56bdfeb591af8b5fdeadf019f8fa729643232d7e0768674411a7d959bb00e12e114280a6949f871514e1a86e01e0033372a0a826d15720050d7cffb80e69"},
+				IsOccurence: cdxIsOccJustify,
+			},
+			{
+				Pkg:         cdxReactiveCommonPack,
+				Artifact:    &model.ArtifactInputSpec{Algorithm: "sha3-512", Digest: "54ffa51cb2fb25e70871e4b69489814ebb3d23d4f958e83ef1f811c00a8753c6c30c5bbc1b48b6427357eb70e5c35c7b357f5252e246fbfa00b90ee22ad095e1"},
+				IsOccurence: cdxIsOccJustify,
+			},
+		},
 	}
 
-	CycloneDXNoDependentComponentsNodes = []assembler.GuacNode{
-		cdxQuarkusParentPackage,
+	CdxNpmMissingDependsOnIngestionPredicates = assembler.IngestPredicates{
+		IsDependency: []assembler.IsDependencyIngest{
+			{Pkg: cdxWebAppPackage, DepPkg: cdxBootstrapPackage, IsDependency: cdxTopLevelIsDep},
+		},
 	}
-	CyloneDXNoDependentComponentsEdges = []assembler.GuacEdge{}
+
+	CdxNoDependentComponentsIngestionPredicates = assembler.IngestPredicates{}
 
 	// ceritifer testdata
 
@@ -1097,6 +1690,20 @@ var IngestPredicatesCmpOpts = []cmp.Option{
 	cmpopts.SortSlices(isDependencyLess),
 	cmpopts.SortSlices(isOccurenceLess),
 	cmpopts.SortSlices(packageQualifierInputSpecLess),
+	cmpopts.SortSlices(hasSlsaLess),
+	cmpopts.SortSlices(certifyVulnLess),
+	cmpopts.SortSlices(isVulnLess),
+	cmpopts.SortSlices(hasSourceAtLess),
+	cmpopts.SortSlices(certifyBadLess),
+	cmpopts.SortSlices(hasSBOMLess),
+	cmpopts.SortSlices(hashEqualLess),
+	cmpopts.SortSlices(certifyPkgLess),
+	cmpopts.SortSlices(vexLess),
+	cmpopts.SortSlices(vulnMetadataLess),
+	cmpopts.SortSlices(vulnAffectedLess),
+	cmpopts.SortSlices(certifyEOLLess),
+	cmpopts.SortSlices(psaInputSpecLess),
+	cmpopts.SortSlices(slsaPredicateInputSpecLess),
 }
 
 func certifyScorecardLess(e1, e2 assembler.CertifyScorecardIngest) bool {
@@ -1115,6 +1722,62 @@ func packageQualifierInputSpecLess(e1, e2 generated.PackageQualifierInputSpec) b
 	return gLess(e1, e2)
 }
 
+func hasSlsaLess(e1, e2 assembler.HasSlsaIngest) bool {
+	return gLess(e1, e2)
+}
+
+func certifyVulnLess(e1, e2 assembler.CertifyVulnIngest) bool {
+	return gLess(e1, e2)
+}
+
+func isVulnLess(e1, e2 assembler.IsVulnIngest) bool {
+	return gLess(e1, e2)
+}
+
+func hasSourceAtLess(e1, e2 assembler.HasSourceAtIngest) bool {
+	return gLess(e1, e2)
+}
+
+func certifyBadLess(e1, e2 assembler.CertifyBadIngest) bool {
+	return gLess(e1, e2)
+}
+
+func hasSBOMLess(e1, e2 assembler.HasSBOMIngest) bool {
+	return gLess(e1, e2)
+}
+
+func hashEqualLess(e1, e2 assembler.HashEqualIngest) bool {
+	return gLess(e1, e2)
+}
+
+func certifyPkgLess(e1, e2 assembler.CertifyPkgIngest) bool {
+	return gLess(e1, e2)
+}
+
+func vexLess(e1, e2 assembler.VexIngest) bool {
+	return gLess(e1, e2)
+}
+
+func vulnMetadataLess(e1, e2 assembler.VulnMetadataIngest) bool {
+	return gLess(e1, e2)
+}
+
+func vulnAffectedLess(e1, e2 assembler.VulnAffectedIngest) bool {
+	return gLess(e1, e2)
+}
+
+func certifyEOLLess(e1, e2 assembler.CertifyEOLIngest) bool {
+	return gLess(e1, e2)
+}
+
+func psaInputSpecLess(e1, e2 generated.PackageSourceOrArtifactInput) bool {
+	return gLess(e1, e2)
+}
+
+func slsaPredicateInputSpecLess(e1, e2 generated.SLSAPredicateInputSpec) bool {
+	return gLess(e1, e2)
+}
+
 func gLess(e1, e2 any) bool {
 	s1, _ := json.Marshal(e1)
 	s2, _ := json.Marshal(e2)
@@ -148,6 +148,18 @@ type IngestPredicates struct {
 	CertifyScorecard []CertifyScorecardIngest
 	IsDependency     []IsDependencyIngest
 	IsOccurence      []IsOccurenceIngest
+	HasSlsa          []HasSlsaIngest
+	CertifyVuln      []CertifyVulnIngest
+	IsVuln           []IsVulnIngest
+	HasSourceAt      []HasSourceAtIngest
+	CertifyBad       []CertifyBadIngest
+	HasSBOM          []HasSBOMIngest
+	HashEqual        []HashEqualIngest
+	CertifyPkg       []CertifyPkgIngest
+	Vex              []VexIngest
+	VulnMetadata     []VulnMetadataIngest
+	VulnAffected     []VulnAffectedIngest
+	CertifyEOL       []CertifyEOLIngest
 }
 
 type CertifyScorecardIngest struct {
@@ -172,5 +184,121 @@ type IsOccurenceIngest struct {
 	IsOccurence *generated.IsOccurrenceInputSpec
 }
 
+type HasSlsaIngest struct {
+	// HasSlsa describes either pkg, src or artifact
+	Pkg      *generated.PkgInputSpec
+	Src      *generated.SourceInputSpec
+	Artifact *generated.ArtifactInputSpec
+
+	// Materials are the packages, sources or artifacts the subject was
+	// built from
+	Materials []generated.PackageSourceOrArtifactInput
+
+	// Builder is the required builder of the subject
+	Builder *generated.BuilderInputSpec
+
+	HasSlsa *generated.SLSAInputSpec
+}
+
+type CertifyVulnIngest struct {
+	Pkg *generated.PkgInputSpec
+
+	// Vulnerability is either osv, cve or ghsa
+	OSV  *generated.OSVInputSpec
+	CVE  *generated.CVEInputSpec
+	GHSA *generated.GHSAInputSpec
+	// NoVuln records that the package was scanned and no vulnerability
+	// was found, in place of a vulnerability
+	NoVuln bool
+
+	VulnData *generated.VulnerabilityMetaDataInput
+}
+
+type IsVulnIngest struct {
+	OSV *generated.OSVInputSpec
+
+	// Vulnerability is either cve or ghsa
+	CVE  *generated.CVEInputSpec
+	GHSA *generated.GHSAInputSpec
+
+	IsVuln *generated.IsVulnerabilityInputSpec
+}
+
+type HasSourceAtIngest struct {
+	Pkg          *generated.PkgInputSpec
+	PkgMatchFlag generated.MatchFlags
+	Src          *generated.SourceInputSpec
+	HasSourceAt  *generated.HasSourceAtInputSpec
+}
+
+type CertifyBadIngest struct {
+	// CertifyBad describes either pkg, src or artifact
+	Pkg          *generated.PkgInputSpec
+	Src          *generated.SourceInputSpec
+	Artifact     *generated.ArtifactInputSpec
+	PkgMatchFlag generated.MatchFlags
+
+	CertifyBad *generated.CertifyBadInputSpec
+}
+
+type HasSBOMIngest struct {
+	// HasSBOM describes either pkg or src
+	Pkg *generated.PkgInputSpec
+	Src *generated.SourceInputSpec
+
+	HasSBOM *generated.HasSBOMInputSpec
+}
+
+type HashEqualIngest struct {
+	Artifact      *generated.ArtifactInputSpec
+	EqualArtifact *generated.ArtifactInputSpec
+	HashEqual     *generated.HashEqualInputSpec
+}
+
+type CertifyPkgIngest struct {
+	Pkg        *generated.PkgInputSpec
+	DepPkg     *generated.PkgInputSpec
+	CertifyPkg *generated.CertifyPkgInputSpec
+}
+
+type VexIngest struct {
+	// Vex describes either pkg or artifact
+	Pkg      *generated.PkgInputSpec
+	Artifact *generated.ArtifactInputSpec
+
+	// Vulnerability is either osv, cve or ghsa
+	OSV  *generated.OSVInputSpec
+	CVE  *generated.CVEInputSpec
+	GHSA *generated.GHSAInputSpec
+
+	VexData *generated.VexStatementInputSpec
+}
+
+type VulnMetadataIngest struct {
+	// Vulnerability is either osv, cve or ghsa
+	OSV  *generated.OSVInputSpec
+	CVE  *generated.CVEInputSpec
+	GHSA *generated.GHSAInputSpec
+
+	VulnMetadata *generated.VulnMetadataInputSpec
+}
+
+type VulnAffectedIngest struct {
+	// Pkg is the affected package, only its type, namespace and name are used
+	Pkg *generated.PkgInputSpec
+
+	// Vulnerability is either osv, cve or ghsa
+	OSV  *generated.OSVInputSpec
+	CVE  *generated.CVEInputSpec
+	GHSA *generated.GHSAInputSpec
+
+	VulnAffected *generated.VulnAffectedInputSpec
+}
+
+type CertifyEOLIngest struct {
+	Pkg        *generated.PkgInputSpec
+	CertifyEOL *generated.CertifyEOLInputSpec
+}
+
 // AssemblerInput represents the inputs to add to the graph
 type AssemblerInput = IngestPredicates
@@ -47,6 +47,50 @@ type Backend interface {
 	IsVulnerability(ctx context.Context, isVulnerabilitySpec *model.IsVulnerabilitySpec) ([]*model.IsVulnerability, error)
 	CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error)
 	HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec) ([]*model.HasSlsa, error)
+	VulnMetadata(ctx context.Context, vulnMetadataSpec *model.VulnMetadataSpec) ([]*model.VulnMetadata, error)
+	VulnAffected(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec) ([]*model.VulnAffected, error)
+	AffectedPackages(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec) ([]*model.AffectedPackage, error)
+	CertifyEol(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec) ([]*model.CertifyEol, error)
+	EolDependents(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec) ([]*model.IsDependency, error)
+	TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error)
+	Dependents(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error)
+
+	// Retrieval of any node by its id
+	Node(ctx context.Context, id string) (model.Node, error)
+	Nodes(ctx context.Context, ids []string) ([]model.Node, error)
+
+	// Shortest path between two nodes, following only the given edges
+	Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error)
+
+	// Nodes directly linked to a node, following only the given edges
+	Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error)
+
+	// Paginated retrieval read-only queries, returning a page of the results
+	// of the queries above
+	PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error)
+	SourcesList(ctx context.Context, sourceSpec *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error)
+	CveList(ctx context.Context, cveSpec *model.CVESpec, after *string, first *int) (*model.CVEConnection, error)
+	GhsaList(ctx context.Context, ghsaSpec *model.GHSASpec, after *string, first *int) (*model.GHSAConnection, error)
+	OsvList(ctx context.Context, osvSpec *model.OSVSpec, after *string, first *int) (*model.OSVConnection, error)
+	ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error)
+	BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error)
+	HashEqualList(ctx context.Context, hashEqualSpec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error)
+	IsOccurrenceList(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error)
+	HasSBOMList(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error)
+	IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error)
+	CertifyPkgList(ctx context.Context, certifyPkgSpec *model.CertifyPkgSpec, after *string, first *int) (*model.CertifyPkgConnection, error)
+	HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error)
+	CertifyBadList(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error)
+	ScorecardsList(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error)
+	CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error)
+	IsVulnerabilityList(ctx context.Context, isVulnerabilitySpec *model.IsVulnerabilitySpec, after *string, first *int) (*model.IsVulnerabilityConnection, error)
+	CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error)
+	HasSLSAList(ctx context.Context, hasSLSASpec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error)
+	VulnMetadataList(ctx context.Context, vulnMetadataSpec *model.VulnMetadataSpec, after *string, first *int) (*model.VulnMetadataConnection, error)
+	VulnAffectedList(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec, after *string, first *int) (*model.VulnAffectedConnection, error)
+	AffectedPackagesList(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec, after *string, first *int) (*model.AffectedPackageConnection, error)
+	CertifyEOLList(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec, after *string, first *int) (*model.CertifyEOLConnection, error)
+	EolDependentsList(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec, after *string, first *int) (*model.IsDependencyConnection, error)
 
 	// Mutations for software trees (read-write queries)
 	IngestPackage(ctx context.Context, pkg *model.PkgInputSpec) (*model.Package, error)
@@ -70,7 +114,10 @@ type Backend interface {
 	IngestHasSbom(ctx context.Context, subject model.PackageOrSourceInput, hasSbom model.HasSBOMInputSpec) (*model.HasSbom, error)
 	IngestHasSourceAt(ctx context.Context, pkg model.PkgInputSpec, pkgMatchType model.MatchFlags, source model.SourceInputSpec, hasSourceAt model.HasSourceAtInputSpec) (*model.HasSourceAt, error)
 	IngestIsVulnerability(ctx context.Context, osv model.OSVInputSpec, vulnerability model.CveOrGhsaInput, isVulnerability model.IsVulnerabilityInputSpec) (*model.IsVulnerability, error)
-	IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error)
+	IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.OsvCveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error)
+	IngestVulnMetadata(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, vulnMetadata model.VulnMetadataInputSpec) (*model.VulnMetadata, error)
+	IngestVulnAffected(ctx context.Context, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, vulnAffected model.VulnAffectedInputSpec) (*model.VulnAffected, error)
+	IngestCertifyEol(ctx context.Context, pkg model.PkgInputSpec, certifyEol model.CertifyEOLInputSpec) (*model.CertifyEol, error)
 }
 
 // BackendArgs interface allows each backend to specify the arguments needed to
@@ -0,0 +1,69 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// IsVersionAffected returns true if the version of a package of the given purl
+// type falls in the affected versions of vulnAffected: either it is listed
+// explicitly or it lies within one of the SEMVER or ECOSYSTEM ranges. GIT
+// ranges refer to commits and are never matched.
+func IsVersionAffected(pkgType string, version string, vulnAffected *model.VulnAffected) bool {
+	if version == "" || vulnAffected == nil {
+		return false
+	}
+	for _, v := range vulnAffected.Versions {
+		if v == version {
+			return true
+		}
+	}
+	for _, r := range vulnAffected.Ranges {
+		var compare func(a, b string) int
+		switch r.RangeType {
+		case model.AffectedRangeTypeSemver:
+			compare = helpers.CompareSemver
+		case model.AffectedRangeTypeEcosystem:
+			compare = func(a, b string) int { return helpers.CompareVersions(pkgType, a, b) }
+		default:
+			continue
+		}
+		if isInRange(version, r.Events, compare) {
+			return true
+		}
+	}
+	return false
+}
+
+// isInRange replays the events of a range to find if the version is affected
+func isInRange(version string, events []*model.AffectedEvent, compare func(a, b string) int) bool {
+	rangeEvents := []helpers.AffectedEvent{}
+	for _, e := range events {
+		switch e.EventType {
+		case model.AffectedEventTypeIntroduced:
+			rangeEvents = append(rangeEvents, helpers.AffectedEvent{Introduced: e.Version})
+		case model.AffectedEventTypeFixed:
+			rangeEvents = append(rangeEvents, helpers.AffectedEvent{Fixed: e.Version})
+		case model.AffectedEventTypeLastAffected:
+			rangeEvents = append(rangeEvents, helpers.AffectedEvent{LastAffected: e.Version})
+		case model.AffectedEventTypeLimit:
+			rangeEvents = append(rangeEvents, helpers.AffectedEvent{Limit: e.Version})
+		}
+	}
+	return helpers.IsVersionInRange(version, rangeEvents, compare)
+}
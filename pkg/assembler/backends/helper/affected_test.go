@@ -0,0 +1,170 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestIsVersionAffected(t *testing.T) {
+	introduced := model.AffectedEventTypeIntroduced
+	fixed := model.AffectedEventTypeFixed
+	lastAffected := model.AffectedEventTypeLastAffected
+	limit := model.AffectedEventTypeLimit
+	event := func(eventType model.AffectedEventType, version string) *model.AffectedEvent {
+		return &model.AffectedEvent{EventType: eventType, Version: version}
+	}
+
+	tests := []struct {
+		name         string
+		pkgType      string
+		version      string
+		vulnAffected *model.VulnAffected
+		want         bool
+	}{{
+		name:    "explicit version",
+		pkgType: "pypi",
+		version: "1.0",
+		vulnAffected: &model.VulnAffected{
+			Versions: []string{"0.9", "1.0"},
+		},
+		want: true,
+	}, {
+		name:    "semver in range",
+		pkgType: "npm",
+		version: "1.2.3",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeSemver,
+				Events:    []*model.AffectedEvent{event(introduced, "1.0.0"), event(fixed, "1.2.4")},
+			}},
+		},
+		want: true,
+	}, {
+		name:    "semver fixed version",
+		pkgType: "npm",
+		version: "1.2.4",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeSemver,
+				Events:    []*model.AffectedEvent{event(introduced, "1.0.0"), event(fixed, "1.2.4")},
+			}},
+		},
+		want: false,
+	}, {
+		name:    "semver below introduced",
+		pkgType: "golang",
+		version: "v0.9.0",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeSemver,
+				Events:    []*model.AffectedEvent{event(introduced, "1.0.0"), event(fixed, "1.2.4")},
+			}},
+		},
+		want: false,
+	}, {
+		name:    "introduced zero with unsorted events",
+		pkgType: "pypi",
+		version: "2.1",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeEcosystem,
+				Events: []*model.AffectedEvent{
+					event(introduced, "2.0"), event(fixed, "1.5"), event(introduced, "0"), event(fixed, "2.2"),
+				},
+			}},
+		},
+		want: true,
+	}, {
+		name:    "between two intervals",
+		pkgType: "pypi",
+		version: "1.8",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeEcosystem,
+				Events: []*model.AffectedEvent{
+					event(introduced, "0"), event(fixed, "1.5"), event(introduced, "2.0"), event(fixed, "2.2"),
+				},
+			}},
+		},
+		want: false,
+	}, {
+		name:    "maven last affected",
+		pkgType: "maven",
+		version: "2.14.1",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeEcosystem,
+				Events:    []*model.AffectedEvent{event(introduced, "2.0-beta9"), event(lastAffected, "2.14.1")},
+			}},
+		},
+		want: true,
+	}, {
+		name:    "maven after last affected",
+		pkgType: "maven",
+		version: "2.15.0",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeEcosystem,
+				Events:    []*model.AffectedEvent{event(introduced, "2.0-beta9"), event(lastAffected, "2.14.1")},
+			}},
+		},
+		want: false,
+	}, {
+		name:    "deb with epoch",
+		pkgType: "deb",
+		version: "1:1.2.3-1",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeEcosystem,
+				Events:    []*model.AffectedEvent{event(introduced, "0"), event(fixed, "1:1.2.3-2")},
+			}},
+		},
+		want: true,
+	}, {
+		name:    "at or above limit",
+		pkgType: "npm",
+		version: "3.0.0",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeSemver,
+				Events:    []*model.AffectedEvent{event(introduced, "0"), event(limit, "3.0.0")},
+			}},
+		},
+		want: false,
+	}, {
+		name:    "git ranges are not matched",
+		pkgType: "npm",
+		version: "1.0.0",
+		vulnAffected: &model.VulnAffected{
+			Ranges: []*model.AffectedRange{{
+				RangeType: model.AffectedRangeTypeGit,
+				Repo:      "https://github.com/example/example",
+				Events:    []*model.AffectedEvent{event(introduced, "0")},
+			}},
+		},
+		want: false,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsVersionAffected(tt.pkgType, tt.version, tt.vulnAffected); got != tt.want {
+				t.Errorf("IsVersionAffected() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Connections returned by the paginated list queries, built from a page of
+// the results
+
+// AffectedPackageConnection returns the connection of a page of AffectedPackage results
+func AffectedPackageConnection(page *Page[*model.AffectedPackage]) *model.AffectedPackageConnection {
+	edges := make([]*model.AffectedPackageEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.AffectedPackageEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.AffectedPackageConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// ArtifactConnection returns the connection of a page of Artifact results
+func ArtifactConnection(page *Page[*model.Artifact]) *model.ArtifactConnection {
+	edges := make([]*model.ArtifactEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.ArtifactEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.ArtifactConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// BuilderConnection returns the connection of a page of Builder results
+func BuilderConnection(page *Page[*model.Builder]) *model.BuilderConnection {
+	edges := make([]*model.BuilderEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.BuilderEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.BuilderConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// CVEConnection returns the connection of a page of Cve results
+func CVEConnection(page *Page[*model.Cve]) *model.CVEConnection {
+	edges := make([]*model.CVEEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.CVEEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.CVEConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// CertifyBadConnection returns the connection of a page of CertifyBad results
+func CertifyBadConnection(page *Page[*model.CertifyBad]) *model.CertifyBadConnection {
+	edges := make([]*model.CertifyBadEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.CertifyBadEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.CertifyBadConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// CertifyEOLConnection returns the connection of a page of CertifyEol results
+func CertifyEOLConnection(page *Page[*model.CertifyEol]) *model.CertifyEOLConnection {
+	edges := make([]*model.CertifyEOLEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.CertifyEOLEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.CertifyEOLConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// CertifyPkgConnection returns the connection of a page of CertifyPkg results
+func CertifyPkgConnection(page *Page[*model.CertifyPkg]) *model.CertifyPkgConnection {
+	edges := make([]*model.CertifyPkgEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.CertifyPkgEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.CertifyPkgConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// CertifyScorecardConnection returns the connection of a page of CertifyScorecard results
+func CertifyScorecardConnection(page *Page[*model.CertifyScorecard]) *model.CertifyScorecardConnection {
+	edges := make([]*model.CertifyScorecardEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.CertifyScorecardEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.CertifyScorecardConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// CertifyVEXStatementConnection returns the connection of a page of CertifyVEXStatement results
+func CertifyVEXStatementConnection(page *Page[*model.CertifyVEXStatement]) *model.CertifyVEXStatementConnection {
+	edges := make([]*model.CertifyVEXStatementEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.CertifyVEXStatementEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.CertifyVEXStatementConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// CertifyVulnConnection returns the connection of a page of CertifyVuln results
+func CertifyVulnConnection(page *Page[*model.CertifyVuln]) *model.CertifyVulnConnection {
+	edges := make([]*model.CertifyVulnEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.CertifyVulnEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.CertifyVulnConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// GHSAConnection returns the connection of a page of Ghsa results
+func GHSAConnection(page *Page[*model.Ghsa]) *model.GHSAConnection {
+	edges := make([]*model.GHSAEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.GHSAEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.GHSAConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// HasSBOMConnection returns the connection of a page of HasSbom results
+func HasSBOMConnection(page *Page[*model.HasSbom]) *model.HasSBOMConnection {
+	edges := make([]*model.HasSBOMEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.HasSBOMEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.HasSBOMConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// HasSLSAConnection returns the connection of a page of HasSlsa results
+func HasSLSAConnection(page *Page[*model.HasSlsa]) *model.HasSLSAConnection {
+	edges := make([]*model.HasSLSAEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.HasSLSAEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.HasSLSAConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// HasSourceAtConnection returns the connection of a page of HasSourceAt results
+func HasSourceAtConnection(page *Page[*model.HasSourceAt]) *model.HasSourceAtConnection {
+	edges := make([]*model.HasSourceAtEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.HasSourceAtEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.HasSourceAtConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// HashEqualConnection returns the connection of a page of HashEqual results
+func HashEqualConnection(page *Page[*model.HashEqual]) *model.HashEqualConnection {
+	edges := make([]*model.HashEqualEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.HashEqualEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.HashEqualConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// IsDependencyConnection returns the connection of a page of IsDependency results
+func IsDependencyConnection(page *Page[*model.IsDependency]) *model.IsDependencyConnection {
+	edges := make([]*model.IsDependencyEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.IsDependencyEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.IsDependencyConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// IsOccurrenceConnection returns the connection of a page of IsOccurrence results
+func IsOccurrenceConnection(page *Page[*model.IsOccurrence]) *model.IsOccurrenceConnection {
+	edges := make([]*model.IsOccurrenceEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.IsOccurrenceEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.IsOccurrenceConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// IsVulnerabilityConnection returns the connection of a page of IsVulnerability results
+func IsVulnerabilityConnection(page *Page[*model.IsVulnerability]) *model.IsVulnerabilityConnection {
+	edges := make([]*model.IsVulnerabilityEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.IsVulnerabilityEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.IsVulnerabilityConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// OSVConnection returns the connection of a page of Osv results
+func OSVConnection(page *Page[*model.Osv]) *model.OSVConnection {
+	edges := make([]*model.OSVEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.OSVEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.OSVConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// PackageConnection returns the connection of a page of Package results
+func PackageConnection(page *Page[*model.Package]) *model.PackageConnection {
+	edges := make([]*model.PackageEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.PackageEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.PackageConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// SourceConnection returns the connection of a page of Source results
+func SourceConnection(page *Page[*model.Source]) *model.SourceConnection {
+	edges := make([]*model.SourceEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.SourceEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.SourceConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// VulnAffectedConnection returns the connection of a page of VulnAffected results
+func VulnAffectedConnection(page *Page[*model.VulnAffected]) *model.VulnAffectedConnection {
+	edges := make([]*model.VulnAffectedEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.VulnAffectedEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.VulnAffectedConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
+
+// VulnMetadataConnection returns the connection of a page of VulnMetadata results
+func VulnMetadataConnection(page *Page[*model.VulnMetadata]) *model.VulnMetadataConnection {
+	edges := make([]*model.VulnMetadataEdge, 0, len(page.Nodes))
+	for i, n := range page.Nodes {
+		edges = append(edges, &model.VulnMetadataEdge{Cursor: page.Cursors[i], Node: n})
+	}
+	return &model.VulnMetadataConnection{
+		TotalCount: page.TotalCount,
+		PageInfo:   page.PageInfo(),
+		Edges:      edges,
+	}
+}
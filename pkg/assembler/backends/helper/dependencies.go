@@ -0,0 +1,233 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// PackageVersions returns a package trie for each package version matching
+// the filter. Backends provide it to the transitive dependency queries so
+// that the versions are retrieved whatever the fields of the GraphQL query.
+type PackageVersions func(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error)
+
+// dependencyLink is an IsDependency followed from a package version to the
+// package it leads to
+type dependencyLink struct {
+	dependency *model.IsDependency
+	pkg        *model.Package
+}
+
+// TransitiveDependencies returns the transitive closure of the dependencies
+// of the package versions matching the filter, resolving the version range of
+// each IsDependency to the package versions it matches
+func TransitiveDependencies(ctx context.Context, b backends.Backend, versions PackageVersions, pkgSpec *model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error) {
+	return walkDependencies(ctx, versions, pkgSpec, maxDepth, func(pkg *model.Package) ([]dependencyLink, error) {
+		found, err := b.IsDependency(ctx, &model.IsDependencySpec{Package: pkgVersionSpec(pkg)})
+		if err != nil {
+			return nil, err
+		}
+		var links []dependencyLink
+		for _, dependency := range found {
+			// qualifiers are matched as a subset, keep only the exact version
+			if VertexID(dependency.Package) != VertexID(pkg) {
+				continue
+			}
+			resolved, err := resolveDependency(ctx, versions, dependency)
+			if err != nil {
+				return nil, err
+			}
+			for _, p := range resolved {
+				links = append(links, dependencyLink{dependency: dependency, pkg: p})
+			}
+		}
+		return links, nil
+	})
+}
+
+// Dependents returns the package versions depending on the package versions
+// matching the filter, directly or not. A package version depends on another
+// if the version range of its IsDependency matches the version or cannot be
+// parsed.
+func Dependents(ctx context.Context, b backends.Backend, versions PackageVersions, pkgSpec *model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error) {
+	return walkDependencies(ctx, versions, pkgSpec, maxDepth, func(pkg *model.Package) ([]dependencyLink, error) {
+		ns := pkg.Namespaces[0]
+		name := ns.Names[0]
+		version := name.Versions[0]
+		found, err := b.IsDependency(ctx, &model.IsDependencySpec{
+			DependentPackage: &model.PkgNameSpec{
+				Type:      &pkg.Type,
+				Namespace: &ns.Namespace,
+				Name:      &name.Name,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var links []dependencyLink
+		for _, dependency := range found {
+			if match, ok := helpers.MatchVersionRange(pkg.Type, version.Version, dependency.VersionRange); ok && !match {
+				continue
+			}
+			links = append(links, dependencyLink{dependency: dependency, pkg: dependency.Package})
+		}
+		return links, nil
+	})
+}
+
+// walkDependencies follows the links of the package versions matching the
+// filter breadth first, each package version being followed once from the
+// shallowest depth it is reached at. A link is part of a cycle if the package
+// it leads to reaches back the package it starts from through the links found
+// within maxDepth.
+func walkDependencies(ctx context.Context, versions PackageVersions, pkgSpec *model.PkgSpec, maxDepth *int, follow func(pkg *model.Package) ([]dependencyLink, error)) ([]*model.TransitiveDependency, error) {
+	if maxDepth != nil && *maxDepth < 0 {
+		return nil, gqlerror.Errorf("maxDepth must not be negative, got %d", *maxDepth)
+	}
+	roots, err := versions(ctx, pkgSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	reached := map[string]bool{}
+	var frontier []*model.Package
+	for _, root := range roots {
+		id := VertexID(root)
+		if reached[id] {
+			continue
+		}
+		reached[id] = true
+		frontier = append(frontier, root)
+	}
+
+	result := []*model.TransitiveDependency{}
+	// links holds the ids of the package versions each package version links
+	// to, and froms the id of the package version each result starts from
+	links := map[string][]string{}
+	var froms []string
+	for depth := 1; len(frontier) > 0 && (maxDepth == nil || depth <= *maxDepth); depth++ {
+		var next []*model.Package
+		for _, pkg := range frontier {
+			from := VertexID(pkg)
+			found, err := follow(pkg)
+			if err != nil {
+				return nil, err
+			}
+			for _, link := range found {
+				id := VertexID(link.pkg)
+				result = append(result, &model.TransitiveDependency{
+					Package:    link.pkg,
+					Dependency: link.dependency,
+					Depth:      depth,
+				})
+				froms = append(froms, from)
+				links[from] = append(links[from], id)
+				if reached[id] || !isPackageVersion(link.pkg) {
+					continue
+				}
+				reached[id] = true
+				next = append(next, link.pkg)
+			}
+		}
+		frontier = next
+	}
+
+	reachable := map[string]map[string]bool{}
+	for i, r := range result {
+		id := VertexID(r.Package)
+		if reachable[id] == nil {
+			reachable[id] = reachableFrom(links, id)
+		}
+		r.Cycle = reachable[id][froms[i]]
+	}
+	return result, nil
+}
+
+// reachableFrom returns the ids of the package versions reachable from the
+// package version with the given id, including itself
+func reachableFrom(links map[string][]string, id string) map[string]bool {
+	seen := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range links[current] {
+			if !seen[next] {
+				seen[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return seen
+}
+
+func isPackageVersion(pkg *model.Package) bool {
+	return len(pkg.Namespaces) == 1 && len(pkg.Namespaces[0].Names) == 1 && len(pkg.Namespaces[0].Names[0].Versions) == 1
+}
+
+// resolveDependency returns the package versions matching the version range
+// of an IsDependency, or the dependent package name if there is none
+func resolveDependency(ctx context.Context, versions PackageVersions, dependency *model.IsDependency) ([]*model.Package, error) {
+	name := packageName(dependency.DependentPackage)
+	if name == nil || len(name.Namespaces) == 0 || len(name.Namespaces[0].Names) == 0 {
+		return nil, nil
+	}
+	found, err := versions(ctx, &model.PkgSpec{
+		Type:      &name.Type,
+		Namespace: &name.Namespaces[0].Namespace,
+		Name:      &name.Namespaces[0].Names[0].Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var resolved []*model.Package
+	for _, pkg := range found {
+		version := pkg.Namespaces[0].Names[0].Versions[0].Version
+		if match, ok := helpers.MatchVersionRange(pkg.Type, version, dependency.VersionRange); ok && match {
+			resolved = append(resolved, pkg)
+		}
+	}
+	if len(resolved) == 0 {
+		return []*model.Package{name}, nil
+	}
+	return resolved, nil
+}
+
+// pkgVersionSpec returns the filter matching the package version of a
+// package trie with a single version
+func pkgVersionSpec(pkg *model.Package) *model.PkgSpec {
+	ns := pkg.Namespaces[0]
+	name := ns.Names[0]
+	version := name.Versions[0]
+	matchOnlyEmptyQualifiers := len(version.Qualifiers) == 0
+	spec := &model.PkgSpec{
+		Type:                     &pkg.Type,
+		Namespace:                &ns.Namespace,
+		Name:                     &name.Name,
+		Version:                  &version.Version,
+		Subpath:                  &version.Subpath,
+		MatchOnlyEmptyQualifiers: &matchOnlyEmptyQualifiers,
+	}
+	for _, q := range version.Qualifiers {
+		spec.Qualifiers = append(spec.Qualifiers, &model.PackageQualifierSpec{Key: q.Key, Value: &q.Value})
+	}
+	return spec
+}
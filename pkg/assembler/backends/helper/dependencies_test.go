@@ -0,0 +1,175 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestTransitiveDependencies(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("unable to create backend: %v", err)
+	}
+
+	npm := func(name string, version string) model.PkgInputSpec {
+		return model.PkgInputSpec{Type: "npm", Namespace: ptrfrom(""), Name: name, Version: ptrfrom(version)}
+	}
+	app := npm("app", "1.0.0")
+	libA1 := npm("lib-a", "1.0.0")
+	libA2 := npm("lib-a", "2.0.0")
+	libB := npm("lib-b", "1.0.0")
+	libC := npm("lib-c", "1.0.0")
+	sibA := npm("sib-a", "1.0.0")
+	sibB := npm("sib-b", "1.0.0")
+	sibC := npm("sib-c", "1.0.0")
+	for _, p := range []model.PkgInputSpec{app, libA1, libA2, libB, libC, sibA, sibB, sibC} {
+		if _, err := b.IngestPackage(ctx, &p); err != nil {
+			t.Fatalf("unable to ingest package: %v", err)
+		}
+	}
+	ingestDependency := func(pkg model.PkgInputSpec, depPkg model.PkgInputSpec, versionRange string) string {
+		dependency, err := b.IngestDependency(ctx, pkg, depPkg, model.IsDependencyInputSpec{
+			VersionRange:  versionRange,
+			Justification: "test",
+			Origin:        "test",
+			Collector:     "test",
+		})
+		if err != nil {
+			t.Fatalf("unable to ingest dependency: %v", err)
+		}
+		return dependency.ID
+	}
+	appOnLibA := ingestDependency(app, libA2, ">=2.0.0")
+	appOnLibC := ingestDependency(app, libC, "latest")
+	libAOnLibB := ingestDependency(libA2, libB, "^1.0.0")
+	libBOnApp := ingestDependency(libB, app, "1.0.0")
+	// sib-b and sib-c depend on each other, not on sib-a
+	sibAOnSibB := ingestDependency(sibA, sibB, "1.0.0")
+	sibAOnSibC := ingestDependency(sibA, sibC, "1.0.0")
+	sibBOnSibC := ingestDependency(sibB, sibC, "1.0.0")
+	sibCOnSibB := ingestDependency(sibC, sibB, "1.0.0")
+
+	nsID := helper.PkgNamespaceID(helper.PkgTypeID("npm"), "")
+	versionID := func(name string, version string) string {
+		return helper.PkgVersionID(helper.PkgNameID(nsID, name), version, "", nil)
+	}
+	appID := versionID("app", "1.0.0")
+	libA2ID := versionID("lib-a", "2.0.0")
+	libBID := versionID("lib-b", "1.0.0")
+	libCNameID := helper.PkgNameID(nsID, "lib-c")
+	sibBID := versionID("sib-b", "1.0.0")
+	sibCID := versionID("sib-c", "1.0.0")
+
+	// dep is a TransitiveDependency by ids
+	type dep struct {
+		Package    string
+		Dependency string
+		Depth      int
+		Cycle      bool
+	}
+	pkgSpec := func(name string, version string) *model.PkgSpec {
+		return &model.PkgSpec{Type: ptrfrom("npm"), Name: ptrfrom(name), Version: ptrfrom(version)}
+	}
+	tests := []struct {
+		name       string
+		dependents bool
+		pkgSpec    *model.PkgSpec
+		maxDepth   *int
+		want       []dep
+		wantErr    bool
+	}{{
+		name:    "dependencies",
+		pkgSpec: pkgSpec("app", "1.0.0"),
+		want: []dep{
+			{libA2ID, appOnLibA, 1, true},
+			// latest is not a version range, the dependency is the name
+			{libCNameID, appOnLibC, 1, false},
+			{libBID, libAOnLibB, 2, true},
+			{appID, libBOnApp, 3, true},
+		},
+	}, {
+		name:    "cycle between siblings",
+		pkgSpec: pkgSpec("sib-a", "1.0.0"),
+		want: []dep{
+			{sibBID, sibAOnSibB, 1, false},
+			{sibCID, sibAOnSibC, 1, false},
+			{sibCID, sibBOnSibC, 2, true},
+			{sibBID, sibCOnSibB, 2, true},
+		},
+	}, {
+		name:     "dependencies up to a depth",
+		pkgSpec:  pkgSpec("app", "1.0.0"),
+		maxDepth: ptrfrom(1),
+		want: []dep{
+			// the cycle is not found within the depth
+			{libA2ID, appOnLibA, 1, false},
+			{libCNameID, appOnLibC, 1, false},
+		},
+	}, {
+		name:    "no dependencies",
+		pkgSpec: pkgSpec("lib-a", "1.0.0"),
+		want:    []dep{},
+	}, {
+		name:       "dependents",
+		dependents: true,
+		pkgSpec:    pkgSpec("lib-b", "1.0.0"),
+		want: []dep{
+			{libA2ID, libAOnLibB, 1, true},
+			{appID, appOnLibA, 2, true},
+			{libBID, libBOnApp, 3, true},
+		},
+	}, {
+		name:       "version not in range",
+		dependents: true,
+		pkgSpec:    pkgSpec("lib-a", "1.0.0"),
+		want:       []dep{},
+	}, {
+		name:     "negative depth",
+		pkgSpec:  pkgSpec("app", "1.0.0"),
+		maxDepth: ptrfrom(-1),
+		wantErr:  true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := b.TransitiveDependencies
+			if tt.dependents {
+				query = b.Dependents
+			}
+			got, err := query(ctx, tt.pkgSpec, tt.maxDepth)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			deps := []dep{}
+			for _, d := range got {
+				deps = append(deps, dep{helper.VertexID(d.Package), d.Dependency.ID, d.Depth, d.Cycle})
+			}
+			if diff := cmp.Diff(tt.want, deps); diff != "" {
+				t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+)
+
+// EOLDependents returns the dependencies on the packages of the given
+// certifications, found with isDependency. Dependencies are recorded at the
+// package name level, so the packages are queried once per distinct name and
+// the dependencies whose version range excludes all the end of life versions
+// are dropped. Ranges that cannot be parsed are kept, as are all the
+// dependencies on a package certified at the name level.
+func EOLDependents(ctx context.Context, certifications []*model.CertifyEol, isDependency func(ctx context.Context, spec *model.IsDependencySpec) ([]*model.IsDependency, error)) ([]*model.IsDependency, error) {
+	type eolName struct {
+		pkgType     string
+		spec        *model.IsDependencySpec
+		versions    []string
+		allVersions bool
+	}
+	var names []*eolName
+	seen := map[[3]string]*eolName{}
+	for _, c := range certifications {
+		for _, ns := range c.Package.Namespaces {
+			for _, n := range ns.Names {
+				key := [3]string{c.Package.Type, ns.Namespace, n.Name}
+				name, ok := seen[key]
+				if !ok {
+					name = &eolName{
+						pkgType: c.Package.Type,
+						spec: &model.IsDependencySpec{
+							DependentPackage: &model.PkgNameSpec{
+								Type:      &key[0],
+								Namespace: &key[1],
+								Name:      &key[2],
+							},
+						},
+					}
+					seen[key] = name
+					names = append(names, name)
+				}
+				if len(n.Versions) == 0 {
+					name.allVersions = true
+				}
+				for _, v := range n.Versions {
+					name.versions = append(name.versions, v.Version)
+				}
+			}
+		}
+	}
+
+	var dependents []*model.IsDependency
+	for _, name := range names {
+		found, err := isDependency(ctx, name.spec)
+		if err != nil {
+			return nil, err
+		}
+		for _, dependency := range found {
+			if name.allVersions || matchesAnyVersion(name.pkgType, name.versions, dependency.VersionRange) {
+				dependents = append(dependents, dependency)
+			}
+		}
+	}
+	return dependents, nil
+}
+
+func matchesAnyVersion(pkgType string, versions []string, versionRange string) bool {
+	for _, v := range versions {
+		if match, ok := helpers.MatchVersionRange(pkgType, v, versionRange); !ok || match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestEOLDependents(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("unable to create backend: %v", err)
+	}
+
+	pypi := func(name string, version string) model.PkgInputSpec {
+		return model.PkgInputSpec{Type: "pypi", Namespace: ptrfrom(""), Name: name, Version: ptrfrom(version)}
+	}
+	python2 := pypi("python", "2.7")
+	python3 := pypi("python", "3.11")
+	dependents := []struct {
+		pkg          model.PkgInputSpec
+		versionRange string
+	}{
+		{pkg: pypi("legacy", "1.0"), versionRange: "<3"},
+		{pkg: pypi("modern", "1.0"), versionRange: ">=3.8"},
+		{pkg: pypi("any", "1.0"), versionRange: ""},
+		{pkg: pypi("unknown", "1.0"), versionRange: "latest"},
+	}
+	for _, p := range []model.PkgInputSpec{python2, python3, dependents[0].pkg, dependents[1].pkg, dependents[2].pkg, dependents[3].pkg} {
+		if _, err := b.IngestPackage(ctx, &p); err != nil {
+			t.Fatalf("unable to ingest package: %v", err)
+		}
+	}
+	dependencies := map[string]string{}
+	for _, d := range dependents {
+		dependency, err := b.IngestDependency(ctx, d.pkg, python3, model.IsDependencyInputSpec{
+			VersionRange:  d.versionRange,
+			Justification: "test",
+			Origin:        "test",
+			Collector:     "test",
+		})
+		if err != nil {
+			t.Fatalf("unable to ingest dependency: %v", err)
+		}
+		dependencies[d.pkg.Name] = dependency.ID
+	}
+	eol := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = b.IngestCertifyEol(ctx, python2, model.CertifyEOLInputSpec{
+		Product:     "python",
+		Cycle:       "2.7",
+		Status:      model.SupportStatusEol,
+		Eol:         &eol,
+		Latest:      "2.7.18",
+		TimeChecked: time.Now(),
+		Origin:      "test",
+		Collector:   "test",
+	})
+	if err != nil {
+		t.Fatalf("unable to ingest end of life: %v", err)
+	}
+
+	found, err := b.EolDependents(ctx, &model.CertifyEOLSpec{})
+	if err != nil {
+		t.Fatalf("EolDependents() error = %v", err)
+	}
+	var got []string
+	for _, d := range found {
+		got = append(got, d.ID)
+	}
+	sort.Strings(got)
+	// the range excluding python 2.7 is dropped, the range that cannot be
+	// parsed is kept
+	want := []string{dependencies["legacy"], dependencies["any"], dependencies["unknown"]}
+	sort.Strings(want)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("EolDependents() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,509 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Kinds of the vertices of the GUAC graph a path goes through. Evidence
+// vertices have the type name of the evidence as kind.
+const (
+	PackageVersionVertex = "PackageVersion"
+	PackageNameVertex    = "PackageName"
+	SourceNameVertex     = "SourceName"
+	ArtifactVertex       = "Artifact"
+	BuilderVertex        = "Builder"
+	CveVertex            = "CVE"
+	GhsaVertex           = "GHSA"
+	OsvVertex            = "OSV"
+	NoVulnVertex         = "NoVuln"
+)
+
+// EvidenceVertices are the kinds of the evidence vertices
+var EvidenceVertices = []string{
+	"CertifyBad",
+	"CertifyEOL",
+	"CertifyPkg",
+	"CertifyScorecard",
+	"CertifyVEXStatement",
+	"CertifyVuln",
+	"HasSBOM",
+	"HasSLSA",
+	"HasSourceAt",
+	"HashEqual",
+	"IsDependency",
+	"IsOccurrence",
+	"IsVulnerability",
+	"VulnAffected",
+	"VulnMetadata",
+}
+
+// Step is a link from a kind of vertex to another
+type Step [2]string
+
+// edgeSteps lists the steps each edge of the GraphQL schema allows
+var edgeSteps = map[model.Edge][]Step{
+	model.EdgePackageNamePackageVersion:   {{PackageNameVertex, PackageVersionVertex}},
+	model.EdgePackageVersionPackageName:   {{PackageVersionVertex, PackageNameVertex}},
+	model.EdgePackageIsOccurrence:         {{PackageVersionVertex, "IsOccurrence"}, {PackageNameVertex, "IsOccurrence"}},
+	model.EdgePackageIsDependency:         {{PackageVersionVertex, "IsDependency"}, {PackageNameVertex, "IsDependency"}},
+	model.EdgePackageCertifyVexStatement:  {{PackageVersionVertex, "CertifyVEXStatement"}, {PackageNameVertex, "CertifyVEXStatement"}},
+	model.EdgePackageCertifyBad:           {{PackageVersionVertex, "CertifyBad"}, {PackageNameVertex, "CertifyBad"}},
+	model.EdgePackageCertifyPkg:           {{PackageVersionVertex, "CertifyPkg"}, {PackageNameVertex, "CertifyPkg"}},
+	model.EdgePackageCertifyVuln:          {{PackageVersionVertex, "CertifyVuln"}, {PackageNameVertex, "CertifyVuln"}},
+	model.EdgePackageHasSourceAt:          {{PackageVersionVertex, "HasSourceAt"}, {PackageNameVertex, "HasSourceAt"}},
+	model.EdgePackageHasSbom:              {{PackageVersionVertex, "HasSBOM"}, {PackageNameVertex, "HasSBOM"}},
+	model.EdgePackageHasSlsa:              {{PackageVersionVertex, "HasSLSA"}, {PackageNameVertex, "HasSLSA"}},
+	model.EdgePackageVulnAffected:         {{PackageVersionVertex, "VulnAffected"}, {PackageNameVertex, "VulnAffected"}},
+	model.EdgePackageCertifyEol:           {{PackageVersionVertex, "CertifyEOL"}, {PackageNameVertex, "CertifyEOL"}},
+	model.EdgeSourceIsOccurrence:          {{SourceNameVertex, "IsOccurrence"}},
+	model.EdgeSourceCertifyBad:            {{SourceNameVertex, "CertifyBad"}},
+	model.EdgeSourceCertifyScorecard:      {{SourceNameVertex, "CertifyScorecard"}},
+	model.EdgeSourceHasSourceAt:           {{SourceNameVertex, "HasSourceAt"}},
+	model.EdgeSourceHasSbom:               {{SourceNameVertex, "HasSBOM"}},
+	model.EdgeSourceHasSlsa:               {{SourceNameVertex, "HasSLSA"}},
+	model.EdgeArtifactIsOccurrence:        {{ArtifactVertex, "IsOccurrence"}},
+	model.EdgeArtifactCertifyVexStatement: {{ArtifactVertex, "CertifyVEXStatement"}},
+	model.EdgeArtifactHashEqual:           {{ArtifactVertex, "HashEqual"}},
+	model.EdgeArtifactCertifyBad:          {{ArtifactVertex, "CertifyBad"}},
+	model.EdgeArtifactHasSlsa:             {{ArtifactVertex, "HasSLSA"}},
+	model.EdgeBuilderHasSlsa:              {{BuilderVertex, "HasSLSA"}},
+	model.EdgeCveIsVulnerability:          {{CveVertex, "IsVulnerability"}},
+	model.EdgeCveCertifyVexStatement:      {{CveVertex, "CertifyVEXStatement"}},
+	model.EdgeCveCertifyVuln:              {{CveVertex, "CertifyVuln"}},
+	model.EdgeCveVulnMetadata:             {{CveVertex, "VulnMetadata"}},
+	model.EdgeCveVulnAffected:             {{CveVertex, "VulnAffected"}},
+	model.EdgeGhsaIsVulnerability:         {{GhsaVertex, "IsVulnerability"}},
+	model.EdgeGhsaCertifyVexStatement:     {{GhsaVertex, "CertifyVEXStatement"}},
+	model.EdgeGhsaCertifyVuln:             {{GhsaVertex, "CertifyVuln"}},
+	model.EdgeGhsaVulnMetadata:            {{GhsaVertex, "VulnMetadata"}},
+	model.EdgeGhsaVulnAffected:            {{GhsaVertex, "VulnAffected"}},
+	model.EdgeOsvIsVulnerability:          {{OsvVertex, "IsVulnerability"}},
+	model.EdgeOsvCertifyVexStatement:      {{OsvVertex, "CertifyVEXStatement"}},
+	model.EdgeOsvCertifyVuln:              {{OsvVertex, "CertifyVuln"}},
+	model.EdgeOsvVulnMetadata:             {{OsvVertex, "VulnMetadata"}},
+	model.EdgeOsvVulnAffected:             {{OsvVertex, "VulnAffected"}},
+	model.EdgeNoVulnCertifyVuln:           {{NoVulnVertex, "CertifyVuln"}},
+	model.EdgeIsOccurrencePackage:         {{"IsOccurrence", PackageVersionVertex}, {"IsOccurrence", PackageNameVertex}},
+	model.EdgeIsOccurrenceSource:          {{"IsOccurrence", SourceNameVertex}},
+	model.EdgeIsOccurrenceArtifact:        {{"IsOccurrence", ArtifactVertex}},
+	model.EdgeIsDependencyPackage:         {{"IsDependency", PackageVersionVertex}, {"IsDependency", PackageNameVertex}},
+	model.EdgeIsVulnerabilityOsv:          {{"IsVulnerability", OsvVertex}},
+	model.EdgeIsVulnerabilityCve:          {{"IsVulnerability", CveVertex}},
+	model.EdgeIsVulnerabilityGhsa:         {{"IsVulnerability", GhsaVertex}},
+	model.EdgeCertifyVexStatementPackage:  {{"CertifyVEXStatement", PackageVersionVertex}, {"CertifyVEXStatement", PackageNameVertex}},
+	model.EdgeCertifyVexStatementArtifact: {{"CertifyVEXStatement", ArtifactVertex}},
+	model.EdgeCertifyVexStatementCve:      {{"CertifyVEXStatement", CveVertex}},
+	model.EdgeCertifyVexStatementGhsa:     {{"CertifyVEXStatement", GhsaVertex}},
+	model.EdgeCertifyVexStatementOsv:      {{"CertifyVEXStatement", OsvVertex}},
+	model.EdgeHashEqualArtifact:           {{"HashEqual", ArtifactVertex}},
+	model.EdgeCertifyBadPackage:           {{"CertifyBad", PackageVersionVertex}, {"CertifyBad", PackageNameVertex}},
+	model.EdgeCertifyBadSource:            {{"CertifyBad", SourceNameVertex}},
+	model.EdgeCertifyBadArtifact:          {{"CertifyBad", ArtifactVertex}},
+	model.EdgeCertifyPkgPackage:           {{"CertifyPkg", PackageVersionVertex}, {"CertifyPkg", PackageNameVertex}},
+	model.EdgeCertifyScorecardSource:      {{"CertifyScorecard", SourceNameVertex}},
+	model.EdgeCertifyVulnPackage:          {{"CertifyVuln", PackageVersionVertex}, {"CertifyVuln", PackageNameVertex}},
+	model.EdgeCertifyVulnCve:              {{"CertifyVuln", CveVertex}},
+	model.EdgeCertifyVulnGhsa:             {{"CertifyVuln", GhsaVertex}},
+	model.EdgeCertifyVulnOsv:              {{"CertifyVuln", OsvVertex}},
+	model.EdgeCertifyVulnNoVuln:           {{"CertifyVuln", NoVulnVertex}},
+	model.EdgeHasSourceAtPackage:          {{"HasSourceAt", PackageVersionVertex}, {"HasSourceAt", PackageNameVertex}},
+	model.EdgeHasSourceAtSource:           {{"HasSourceAt", SourceNameVertex}},
+	model.EdgeHasSbomPackage:              {{"HasSBOM", PackageVersionVertex}, {"HasSBOM", PackageNameVertex}},
+	model.EdgeHasSbomSource:               {{"HasSBOM", SourceNameVertex}},
+	model.EdgeHasSlsaPackage:              {{"HasSLSA", PackageVersionVertex}, {"HasSLSA", PackageNameVertex}},
+	model.EdgeHasSlsaSource:               {{"HasSLSA", SourceNameVertex}},
+	model.EdgeHasSlsaArtifact:             {{"HasSLSA", ArtifactVertex}},
+	model.EdgeHasSlsaBuilder:              {{"HasSLSA", BuilderVertex}},
+	model.EdgeVulnMetadataCve:             {{"VulnMetadata", CveVertex}},
+	model.EdgeVulnMetadataGhsa:            {{"VulnMetadata", GhsaVertex}},
+	model.EdgeVulnMetadataOsv:             {{"VulnMetadata", OsvVertex}},
+	model.EdgeVulnAffectedPackage:         {{"VulnAffected", PackageVersionVertex}, {"VulnAffected", PackageNameVertex}},
+	model.EdgeVulnAffectedCve:             {{"VulnAffected", CveVertex}},
+	model.EdgeVulnAffectedGhsa:            {{"VulnAffected", GhsaVertex}},
+	model.EdgeVulnAffectedOsv:             {{"VulnAffected", OsvVertex}},
+	model.EdgeCertifyEolPackage:           {{"CertifyEOL", PackageVersionVertex}, {"CertifyEOL", PackageNameVertex}},
+}
+
+// PathSteps returns the steps a path can take following only the given edges,
+// or any edge if none is given
+func PathSteps(usingOnly []model.Edge) map[Step]bool {
+	if len(usingOnly) == 0 {
+		usingOnly = model.AllEdge
+	}
+	steps := map[Step]bool{}
+	for _, edge := range usingOnly {
+		for _, step := range edgeSteps[edge] {
+			steps[step] = true
+		}
+	}
+	return steps
+}
+
+// VertexKind returns the kind of the vertex with the given node id. Only the
+// evidence and the leaves of the software trees are vertices a path can go
+// through.
+func VertexKind(id string) (string, error) {
+	typeName, _, err := DecodeID(id)
+	if err != nil {
+		return "", gqlerror.Errorf("%v", err)
+	}
+	switch typeName {
+	case "PackageVersion", "PackageName", "SourceName", "Artifact", "Builder", "NoVuln":
+		return typeName, nil
+	case "CVEId":
+		return CveVertex, nil
+	case "GHSAId":
+		return GhsaVertex, nil
+	case "OSVId":
+		return OsvVertex, nil
+	}
+	for _, kind := range EvidenceVertices {
+		if typeName == kind {
+			return kind, nil
+		}
+	}
+	return "", gqlerror.Errorf("node id %q is not the id of an evidence, package version or name, source name, artifact, builder or vulnerability", id)
+}
+
+// VertexID returns the id of the vertex of a node of a software tree: the
+// single version of a package or else its name, the single name of a
+// source, or the single vulnerability of a vulnerability trie
+func VertexID(node model.Node) string {
+	switch n := node.(type) {
+	case *model.Package:
+		if len(n.Namespaces) == 0 || len(n.Namespaces[0].Names) == 0 {
+			return ""
+		}
+		name := n.Namespaces[0].Names[0]
+		if len(name.Versions) != 1 {
+			return name.ID
+		}
+		return name.Versions[0].ID
+	case *model.Source:
+		if len(n.Namespaces) == 0 || len(n.Namespaces[0].Names) == 0 {
+			return ""
+		}
+		return n.Namespaces[0].Names[0].ID
+	case *model.Artifact:
+		return n.ID
+	case *model.Builder:
+		return n.ID
+	case *model.Cve:
+		if len(n.CveID) == 1 {
+			return CveVulnerabilityID(n.Year, n.CveID[0].ID)
+		}
+	case *model.Ghsa:
+		if len(n.GhsaID) == 1 {
+			return GhsaVulnerabilityID(n.GhsaID[0].ID)
+		}
+	case *model.Osv:
+		if len(n.OsvID) == 1 {
+			return OsvVulnerabilityID(n.OsvID[0].ID)
+		}
+	case *model.NoVuln:
+		return n.ID
+	}
+	return ""
+}
+
+// ValidatePath checks the arguments of a path query
+func ValidatePath(subject string, target string, maxPathLength int) error {
+	if maxPathLength < 0 {
+		return gqlerror.Errorf("maxPathLength must not be negative, got %d", maxPathLength)
+	}
+	for _, id := range []string{subject, target} {
+		if _, err := VertexKind(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Graph is the GUAC graph built from the evidence, for the backends that
+// cannot search paths themselves
+type Graph struct {
+	nodes  map[string]model.Node
+	kinds  map[string]string
+	links  map[string][]string
+	linked map[Step]bool
+}
+
+// NewGraph returns an empty graph
+func NewGraph() *Graph {
+	return &Graph{
+		nodes:  map[string]model.Node{},
+		kinds:  map[string]string{},
+		links:  map[string][]string{},
+		linked: map[Step]bool{},
+	}
+}
+
+// AddEvidence adds an evidence node to the graph, linked to the nodes of the
+// software trees it refers to
+func (g *Graph) AddEvidence(evidence model.Node) {
+	id, refs := evidenceRefs(evidence)
+	typeName, _, err := DecodeID(id)
+	if err != nil {
+		return
+	}
+	g.addVertex(id, typeName, evidence)
+	for _, ref := range refs {
+		if refID, ok := g.addNode(ref); ok {
+			g.link(id, refID)
+		}
+	}
+}
+
+// AddNode adds the vertex of a node of a software tree to the graph, a package
+// version being linked to its package name
+func (g *Graph) AddNode(node model.Node) {
+	g.addNode(node)
+}
+
+// Neighbors returns the nodes linked to the node with the given id by the
+// given edges, in the order the links were added
+func (g *Graph) Neighbors(id string, usingOnly []model.Edge) []model.Node {
+	steps := PathSteps(usingOnly)
+	neighbors := []model.Node{}
+	for _, linked := range g.links[id] {
+		if steps[Step{g.kinds[id], g.kinds[linked]}] {
+			neighbors = append(neighbors, g.nodes[linked])
+		}
+	}
+	return neighbors
+}
+
+// Path returns the shortest path from the subject to the target with at most
+// maxPathLength edges, following only the given edges. The path is found by a
+// breadth first search visiting the links in the order the evidence was
+// added, so the same graph always returns the same path.
+func (g *Graph) Path(subject string, target string, maxPathLength int, usingOnly []model.Edge) []model.Node {
+	if _, ok := g.nodes[subject]; !ok {
+		return []model.Node{}
+	}
+	if subject == target {
+		return []model.Node{g.nodes[subject]}
+	}
+	steps := PathSteps(usingOnly)
+	previous := map[string]string{subject: ""}
+	frontier := []string{subject}
+	for length := 0; length < maxPathLength && len(frontier) > 0; length++ {
+		var next []string
+		for _, id := range frontier {
+			for _, linked := range g.links[id] {
+				if _, seen := previous[linked]; seen {
+					continue
+				}
+				if !steps[Step{g.kinds[id], g.kinds[linked]}] {
+					continue
+				}
+				previous[linked] = id
+				if linked == target {
+					return g.pathTo(previous, target)
+				}
+				next = append(next, linked)
+			}
+		}
+		frontier = next
+	}
+	return []model.Node{}
+}
+
+func (g *Graph) pathTo(previous map[string]string, target string) []model.Node {
+	var path []model.Node
+	for id := target; id != ""; id = previous[id] {
+		path = append(path, g.nodes[id])
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+func (g *Graph) addVertex(id string, kind string, node model.Node) {
+	if _, ok := g.nodes[id]; ok {
+		return
+	}
+	g.nodes[id] = node
+	g.kinds[id] = kind
+}
+
+func (g *Graph) link(from string, to string) {
+	if g.linked[Step{from, to}] {
+		return
+	}
+	g.linked[Step{from, to}] = true
+	g.linked[Step{to, from}] = true
+	g.links[from] = append(g.links[from], to)
+	g.links[to] = append(g.links[to], from)
+}
+
+// addNode adds the vertex of a node of a software tree, returning its id. A
+// package with a single version is that version, linked to its package name,
+// and any other package is its name.
+func (g *Graph) addNode(node any) (string, bool) {
+	switch n := node.(type) {
+	case *model.Package:
+		if n == nil || len(n.Namespaces) == 0 || len(n.Namespaces[0].Names) == 0 {
+			return "", false
+		}
+		ns := n.Namespaces[0]
+		name := ns.Names[0]
+		g.addVertex(name.ID, PackageNameVertex, packageTrie(n, ns, name, nil))
+		if len(name.Versions) != 1 {
+			return name.ID, true
+		}
+		version := name.Versions[0]
+		g.addVertex(version.ID, PackageVersionVertex, packageTrie(n, ns, name, version))
+		g.link(version.ID, name.ID)
+		return version.ID, true
+	case *model.Source:
+		if n == nil || len(n.Namespaces) == 0 || len(n.Namespaces[0].Names) == 0 {
+			return "", false
+		}
+		id := n.Namespaces[0].Names[0].ID
+		g.addVertex(id, SourceNameVertex, n)
+		return id, true
+	case *model.Artifact:
+		if n == nil {
+			return "", false
+		}
+		g.addVertex(n.ID, ArtifactVertex, n)
+		return n.ID, true
+	case *model.Builder:
+		if n == nil {
+			return "", false
+		}
+		g.addVertex(n.ID, BuilderVertex, n)
+		return n.ID, true
+	case *model.Cve:
+		if n == nil || len(n.CveID) != 1 {
+			return "", false
+		}
+		cve := WithVulnerabilityID(n).(*model.Cve)
+		g.addVertex(cve.ID, CveVertex, cve)
+		return cve.ID, true
+	case *model.Ghsa:
+		if n == nil || len(n.GhsaID) != 1 {
+			return "", false
+		}
+		ghsa := WithVulnerabilityID(n).(*model.Ghsa)
+		g.addVertex(ghsa.ID, GhsaVertex, ghsa)
+		return ghsa.ID, true
+	case *model.Osv:
+		if n == nil || len(n.OsvID) != 1 {
+			return "", false
+		}
+		osv := WithVulnerabilityID(n).(*model.Osv)
+		g.addVertex(osv.ID, OsvVertex, osv)
+		return osv.ID, true
+	case *model.NoVuln:
+		if n == nil {
+			return "", false
+		}
+		g.addVertex(n.ID, NoVulnVertex, n)
+		return n.ID, true
+	}
+	return "", false
+}
+
+// packageTrie returns the package trie leading to a single name, and version
+// if not nil
+func packageTrie(pkg *model.Package, ns *model.PackageNamespace, name *model.PackageName, version *model.PackageVersion) *model.Package {
+	versions := []*model.PackageVersion{}
+	if version != nil {
+		versions = append(versions, version)
+	}
+	return &model.Package{
+		ID:   pkg.ID,
+		Type: pkg.Type,
+		Namespaces: []*model.PackageNamespace{{
+			ID:        ns.ID,
+			Namespace: ns.Namespace,
+			Names: []*model.PackageName{{
+				ID:       name.ID,
+				Name:     name.Name,
+				Versions: versions,
+			}},
+		}},
+	}
+}
+
+// packageName returns the package trie leading to the name of a package,
+// for the evidence about package names that may return all the versions of
+// the name
+func packageName(pkg *model.Package) *model.Package {
+	if pkg == nil || len(pkg.Namespaces) == 0 || len(pkg.Namespaces[0].Names) == 0 {
+		return pkg
+	}
+	return packageTrie(pkg, pkg.Namespaces[0], pkg.Namespaces[0].Names[0], nil)
+}
+
+// evidenceRefs returns the id of an evidence node and the nodes of the
+// software trees it refers to
+func evidenceRefs(evidence model.Node) (string, []any) {
+	switch e := evidence.(type) {
+	case *model.CertifyBad:
+		return e.ID, []any{e.Subject}
+	case *model.CertifyEol:
+		return e.ID, []any{e.Package}
+	case *model.CertifyPkg:
+		refs := []any{}
+		for _, pkg := range e.Packages {
+			refs = append(refs, pkg)
+		}
+		return e.ID, refs
+	case *model.CertifyScorecard:
+		return e.ID, []any{e.Source}
+	case *model.CertifyVEXStatement:
+		return e.ID, []any{e.Subject, e.Vulnerability}
+	case *model.CertifyVuln:
+		return e.ID, []any{e.Package, e.Vulnerability}
+	case *model.HasSbom:
+		return e.ID, []any{e.Subject}
+	case *model.HasSlsa:
+		refs := []any{e.Subject}
+		if e.Slsa != nil {
+			for _, builtFrom := range e.Slsa.BuiltFrom {
+				refs = append(refs, builtFrom)
+			}
+			refs = append(refs, e.Slsa.BuiltBy)
+		}
+		return e.ID, refs
+	case *model.HasSourceAt:
+		return e.ID, []any{e.Package, e.Source}
+	case *model.HashEqual:
+		refs := []any{}
+		for _, artifact := range e.Artifacts {
+			refs = append(refs, artifact)
+		}
+		return e.ID, refs
+	case *model.IsDependency:
+		return e.ID, []any{e.Package, packageName(e.DependentPackage)}
+	case *model.IsOccurrence:
+		return e.ID, []any{e.Subject, e.Artifact}
+	case *model.IsVulnerability:
+		return e.ID, []any{e.Osv, e.Vulnerability}
+	case *model.VulnAffected:
+		return e.ID, []any{packageName(e.Package), e.Vulnerability}
+	case *model.VulnMetadata:
+		return e.ID, []any{e.Vulnerability}
+	}
+	return "", nil
+}
@@ -0,0 +1,321 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// testGraph is a graph from an artifact to a CVE: the artifact is an
+// occurrence of a package version, which depends on a package name whose
+// version has the CVE
+type testGraph struct {
+	b             backends.Backend
+	artifactID    string
+	occurrenceID  string
+	nameID        string
+	versionID     string
+	dependencyID  string
+	depNameID     string
+	depVersionID  string
+	certifyVulnID string
+	cveID         string
+}
+
+func newTestGraph(ctx context.Context, t *testing.T) *testGraph {
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("unable to create backend: %v", err)
+	}
+
+	pkg := model.PkgInputSpec{
+		Type:      "pypi",
+		Namespace: ptrfrom(""),
+		Name:      "django",
+		Version:   ptrfrom("1.11.1"),
+	}
+	depPkg := model.PkgInputSpec{
+		Type:      "pypi",
+		Namespace: ptrfrom(""),
+		Name:      "sqlparse",
+		Version:   ptrfrom("0.4.1"),
+	}
+	artifact := model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abc"}
+	cve := model.CVEInputSpec{Year: "2023", CveID: "cve-2023-30608"}
+	for _, p := range []*model.PkgInputSpec{&pkg, &depPkg} {
+		if _, err := b.IngestPackage(ctx, p); err != nil {
+			t.Fatalf("unable to ingest package: %v", err)
+		}
+	}
+	if _, err := b.IngestArtifact(ctx, &artifact); err != nil {
+		t.Fatalf("unable to ingest artifact: %v", err)
+	}
+	if _, err := b.IngestCve(ctx, &cve); err != nil {
+		t.Fatalf("unable to ingest cve: %v", err)
+	}
+
+	occurrence, err := b.IngestOccurrence(ctx, model.PackageOrSourceInput{Package: &pkg}, artifact, model.IsOccurrenceInputSpec{
+		Justification: "test",
+		Origin:        "test",
+		Collector:     "test",
+	})
+	if err != nil {
+		t.Fatalf("unable to ingest occurrence: %v", err)
+	}
+	dependency, err := b.IngestDependency(ctx, pkg, depPkg, model.IsDependencyInputSpec{
+		VersionRange:  ">=0.4",
+		Justification: "test",
+		Origin:        "test",
+		Collector:     "test",
+	})
+	if err != nil {
+		t.Fatalf("unable to ingest dependency: %v", err)
+	}
+	certifyVuln, err := b.IngestVulnerability(ctx, depPkg, model.OsvCveOrGhsaInput{Cve: &cve}, model.VulnerabilityMetaDataInput{
+		TimeScanned: time.Unix(1e9, 0),
+		Origin:      "test",
+		Collector:   "test",
+	})
+	if err != nil {
+		t.Fatalf("unable to ingest vulnerability: %v", err)
+	}
+
+	nsID := helper.PkgNamespaceID(helper.PkgTypeID("pypi"), "")
+	nameID := helper.PkgNameID(nsID, "django")
+	depNameID := helper.PkgNameID(nsID, "sqlparse")
+	return &testGraph{
+		b:             b,
+		artifactID:    helper.ArtifactID("sha256", "abc"),
+		occurrenceID:  occurrence.ID,
+		nameID:        nameID,
+		versionID:     helper.PkgVersionID(nameID, "1.11.1", "", nil),
+		dependencyID:  dependency.ID,
+		depNameID:     depNameID,
+		depVersionID:  helper.PkgVersionID(depNameID, "0.4.1", "", nil),
+		certifyVulnID: certifyVuln.ID,
+		cveID:         helper.CveVulnerabilityID("2023", "cve-2023-30608"),
+	}
+}
+
+// vertexIDs returns the ids of the vertices of the nodes
+func vertexIDs(nodes []model.Node) []string {
+	ids := []string{}
+	for _, n := range nodes {
+		id := helper.VertexID(n)
+		if id == "" {
+			id = evidenceID(n)
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestPath(t *testing.T) {
+	ctx := context.Background()
+	g := newTestGraph(ctx, t)
+	fullPath := []string{
+		g.artifactID,
+		g.occurrenceID,
+		g.versionID,
+		g.dependencyID,
+		g.depNameID,
+		g.depVersionID,
+		g.certifyVulnID,
+		g.cveID,
+	}
+
+	tests := []struct {
+		name          string
+		subject       string
+		target        string
+		maxPathLength int
+		usingOnly     []model.Edge
+		want          []string
+		wantErr       bool
+	}{{
+		name:          "artifact to cve",
+		subject:       g.artifactID,
+		target:        g.cveID,
+		maxPathLength: 10,
+		want:          fullPath,
+	}, {
+		name:          "cve to artifact",
+		subject:       g.cveID,
+		target:        g.artifactID,
+		maxPathLength: 7,
+		want: []string{
+			g.cveID,
+			g.certifyVulnID,
+			g.depVersionID,
+			g.depNameID,
+			g.dependencyID,
+			g.versionID,
+			g.occurrenceID,
+			g.artifactID,
+		},
+	}, {
+		name:          "path too long",
+		subject:       g.artifactID,
+		target:        g.cveID,
+		maxPathLength: 6,
+		want:          []string{},
+	}, {
+		name:          "using only the edges of the path",
+		subject:       g.artifactID,
+		target:        g.cveID,
+		maxPathLength: 10,
+		usingOnly: []model.Edge{
+			model.EdgeArtifactIsOccurrence,
+			model.EdgeIsOccurrencePackage,
+			model.EdgePackageIsDependency,
+			model.EdgeIsDependencyPackage,
+			model.EdgePackageNamePackageVersion,
+			model.EdgePackageCertifyVuln,
+			model.EdgeCertifyVulnCve,
+		},
+		want: fullPath,
+	}, {
+		name:          "using only edges in the other direction",
+		subject:       g.artifactID,
+		target:        g.cveID,
+		maxPathLength: 10,
+		usingOnly: []model.Edge{
+			model.EdgeIsOccurrenceArtifact,
+			model.EdgePackageIsOccurrence,
+			model.EdgeIsDependencyPackage,
+			model.EdgePackageIsDependency,
+			model.EdgePackageVersionPackageName,
+			model.EdgeCertifyVulnPackage,
+			model.EdgeCveCertifyVuln,
+		},
+		want: []string{},
+	}, {
+		name:          "same node",
+		subject:       g.dependencyID,
+		target:        g.dependencyID,
+		maxPathLength: 0,
+		want:          []string{g.dependencyID},
+	}, {
+		name:          "evidence to package",
+		subject:       g.dependencyID,
+		target:        g.versionID,
+		maxPathLength: 1,
+		want:          []string{g.dependencyID, g.versionID},
+	}, {
+		name:          "negative length",
+		subject:       g.artifactID,
+		target:        g.cveID,
+		maxPathLength: -1,
+		wantErr:       true,
+	}, {
+		name:          "not a vertex",
+		subject:       helper.PkgTypeID("pypi"),
+		target:        g.cveID,
+		maxPathLength: 10,
+		wantErr:       true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := g.b.Path(ctx, tt.subject, tt.target, tt.maxPathLength, tt.usingOnly)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Path() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, vertexIDs(got)); diff != "" {
+				t.Errorf("unexpected path (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func evidenceID(n model.Node) string {
+	switch e := n.(type) {
+	case *model.IsOccurrence:
+		return e.ID
+	case *model.IsDependency:
+		return e.ID
+	case *model.CertifyVuln:
+		return e.ID
+	}
+	return ""
+}
+
+func TestNeighbors(t *testing.T) {
+	ctx := context.Background()
+	g := newTestGraph(ctx, t)
+
+	tests := []struct {
+		name      string
+		node      string
+		usingOnly []model.Edge
+		want      []string
+		wantErr   bool
+	}{{
+		name: "package version",
+		node: g.versionID,
+		want: []string{g.nameID, g.occurrenceID, g.dependencyID},
+	}, {
+		name:      "package version dependencies",
+		node:      g.versionID,
+		usingOnly: []model.Edge{model.EdgePackageIsDependency},
+		want:      []string{g.dependencyID},
+	}, {
+		name: "package name",
+		node: g.depNameID,
+		want: []string{g.depVersionID, g.dependencyID},
+	}, {
+		name: "evidence",
+		node: g.dependencyID,
+		want: []string{g.versionID, g.depNameID},
+	}, {
+		name: "vulnerability",
+		node: g.cveID,
+		want: []string{g.certifyVulnID},
+	}, {
+		name:      "no matching edge",
+		node:      g.artifactID,
+		usingOnly: []model.Edge{model.EdgeIsOccurrenceArtifact},
+		want:      []string{},
+	}, {
+		name:    "not a vertex",
+		node:    helper.PkgTypeID("pypi"),
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := g.b.Neighbors(ctx, tt.node, tt.usingOnly)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Neighbors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, vertexIDs(got)); diff != "" {
+				t.Errorf("unexpected neighbors (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// Node ids are opaque to clients. They encode the GraphQL type of the node
+// followed by a key: the content of the node for the software trees, so that
+// their ids are the same across backends and ingestions, or an identifier
+// assigned by the backend for the evidence.
+
+const idSeparator = "\x00"
+
+func encodeID(typeName string, key ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(append([]string{typeName}, key...), idSeparator)))
+}
+
+// DecodeID returns the GraphQL type name and the key encoded in a node id
+func DecodeID(id string) (string, []string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid node id %q: %w", id, err)
+	}
+	parts := strings.Split(string(b), idSeparator)
+	return parts[0], parts[1:], nil
+}
+
+// EvidenceID returns the id of an evidence node from the identifier assigned
+// to it by the backend
+func EvidenceID(typeName string, backendID string) string {
+	return encodeID(typeName, backendID)
+}
+
+// EvidenceBackendID returns the identifier assigned by the backend to an
+// evidence node of the given type
+func EvidenceBackendID(typeName string, id string) (string, error) {
+	idType, key, err := DecodeID(id)
+	if err != nil {
+		return "", err
+	}
+	if idType != typeName || len(key) != 1 {
+		return "", fmt.Errorf("node id %q is not the id of a %s", id, typeName)
+	}
+	return key[0], nil
+}
+
+func childID(typeName string, parentID string, key ...string) string {
+	_, parentKey, _ := DecodeID(parentID)
+	return encodeID(typeName, append(parentKey, key...)...)
+}
+
+// PkgTypeID returns the id of the package trie root for the given type
+func PkgTypeID(pkgType string) string {
+	return encodeID("Package", pkgType)
+}
+
+// PkgNamespaceID returns the id of a package namespace from the id of its type
+func PkgNamespaceID(typeID string, namespace string) string {
+	return childID("PackageNamespace", typeID, namespace)
+}
+
+// PkgNameID returns the id of a package name from the id of its namespace
+func PkgNameID(namespaceID string, name string) string {
+	return childID("PackageName", namespaceID, name)
+}
+
+// PkgVersionID returns the id of a package version from the id of its name.
+// Qualifiers are unordered, so they are sorted before being encoded.
+func PkgVersionID(nameID string, version string, subpath string, qualifiers []*model.PackageQualifier) string {
+	qs := make([]string, 0, len(qualifiers))
+	for _, q := range qualifiers {
+		qs = append(qs, q.Key+"="+q.Value)
+	}
+	sort.Strings(qs)
+	return childID("PackageVersion", nameID, append([]string{version, subpath}, qs...)...)
+}
+
+// SrcTypeID returns the id of the source trie root for the given type
+func SrcTypeID(srcType string) string {
+	return encodeID("Source", srcType)
+}
+
+// SrcNamespaceID returns the id of a source namespace from the id of its type
+func SrcNamespaceID(typeID string, namespace string) string {
+	return childID("SourceNamespace", typeID, namespace)
+}
+
+// SrcNameID returns the id of a source name from the id of its namespace
+func SrcNameID(namespaceID string, name string, tag *string, commit *string) string {
+	tagString, commitString := "", ""
+	if tag != nil {
+		tagString = *tag
+	}
+	if commit != nil {
+		commitString = *commit
+	}
+	return childID("SourceName", namespaceID, name, tagString, commitString)
+}
+
+// ArtifactID returns the id of an artifact
+func ArtifactID(algorithm string, digest string) string {
+	return encodeID("Artifact", algorithm, digest)
+}
+
+// BuilderID returns the id of a builder
+func BuilderID(uri string) string {
+	return encodeID("Builder", uri)
+}
+
+// CveID returns the id of a CVE year, the root of the CVE trie
+func CveID(year string) string {
+	return encodeID("CVE", year)
+}
+
+// GhsaID returns the id of the GHSA trie root
+func GhsaID() string {
+	return encodeID("GHSA")
+}
+
+// OsvID returns the id of the OSV trie root
+func OsvID() string {
+	return encodeID("OSV")
+}
+
+// CveVulnerabilityID returns the id of a single CVE, identifying the CVE trie
+// holding only that CVE id
+func CveVulnerabilityID(year string, cveID string) string {
+	return childID("CVEId", CveID(year), cveID)
+}
+
+// GhsaVulnerabilityID returns the id of a single GHSA, identifying the GHSA
+// trie holding only that GHSA id
+func GhsaVulnerabilityID(ghsaID string) string {
+	return childID("GHSAId", GhsaID(), ghsaID)
+}
+
+// OsvVulnerabilityID returns the id of a single OSV, identifying the OSV trie
+// holding only that OSV id
+func OsvVulnerabilityID(osvID string) string {
+	return childID("OSVId", OsvID(), osvID)
+}
+
+// NoVulnID returns the id of the NoVuln singleton
+func NoVulnID() string {
+	return encodeID("NoVuln")
+}
+
+// SetPackageIDs sets the ids of all the nodes of a package trie
+func SetPackageIDs(pkg *model.Package) {
+	pkg.ID = PkgTypeID(pkg.Type)
+	for _, ns := range pkg.Namespaces {
+		ns.ID = PkgNamespaceID(pkg.ID, ns.Namespace)
+		for _, name := range ns.Names {
+			name.ID = PkgNameID(ns.ID, name.Name)
+			for _, version := range name.Versions {
+				version.ID = PkgVersionID(name.ID, version.Version, version.Subpath, version.Qualifiers)
+			}
+		}
+	}
+}
+
+// SetSourceIDs sets the ids of all the nodes of a source trie
+func SetSourceIDs(src *model.Source) {
+	src.ID = SrcTypeID(src.Type)
+	for _, ns := range src.Namespaces {
+		ns.ID = SrcNamespaceID(src.ID, ns.Namespace)
+		for _, name := range ns.Names {
+			name.ID = SrcNameID(ns.ID, name.Name, name.Tag, name.Commit)
+		}
+	}
+}
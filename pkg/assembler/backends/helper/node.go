@@ -0,0 +1,264 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Node returns the node of the GUAC graph with the given id, using the
+// retrieval queries of the backend. Nodes of the software trees below the
+// root are returned as the trie that leads to them.
+func Node(ctx context.Context, b backends.Backend, id string) (model.Node, error) {
+	typeName, key, err := DecodeID(id)
+	if err != nil {
+		return nil, gqlerror.Errorf("%v", err)
+	}
+
+	switch typeName {
+	case "Package", "PackageNamespace", "PackageName", "PackageVersion":
+		return pkgNode(ctx, b, id, typeName, key)
+	case "Source", "SourceNamespace", "SourceName":
+		return srcNode(ctx, b, id, typeName, key)
+	case "Artifact":
+		if len(key) != 2 {
+			break
+		}
+		return single(b.Artifacts(ctx, &model.ArtifactSpec{Algorithm: &key[0], Digest: &key[1]}))
+	case "Builder":
+		if len(key) != 1 {
+			break
+		}
+		return single(b.Builders(ctx, &model.BuilderSpec{URI: &key[0]}))
+	case "CVE":
+		if len(key) != 1 {
+			break
+		}
+		return single(b.Cve(ctx, &model.CVESpec{Year: &key[0]}))
+	case "GHSA":
+		return single(b.Ghsa(ctx, &model.GHSASpec{}))
+	case "OSV":
+		return single(b.Osv(ctx, &model.OSVSpec{}))
+	case "CVEId":
+		if len(key) != 2 {
+			break
+		}
+		return vulnerabilityNode(single(b.Cve(ctx, &model.CVESpec{Year: &key[0], CveID: &key[1]})))
+	case "GHSAId":
+		if len(key) != 1 {
+			break
+		}
+		return vulnerabilityNode(single(b.Ghsa(ctx, &model.GHSASpec{GhsaID: &key[0]})))
+	case "OSVId":
+		if len(key) != 1 {
+			break
+		}
+		return vulnerabilityNode(single(b.Osv(ctx, &model.OSVSpec{OsvID: &key[0]})))
+	case "NoVuln":
+		return &model.NoVuln{ID: id, NoVuln: true}, nil
+	case "CertifyBad":
+		return single(b.CertifyBad(ctx, &model.CertifyBadSpec{ID: &id}))
+	case "CertifyEOL":
+		return single(b.CertifyEol(ctx, &model.CertifyEOLSpec{ID: &id}))
+	case "CertifyPkg":
+		return single(b.CertifyPkg(ctx, &model.CertifyPkgSpec{ID: &id}))
+	case "CertifyScorecard":
+		return single(b.Scorecards(ctx, &model.CertifyScorecardSpec{ID: &id}))
+	case "CertifyVEXStatement":
+		return single(b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{ID: &id}))
+	case "CertifyVuln":
+		return single(b.CertifyVuln(ctx, &model.CertifyVulnSpec{ID: &id}))
+	case "HasSBOM":
+		return single(b.HasSBOM(ctx, &model.HasSBOMSpec{ID: &id}))
+	case "HasSLSA":
+		return single(b.HasSlsa(ctx, &model.HasSLSASpec{ID: &id}))
+	case "HasSourceAt":
+		return single(b.HasSourceAt(ctx, &model.HasSourceAtSpec{ID: &id}))
+	case "HashEqual":
+		return single(b.HashEqual(ctx, &model.HashEqualSpec{ID: &id}))
+	case "IsDependency":
+		return single(b.IsDependency(ctx, &model.IsDependencySpec{ID: &id}))
+	case "IsOccurrence":
+		return single(b.IsOccurrence(ctx, &model.IsOccurrenceSpec{ID: &id}))
+	case "IsVulnerability":
+		return single(b.IsVulnerability(ctx, &model.IsVulnerabilitySpec{ID: &id}))
+	case "VulnAffected":
+		return single(b.VulnAffected(ctx, &model.VulnAffectedSpec{ID: &id}))
+	case "VulnMetadata":
+		return single(b.VulnMetadata(ctx, &model.VulnMetadataSpec{ID: &id}))
+	}
+	return nil, gqlerror.Errorf("invalid node id %q", id)
+}
+
+// Nodes returns the nodes of the GUAC graph with the given ids, in the same
+// order
+func Nodes(ctx context.Context, b backends.Backend, ids []string) ([]model.Node, error) {
+	nodes := make([]model.Node, 0, len(ids))
+	for _, id := range ids {
+		node, err := b.Node(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func pkgNode(ctx context.Context, b backends.Backend, id string, typeName string, key []string) (model.Node, error) {
+	spec := &model.PkgSpec{}
+	switch {
+	case typeName == "Package" && len(key) == 1:
+	case typeName == "PackageNamespace" && len(key) == 2:
+		spec.Namespace = &key[1]
+	case typeName == "PackageName" && len(key) == 3:
+		spec.Namespace = &key[1]
+		spec.Name = &key[2]
+	case typeName == "PackageVersion" && len(key) >= 5:
+		spec.Namespace = &key[1]
+		spec.Name = &key[2]
+		spec.Version = &key[3]
+		spec.Subpath = &key[4]
+		matchOnlyEmptyQualifiers := len(key) == 5
+		spec.MatchOnlyEmptyQualifiers = &matchOnlyEmptyQualifiers
+		for _, qualifier := range key[5:] {
+			k, v, _ := strings.Cut(qualifier, "=")
+			spec.Qualifiers = append(spec.Qualifiers, &model.PackageQualifierSpec{Key: k, Value: &v})
+		}
+	default:
+		return nil, gqlerror.Errorf("invalid node id %q", id)
+	}
+	spec.Type = &key[0]
+
+	pkgs, err := b.Packages(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	if typeName == "PackageVersion" {
+		// qualifiers are matched as a subset, keep only the exact version
+		pkgs = filterPackageVersionID(pkgs, id)
+	}
+	return single(pkgs, nil)
+}
+
+func filterPackageVersionID(pkgs []*model.Package, id string) []*model.Package {
+	var filtered []*model.Package
+	for _, pkg := range pkgs {
+		for _, ns := range pkg.Namespaces {
+			for _, name := range ns.Names {
+				for _, version := range name.Versions {
+					if version.ID != id {
+						continue
+					}
+					filtered = append(filtered, &model.Package{
+						ID:   pkg.ID,
+						Type: pkg.Type,
+						Namespaces: []*model.PackageNamespace{{
+							ID:        ns.ID,
+							Namespace: ns.Namespace,
+							Names: []*model.PackageName{{
+								ID:       name.ID,
+								Name:     name.Name,
+								Versions: []*model.PackageVersion{version},
+							}},
+						}},
+					})
+				}
+			}
+		}
+	}
+	if len(filtered) == 0 {
+		// the versions have not been retrieved
+		return pkgs
+	}
+	return filtered
+}
+
+func srcNode(ctx context.Context, b backends.Backend, id string, typeName string, key []string) (model.Node, error) {
+	spec := &model.SourceSpec{}
+	switch {
+	case typeName == "Source" && len(key) == 1:
+	case typeName == "SourceNamespace" && len(key) == 2:
+		spec.Namespace = &key[1]
+	case typeName == "SourceName" && len(key) == 5:
+		spec.Namespace = &key[1]
+		spec.Name = &key[2]
+		// empty string matches names without tag (or commit)
+		if key[3] != "" || key[4] == "" {
+			spec.Tag = &key[3]
+		}
+		if key[4] != "" || key[3] == "" {
+			spec.Commit = &key[4]
+		}
+	default:
+		return nil, gqlerror.Errorf("invalid node id %q", id)
+	}
+	spec.Type = &key[0]
+	return single(b.Sources(ctx, spec))
+}
+
+func single[T model.Node](nodes []T, err error) (model.Node, error) {
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 0:
+		return nil, gqlerror.Errorf("node not found")
+	case 1:
+		return nodes[0], nil
+	}
+	return nil, gqlerror.Errorf("node id matches %d nodes", len(nodes))
+}
+
+// vulnerabilityNode returns the vulnerability trie found by the id of a single
+// vulnerability, with that id
+func vulnerabilityNode(node model.Node, err error) (model.Node, error) {
+	if err != nil {
+		return nil, err
+	}
+	return WithVulnerabilityID(node), nil
+}
+
+// WithVulnerabilityID returns a copy of a vulnerability trie holding a single
+// vulnerability id, with the id of that vulnerability as id. Other nodes are
+// returned as is.
+func WithVulnerabilityID(node model.Node) model.Node {
+	switch v := node.(type) {
+	case *model.Cve:
+		if len(v.CveID) == 1 {
+			cve := *v
+			cve.ID = CveVulnerabilityID(v.Year, v.CveID[0].ID)
+			return &cve
+		}
+	case *model.Ghsa:
+		if len(v.GhsaID) == 1 {
+			ghsa := *v
+			ghsa.ID = GhsaVulnerabilityID(v.GhsaID[0].ID)
+			return &ghsa
+		}
+	case *model.Osv:
+		if len(v.OsvID) == 1 {
+			osv := *v
+			osv.ID = OsvVulnerabilityID(v.OsvID[0].ID)
+			return &osv
+		}
+	}
+	return node
+}
@@ -0,0 +1,210 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func ptrfrom[T any](t T) *T {
+	return &t
+}
+
+func TestNode(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("unable to create backend: %v", err)
+	}
+
+	pkg := model.PkgInputSpec{
+		Type:      "pypi",
+		Namespace: ptrfrom(""),
+		Name:      "django",
+		Version:   ptrfrom("1.11.1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{
+			{Key: "b", Value: "2"},
+			{Key: "a", Value: "1"},
+		},
+	}
+	otherVersion := model.PkgInputSpec{
+		Type:      "pypi",
+		Namespace: ptrfrom(""),
+		Name:      "django",
+		Version:   ptrfrom("1.11.1"),
+		Qualifiers: []*model.PackageQualifierInputSpec{
+			{Key: "a", Value: "1"},
+		},
+	}
+	depPkg := model.PkgInputSpec{
+		Type:      "pypi",
+		Namespace: ptrfrom(""),
+		Name:      "sqlparse",
+	}
+	for _, p := range []*model.PkgInputSpec{&pkg, &otherVersion, &depPkg} {
+		if _, err := b.IngestPackage(ctx, p); err != nil {
+			t.Fatalf("unable to ingest package: %v", err)
+		}
+	}
+	dependency, err := b.IngestDependency(ctx, pkg, depPkg, model.IsDependencyInputSpec{
+		VersionRange:  ">=0.2",
+		Justification: "test",
+		Origin:        "test",
+		Collector:     "test",
+	})
+	if err != nil {
+		t.Fatalf("unable to ingest dependency: %v", err)
+	}
+	src, err := b.IngestSource(ctx, &model.SourceInputSpec{
+		Type:      "git",
+		Namespace: "github.com/django",
+		Name:      "django",
+		Tag:       ptrfrom("1.11.1"),
+	})
+	if err != nil {
+		t.Fatalf("unable to ingest source: %v", err)
+	}
+	artifact, err := b.IngestArtifact(ctx, &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abc"})
+	if err != nil {
+		t.Fatalf("unable to ingest artifact: %v", err)
+	}
+
+	typeID := helper.PkgTypeID("pypi")
+	nameID := helper.PkgNameID(helper.PkgNamespaceID(typeID, ""), "django")
+	// qualifiers are in a different order than at ingestion
+	versionID := helper.PkgVersionID(nameID, "1.11.1", "", []*model.PackageQualifier{
+		{Key: "a", Value: "1"},
+		{Key: "b", Value: "2"},
+	})
+	srcNameID := helper.SrcNameID(helper.SrcNamespaceID(helper.SrcTypeID("git"), "github.com/django"), "django", ptrfrom("1.11.1"), nil)
+
+	if dependency.ID == "" {
+		t.Fatalf("ingested dependency has no id")
+	}
+	if src.ID != helper.SrcTypeID("git") {
+		t.Errorf("source id = %q, want %q", src.ID, helper.SrcTypeID("git"))
+	}
+	if artifact.ID != helper.ArtifactID("sha256", "abc") {
+		t.Errorf("artifact id = %q, want %q", artifact.ID, helper.ArtifactID("sha256", "abc"))
+	}
+
+	// versions returns the ids of the versions in a package trie
+	versions := func(n model.Node) []string {
+		ids := []string{}
+		for _, ns := range n.(*model.Package).Namespaces {
+			for _, name := range ns.Names {
+				for _, version := range name.Versions {
+					ids = append(ids, version.ID)
+				}
+			}
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		check   func(t *testing.T, n model.Node)
+		wantErr bool
+	}{{
+		name: "package type",
+		id:   typeID,
+		check: func(t *testing.T, n model.Node) {
+			if got := len(versions(n)); got != 3 {
+				t.Errorf("got %d versions, want 3", got)
+			}
+		},
+	}, {
+		name: "package name",
+		id:   nameID,
+		check: func(t *testing.T, n model.Node) {
+			if got := len(versions(n)); got != 2 {
+				t.Errorf("got %d versions, want 2", got)
+			}
+		},
+	}, {
+		name: "package version",
+		id:   versionID,
+		check: func(t *testing.T, n model.Node) {
+			if diff := cmp.Diff([]string{versionID}, versions(n)); diff != "" {
+				t.Errorf("unexpected versions (-want +got):\n%s", diff)
+			}
+		},
+	}, {
+		name: "source name",
+		id:   srcNameID,
+		check: func(t *testing.T, n model.Node) {
+			if got := n.(*model.Source).Namespaces[0].Names[0].ID; got != srcNameID {
+				t.Errorf("got source name %q, want %q", got, srcNameID)
+			}
+		},
+	}, {
+		name: "artifact",
+		id:   artifact.ID,
+		check: func(t *testing.T, n model.Node) {
+			if diff := cmp.Diff(artifact, n); diff != "" {
+				t.Errorf("unexpected artifact (-want +got):\n%s", diff)
+			}
+		},
+	}, {
+		name: "evidence",
+		id:   dependency.ID,
+		check: func(t *testing.T, n model.Node) {
+			if got := n.(*model.IsDependency).ID; got != dependency.ID {
+				t.Errorf("got dependency %q, want %q", got, dependency.ID)
+			}
+		},
+	}, {
+		name:    "missing evidence",
+		id:      helper.EvidenceID("IsDependency", "1000"),
+		wantErr: true,
+	}, {
+		name:    "missing package",
+		id:      helper.PkgTypeID("npm"),
+		wantErr: true,
+	}, {
+		name:    "invalid id",
+		id:      "not an id",
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := b.Node(ctx, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Node() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.check != nil {
+				tt.check(t, got)
+			}
+		})
+	}
+
+	t.Run("nodes", func(t *testing.T) {
+		nodes, err := b.Nodes(ctx, []string{dependency.ID, artifact.ID})
+		if err != nil {
+			t.Fatalf("Nodes() error = %v", err)
+		}
+		if diff := cmp.Diff([]model.Node{dependency, artifact}, nodes); diff != "" {
+			t.Errorf("unexpected nodes (-want +got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,238 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// DefaultPageSize is the number of results in a page when the list query does
+// not set it
+const DefaultPageSize = 100
+
+// Page is a page of the results of a list query: the nodes of the page in the
+// order of the backend, with their cursors
+type Page[T any] struct {
+	Nodes       []T
+	Cursors     []string
+	HasNextPage bool
+	TotalCount  int
+}
+
+// PageInfo returns the GraphQL description of the page
+func (p *Page[T]) PageInfo() *model.PageInfo {
+	info := &model.PageInfo{HasNextPage: p.HasNextPage}
+	if len(p.Cursors) > 0 {
+		info.StartCursor = &p.Cursors[0]
+		info.EndCursor = &p.Cursors[len(p.Cursors)-1]
+	}
+	return info
+}
+
+// PageSize returns the number of results to return in a page
+func PageSize(first *int) (int, error) {
+	if first == nil {
+		return DefaultPageSize, nil
+	}
+	if *first < 1 {
+		return 0, gqlerror.Errorf("first must be positive, got %d", *first)
+	}
+	return *first, nil
+}
+
+// Cursor returns the opaque cursor of a result from the key the backend
+// orders the results by
+func Cursor(key string) string {
+	return encodeID("Cursor", key)
+}
+
+// CursorKey returns the key the backend orders the results by from a cursor
+func CursorKey(cursor string) (string, error) {
+	typeName, key, err := DecodeID(cursor)
+	if err != nil || typeName != "Cursor" || len(key) != 1 {
+		return "", gqlerror.Errorf("invalid cursor %q", cursor)
+	}
+	return key[0], nil
+}
+
+// Paginate returns the page of nodes after the node with the given cursor. The
+// nodes must always be listed in the same order by the backend and key returns
+// the unique key of a node in that list.
+func Paginate[T any](nodes []T, key func(T) string, after *string, first *int) (*Page[T], error) {
+	size, err := PageSize(first)
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+	if after != nil {
+		afterKey, err := CursorKey(*after)
+		if err != nil {
+			return nil, err
+		}
+		start = -1
+		for i, n := range nodes {
+			if key(n) == afterKey {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			return nil, gqlerror.Errorf("cursor %q does not match any result", *after)
+		}
+	}
+
+	end := start + size
+	if end > len(nodes) {
+		end = len(nodes)
+	}
+	page := &Page[T]{
+		Nodes:       nodes[start:end],
+		Cursors:     make([]string, 0, end-start),
+		HasNextPage: end < len(nodes),
+		TotalCount:  len(nodes),
+	}
+	for _, n := range page.Nodes {
+		page.Cursors = append(page.Cursors, Cursor(key(n)))
+	}
+	return page, nil
+}
+
+// The paginated queries over the software trees return one trie per leaf, so
+// that the page size counts package versions, source names and vulnerability
+// ids instead of tries of arbitrary size.
+
+// FlattenPackages returns a package trie for each version in the tries
+func FlattenPackages(pkgs []*model.Package) []*model.Package {
+	var flat []*model.Package
+	for _, p := range pkgs {
+		for _, ns := range p.Namespaces {
+			for _, n := range ns.Names {
+				for _, v := range n.Versions {
+					flat = append(flat, &model.Package{
+						ID:   p.ID,
+						Type: p.Type,
+						Namespaces: []*model.PackageNamespace{{
+							ID:        ns.ID,
+							Namespace: ns.Namespace,
+							Names: []*model.PackageName{{
+								ID:       n.ID,
+								Name:     n.Name,
+								Versions: []*model.PackageVersion{v},
+							}},
+						}},
+					})
+				}
+			}
+		}
+	}
+	return flat
+}
+
+// PackageKey returns the key of a package trie with a single version
+func PackageKey(pkg *model.Package) string {
+	return pkg.Namespaces[0].Names[0].Versions[0].ID
+}
+
+// FlattenSources returns a source trie for each name in the tries
+func FlattenSources(srcs []*model.Source) []*model.Source {
+	var flat []*model.Source
+	for _, s := range srcs {
+		for _, ns := range s.Namespaces {
+			for _, n := range ns.Names {
+				flat = append(flat, &model.Source{
+					ID:   s.ID,
+					Type: s.Type,
+					Namespaces: []*model.SourceNamespace{{
+						ID:        ns.ID,
+						Namespace: ns.Namespace,
+						Names:     []*model.SourceName{n},
+					}},
+				})
+			}
+		}
+	}
+	return flat
+}
+
+// SourceKey returns the key of a source trie with a single name
+func SourceKey(src *model.Source) string {
+	return src.Namespaces[0].Names[0].ID
+}
+
+// FlattenCves returns a CVE trie for each CVE id in the tries
+func FlattenCves(cves []*model.Cve) []*model.Cve {
+	var flat []*model.Cve
+	for _, c := range cves {
+		for _, id := range c.CveID {
+			flat = append(flat, &model.Cve{
+				ID:    c.ID,
+				Year:  c.Year,
+				CveID: []*model.CVEId{id},
+			})
+		}
+	}
+	return flat
+}
+
+// CveKey returns the key of a CVE trie with a single CVE id
+func CveKey(cve *model.Cve) string {
+	return cve.CveID[0].ID
+}
+
+// FlattenGhsas returns a GHSA trie for each GHSA id in the tries
+func FlattenGhsas(ghsas []*model.Ghsa) []*model.Ghsa {
+	var flat []*model.Ghsa
+	for _, g := range ghsas {
+		for _, id := range g.GhsaID {
+			flat = append(flat, &model.Ghsa{
+				ID:     g.ID,
+				GhsaID: []*model.GHSAId{id},
+			})
+		}
+	}
+	return flat
+}
+
+// GhsaKey returns the key of a GHSA trie with a single GHSA id
+func GhsaKey(ghsa *model.Ghsa) string {
+	return ghsa.GhsaID[0].ID
+}
+
+// FlattenOsvs returns an OSV trie for each OSV id in the tries
+func FlattenOsvs(osvs []*model.Osv) []*model.Osv {
+	var flat []*model.Osv
+	for _, o := range osvs {
+		for _, id := range o.OsvID {
+			flat = append(flat, &model.Osv{
+				ID:    o.ID,
+				OsvID: []*model.OSVId{id},
+			})
+		}
+	}
+	return flat
+}
+
+// OsvKey returns the key of an OSV trie with a single OSV id
+func OsvKey(osv *model.Osv) string {
+	return osv.OsvID[0].ID
+}
+
+// AffectedPackageKey returns the key of an affected package version
+func AffectedPackageKey(a *model.AffectedPackage) string {
+	return PackageKey(a.Package) + " " + a.VulnAffected.ID
+}
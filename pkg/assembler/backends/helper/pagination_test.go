@@ -0,0 +1,158 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestPaginate(t *testing.T) {
+	nodes := []string{"a", "b", "c", "d", "e"}
+	key := func(n string) string { return n }
+	tests := []struct {
+		name     string
+		after    *string
+		first    *int
+		want     []string
+		wantNext bool
+		wantErr  bool
+	}{{
+		name:  "default page size",
+		first: nil,
+		want:  []string{"a", "b", "c", "d", "e"},
+	}, {
+		name:     "first page",
+		first:    ptrfrom(2),
+		want:     []string{"a", "b"},
+		wantNext: true,
+	}, {
+		name:     "middle page",
+		after:    ptrfrom(helper.Cursor("b")),
+		first:    ptrfrom(2),
+		want:     []string{"c", "d"},
+		wantNext: true,
+	}, {
+		name:  "last page",
+		after: ptrfrom(helper.Cursor("c")),
+		first: ptrfrom(2),
+		want:  []string{"d", "e"},
+	}, {
+		name:  "after the end",
+		after: ptrfrom(helper.Cursor("e")),
+		want:  []string{},
+	}, {
+		name:    "zero page size",
+		first:   ptrfrom(0),
+		wantErr: true,
+	}, {
+		name:    "negative page size",
+		first:   ptrfrom(-1),
+		wantErr: true,
+	}, {
+		name:    "unknown cursor",
+		after:   ptrfrom(helper.Cursor("z")),
+		wantErr: true,
+	}, {
+		name:    "invalid cursor",
+		after:   ptrfrom("b"),
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			page, err := helper.Paginate(nodes, key, test.after, test.first)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %t", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.want, page.Nodes); diff != "" {
+				t.Errorf("unexpected nodes (-want +got):\n%s", diff)
+			}
+			if page.HasNextPage != test.wantNext {
+				t.Errorf("got hasNextPage %t, want %t", page.HasNextPage, test.wantNext)
+			}
+			if page.TotalCount != len(nodes) {
+				t.Errorf("got totalCount %d, want %d", page.TotalCount, len(nodes))
+			}
+		})
+	}
+}
+
+func TestPackagesList(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("unable to create backend: %v", err)
+	}
+
+	for _, name := range []string{"django", "sqlparse"} {
+		for _, version := range []string{"1.0", "2.0", "3.0"} {
+			_, err := b.IngestPackage(ctx, &model.PkgInputSpec{
+				Type:      "pypi",
+				Namespace: ptrfrom(""),
+				Name:      name,
+				Version:   ptrfrom(version),
+			})
+			if err != nil {
+				t.Fatalf("unable to ingest package: %v", err)
+			}
+		}
+	}
+	pkgs, err := b.Packages(ctx, &model.PkgSpec{Type: ptrfrom("pypi")})
+	if err != nil {
+		t.Fatalf("unable to query packages: %v", err)
+	}
+	var want []string
+	for _, p := range helper.FlattenPackages(pkgs) {
+		want = append(want, helper.PackageKey(p))
+	}
+	if len(want) != 6 {
+		t.Fatalf("got %d versions, want 6", len(want))
+	}
+
+	// read all versions two at a time
+	var got []string
+	var after *string
+	for pages := 1; ; pages++ {
+		conn, err := b.PackagesList(ctx, &model.PkgSpec{Type: ptrfrom("pypi")}, after, ptrfrom(2))
+		if err != nil {
+			t.Fatalf("unable to list packages: %v", err)
+		}
+		if conn.TotalCount != len(want) {
+			t.Errorf("got totalCount %d, want %d", conn.TotalCount, len(want))
+		}
+		for _, e := range conn.Edges {
+			got = append(got, helper.PackageKey(e.Node))
+		}
+		if !conn.PageInfo.HasNextPage {
+			if pages != 3 {
+				t.Errorf("got %d pages, want 3", pages)
+			}
+			break
+		}
+		after = conn.PageInfo.EndCursor
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected versions (-want +got):\n%s", diff)
+	}
+}
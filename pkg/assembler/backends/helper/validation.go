@@ -31,12 +31,32 @@ func ValidateOsvCveOrGhsaIngestionInput(vulnerability model.OsvCveOrGhsaInput) e
 	if vulnerability.Cve != nil {
 		vulnDefined = vulnDefined + 1
 	}
+	if vulnerability.NoVuln != nil {
+		if !*vulnerability.NoVuln {
+			return gqlerror.Errorf("noVuln must be true when specified")
+		}
+		vulnDefined = vulnDefined + 1
+	}
 	if vulnDefined != 1 {
-		return gqlerror.Errorf("Must specify at most one vulnerability (cve, osv, or ghsa)")
+		return gqlerror.Errorf("Must specify at most one vulnerability (cve, osv, ghsa or noVuln)")
 	}
 	return nil
 }
 
+func ValidateVulnMetadataIngestionInput(vulnerability model.OsvCveOrGhsaInput) error {
+	if vulnerability.NoVuln != nil {
+		return gqlerror.Errorf("noVuln cannot have vulnerability metadata")
+	}
+	return ValidateOsvCveOrGhsaIngestionInput(vulnerability)
+}
+
+func ValidateVulnAffectedIngestionInput(vulnerability model.OsvCveOrGhsaInput) error {
+	if vulnerability.NoVuln != nil {
+		return gqlerror.Errorf("noVuln cannot have affected versions")
+	}
+	return ValidateOsvCveOrGhsaIngestionInput(vulnerability)
+}
+
 func ValidateOsvCveOrGhsaQueryInput(vulnerability *model.OsvCveOrGhsaSpec) (bool, error) {
 	if vulnerability == nil {
 		return true, nil
@@ -51,8 +71,11 @@ func ValidateOsvCveOrGhsaQueryInput(vulnerability *model.OsvCveOrGhsaSpec) (bool
 		if vulnerability.Cve != nil {
 			vulnDefined = vulnDefined + 1
 		}
+		if vulnerability.NoVuln != nil {
+			vulnDefined = vulnDefined + 1
+		}
 		if vulnDefined != 1 {
-			return false, gqlerror.Errorf("Must specify at most one vulnerability (cve, osv, or ghsa)")
+			return false, gqlerror.Errorf("Must specify at most one vulnerability (cve, osv, ghsa or noVuln)")
 		}
 	}
 	return false, nil
@@ -19,6 +19,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -91,6 +92,30 @@ func (c *neo4jClient) Artifacts(ctx context.Context, artifactSpec *model.Artifac
 	return result.([]*model.Artifact), nil
 }
 
+func (c *neo4jClient) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	sb.WriteString("MATCH (a:Artifact)")
+
+	setArtifactMatchValues(&sb, artifactSpec, false, &firstMatch, queryValues)
+
+	query := queryPart{match: sb.String(), returnValue: " RETURN a.algorithm, a.digest, id(a)"}
+	page, err := listNodes(c, p, query, queryValues, "a", func(values []interface{}) *model.Artifact {
+		return generateModelArtifact(values[0].(string), values[1].(string))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.ArtifactConnection(page), nil
+}
+
 func (c *neo4jClient) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close()
@@ -156,6 +181,7 @@ func setArtifactMatchValues(sb *strings.Builder, art *model.ArtifactSpec, object
 
 func generateModelArtifact(algorithm, digest string) *model.Artifact {
 	artifact := model.Artifact{
+		ID:        helper.ArtifactID(algorithm, digest),
 		Algorithm: algorithm,
 		Digest:    digest,
 	}
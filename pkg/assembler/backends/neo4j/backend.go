@@ -21,7 +21,10 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
 )
 
 const (
@@ -105,6 +108,51 @@ func matchProperties(sb *strings.Builder, firstMatch bool, label, property strin
 	sb.WriteString(resolver)
 }
 
+// evidenceIDProperty is the property holding the identifier generated for an
+// evidence node when it is ingested. Unlike the identifiers neo4j assigns to
+// the nodes, it is never reused.
+const evidenceIDProperty = "evidenceId"
+
+// setEvidenceID returns the clause generating the identifier of the evidence
+// node bound to label, to follow the MERGE of the node. The identifier is kept
+// when the same evidence is ingested again.
+func setEvidenceID(label string) string {
+	return "\nON CREATE SET " + label + "." + evidenceIDProperty + " = randomUUID()"
+}
+
+// matchID matches the evidence node with the given label on the identifier
+// generated for it. An id that does not belong to an evidence node of the
+// given type matches no node.
+func matchID(sb *strings.Builder, firstMatch bool, label, typeName string, id string, queryValues map[string]any) {
+	if firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	sb.WriteString(label)
+	sb.WriteString("." + evidenceIDProperty + " = $id")
+
+	evidenceID, err := helper.EvidenceBackendID(typeName, id)
+	if err != nil {
+		evidenceID = ""
+	}
+	queryValues["id"] = evidenceID
+}
+
+// evidenceID returns the id of an evidence node of the given type
+func evidenceID(typeName string, node dbtype.Node) string {
+	id, _ := node.Props[evidenceIDProperty].(string)
+	return helper.EvidenceID(typeName, id)
+}
+
+func (c *neo4jClient) Node(ctx context.Context, id string) (model.Node, error) {
+	return helper.Node(ctx, c, id)
+}
+
+func (c *neo4jClient) Nodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	return helper.Nodes(ctx, c, ids)
+}
+
 // getPreloads get the specific graphQL query fields that are requested.
 // graphql.CollectAllFields only provides the top level fields and none of the nested fields below it.
 // getPreloads recursively goes through the fields and retrieves each nested field below it.
@@ -18,6 +18,7 @@ package neo4jBackend
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -85,6 +86,28 @@ func (c *neo4jClient) Builders(ctx context.Context, builderSpec *model.BuilderSp
 	return result.([]*model.Builder), nil
 }
 
+func (c *neo4jClient) BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	query := queryPart{match: "MATCH (b:Builder)", returnValue: " RETURN b.uri, id(b)"}
+	values := map[string]any{}
+	if builderSpec.URI != nil {
+		query.match = "MATCH (b:Builder) WHERE b.uri = $uri"
+		values["uri"] = *builderSpec.URI
+	}
+
+	page, err := listNodes(c, p, query, values, "b", func(values []interface{}) *model.Builder {
+		return generateModelBuilder(values[0].(string))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.BuilderConnection(page), nil
+}
+
 func (c *neo4jClient) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
 	defer session.Close()
@@ -119,6 +142,7 @@ func (c *neo4jClient) IngestBuilder(ctx context.Context, builder *model.BuilderI
 
 func generateModelBuilder(uri string) *model.Builder {
 	builder := model.Builder{
+		ID:  helper.BuilderID(uri),
 		URI: uri,
 	}
 	return &builder
@@ -57,15 +57,15 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 			setPkgMatchValues(&sb, certifyBadSpec.Subject.Package, false, &firstMatch, queryValues)
 		}
 		setCertifyBadValues(&sb, certifyBadSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		if certifyBadSpec.Subject.Package == nil || certifyBadSpec.Subject.Package != nil && certifyBadSpec.Subject.Package.Version == nil &&
 			certifyBadSpec.Subject.Package.Subpath == nil && len(certifyBadSpec.Subject.Package.Qualifiers) == 0 &&
 			!*certifyBadSpec.Subject.Package.MatchOnlyEmptyQualifiers {
 
-			sb.WriteString("\nUNION")
+			sb.Reset()
 			// query without pkgVersion
-			query = "\nMATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+			query = "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
 				"-[:PkgHasName]->(name:PkgName)-[:subject]-(certifyBad:CertifyBad)" +
 				"\nWITH *, null AS version"
 			sb.WriteString(query)
@@ -76,12 +76,12 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 				setPkgMatchValues(&sb, certifyBadSpec.Subject.Package, false, &firstMatch, queryValues)
 			}
 			setCertifyBadValues(&sb, certifyBadSpec, &firstMatch, queryValues)
-			sb.WriteString(returnValue)
+			queryParts = append(queryParts, queryPart{match: sb.String(), returnValue: returnValue})
 		}
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyBad")
 				if err != nil {
 					return nil, err
 				}
@@ -106,6 +106,7 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 					}
 
 					certifyBad := generateModelCertifyBad(pkg, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad.ID = evidenceID("CertifyBad", certifyBadNode)
 
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
@@ -135,11 +136,11 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 			setSrcMatchValues(&sb, certifyBadSpec.Subject.Source, false, &firstMatch, queryValues)
 		}
 		setCertifyBadValues(&sb, certifyBadSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyBad")
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyBad"}}
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyBad")
 				if err != nil {
 					return nil, err
 				}
@@ -163,6 +164,7 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 					}
 
 					certifyBad := generateModelCertifyBad(src, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad.ID = evidenceID("CertifyBad", certifyBadNode)
 
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
@@ -190,11 +192,11 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 			setArtifactMatchValues(&sb, certifyBadSpec.Subject.Artifact, false, &firstMatch, queryValues)
 		}
 		setCertifyBadValues(&sb, certifyBadSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN a.algorithm, a.digest, certifyBad")
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN a.algorithm, a.digest, certifyBad"}}
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyBad")
 				if err != nil {
 					return nil, err
 				}
@@ -214,6 +216,7 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 					}
 
 					certifyBad := generateModelCertifyBad(artifact, certifyBadNode.Props[justification].(string), certifyBadNode.Props[origin].(string), certifyBadNode.Props[collector].(string))
+					certifyBad.ID = evidenceID("CertifyBad", certifyBadNode)
 					collectedCertifyBad = append(collectedCertifyBad, certifyBad)
 				}
 				if err = result.Err(); err != nil {
@@ -232,7 +235,27 @@ func (c *neo4jClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Cert
 
 }
 
+func (c *neo4jClient) CertifyBadList(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.CertifyBad(withPager(ctx, p), certifyBadSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "CertifyBad", found, func(n *model.CertifyBad) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyBadConnection(page), nil
+}
+
 func setCertifyBadValues(sb *strings.Builder, certifyBadSpec *model.CertifyBadSpec, firstMatch *bool, queryValues map[string]any) {
+	if certifyBadSpec.ID != nil {
+		matchID(sb, *firstMatch, "certifyBad", "CertifyBad", *certifyBadSpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if certifyBadSpec.Justification != nil {
 		matchProperties(sb, *firstMatch, "certifyBad", "justification", "$justification")
 		*firstMatch = false
@@ -0,0 +1,280 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	product     string = "product"
+	cycle       string = "cycle"
+	eol         string = "eol"
+	support     string = "support"
+	latest      string = "latest"
+	timeChecked string = "timeChecked"
+)
+
+// Query CertifyEOL
+
+func (c *neo4jClient) CertifyEol(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec) ([]*model.CertifyEol, error) {
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close()
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	query := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+		"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)" +
+		"<-[:subject]-(certifyEOL:CertifyEOL)"
+	sb.WriteString(query)
+
+	if certifyEOLSpec.Package != nil {
+		setPkgMatchValues(&sb, certifyEOLSpec.Package, false, &firstMatch, queryValues)
+	}
+	setCertifyEOLValues(&sb, certifyEOLSpec, &firstMatch, queryValues)
+	queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
+		"version.qualifier_list, certifyEOL"}}
+
+	result, err := session.ReadTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+
+			result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyEOL")
+			if err != nil {
+				return nil, err
+			}
+
+			collectedCertifyEOL := []*model.CertifyEol{}
+
+			for result.Next() {
+				certifyEOL, err := generateModelCertifyEOL(result.Record().Values)
+				if err != nil {
+					return nil, err
+				}
+				collectedCertifyEOL = append(collectedCertifyEOL, certifyEOL)
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return collectedCertifyEOL, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.CertifyEol), nil
+}
+
+func (c *neo4jClient) CertifyEOLList(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec, after *string, first *int) (*model.CertifyEOLConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.CertifyEol(withPager(ctx, p), certifyEOLSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "CertifyEOL", found, func(n *model.CertifyEol) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyEOLConnection(page), nil
+}
+
+func setCertifyEOLValues(sb *strings.Builder, certifyEOLSpec *model.CertifyEOLSpec, firstMatch *bool, queryValues map[string]any) {
+	if certifyEOLSpec.ID != nil {
+		matchID(sb, *firstMatch, "certifyEOL", "CertifyEOL", *certifyEOLSpec.ID, queryValues)
+		*firstMatch = false
+	}
+	if certifyEOLSpec.Product != nil {
+		matchProperties(sb, *firstMatch, "certifyEOL", product, "$"+product)
+		*firstMatch = false
+		queryValues[product] = certifyEOLSpec.Product
+	}
+	if certifyEOLSpec.Cycle != nil {
+		matchProperties(sb, *firstMatch, "certifyEOL", cycle, "$"+cycle)
+		*firstMatch = false
+		queryValues[cycle] = certifyEOLSpec.Cycle
+	}
+	if certifyEOLSpec.Status != nil {
+		matchProperties(sb, *firstMatch, "certifyEOL", status, "$"+status)
+		*firstMatch = false
+		queryValues[status] = certifyEOLSpec.Status.String()
+	}
+	if certifyEOLSpec.EolBefore != nil {
+		whereOrAnd(sb, firstMatch)
+		sb.WriteString("certifyEOL.eol < $eolBefore")
+		queryValues["eolBefore"] = certifyEOLSpec.EolBefore.UTC()
+	}
+	if certifyEOLSpec.Origin != nil {
+		matchProperties(sb, *firstMatch, "certifyEOL", origin, "$"+origin)
+		*firstMatch = false
+		queryValues[origin] = certifyEOLSpec.Origin
+	}
+	if certifyEOLSpec.Collector != nil {
+		matchProperties(sb, *firstMatch, "certifyEOL", collector, "$"+collector)
+		*firstMatch = false
+		queryValues[collector] = certifyEOLSpec.Collector
+	}
+}
+
+// generateModelCertifyEOL builds the CertifyEOL from a record returning the
+// package version path followed by the certifyEOL node
+func generateModelCertifyEOL(values []interface{}) (*model.CertifyEol, error) {
+	pkgQualifiers := values[5]
+	subPath := values[4]
+	version := values[3]
+	nameString := values[2].(string)
+	namespaceString := values[1].(string)
+	typeString := values[0].(string)
+
+	pkg := generateModelPackage(typeString, namespaceString, nameString, version, subPath, pkgQualifiers)
+
+	certifyEOLNode, ok := values[6].(dbtype.Node)
+	if !ok {
+		return nil, gqlerror.Errorf("certifyEOL Node not found in neo4j")
+	}
+
+	certifyEOL := &model.CertifyEol{
+		ID:          evidenceID("CertifyEOL", certifyEOLNode),
+		Package:     pkg,
+		Product:     certifyEOLNode.Props[product].(string),
+		Cycle:       certifyEOLNode.Props[cycle].(string),
+		Status:      model.SupportStatus(certifyEOLNode.Props[status].(string)),
+		Latest:      certifyEOLNode.Props[latest].(string),
+		TimeChecked: certifyEOLNode.Props[timeChecked].(time.Time),
+		Origin:      certifyEOLNode.Props[origin].(string),
+		Collector:   certifyEOLNode.Props[collector].(string),
+	}
+	if t, ok := certifyEOLNode.Props[eol].(time.Time); ok {
+		certifyEOL.Eol = &t
+	}
+	if t, ok := certifyEOLNode.Props[support].(time.Time); ok {
+		certifyEOL.Support = &t
+	}
+	return certifyEOL, nil
+}
+
+// Ingest CertifyEOL
+
+func (c *neo4jClient) IngestCertifyEol(ctx context.Context, pkg model.PkgInputSpec, certifyEol model.CertifyEOLInputSpec) (*model.CertifyEol, error) {
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close()
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	queryValues[product] = certifyEol.Product
+	queryValues[cycle] = certifyEol.Cycle
+	queryValues[status] = certifyEol.Status.String()
+	queryValues[eol] = nil
+	if certifyEol.Eol != nil {
+		queryValues[eol] = certifyEol.Eol.UTC()
+	}
+	queryValues[support] = nil
+	if certifyEol.Support != nil {
+		queryValues[support] = certifyEol.Support.UTC()
+	}
+	queryValues[latest] = certifyEol.Latest
+	queryValues[timeChecked] = certifyEol.TimeChecked.UTC()
+	queryValues[origin] = certifyEol.Origin
+	queryValues[collector] = certifyEol.Collector
+
+	selectedPkgSpec := helper.ConvertPkgInputSpecToPkgSpec(&pkg)
+
+	query := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+		"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)"
+	sb.WriteString(query)
+	setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+
+	// a newer check of the same cycle replaces the status
+	merge := "\nMERGE (version)<-[:subject]-(certifyEOL:CertifyEOL{product:$product,cycle:$cycle,origin:$origin,collector:$collector})" +
+		setEvidenceID("certifyEOL") +
+		"\nSET certifyEOL.status = $status, certifyEOL.eol = $eol, certifyEOL.support = $support, " +
+		"certifyEOL.latest = $latest, certifyEOL.timeChecked = $timeChecked"
+	sb.WriteString(merge)
+	sb.WriteString(" RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
+		"version.qualifier_list, certifyEOL")
+
+	result, err := session.WriteTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			// query returns a single record
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return generateModelCertifyEOL(record.Values)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.CertifyEol), nil
+}
+
+// Query EOLDependents
+
+func (c *neo4jClient) EolDependents(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec) ([]*model.IsDependency, error) {
+
+	foundCertifyEOL, err := c.CertifyEol(ctx, certifyEOLSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.EOLDependents(ctx, foundCertifyEOL, c.IsDependency)
+}
+
+func (c *neo4jClient) EolDependentsList(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	// dependents are found from each end-of-life package, so they are
+	// paginated once all are found
+	found, err := c.EolDependents(ctx, certifyEOLSpec)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(found))
+	for _, d := range found {
+		ids = append(ids, d.ID)
+	}
+	p.countKeys("EolDependents", ids)
+	page, err := evidencePage(p, "IsDependency", found, func(n *model.IsDependency) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsDependencyConnection(page), nil
+}
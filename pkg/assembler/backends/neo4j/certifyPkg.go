@@ -37,7 +37,6 @@ func (c *neo4jClient) CertifyPkg(ctx context.Context, certifyPkgSpec *model.Cert
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
 
-	var sb strings.Builder
 	var selectedPkg *model.PkgSpec = nil
 	var dependentPkg *model.PkgSpec = nil
 	if certifyPkgSpec.Packages != nil && len(certifyPkgSpec.Packages) != 0 {
@@ -52,16 +51,16 @@ func (c *neo4jClient) CertifyPkg(ctx context.Context, certifyPkgSpec *model.Cert
 	queryValues := map[string]any{}
 
 	// query with for subject and object package
-	queryCertifyPkg(&sb, selectedPkg, dependentPkg, certifyPkgSpec, false, queryValues)
+	queryParts := []queryPart{queryCertifyPkg(selectedPkg, dependentPkg, certifyPkgSpec, queryValues)}
 
 	if len(certifyPkgSpec.Packages) > 0 {
 		// query with reverse order for subject and object package
-		queryCertifyPkg(&sb, dependentPkg, selectedPkg, certifyPkgSpec, true, queryValues)
+		queryParts = append(queryParts, queryCertifyPkg(dependentPkg, selectedPkg, certifyPkgSpec, queryValues))
 	}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyPkg")
 			if err != nil {
 				return nil, err
 			}
@@ -96,6 +95,7 @@ func (c *neo4jClient) CertifyPkg(ctx context.Context, certifyPkgSpec *model.Cert
 				}
 
 				certifyPkg := &model.CertifyPkg{
+					ID:            evidenceID("CertifyPkg", certifyPkgNode),
 					Packages:      []*model.Package{pkg, depPkg},
 					Justification: certifyPkgNode.Props[justification].(string),
 					Origin:        certifyPkgNode.Props[origin].(string),
@@ -116,19 +116,31 @@ func (c *neo4jClient) CertifyPkg(ctx context.Context, certifyPkgSpec *model.Cert
 	return result.([]*model.CertifyPkg), nil
 }
 
-func queryCertifyPkg(sb *strings.Builder, selectedPkg *model.PkgSpec, dependentPkg *model.PkgSpec, certifyPkgSpec *model.CertifyPkgSpec, addInitialUnion bool,
-	queryValues map[string]any) {
+func (c *neo4jClient) CertifyPkgList(ctx context.Context, certifyPkgSpec *model.CertifyPkgSpec, after *string, first *int) (*model.CertifyPkgConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.CertifyPkg(withPager(ctx, p), certifyPkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "CertifyPkg", found, func(n *model.CertifyPkg) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyPkgConnection(page), nil
+}
+
+func queryCertifyPkg(selectedPkg *model.PkgSpec, dependentPkg *model.PkgSpec, certifyPkgSpec *model.CertifyPkgSpec, queryValues map[string]any) queryPart {
+	var sb strings.Builder
 
 	returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
 		"version.qualifier_list, certifyPkg, objPkgType.type, objPkgNamespace.namespace, objPkgName.name, " +
 		"objPkgVersion.version, objPkgVersion.subpath, objPkgVersion.qualifier_list"
 
-	if addInitialUnion {
-		sb.WriteString("\nUNION")
-		sb.WriteString("\n")
-	}
 	// query with selectedPkg at pkgVersion and dependentPkg at pkgVersion
-	query := "\nMATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+	query := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
 		"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)" +
 		"-[:subject]-(certifyPkg:CertifyPkg)-[:pkg_certification]-(objPkgVersion:PkgVersion)" +
 		"\nWITH *" +
@@ -138,14 +150,18 @@ func queryCertifyPkg(sb *strings.Builder, selectedPkg *model.PkgSpec, dependentP
 	sb.WriteString(query)
 
 	firstMatch := true
-	setPkgMatchValues(sb, selectedPkg, false, &firstMatch, queryValues)
-	setPkgMatchValues(sb, dependentPkg, true, &firstMatch, queryValues)
-	setCertifyPkgValues(sb, certifyPkgSpec, &firstMatch, queryValues)
+	setPkgMatchValues(&sb, selectedPkg, false, &firstMatch, queryValues)
+	setPkgMatchValues(&sb, dependentPkg, true, &firstMatch, queryValues)
+	setCertifyPkgValues(&sb, certifyPkgSpec, &firstMatch, queryValues)
 
-	sb.WriteString(returnValue)
+	return queryPart{match: sb.String(), returnValue: returnValue}
 }
 
 func setCertifyPkgValues(sb *strings.Builder, certifyPkgSpec *model.CertifyPkgSpec, firstMatch *bool, queryValues map[string]any) {
+	if certifyPkgSpec.ID != nil {
+		matchID(sb, *firstMatch, "certifyPkg", "CertifyPkg", *certifyPkgSpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if certifyPkgSpec.Justification != nil {
 
 		matchProperties(sb, *firstMatch, "certifyPkg", justification, "$"+justification)
@@ -198,7 +214,8 @@ func (c *neo4jClient) IngestCertifyPkg(ctx context.Context, pkg model.PkgInputSp
 	setPkgMatchValues(&sb, depPkgSpec, true, &firstMatch, queryValues)
 
 	merge := "\nMERGE (version)<-[:subject]-(certifyPkg:CertifyPkg{justification:$justification,origin:$origin,collector:$collector})" +
-		"-[:pkg_certification]->(objPkgVersion)"
+		"-[:pkg_certification]->(objPkgVersion)" +
+		setEvidenceID("certifyPkg")
 
 	sb.WriteString(merge)
 
@@ -247,6 +264,7 @@ func (c *neo4jClient) IngestCertifyPkg(ctx context.Context, pkg model.PkgInputSp
 			}
 
 			certifyPkg := &model.CertifyPkg{
+				ID:            evidenceID("CertifyPkg", certifyPkgNode),
 				Packages:      []*model.Package{pkg, depPkg},
 				Justification: certifyPkgNode.Props[justification].(string),
 				Origin:        certifyPkgNode.Props[origin].(string),
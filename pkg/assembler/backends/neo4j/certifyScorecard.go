@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -52,11 +53,11 @@ func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *mode
 
 	setSrcMatchValues(&sb, certifyScorecardSpec.Source, false, &firstMatch, queryValues)
 	setCertifyScorecardValues(&sb, certifyScorecardSpec, &firstMatch, queryValues)
-	sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyScorecard")
+	queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, certifyScorecard"}}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyScorecard")
 			if err != nil {
 				return nil, err
 			}
@@ -95,6 +96,7 @@ func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *mode
 				}
 
 				certifyScorecard := &model.CertifyScorecard{
+					ID:        evidenceID("CertifyScorecard", certifyScorecardNode),
 					Source:    src,
 					Scorecard: &scorecard,
 				}
@@ -114,6 +116,22 @@ func (c *neo4jClient) Scorecards(ctx context.Context, certifyScorecardSpec *mode
 	return result.([]*model.CertifyScorecard), nil
 }
 
+func (c *neo4jClient) ScorecardsList(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.Scorecards(withPager(ctx, p), certifyScorecardSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "CertifyScorecard", found, func(n *model.CertifyScorecard) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyScorecardConnection(page), nil
+}
+
 func getCollectedChecks(keyList []interface{}, valueList []interface{}) ([]*model.ScorecardCheck, error) {
 	if len(keyList) != len(valueList) {
 		return nil, gqlerror.Errorf("length of scorecard checks do not match")
@@ -147,6 +165,10 @@ func getScorecardChecks(checks []*model.ScorecardCheckSpec) ([]string, []int) {
 }
 
 func setCertifyScorecardValues(sb *strings.Builder, certifyScorecardSpec *model.CertifyScorecardSpec, firstMatch *bool, queryValues map[string]any) {
+	if certifyScorecardSpec.ID != nil {
+		matchID(sb, *firstMatch, "certifyScorecard", "CertifyScorecard", *certifyScorecardSpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if certifyScorecardSpec.TimeScanned != nil {
 		matchProperties(sb, *firstMatch, "certifyScorecard", timeScanned, "$"+timeScanned)
 		*firstMatch = false
@@ -247,7 +269,8 @@ func (c *neo4jClient) CertifyScorecard(ctx context.Context, source model.SourceI
 			query := `
 MATCH (root:Src) -[:SrcHasType]-> (type:SrcType) -[:SrcHasNamespace]-> (ns:SrcNamespace) -[:SrcHasName] -> (name:SrcName)
 WHERE type.type = $sourceType AND ns.namespace = $namespace AND name.name = $name AND name.commit = $commit AND name.tag = $tag
-MERGE (name) <-[:subject]- (certifyScorecard:CertifyScorecard{timeScanned:$timeScanned,aggregateScore:$aggregateScore,scorecardVersion:$scorecardVersion,scorecardCommit:$scorecardCommit,checkKeys:$checkKeys,checkValues:$checkValues,origin:$origin,collector:$collector})
+MERGE (name) <-[:subject]- (certifyScorecard:CertifyScorecard{timeScanned:$timeScanned,aggregateScore:$aggregateScore,scorecardVersion:$scorecardVersion,scorecardCommit:$scorecardCommit,checkKeys:$checkKeys,checkValues:$checkValues,origin:$origin,collector:$collector})` +
+				setEvidenceID("certifyScorecard") + `
 RETURN type.type, ns.namespace, name.name, name.commit, name.tag, certifyScorecard`
 			result, err := tx.Run(query, values)
 			if err != nil {
@@ -288,6 +311,7 @@ RETURN type.type, ns.namespace, name.name, name.commit, name.tag, certifyScoreca
 			src := generateModelSource(srcType, namespaceStr, nameStr, commit, tag)
 
 			certification := model.CertifyScorecard{
+				ID:        evidenceID("CertifyScorecard", certifyScorecardNode),
 				Source:    src,
 				Scorecard: &scorecard,
 			}
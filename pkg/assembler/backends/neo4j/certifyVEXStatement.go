@@ -17,7 +17,6 @@ package neo4jBackend
 
 import (
 	"context"
-	"fmt"
 	"strings"
 	"time"
 
@@ -28,6 +27,23 @@ import (
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+const (
+	status           string = "status"
+	vexJustification string = "vexJustification"
+	statement        string = "statement"
+	statusNotes      string = "statusNotes"
+	actionStatement  string = "actionStatement"
+)
+
+// vulnerability node kinds that a CertifyVEXStatement can be about
+const (
+	vexVulnOsv  string = "osv"
+	vexVulnCve  string = "cve"
+	vexVulnGhsa string = "ghsa"
+)
+
+// Query CertifyVEXStatement
+
 func (c *neo4jClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
 
 	querySubjectAll, err := helper.ValidatePackageOrArtifactQueryInput(certifyVEXStatementSpec.Subject)
@@ -35,326 +51,187 @@ func (c *neo4jClient) CertifyVEXStatement(ctx context.Context, certifyVEXStateme
 		return nil, err
 	}
 
-	queryVulnAll, err := helper.ValidateCveOrGhsaQueryInput(certifyVEXStatementSpec.Vulnerability)
+	queryVulnAll, err := helper.ValidateOsvCveOrGhsaQueryInput(certifyVEXStatementSpec.Vulnerability)
 	if err != nil {
 		return nil, err
 	}
-
-	queryAll := false
-	if querySubjectAll && queryVulnAll {
-		queryAll = true
+	if certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.NoVuln != nil {
+		// a VEX statement is never about NoVuln
+		if *certifyVEXStatementSpec.Vulnerability.NoVuln {
+			return []*model.CertifyVEXStatement{}, nil
+		}
+		queryVulnAll = true
 	}
 
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
 
-	aggregateCertifyVEXStatement := []*model.CertifyVEXStatement{}
-
-	if queryAll || (querySubjectAll && certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Cve != nil) ||
-		(queryVulnAll && certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Package != nil) ||
-		(certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Package != nil &&
-			certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Cve != nil) {
-
-		var sb strings.Builder
-		var firstMatch bool = true
-		queryValues := map[string]any{}
-
-		// query CVE
-		returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
-			"version.qualifier_list, certifyVEXStatement, cveYear.year, cveID.id"
-
-		// query with pkgVersion
-		query := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
-			"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)" +
-			"-[:subject]-(certifyVEXStatement:CertifyVEXStatement)-[:about]-(cveID:CveID)<-[:CveHasID]" +
-			"-(cveYear:CveYear)<-[:CveIsYear]-(rootCve:Cve)"
-		sb.WriteString(query)
-
-		if certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Package != nil {
-			setPkgMatchValues(&sb, certifyVEXStatementSpec.Subject.Package, false, &firstMatch, queryValues)
-		}
-		if certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Cve != nil {
-			setCveMatchValues(&sb, certifyVEXStatementSpec.Vulnerability.Cve, &firstMatch, queryValues)
-		}
-		setCertifyVEXStatementValues(&sb, certifyVEXStatementSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
-
-		result, err := session.ReadTransaction(
-			func(tx neo4j.Transaction) (interface{}, error) {
-
-				result, err := tx.Run(sb.String(), queryValues)
-				if err != nil {
-					return nil, err
-				}
-
-				collectedCertifyVEXStatement := []*model.CertifyVEXStatement{}
-
-				for result.Next() {
-
-					pkgQualifiers := result.Record().Values[5]
-					subPath := result.Record().Values[4]
-					version := result.Record().Values[3]
-					nameString := result.Record().Values[2].(string)
-					namespaceString := result.Record().Values[1].(string)
-					typeString := result.Record().Values[0].(string)
-
-					pkg := generateModelPackage(typeString, namespaceString, nameString, version, subPath, pkgQualifiers)
-
-					idStr := result.Record().Values[8].(string)
-					yearStr := result.Record().Values[7].(string)
-					cve := generateModelCve(yearStr, idStr)
-
-					certifyVEXStatementNode := dbtype.Node{}
-					if result.Record().Values[1] != nil {
-						certifyVEXStatementNode = result.Record().Values[6].(dbtype.Node)
-					} else {
-						return nil, gqlerror.Errorf("certifyVEXStatement Node not found in neo4j")
-					}
-
-					certifyVEXStatement := generateModelCertifyVEXStatement(pkg, cve, certifyVEXStatementNode.Props[justification].(string),
-						certifyVEXStatementNode.Props[origin].(string), certifyVEXStatementNode.Props[collector].(string), certifyVEXStatementNode.Props[knownSince].(time.Time))
-
-					collectedCertifyVEXStatement = append(collectedCertifyVEXStatement, certifyVEXStatement)
-				}
-				if err = result.Err(); err != nil {
-					return nil, err
-				}
-
-				return collectedCertifyVEXStatement, nil
-			})
-		if err != nil {
-			return nil, err
-		}
-		aggregateCertifyVEXStatement = append(aggregateCertifyVEXStatement, result.([]*model.CertifyVEXStatement)...)
+	var subjects []bool
+	if querySubjectAll || certifyVEXStatementSpec.Subject.Package != nil {
+		subjects = append(subjects, true)
 	}
-
-	if queryAll || (querySubjectAll && certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Ghsa != nil) ||
-		(queryVulnAll && certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Package != nil) ||
-		(certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Package != nil &&
-			certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Ghsa != nil) {
-
-		var sb strings.Builder
-		var firstMatch bool = true
-		queryValues := map[string]any{}
-
-		// query ghsa
-		returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
-			"version.qualifier_list, certifyVEXStatement, ghsaID.id"
-
-		// query with pkgVersion
-		query := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
-			"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)" +
-			"-[:subject]-(certifyVEXStatement:CertifyVEXStatement)-[:about]-(ghsaID:GhsaID)<-[:GhsaHasID]" +
-			"-(rootGhsa:Ghsa)"
-		sb.WriteString(query)
-
-		if certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Package != nil {
-			setPkgMatchValues(&sb, certifyVEXStatementSpec.Subject.Package, false, &firstMatch, queryValues)
-		}
-		if certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Ghsa != nil {
-			setGhsaMatchValues(&sb, certifyVEXStatementSpec.Vulnerability.Ghsa, &firstMatch, queryValues)
-		}
-		setCertifyVEXStatementValues(&sb, certifyVEXStatementSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
-
-		result, err := session.ReadTransaction(
-			func(tx neo4j.Transaction) (interface{}, error) {
-
-				result, err := tx.Run(sb.String(), queryValues)
-				if err != nil {
-					return nil, err
-				}
-
-				collectedCertifyVEXStatement := []*model.CertifyVEXStatement{}
-
-				for result.Next() {
-					pkgQualifiers := result.Record().Values[5]
-					subPath := result.Record().Values[4]
-					version := result.Record().Values[3]
-					nameString := result.Record().Values[2].(string)
-					namespaceString := result.Record().Values[1].(string)
-					typeString := result.Record().Values[0].(string)
-
-					pkg := generateModelPackage(typeString, namespaceString, nameString, version, subPath, pkgQualifiers)
-
-					idStr := result.Record().Values[7].(string)
-					ghsa := generateModelGhsa(idStr)
-
-					certifyVEXStatementNode := dbtype.Node{}
-					if result.Record().Values[1] != nil {
-						certifyVEXStatementNode = result.Record().Values[6].(dbtype.Node)
-					} else {
-						return nil, gqlerror.Errorf("certifyVEXStatement Node not found in neo4j")
-					}
-
-					certifyVEXStatement := generateModelCertifyVEXStatement(pkg, ghsa, certifyVEXStatementNode.Props[justification].(string),
-						certifyVEXStatementNode.Props[origin].(string), certifyVEXStatementNode.Props[collector].(string), certifyVEXStatementNode.Props[knownSince].(time.Time))
-
-					collectedCertifyVEXStatement = append(collectedCertifyVEXStatement, certifyVEXStatement)
-				}
-				if err = result.Err(); err != nil {
-					return nil, err
-				}
-
-				return collectedCertifyVEXStatement, nil
-			})
-		if err != nil {
-			return nil, err
-		}
-		aggregateCertifyVEXStatement = append(aggregateCertifyVEXStatement, result.([]*model.CertifyVEXStatement)...)
+	if querySubjectAll || certifyVEXStatementSpec.Subject.Artifact != nil {
+		subjects = append(subjects, false)
 	}
-	if queryAll || (querySubjectAll && certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Cve != nil) ||
-		(queryVulnAll && certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Artifact != nil) ||
-		(certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Artifact != nil &&
-			certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Cve != nil) {
-
-		var sb strings.Builder
-		var firstMatch bool = true
-		queryValues := map[string]any{}
 
-		// query CVE
-		returnValue := " RETURN a.algorithm, a.digest, certifyVEXStatement, cveYear.year, cveID.id"
-
-		// query artifact
-		query := "MATCH (a:Artifact)" +
-			"-[:subject]-(certifyVEXStatement:CertifyVEXStatement)-[:about]-(cveID:CveID)<-[:CveHasID]" +
-			"-(cveYear:CveYear)<-[:CveIsYear]-(rootCve:Cve)"
-		sb.WriteString(query)
-
-		if certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Artifact != nil {
-			setArtifactMatchValues(&sb, certifyVEXStatementSpec.Subject.Artifact, false, &firstMatch, queryValues)
-		}
-		if certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Cve != nil {
-			setCveMatchValues(&sb, certifyVEXStatementSpec.Vulnerability.Cve, &firstMatch, queryValues)
-		}
-		setCertifyVEXStatementValues(&sb, certifyVEXStatementSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
-
-		result, err := session.ReadTransaction(
-			func(tx neo4j.Transaction) (interface{}, error) {
-
-				result, err := tx.Run(sb.String(), queryValues)
-				if err != nil {
-					return nil, err
-				}
-
-				collectedCertifyVEXStatement := []*model.CertifyVEXStatement{}
-
-				for result.Next() {
-					algorithm := result.Record().Values[0].(string)
-					digest := result.Record().Values[1].(string)
-					artifact := generateModelArtifact(algorithm, digest)
+	var vulns []string
+	if queryVulnAll || certifyVEXStatementSpec.Vulnerability.Osv != nil {
+		vulns = append(vulns, vexVulnOsv)
+	}
+	if queryVulnAll || certifyVEXStatementSpec.Vulnerability.Cve != nil {
+		vulns = append(vulns, vexVulnCve)
+	}
+	if queryVulnAll || certifyVEXStatementSpec.Vulnerability.Ghsa != nil {
+		vulns = append(vulns, vexVulnGhsa)
+	}
 
-					idStr := result.Record().Values[4].(string)
-					yearStr := result.Record().Values[3].(string)
-					cve := generateModelCve(yearStr, idStr)
+	aggregateCertifyVEXStatement := []*model.CertifyVEXStatement{}
 
-					certifyVEXStatementNode := dbtype.Node{}
-					if result.Record().Values[1] != nil {
-						certifyVEXStatementNode = result.Record().Values[2].(dbtype.Node)
-					} else {
-						return nil, gqlerror.Errorf("certifyVEXStatement Node not found in neo4j")
+	for _, subjectPkg := range subjects {
+		for _, vuln := range vulns {
+			subjectPkg := subjectPkg
+			vuln := vuln
+
+			var sb strings.Builder
+			var firstMatch bool = true
+			queryValues := map[string]any{}
+
+			subjectMatch, subjectReturn := vexSubjectQuery(subjectPkg)
+			vulnMatch, vulnReturn := vexVulnerabilityQuery(vuln)
+
+			sb.WriteString("MATCH " + subjectMatch +
+				"-[:subject]-(certifyVEXStatement:CertifyVEXStatement)-[:about]-" + vulnMatch)
+
+			if subjectPkg && certifyVEXStatementSpec.Subject != nil {
+				setPkgMatchValues(&sb, certifyVEXStatementSpec.Subject.Package, false, &firstMatch, queryValues)
+			}
+			if !subjectPkg && certifyVEXStatementSpec.Subject != nil {
+				setArtifactMatchValues(&sb, certifyVEXStatementSpec.Subject.Artifact, false, &firstMatch, queryValues)
+			}
+			if certifyVEXStatementSpec.Vulnerability != nil {
+				setVexVulnerabilityMatchValues(&sb, vuln, certifyVEXStatementSpec.Vulnerability, &firstMatch, queryValues)
+			}
+			setCertifyVEXStatementValues(&sb, certifyVEXStatementSpec, &firstMatch, queryValues)
+			queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN " + subjectReturn + ", certifyVEXStatement, " + vulnReturn}}
+
+			result, err := session.ReadTransaction(
+				func(tx neo4j.Transaction) (interface{}, error) {
+
+					result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyVEXStatement")
+					if err != nil {
+						return nil, err
 					}
 
-					certifyVEXStatement := generateModelCertifyVEXStatement(artifact, cve, certifyVEXStatementNode.Props[justification].(string),
-						certifyVEXStatementNode.Props[origin].(string), certifyVEXStatementNode.Props[collector].(string), certifyVEXStatementNode.Props[knownSince].(time.Time))
+					collectedCertifyVEXStatement := []*model.CertifyVEXStatement{}
 
-					collectedCertifyVEXStatement = append(collectedCertifyVEXStatement, certifyVEXStatement)
-				}
-				if err = result.Err(); err != nil {
-					return nil, err
-				}
+					for result.Next() {
+						certifyVEXStatement, err := generateModelCertifyVEXStatementFromValues(result.Record().Values, subjectPkg, vuln)
+						if err != nil {
+							return nil, err
+						}
+						collectedCertifyVEXStatement = append(collectedCertifyVEXStatement, certifyVEXStatement)
+					}
+					if err = result.Err(); err != nil {
+						return nil, err
+					}
 
-				return collectedCertifyVEXStatement, nil
-			})
-		if err != nil {
-			return nil, err
+					return collectedCertifyVEXStatement, nil
+				})
+			if err != nil {
+				return nil, err
+			}
+			aggregateCertifyVEXStatement = append(aggregateCertifyVEXStatement, result.([]*model.CertifyVEXStatement)...)
 		}
-		aggregateCertifyVEXStatement = append(aggregateCertifyVEXStatement, result.([]*model.CertifyVEXStatement)...)
 	}
+	return aggregateCertifyVEXStatement, nil
+}
 
-	if queryAll || (querySubjectAll && certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Ghsa != nil) ||
-		(queryVulnAll && certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Artifact != nil) ||
-		(certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Artifact != nil &&
-			certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Ghsa != nil) {
-
-		var sb strings.Builder
-		var firstMatch bool = true
-		queryValues := map[string]any{}
-
-		// query ghsa
-		returnValue := " RETURN a.algorithm, a.digest, certifyVEXStatement, ghsaID.id"
-
-		// query artifact
-		query := "MATCH (a:Artifact)" +
-			"-[:subject]-(certifyVEXStatement:CertifyVEXStatement)-[:about]-(ghsaID:GhsaID)<-[:GhsaHasID]" +
-			"-(rootGhsa:Ghsa)"
-		sb.WriteString(query)
-
-		if certifyVEXStatementSpec.Subject != nil && certifyVEXStatementSpec.Subject.Artifact != nil {
-			setArtifactMatchValues(&sb, certifyVEXStatementSpec.Subject.Artifact, false, &firstMatch, queryValues)
-		}
-		if certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Ghsa != nil {
-			setGhsaMatchValues(&sb, certifyVEXStatementSpec.Vulnerability.Ghsa, &firstMatch, queryValues)
-		}
-		setCertifyVEXStatementValues(&sb, certifyVEXStatementSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
-
-		result, err := session.ReadTransaction(
-			func(tx neo4j.Transaction) (interface{}, error) {
-
-				result, err := tx.Run(sb.String(), queryValues)
-				if err != nil {
-					return nil, err
-				}
-
-				collectedCertifyVEXStatement := []*model.CertifyVEXStatement{}
-
-				for result.Next() {
-					algorithm := result.Record().Values[0].(string)
-					digest := result.Record().Values[1].(string)
-					artifact := generateModelArtifact(algorithm, digest)
-
-					idStr := result.Record().Values[3].(string)
-					ghsa := generateModelGhsa(idStr)
-
-					certifyVEXStatementNode := dbtype.Node{}
-					if result.Record().Values[1] != nil {
-						certifyVEXStatementNode = result.Record().Values[2].(dbtype.Node)
-					} else {
-						return nil, gqlerror.Errorf("certifyVEXStatement Node not found in neo4j")
-					}
+func (c *neo4jClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.CertifyVEXStatement(withPager(ctx, p), certifyVEXStatementSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "CertifyVEXStatement", found, func(n *model.CertifyVEXStatement) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyVEXStatementConnection(page), nil
+}
 
-					certifyVEXStatement := generateModelCertifyVEXStatement(artifact, ghsa, certifyVEXStatementNode.Props[justification].(string),
-						certifyVEXStatementNode.Props[origin].(string), certifyVEXStatementNode.Props[collector].(string), certifyVEXStatementNode.Props[knownSince].(time.Time))
+// vexSubjectQuery returns the match pattern and the returned columns for the
+// subject (package version or artifact) of a CertifyVEXStatement
+func vexSubjectQuery(subjectPkg bool) (string, string) {
+	if subjectPkg {
+		return "(root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+				"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)",
+			"type.type, namespace.namespace, name.name, version.version, version.subpath, version.qualifier_list"
+	}
+	return "(a:Artifact)", "a.algorithm, a.digest"
+}
 
-					collectedCertifyVEXStatement = append(collectedCertifyVEXStatement, certifyVEXStatement)
-				}
-				if err = result.Err(); err != nil {
-					return nil, err
-				}
+// vexVulnerabilityQuery returns the match pattern, starting from the ID node,
+// and the returned columns for the vulnerability of a CertifyVEXStatement
+func vexVulnerabilityQuery(vuln string) (string, string) {
+	switch vuln {
+	case vexVulnOsv:
+		return "(osvID:OsvID)<-[:OsvHasID]-(rootOsv:Osv)", "osvID.id"
+	case vexVulnCve:
+		return "(cveID:CveID)<-[:CveHasID]-(cveYear:CveYear)<-[:CveIsYear]-(rootCve:Cve)", "cveYear.year, cveID.id"
+	default:
+		return "(ghsaID:GhsaID)<-[:GhsaHasID]-(rootGhsa:Ghsa)", "ghsaID.id"
+	}
+}
 
-				return collectedCertifyVEXStatement, nil
-			})
-		if err != nil {
-			return nil, err
-		}
-		aggregateCertifyVEXStatement = append(aggregateCertifyVEXStatement, result.([]*model.CertifyVEXStatement)...)
+func setVexVulnerabilityMatchValues(sb *strings.Builder, vuln string, vulnerability *model.OsvCveOrGhsaSpec, firstMatch *bool, queryValues map[string]any) {
+	switch vuln {
+	case vexVulnOsv:
+		setOSVMatchValues(sb, vulnerability.Osv, firstMatch, queryValues)
+	case vexVulnCve:
+		setCveMatchValues(sb, vulnerability.Cve, firstMatch, queryValues)
+	case vexVulnGhsa:
+		setGhsaMatchValues(sb, vulnerability.Ghsa, firstMatch, queryValues)
 	}
-	return aggregateCertifyVEXStatement, nil
 }
 
 func setCertifyVEXStatementValues(sb *strings.Builder, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, firstMatch *bool, queryValues map[string]any) {
+	if certifyVEXStatementSpec.ID != nil {
+		matchID(sb, *firstMatch, "certifyVEXStatement", "CertifyVEXStatement", *certifyVEXStatementSpec.ID, queryValues)
+		*firstMatch = false
+	}
+	if certifyVEXStatementSpec.Status != nil {
+		matchProperties(sb, *firstMatch, "certifyVEXStatement", status, "$"+status)
+		*firstMatch = false
+		queryValues[status] = certifyVEXStatementSpec.Status.String()
+	}
+	if certifyVEXStatementSpec.VexJustification != nil {
+		matchProperties(sb, *firstMatch, "certifyVEXStatement", vexJustification, "$"+vexJustification)
+		*firstMatch = false
+		queryValues[vexJustification] = certifyVEXStatementSpec.VexJustification.String()
+	}
+	if certifyVEXStatementSpec.Statement != nil {
+		matchProperties(sb, *firstMatch, "certifyVEXStatement", statement, "$"+statement)
+		*firstMatch = false
+		queryValues[statement] = certifyVEXStatementSpec.Statement
+	}
+	if certifyVEXStatementSpec.StatusNotes != nil {
+		matchProperties(sb, *firstMatch, "certifyVEXStatement", statusNotes, "$"+statusNotes)
+		*firstMatch = false
+		queryValues[statusNotes] = certifyVEXStatementSpec.StatusNotes
+	}
+	if certifyVEXStatementSpec.ActionStatement != nil {
+		matchProperties(sb, *firstMatch, "certifyVEXStatement", actionStatement, "$"+actionStatement)
+		*firstMatch = false
+		queryValues[actionStatement] = certifyVEXStatementSpec.ActionStatement
+	}
 	if certifyVEXStatementSpec.KnownSince != nil {
 		matchProperties(sb, *firstMatch, "certifyVEXStatement", knownSince, "$"+knownSince)
 		*firstMatch = false
 		queryValues[knownSince] = certifyVEXStatementSpec.KnownSince.UTC()
 	}
-	if certifyVEXStatementSpec.Justification != nil {
-		matchProperties(sb, *firstMatch, "certifyVEXStatement", justification, "$"+justification)
-		*firstMatch = false
-		queryValues["justification"] = certifyVEXStatementSpec.Justification
-	}
 	if certifyVEXStatementSpec.Origin != nil {
 		matchProperties(sb, *firstMatch, "certifyVEXStatement", origin, "$"+origin)
 		*firstMatch = false
@@ -367,27 +244,153 @@ func setCertifyVEXStatementValues(sb *strings.Builder, certifyVEXStatementSpec *
 	}
 }
 
-func generateModelCertifyVEXStatement(subject model.PackageOrArtifact, vuln model.CveOrGhsa, justification, origin, collector string, knownSince time.Time) *model.CertifyVEXStatement {
+// generateModelCertifyVEXStatementFromValues builds the CertifyVEXStatement from
+// a record returned by a query built with vexSubjectQuery and vexVulnerabilityQuery
+func generateModelCertifyVEXStatementFromValues(values []any, subjectPkg bool, vuln string) (*model.CertifyVEXStatement, error) {
+	var subject model.PackageOrArtifact
+	var idx int
+	if subjectPkg {
+		pkgQualifiers := values[5]
+		subPath := values[4]
+		version := values[3]
+		nameString := values[2].(string)
+		namespaceString := values[1].(string)
+		typeString := values[0].(string)
+		subject = generateModelPackage(typeString, namespaceString, nameString, version, subPath, pkgQualifiers)
+		idx = 6
+	} else {
+		algorithm := values[0].(string)
+		digest := values[1].(string)
+		subject = generateModelArtifact(algorithm, digest)
+		idx = 2
+	}
+
+	certifyVEXStatementNode, ok := values[idx].(dbtype.Node)
+	if !ok {
+		return nil, gqlerror.Errorf("certifyVEXStatement Node not found in neo4j")
+	}
+
+	var vulnerability model.OsvCveOrGhsa
+	switch vuln {
+	case vexVulnOsv:
+		vulnerability = generateModelOsv(values[idx+1].(string))
+	case vexVulnCve:
+		vulnerability = generateModelCve(values[idx+1].(string), values[idx+2].(string))
+	default:
+		vulnerability = generateModelGhsa(values[idx+1].(string))
+	}
+
+	props := certifyVEXStatementNode.Props
+	certifyVEXStatement := generateModelCertifyVEXStatement(subject, vulnerability, model.VexStatus(props[status].(string)),
+		model.VexJustification(props[vexJustification].(string)), props[statement].(string), props[statusNotes].(string),
+		props[actionStatement].(string), props[origin].(string), props[collector].(string), props[knownSince].(time.Time))
+	certifyVEXStatement.ID = evidenceID("CertifyVEXStatement", certifyVEXStatementNode)
+	return certifyVEXStatement, nil
+}
+
+func generateModelCertifyVEXStatement(subject model.PackageOrArtifact, vuln model.OsvCveOrGhsa, status model.VexStatus, vexJustification model.VexJustification,
+	statement, statusNotes, actionStatement, origin, collector string, knownSince time.Time) *model.CertifyVEXStatement {
 	certifyVEXStatement := model.CertifyVEXStatement{
-		Subject:       subject,
-		Vulnerability: vuln,
-		Justification: justification,
-		KnownSince:    knownSince,
-		Origin:        origin,
-		Collector:     collector,
+		Subject:          subject,
+		Vulnerability:    vuln,
+		Status:           status,
+		VexJustification: vexJustification,
+		Statement:        statement,
+		StatusNotes:      statusNotes,
+		ActionStatement:  actionStatement,
+		KnownSince:       knownSince,
+		Origin:           origin,
+		Collector:        collector,
 	}
 	return &certifyVEXStatement
 }
 
-func (c *neo4jClient) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+// Ingest CertifyVEXStatement
+
+func (c *neo4jClient) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.OsvCveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
 
 	err := helper.ValidatePackageOrArtifactInput(&subject, "IngestVEXStatement")
 	if err != nil {
 		return nil, err
 	}
-	err = helper.ValidateCveOrGhsaIngestionInput(vulnerability, "IngestVEXStatement")
+	err = helper.ValidateOsvCveOrGhsaIngestionInput(vulnerability)
 	if err != nil {
 		return nil, err
 	}
-	panic(fmt.Errorf("not implemented: IngestVEXStatement - IngestVEXStatement"))
+	if vulnerability.NoVuln != nil {
+		return nil, gqlerror.Errorf("IngestVEXStatement :: a VEX statement cannot be about noVuln")
+	}
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close()
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	queryValues[status] = vexStatement.Status.String()
+	queryValues[vexJustification] = vexStatement.VexJustification.String()
+	queryValues[statement] = vexStatement.Statement
+	queryValues[statusNotes] = vexStatement.StatusNotes
+	queryValues[actionStatement] = vexStatement.ActionStatement
+	queryValues[knownSince] = vexStatement.KnownSince.UTC()
+	queryValues[origin] = vexStatement.Origin
+	queryValues[collector] = vexStatement.Collector
+
+	subjectPkg := subject.Package != nil
+	subjectMatch, subjectReturn := vexSubjectQuery(subjectPkg)
+	sb.WriteString("MATCH " + subjectMatch)
+	subjectNode := "a"
+	if subjectPkg {
+		// TODO: use generics here between PkgInputSpec and PkgSpecs?
+		setPkgMatchValues(&sb, helper.ConvertPkgInputSpecToPkgSpec(subject.Package), false, &firstMatch, queryValues)
+		subjectNode = "version"
+	} else {
+		setArtifactMatchValues(&sb, helper.ConvertArtInputSpecToArtSpec(subject.Artifact), false, &firstMatch, queryValues)
+	}
+
+	var vuln string
+	selectedVulnSpec := &model.OsvCveOrGhsaSpec{}
+	if vulnerability.Osv != nil {
+		vuln = vexVulnOsv
+		selectedVulnSpec.Osv = helper.ConvertOsvInputSpecToOsvSpec(vulnerability.Osv)
+	} else if vulnerability.Cve != nil {
+		vuln = vexVulnCve
+		selectedVulnSpec.Cve = helper.ConvertCveInputSpecToCveSpec(vulnerability.Cve)
+	} else {
+		vuln = vexVulnGhsa
+		selectedVulnSpec.Ghsa = helper.ConvertGhsaInputSpecToGhsaSpec(vulnerability.Ghsa)
+	}
+	vulnMatch, vulnReturn := vexVulnerabilityQuery(vuln)
+	sb.WriteString("\nMATCH " + vulnMatch)
+	firstMatch = true
+	setVexVulnerabilityMatchValues(&sb, vuln, selectedVulnSpec, &firstMatch, queryValues)
+
+	merge := "\nMERGE (" + subjectNode + ")<-[:subject]-(certifyVEXStatement:CertifyVEXStatement{status:$status,vexJustification:$vexJustification," +
+		"statement:$statement,statusNotes:$statusNotes,actionStatement:$actionStatement,knownSince:$knownSince,origin:$origin,collector:$collector})" +
+		"-[:about]->(" + vuln + "ID)" +
+		setEvidenceID("certifyVEXStatement")
+	sb.WriteString(merge)
+	sb.WriteString(" RETURN " + subjectReturn + ", certifyVEXStatement, " + vulnReturn)
+
+	result, err := session.WriteTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			// query returns a single record
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return generateModelCertifyVEXStatementFromValues(record.Values, subjectPkg, vuln)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.CertifyVEXStatement), nil
 }
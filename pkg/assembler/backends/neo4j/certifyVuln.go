@@ -46,9 +46,12 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 		return nil, err
 	}
 
+	// noVuln set to false matches any vulnerability except NoVuln
+	queryVulns := queryAll || (certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.NoVuln != nil && !*certifyVulnSpec.Vulnerability.NoVuln)
+
 	aggregateCertifyVuln := []*model.CertifyVuln{}
 
-	if queryAll || (certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.Cve != nil) {
+	if queryVulns || (certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.Cve != nil) {
 
 		var sb strings.Builder
 		var firstMatch bool = true
@@ -70,12 +73,12 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 			setCveMatchValues(&sb, certifyVulnSpec.Vulnerability.Cve, &firstMatch, queryValues)
 		}
 		setCertifyVulnValues(&sb, certifyVulnSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyVuln")
 				if err != nil {
 					return nil, err
 				}
@@ -106,6 +109,7 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 					certifyVuln := generateModelCertifyVuln(pkg, cve, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 						certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 						certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
+					certifyVuln.ID = evidenceID("CertifyVuln", certifyVulnNode)
 
 					collectedCertifyVuln = append(collectedCertifyVuln, certifyVuln)
 				}
@@ -121,7 +125,7 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 		aggregateCertifyVuln = append(aggregateCertifyVuln, result.([]*model.CertifyVuln)...)
 	}
 
-	if queryAll || (certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.Ghsa != nil) {
+	if queryVulns || (certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.Ghsa != nil) {
 
 		var sb strings.Builder
 		var firstMatch bool = true
@@ -143,12 +147,12 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 			setGhsaMatchValues(&sb, certifyVulnSpec.Vulnerability.Ghsa, &firstMatch, queryValues)
 		}
 		setCertifyVulnValues(&sb, certifyVulnSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyVuln")
 				if err != nil {
 					return nil, err
 				}
@@ -178,6 +182,7 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 					certifyVuln := generateModelCertifyVuln(pkg, ghsa, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 						certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 						certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
+					certifyVuln.ID = evidenceID("CertifyVuln", certifyVulnNode)
 
 					collectedCertifyVuln = append(collectedCertifyVuln, certifyVuln)
 				}
@@ -193,7 +198,7 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 		aggregateCertifyVuln = append(aggregateCertifyVuln, result.([]*model.CertifyVuln)...)
 	}
 
-	if queryAll || (certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.Osv != nil) {
+	if queryVulns || (certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.Osv != nil) {
 
 		var sb strings.Builder
 		var firstMatch bool = true
@@ -216,12 +221,12 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 			setOSVMatchValues(&sb, certifyVulnSpec.Vulnerability.Osv, &firstMatch, queryValues)
 		}
 		setCertifyVulnValues(&sb, certifyVulnSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyVuln")
 				if err != nil {
 					return nil, err
 				}
@@ -251,6 +256,71 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 					certifyVuln := generateModelCertifyVuln(pkg, osv, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 						certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 						certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
+					certifyVuln.ID = evidenceID("CertifyVuln", certifyVulnNode)
+
+					collectedCertifyVuln = append(collectedCertifyVuln, certifyVuln)
+				}
+				if err = result.Err(); err != nil {
+					return nil, err
+				}
+
+				return collectedCertifyVuln, nil
+			})
+		if err != nil {
+			return nil, err
+		}
+		aggregateCertifyVuln = append(aggregateCertifyVuln, result.([]*model.CertifyVuln)...)
+	}
+
+	if queryAll || (certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.NoVuln != nil && *certifyVulnSpec.Vulnerability.NoVuln) {
+
+		var sb strings.Builder
+		var firstMatch bool = true
+		queryValues := map[string]any{}
+
+		// query noVuln
+		returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
+			"version.qualifier_list, certifyVuln"
+
+		// query with pkgVersion
+		query := "MATCH (rootPkg:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+			"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)" +
+			"-[:subject]-(certifyVuln:CertifyVuln)-[:is_vuln_to]-(noVuln:NoVuln)"
+		sb.WriteString(query)
+
+		setPkgMatchValues(&sb, certifyVulnSpec.Package, false, &firstMatch, queryValues)
+		setCertifyVulnValues(&sb, certifyVulnSpec, &firstMatch, queryValues)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
+
+		result, err := session.ReadTransaction(
+			func(tx neo4j.Transaction) (interface{}, error) {
+
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "certifyVuln")
+				if err != nil {
+					return nil, err
+				}
+
+				collectedCertifyVuln := []*model.CertifyVuln{}
+
+				for result.Next() {
+					pkgQualifiers := result.Record().Values[5]
+					subPath := result.Record().Values[4]
+					version := result.Record().Values[3]
+					nameString := result.Record().Values[2].(string)
+					namespaceString := result.Record().Values[1].(string)
+					typeString := result.Record().Values[0].(string)
+
+					pkg := generateModelPackage(typeString, namespaceString, nameString, version, subPath, pkgQualifiers)
+
+					certifyVulnNode, ok := result.Record().Values[6].(dbtype.Node)
+					if !ok {
+						return nil, gqlerror.Errorf("certifyVuln Node not found in neo4j")
+					}
+
+					certifyVuln := generateModelCertifyVuln(pkg, generateModelNoVuln(), certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
+						certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
+						certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
+					certifyVuln.ID = evidenceID("CertifyVuln", certifyVulnNode)
 
 					collectedCertifyVuln = append(collectedCertifyVuln, certifyVuln)
 				}
@@ -268,7 +338,31 @@ func (c *neo4jClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Ce
 	return aggregateCertifyVuln, nil
 }
 
+func (c *neo4jClient) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.CertifyVuln(withPager(ctx, p), certifyVulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "CertifyVuln", found, func(n *model.CertifyVuln) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyVulnConnection(page), nil
+}
+
+func generateModelNoVuln() *model.NoVuln {
+	return &model.NoVuln{ID: helper.NoVulnID(), NoVuln: true}
+}
+
 func setCertifyVulnValues(sb *strings.Builder, certifyVulnSpec *model.CertifyVulnSpec, firstMatch *bool, queryValues map[string]any) {
+	if certifyVulnSpec.ID != nil {
+		matchID(sb, *firstMatch, "certifyVuln", "CertifyVuln", *certifyVulnSpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if certifyVulnSpec.TimeScanned != nil {
 		matchProperties(sb, *firstMatch, "certifyVuln", timeScanned, "$"+timeScanned)
 		*firstMatch = false
@@ -373,7 +467,8 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 
 		merge := "\nMERGE (version)<-[:subject]-(certifyVuln:CertifyVuln{timeScanned:$timeScanned,dbUri:$dbUri," +
 			"dbVersion:$dbVersion,scannerUri:$scannerUri,scannerVersion:$scannerVersion,origin:$origin,collector:$collector})" +
-			"-[:is_vuln_to]->(osvID)"
+			"-[:is_vuln_to]->(osvID)" +
+			setEvidenceID("certifyVuln")
 		sb.WriteString(merge)
 		sb.WriteString(returnValue)
 
@@ -412,6 +507,7 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 				certifyVuln := generateModelCertifyVuln(pkg, osv, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 					certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 					certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
+				certifyVuln.ID = evidenceID("CertifyVuln", certifyVulnNode)
 
 				return certifyVuln, nil
 			})
@@ -439,7 +535,8 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 
 		merge := "\nMERGE (version)<-[:subject]-(certifyVuln:CertifyVuln{timeScanned:$timeScanned,dbUri:$dbUri," +
 			"dbVersion:$dbVersion,scannerUri:$scannerUri,scannerVersion:$scannerVersion,origin:$origin,collector:$collector})" +
-			"-[:is_vuln_to]->(cveID)"
+			"-[:is_vuln_to]->(cveID)" +
+			setEvidenceID("certifyVuln")
 		sb.WriteString(merge)
 		sb.WriteString(returnValue)
 
@@ -479,6 +576,7 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 				certifyVuln := generateModelCertifyVuln(pkg, cve, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 					certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 					certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
+				certifyVuln.ID = evidenceID("CertifyVuln", certifyVulnNode)
 
 				return certifyVuln, nil
 			})
@@ -506,7 +604,8 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 
 		merge := "\nMERGE (version)<-[:subject]-(certifyVuln:CertifyVuln{timeScanned:$timeScanned,dbUri:$dbUri," +
 			"dbVersion:$dbVersion,scannerUri:$scannerUri,scannerVersion:$scannerVersion,origin:$origin,collector:$collector})" +
-			"-[:is_vuln_to]->(ghsaID)"
+			"-[:is_vuln_to]->(ghsaID)" +
+			setEvidenceID("certifyVuln")
 		sb.WriteString(merge)
 		sb.WriteString(returnValue)
 
@@ -545,6 +644,64 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 				certifyVuln := generateModelCertifyVuln(pkg, ghsa, certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
 					certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
 					certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
+				certifyVuln.ID = evidenceID("CertifyVuln", certifyVulnNode)
+
+				return certifyVuln, nil
+			})
+		if err != nil {
+			return nil, err
+		}
+
+		return result.(*model.CertifyVuln), nil
+	} else if vulnerability.NoVuln != nil {
+		returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
+			"version.qualifier_list, certifyVuln"
+
+		query := "MATCH (rootPkg:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+			"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)"
+
+		sb.WriteString(query)
+		setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+
+		merge := "\nMERGE (noVuln:NoVuln)" +
+			"\nMERGE (version)<-[:subject]-(certifyVuln:CertifyVuln{timeScanned:$timeScanned,dbUri:$dbUri," +
+			"dbVersion:$dbVersion,scannerUri:$scannerUri,scannerVersion:$scannerVersion,origin:$origin,collector:$collector})" +
+			"-[:is_vuln_to]->(noVuln)" +
+			setEvidenceID("certifyVuln")
+		sb.WriteString(merge)
+		sb.WriteString(returnValue)
+
+		result, err := session.WriteTransaction(
+			func(tx neo4j.Transaction) (interface{}, error) {
+				result, err := tx.Run(sb.String(), queryValues)
+				if err != nil {
+					return nil, err
+				}
+
+				// query returns a single record
+				record, err := result.Single()
+				if err != nil {
+					return nil, err
+				}
+
+				pkgQualifiers := record.Values[5]
+				subPath := record.Values[4]
+				version := record.Values[3]
+				nameString := record.Values[2].(string)
+				namespaceString := record.Values[1].(string)
+				typeString := record.Values[0].(string)
+
+				pkg := generateModelPackage(typeString, namespaceString, nameString, version, subPath, pkgQualifiers)
+
+				certifyVulnNode, ok := record.Values[6].(dbtype.Node)
+				if !ok {
+					return nil, gqlerror.Errorf("certifyVuln Node not found in neo4j")
+				}
+
+				certifyVuln := generateModelCertifyVuln(pkg, generateModelNoVuln(), certifyVulnNode.Props[timeScanned].(time.Time), certifyVulnNode.Props[dbUri].(string),
+					certifyVulnNode.Props[dbVersion].(string), certifyVulnNode.Props[scannerUri].(string), certifyVulnNode.Props[scannerVersion].(string),
+					certifyVulnNode.Props[origin].(string), certifyVulnNode.Props[collector].(string))
+				certifyVuln.ID = evidenceID("CertifyVuln", certifyVulnNode)
 
 				return certifyVuln, nil
 			})
@@ -554,6 +711,6 @@ func (c *neo4jClient) IngestVulnerability(ctx context.Context, pkg model.PkgInpu
 
 		return result.(*model.CertifyVuln), nil
 	} else {
-		return nil, gqlerror.Errorf("package or source not specified for IngestOccurrence")
+		return nil, gqlerror.Errorf("vulnerability not specified for IngestVulnerability")
 	}
 }
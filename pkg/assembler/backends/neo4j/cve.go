@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -192,6 +193,7 @@ func (c *neo4jClient) Cve(ctx context.Context, cveSpec *model.CVESpec) ([]*model
 			cves := []*model.Cve{}
 			for year := range cvesPerYear {
 				cve := &model.Cve{
+					ID:    helper.CveID(year),
 					Year:  year,
 					CveID: cvesPerYear[year],
 				}
@@ -207,6 +209,30 @@ func (c *neo4jClient) Cve(ctx context.Context, cveSpec *model.CVESpec) ([]*model
 	return result.([]*model.Cve), nil
 }
 
+func (c *neo4jClient) CveList(ctx context.Context, cveSpec *model.CVESpec, after *string, first *int) (*model.CVEConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	sb.WriteString("MATCH (root:Cve)-[:CveIsYear]->(cveYear:CveYear)-[:CveHasID]->(cveID:CveID)")
+
+	setCveMatchValues(&sb, cveSpec, &firstMatch, queryValues)
+
+	query := queryPart{match: sb.String(), returnValue: " RETURN cveYear.year, cveID.id, id(cveID)"}
+	page, err := listNodes(c, p, query, queryValues, "cveID", func(values []interface{}) *model.Cve {
+		return generateModelCve(values[0].(string), values[1].(string))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.CVEConnection(page), nil
+}
+
 func (c *neo4jClient) cveYear(ctx context.Context, cveSpec *model.CVESpec) ([]*model.Cve, error) {
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
@@ -233,6 +259,7 @@ func (c *neo4jClient) cveYear(ctx context.Context, cveSpec *model.CVESpec) ([]*m
 			cves := []*model.Cve{}
 			for result.Next() {
 				cve := &model.Cve{
+					ID:    helper.CveID(result.Record().Values[0].(string)),
 					Year:  result.Record().Values[0].(string),
 					CveID: []*model.CVEId{},
 				}
@@ -308,6 +335,7 @@ RETURN cveYear.year, cveID.id`
 func generateModelCve(yearStr, idStr string) *model.Cve {
 	id := &model.CVEId{ID: idStr}
 	cve := model.Cve{
+		ID:    helper.CveID(yearStr),
 		Year:  yearStr,
 		CveID: []*model.CVEId{id},
 	}
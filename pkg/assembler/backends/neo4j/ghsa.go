@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -128,6 +129,7 @@ func (c *neo4jClient) Ghsa(ctx context.Context, ghsaSpec *model.GHSASpec) ([]*mo
 			}
 
 			ghsa := &model.Ghsa{
+				ID:     helper.GhsaID(),
 				GhsaID: ghsaIds,
 			}
 
@@ -140,6 +142,30 @@ func (c *neo4jClient) Ghsa(ctx context.Context, ghsaSpec *model.GHSASpec) ([]*mo
 	return result.([]*model.Ghsa), nil
 }
 
+func (c *neo4jClient) GhsaList(ctx context.Context, ghsaSpec *model.GHSASpec, after *string, first *int) (*model.GHSAConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	sb.WriteString("MATCH (root:Ghsa)-[:GhsaHasID]->(ghsaID:GhsaID)")
+
+	setGhsaMatchValues(&sb, ghsaSpec, &firstMatch, queryValues)
+
+	query := queryPart{match: sb.String(), returnValue: " RETURN ghsaID.id, id(ghsaID)"}
+	page, err := listNodes(c, p, query, queryValues, "ghsaID", func(values []interface{}) *model.Ghsa {
+		return generateModelGhsa(values[0].(string))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.GHSAConnection(page), nil
+}
+
 func setGhsaMatchValues(sb *strings.Builder, ghsa *model.GHSASpec, firstMatch *bool, queryValues map[string]any) {
 	if ghsa != nil {
 		if ghsa.GhsaID != nil {
@@ -188,6 +214,7 @@ RETURN ghsaID.id`
 func generateModelGhsa(id string) *model.Ghsa {
 	ghsaID := &model.GHSAId{ID: id}
 	ghsa := model.Ghsa{
+		ID:     helper.GhsaID(),
 		GhsaID: []*model.GHSAId{ghsaID},
 	}
 	return &ghsa
@@ -60,12 +60,12 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 			setPkgMatchValues(&sb, hasSBOMSpec.Subject.Package, false, &firstMatch, queryValues)
 		}
 		setHasSBOMValues(&sb, hasSBOMSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "hasSBOM")
 				if err != nil {
 					return nil, err
 				}
@@ -90,6 +90,7 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 					}
 
 					hasSBOM := generateModelHasSBOM(pkg, hasSBOMNode.Props[uri].(string), hasSBOMNode.Props[origin].(string), hasSBOMNode.Props[collector].(string))
+					hasSBOM.ID = evidenceID("HasSBOM", hasSBOMNode)
 
 					collectedHasSBOM = append(collectedHasSBOM, hasSBOM)
 				}
@@ -119,12 +120,12 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 			setSrcMatchValues(&sb, hasSBOMSpec.Subject.Source, false, &firstMatch, queryValues)
 		}
 		setHasSBOMValues(&sb, hasSBOMSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, hasSBOM")
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, hasSBOM"}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "hasSBOM")
 				if err != nil {
 					return nil, err
 				}
@@ -148,6 +149,7 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 					}
 
 					hasSBOM := generateModelHasSBOM(src, hasSBOMNode.Props[uri].(string), hasSBOMNode.Props[origin].(string), hasSBOMNode.Props[collector].(string))
+					hasSBOM.ID = evidenceID("HasSBOM", hasSBOMNode)
 
 					collectedHasSBOM = append(collectedHasSBOM, hasSBOM)
 				}
@@ -166,7 +168,27 @@ func (c *neo4jClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpe
 	return aggregateHasSBOM, nil
 }
 
+func (c *neo4jClient) HasSBOMList(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.HasSBOM(withPager(ctx, p), hasSBOMSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "HasSBOM", found, func(n *model.HasSbom) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSBOMConnection(page), nil
+}
+
 func setHasSBOMValues(sb *strings.Builder, hasSBOMSpec *model.HasSBOMSpec, firstMatch *bool, queryValues map[string]any) {
+	if hasSBOMSpec.ID != nil {
+		matchID(sb, *firstMatch, "hasSBOM", "HasSBOM", *hasSBOMSpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if hasSBOMSpec.URI != nil {
 		matchProperties(sb, *firstMatch, "hasSBOM", "uri", "$uri")
 		*firstMatch = false
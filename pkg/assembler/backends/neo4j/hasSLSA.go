@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -89,14 +90,14 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 		sb.WriteString(query)
 		setPkgMatchValues(&sb, hasSLSASpec.Subject.Package, false, &firstMatch, queryValues)
 		setHasSLSAValues(&sb, hasSLSASpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		if hasSLSASpec.Subject.Package == nil || hasSLSASpec.Subject.Package != nil && hasSLSASpec.Subject.Package.Version == nil && hasSLSASpec.Subject.Package.Subpath == nil &&
 			len(hasSLSASpec.Subject.Package.Qualifiers) == 0 && !*hasSLSASpec.Subject.Package.MatchOnlyEmptyQualifiers {
 
-			sb.WriteString("\nUNION")
+			sb.Reset()
 			// query without pkgVersion
-			query := "\nMATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+			query := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
 				"-[:PkgHasName]->(name:PkgName)" +
 				"-[:subject]-(hasSLSA:HasSLSA)" +
 				"\nWITH *, hasSLSA" +
@@ -120,13 +121,13 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 			firstMatch = true
 			setPkgMatchValues(&sb, hasSLSASpec.Subject.Package, false, &firstMatch, queryValues)
 			setHasSLSAValues(&sb, hasSLSASpec, &firstMatch, queryValues)
-			sb.WriteString(returnValue)
+			queryParts = append(queryParts, queryPart{match: sb.String(), returnValue: returnValue})
 		}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "hasSLSA")
 				if err != nil {
 					return nil, err
 				}
@@ -155,6 +156,7 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 						hasSLSA := generateModelHasSLSA(pkg, builder, hasSLSANode.Props[predicate].([]interface{}), hasSLSANode.Props[buildType].(string),
 							hasSLSANode.Props[slsaVersion].(string), hasSLSANode.Props[startedOn].(string), hasSLSANode.Props[finishedOn].(string),
 							hasSLSANode.Props[origin].(time.Time), hasSLSANode.Props[collector].(time.Time))
+						hasSLSA.ID = evidenceID("HasSLSA", hasSLSANode)
 
 						resultHasSlsaMap[pkg] = hasSLSA
 					}
@@ -258,12 +260,12 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 		sb.WriteString(query)
 		setSrcMatchValues(&sb, hasSLSASpec.Subject.Source, false, &firstMatch, queryValues)
 		setHasSLSAValues(&sb, hasSLSASpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "hasSLSA")
 				if err != nil {
 					return nil, err
 				}
@@ -293,6 +295,7 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 						hasSLSA := generateModelHasSLSA(src, builder, hasSLSANode.Props[predicate].([]interface{}), hasSLSANode.Props[buildType].(string),
 							hasSLSANode.Props[slsaVersion].(string), hasSLSANode.Props[startedOn].(string), hasSLSANode.Props[finishedOn].(string),
 							hasSLSANode.Props[origin].(time.Time), hasSLSANode.Props[collector].(time.Time))
+						hasSLSA.ID = evidenceID("HasSLSA", hasSLSANode)
 
 						resultHasSlsaMap[src] = hasSLSA
 					}
@@ -390,12 +393,12 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 		sb.WriteString(query)
 		setArtifactMatchValues(&sb, hasSLSASpec.Subject.Artifact, false, &firstMatch, queryValues)
 		setHasSLSAValues(&sb, hasSLSASpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "hasSLSA")
 				if err != nil {
 					return nil, err
 				}
@@ -421,6 +424,7 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 						hasSLSA := generateModelHasSLSA(artifact, builder, hasSLSANode.Props[predicate].([]interface{}), hasSLSANode.Props[buildType].(string),
 							hasSLSANode.Props[slsaVersion].(string), hasSLSANode.Props[startedOn].(string), hasSLSANode.Props[finishedOn].(string),
 							hasSLSANode.Props[origin].(time.Time), hasSLSANode.Props[collector].(time.Time))
+						hasSLSA.ID = evidenceID("HasSLSA", hasSLSANode)
 
 						resultHasSlsaMap[artifact] = hasSLSA
 					}
@@ -492,6 +496,22 @@ func (c *neo4jClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpe
 
 }
 
+func (c *neo4jClient) HasSLSAList(ctx context.Context, hasSLSASpec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.HasSlsa(withPager(ctx, p), hasSLSASpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "HasSLSA", found, func(n *model.HasSlsa) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSLSAConnection(page), nil
+}
+
 // TODO(pxp928): combine with testing backend in shared utility
 func checkHasSLSAInputs(hasSLSASpec *model.HasSLSASpec) error {
 	subjectsDefined := 0
@@ -541,6 +561,10 @@ func getPredicateValuesFromSpec(slsaPredicate []*model.SLSAPredicateSpec) []stri
 }
 
 func setHasSLSAValues(sb *strings.Builder, hasSLSASpec *model.HasSLSASpec, firstMatch *bool, queryValues map[string]any) {
+	if hasSLSASpec.ID != nil {
+		matchID(sb, *firstMatch, "hasSLSA", "HasSLSA", *hasSLSASpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if hasSLSASpec.BuildType != nil {
 		matchProperties(sb, *firstMatch, "hasSLSA", buildType, "$"+buildType)
 		*firstMatch = false
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -52,14 +53,14 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 	setPkgMatchValues(&sb, hasSourceAtSpec.Package, false, &firstMatch, queryValues)
 	setSrcMatchValues(&sb, hasSourceAtSpec.Source, true, &firstMatch, queryValues)
 	setHasSourceAtValues(&sb, hasSourceAtSpec, &firstMatch, queryValues)
-	sb.WriteString(returnValue)
+	queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 	if hasSourceAtSpec.Package == nil || hasSourceAtSpec.Package != nil && hasSourceAtSpec.Package.Version == nil && hasSourceAtSpec.Package.Subpath == nil &&
 		len(hasSourceAtSpec.Package.Qualifiers) == 0 && !*hasSourceAtSpec.Package.MatchOnlyEmptyQualifiers {
 
-		sb.WriteString("\nUNION")
+		sb.Reset()
 		// query without pkgVersion
-		query = "\nMATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+		query = "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
 			"-[:PkgHasName]->(name:PkgName)" +
 			"-[:subject]-(hasSourceAt:HasSourceAt)-[:has_source]-(objSrcName:SrcName)<-[:SrcHasName]-(objSrcNamespace:SrcNamespace)<-[:SrcHasNamespace]" +
 			"-(objSrcType:SrcType)<-[:SrcHasType]-(objSrcRoot:Src)" +
@@ -70,13 +71,13 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 		setPkgMatchValues(&sb, hasSourceAtSpec.Package, false, &firstMatch, queryValues)
 		setSrcMatchValues(&sb, hasSourceAtSpec.Source, true, &firstMatch, queryValues)
 		setHasSourceAtValues(&sb, hasSourceAtSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts = append(queryParts, queryPart{match: sb.String(), returnValue: returnValue})
 	}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
 
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runQuery(ctx, tx, queryParts, queryValues, "hasSourceAt")
 			if err != nil {
 				return nil, err
 			}
@@ -109,6 +110,7 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 				}
 
 				hasSourceAt := &model.HasSourceAt{
+					ID:            evidenceID("HasSourceAt", hasSourceAtNode),
 					Package:       pkg,
 					Source:        src,
 					KnownSince:    hasSourceAtNode.Props[knownSince].(time.Time),
@@ -131,7 +133,27 @@ func (c *neo4jClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Ha
 	return result.([]*model.HasSourceAt), nil
 }
 
+func (c *neo4jClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.HasSourceAt(withPager(ctx, p), hasSourceAtSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "HasSourceAt", found, func(n *model.HasSourceAt) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSourceAtConnection(page), nil
+}
+
 func setHasSourceAtValues(sb *strings.Builder, hasSourceAtSpec *model.HasSourceAtSpec, firstMatch *bool, queryValues map[string]any) {
+	if hasSourceAtSpec.ID != nil {
+		matchID(sb, *firstMatch, "hasSourceAt", "HasSourceAt", *hasSourceAtSpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if hasSourceAtSpec.KnownSince != nil {
 
 		matchProperties(sb, *firstMatch, "hasSourceAt", "knownSince", "$knownSince")
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
@@ -60,13 +61,13 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 	setArtifactMatchValues(&sb, dependentArt, true, &firstMatch, queryValues)
 	setHashEqualValues(&sb, hashEqualSpec, &firstMatch, queryValues)
 
-	sb.WriteString(returnValue)
+	queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 	if len(hashEqualSpec.Artifacts) > 0 {
-		sb.WriteString("\nUNION")
+		sb.Reset()
 
 		// query with dependentArt being subject
-		query = "\nMATCH (a:Artifact)-[:subject]-(hashEqual:HashEqual)-[:is_equal]-(objArt:Artifact)"
+		query = "MATCH (a:Artifact)-[:subject]-(hashEqual:HashEqual)-[:is_equal]-(objArt:Artifact)"
 		sb.WriteString(query)
 
 		firstMatch = true
@@ -74,12 +75,12 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 		setArtifactMatchValues(&sb, selectedArt, true, &firstMatch, queryValues)
 		setHashEqualValues(&sb, hashEqualSpec, &firstMatch, queryValues)
 
-		sb.WriteString(returnValue)
+		queryParts = append(queryParts, queryPart{match: sb.String(), returnValue: returnValue})
 	}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runQuery(ctx, tx, queryParts, queryValues, "hashEqual")
 			if err != nil {
 				return nil, err
 			}
@@ -104,6 +105,7 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 				}
 
 				hashEqual := &model.HashEqual{
+					ID:            evidenceID("HashEqual", hashEqualNode),
 					Artifacts:     []*model.Artifact{artifact, depArtifact},
 					Justification: hashEqualNode.Props[justification].(string),
 					Origin:        hashEqualNode.Props[origin].(string),
@@ -124,7 +126,27 @@ func (c *neo4jClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEq
 	return result.([]*model.HashEqual), nil
 }
 
+func (c *neo4jClient) HashEqualList(ctx context.Context, hashEqualSpec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.HashEqual(withPager(ctx, p), hashEqualSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "HashEqual", found, func(n *model.HashEqual) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.HashEqualConnection(page), nil
+}
+
 func setHashEqualValues(sb *strings.Builder, hashEqualSpec *model.HashEqualSpec, firstMatch *bool, queryValues map[string]any) {
+	if hashEqualSpec.ID != nil {
+		matchID(sb, *firstMatch, "hashEqual", "HashEqual", *hashEqualSpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if hashEqualSpec.Justification != nil {
 		matchProperties(sb, *firstMatch, "hashEqual", "justification", "$justification")
 		*firstMatch = false
@@ -69,12 +69,12 @@ func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.
 	setPkgMatchValues(&sb, dependentPkg, true, &firstMatch, queryValues)
 	setIsDependencyValues(&sb, isDependencySpec, &firstMatch, queryValues)
 
-	sb.WriteString(returnValue)
+	queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 	result, err := session.ReadTransaction(
 		func(tx neo4j.Transaction) (interface{}, error) {
 
-			result, err := tx.Run(sb.String(), queryValues)
+			result, err := runQuery(ctx, tx, queryParts, queryValues, "isDependency")
 			if err != nil {
 				return nil, err
 			}
@@ -105,6 +105,7 @@ func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.
 				}
 
 				isDependency := &model.IsDependency{
+					ID:               evidenceID("IsDependency", isDependencyNode),
 					Package:          pkg,
 					DependentPackage: depPkg,
 					VersionRange:     isDependencyNode.Props[versionRange].(string),
@@ -126,7 +127,78 @@ func (c *neo4jClient) IsDependency(ctx context.Context, isDependencySpec *model.
 	return result.([]*model.IsDependency), nil
 }
 
+func (c *neo4jClient) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.IsDependency(withPager(ctx, p), isDependencySpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "IsDependency", found, func(n *model.IsDependency) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsDependencyConnection(page), nil
+}
+
+// Query transitive dependencies and dependents
+
+func (c *neo4jClient) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error) {
+	return helper.TransitiveDependencies(ctx, c, c.packageVersions, pkgSpec, maxDepth)
+}
+
+func (c *neo4jClient) Dependents(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error) {
+	return helper.Dependents(ctx, c, c.packageVersions, pkgSpec, maxDepth)
+}
+
+// packageVersions returns a package trie for each package version matching
+// the filter, whatever the fields requested by the GraphQL query
+func (c *neo4jClient) packageVersions(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close()
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	sb.WriteString("MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+		"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)")
+	setPkgMatchValues(&sb, pkgSpec, false, &firstMatch, queryValues)
+	sb.WriteString(" RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, version.qualifier_list")
+
+	result, err := session.ReadTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			collectedPackages := []*model.Package{}
+			for result.Next() {
+				values := result.Record().Values
+				pkg := generateModelPackage(values[0].(string), values[1].(string), values[2].(string), values[3], values[4], values[5])
+				collectedPackages = append(collectedPackages, pkg)
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return collectedPackages, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*model.Package), nil
+}
+
 func setIsDependencyValues(sb *strings.Builder, isDependencySpec *model.IsDependencySpec, firstMatch *bool, queryValues map[string]any) {
+	if isDependencySpec.ID != nil {
+		matchID(sb, *firstMatch, "isDependency", "IsDependency", *isDependencySpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if isDependencySpec.VersionRange != nil {
 
 		matchProperties(sb, *firstMatch, "isDependency", versionRange, "$"+versionRange)
@@ -190,7 +262,8 @@ func (c *neo4jClient) IngestDependency(ctx context.Context, pkg model.PkgInputSp
 	setPkgMatchValues(&sb, &depPkgSpec, true, &firstMatch, queryValues)
 
 	merge := "\nMERGE (version)<-[:subject]-(isDependency:IsDependency{versionRange:$versionRange,justification:$justification,origin:$origin,collector:$collector})" +
-		"-[:dependency]->(objPkgName)"
+		"-[:dependency]->(objPkgName)" +
+		setEvidenceID("isDependency")
 	sb.WriteString(merge)
 	sb.WriteString(returnValue)
 
@@ -230,6 +303,7 @@ func (c *neo4jClient) IngestDependency(ctx context.Context, pkg model.PkgInputSp
 			}
 
 			isDependency := &model.IsDependency{
+				ID:               evidenceID("IsDependency", isDependencyNode),
 				Package:          pkg,
 				DependentPackage: depPkg,
 				VersionRange:     isDependencyNode.Props[versionRange].(string),
@@ -59,12 +59,12 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 		}
 		setArtifactMatchValues(&sb, isOccurrenceSpec.Artifact, true, &firstMatch, queryValues)
 		setIsOccurrenceValues(&sb, isOccurrenceSpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "isOccurrence")
 				if err != nil {
 					return nil, err
 				}
@@ -94,6 +94,7 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 
 					isOccurrence := generateModelIsOccurrence(pkg, artifact, isOccurrenceNode.Props[justification].(string),
 						isOccurrenceNode.Props[origin].(string), isOccurrenceNode.Props[collector].(string))
+					isOccurrence.ID = evidenceID("IsOccurrence", isOccurrenceNode)
 
 					collectedIsOccurrence = append(collectedIsOccurrence, isOccurrence)
 				}
@@ -123,12 +124,12 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 		}
 		setArtifactMatchValues(&sb, isOccurrenceSpec.Artifact, true, &firstMatch, queryValues)
 		setIsOccurrenceValues(&sb, isOccurrenceSpec, &firstMatch, queryValues)
-		sb.WriteString(" RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, isOccurrence, objArt.algorithm, objArt.digest")
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, name.tag, name.commit, isOccurrence, objArt.algorithm, objArt.digest"}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "isOccurrence")
 				if err != nil {
 					return nil, err
 				}
@@ -156,6 +157,7 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 
 					isOccurrence := generateModelIsOccurrence(src, artifact, isOccurrenceNode.Props[justification].(string),
 						isOccurrenceNode.Props[origin].(string), isOccurrenceNode.Props[collector].(string))
+					isOccurrence.ID = evidenceID("IsOccurrence", isOccurrenceNode)
 
 					collectedIsOccurrence = append(collectedIsOccurrence, isOccurrence)
 				}
@@ -173,7 +175,27 @@ func (c *neo4jClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.
 	return aggregateIsOccurrence, nil
 }
 
+func (c *neo4jClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.IsOccurrence(withPager(ctx, p), isOccurrenceSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "IsOccurrence", found, func(n *model.IsOccurrence) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsOccurrenceConnection(page), nil
+}
+
 func setIsOccurrenceValues(sb *strings.Builder, isOccurrenceSpec *model.IsOccurrenceSpec, firstMatch *bool, queryValues map[string]any) {
+	if isOccurrenceSpec.ID != nil {
+		matchID(sb, *firstMatch, "isOccurrence", "IsOccurrence", *isOccurrenceSpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if isOccurrenceSpec.Justification != nil {
 		matchProperties(sb, *firstMatch, "isOccurrence", justification, "$"+justification)
 		*firstMatch = false
@@ -236,7 +258,8 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 		setArtifactMatchValues(&sb, occurrenceArt, true, &firstMatch, queryValues)
 
 		merge := "\nMERGE (version)<-[:subject]-(isOccurrence:IsOccurrence{justification:$justification,origin:$origin,collector:$collector})" +
-			"-[:has_occurrence]->(objArt)"
+			"-[:has_occurrence]->(objArt)" +
+			setEvidenceID("isOccurrence")
 		sb.WriteString(merge)
 		returnValue := " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, " +
 			"version.qualifier_list, isOccurrence, objArt.algorithm, objArt.digest"
@@ -277,6 +300,7 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 
 				isOccurrence := generateModelIsOccurrence(pkg, artifact, isOccurrenceNode.Props[justification].(string),
 					isOccurrenceNode.Props[origin].(string), isOccurrenceNode.Props[collector].(string))
+				isOccurrence.ID = evidenceID("IsOccurrence", isOccurrenceNode)
 
 				return isOccurrence, nil
 			})
@@ -299,7 +323,8 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 		setArtifactMatchValues(&sb, occurrenceArt, true, &firstMatch, queryValues)
 
 		merge := "\nMERGE (name)<-[:subject]-(isOccurrence:IsOccurrence{justification:$justification,origin:$origin,collector:$collector})" +
-			"-[:has_occurrence]->(objArt)"
+			"-[:has_occurrence]->(objArt)" +
+			setEvidenceID("isOccurrence")
 		sb.WriteString(merge)
 		sb.WriteString(returnValue)
 
@@ -336,6 +361,7 @@ func (c *neo4jClient) IngestOccurrence(ctx context.Context, subject model.Packag
 
 				isOccurrence := generateModelIsOccurrence(src, artifact, isOccurrenceNode.Props[justification].(string),
 					isOccurrenceNode.Props[origin].(string), isOccurrenceNode.Props[collector].(string))
+				isOccurrence.ID = evidenceID("IsOccurrence", isOccurrenceNode)
 
 				return isOccurrence, nil
 			})
@@ -57,12 +57,12 @@ func (c *neo4jClient) IsVulnerability(ctx context.Context, isVulnerabilitySpec *
 			setCveMatchValues(&sb, isVulnerabilitySpec.Vulnerability.Cve, &firstMatch, queryValues)
 		}
 		setIsVulnerabilityValues(&sb, isVulnerabilitySpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "isVulnerability")
 				if err != nil {
 					return nil, err
 				}
@@ -86,6 +86,7 @@ func (c *neo4jClient) IsVulnerability(ctx context.Context, isVulnerabilitySpec *
 
 					isVulnerability := generateModelIsVulnerability(osv, cve, isVulnerabilityNode.Props[justification].(string),
 						isVulnerabilityNode.Props[origin].(string), isVulnerabilityNode.Props[collector].(string))
+					isVulnerability.ID = evidenceID("IsVulnerability", isVulnerabilityNode)
 
 					collectedIsVulnerability = append(collectedIsVulnerability, isVulnerability)
 				}
@@ -119,12 +120,12 @@ func (c *neo4jClient) IsVulnerability(ctx context.Context, isVulnerabilitySpec *
 			setGhsaMatchValues(&sb, isVulnerabilitySpec.Vulnerability.Ghsa, &firstMatch, queryValues)
 		}
 		setIsVulnerabilityValues(&sb, isVulnerabilitySpec, &firstMatch, queryValues)
-		sb.WriteString(returnValue)
+		queryParts := []queryPart{{match: sb.String(), returnValue: returnValue}}
 
 		result, err := session.ReadTransaction(
 			func(tx neo4j.Transaction) (interface{}, error) {
 
-				result, err := tx.Run(sb.String(), queryValues)
+				result, err := runQuery(ctx, tx, queryParts, queryValues, "isVulnerability")
 				if err != nil {
 					return nil, err
 				}
@@ -147,6 +148,7 @@ func (c *neo4jClient) IsVulnerability(ctx context.Context, isVulnerabilitySpec *
 
 					isVulnerability := generateModelIsVulnerability(osv, ghsa, isVulnerabilityNode.Props[justification].(string),
 						isVulnerabilityNode.Props[origin].(string), isVulnerabilityNode.Props[collector].(string))
+					isVulnerability.ID = evidenceID("IsVulnerability", isVulnerabilityNode)
 
 					collectedIsVulnerability = append(collectedIsVulnerability, isVulnerability)
 				}
@@ -164,7 +166,27 @@ func (c *neo4jClient) IsVulnerability(ctx context.Context, isVulnerabilitySpec *
 	return aggregateIsVulnerability, nil
 }
 
+func (c *neo4jClient) IsVulnerabilityList(ctx context.Context, isVulnerabilitySpec *model.IsVulnerabilitySpec, after *string, first *int) (*model.IsVulnerabilityConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.IsVulnerability(withPager(ctx, p), isVulnerabilitySpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "IsVulnerability", found, func(n *model.IsVulnerability) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsVulnerabilityConnection(page), nil
+}
+
 func setIsVulnerabilityValues(sb *strings.Builder, isVulnerabilitySpec *model.IsVulnerabilitySpec, firstMatch *bool, queryValues map[string]any) {
+	if isVulnerabilitySpec.ID != nil {
+		matchID(sb, *firstMatch, "isVulnerability", "IsVulnerability", *isVulnerabilitySpec.ID, queryValues)
+		*firstMatch = false
+	}
 	if isVulnerabilitySpec.Justification != nil {
 		matchProperties(sb, *firstMatch, "isVulnerability", justification, "$"+justification)
 		*firstMatch = false
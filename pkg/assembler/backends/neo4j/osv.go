@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -128,6 +129,7 @@ func (c *neo4jClient) Osv(ctx context.Context, osvSpec *model.OSVSpec) ([]*model
 			}
 
 			osv := &model.Osv{
+				ID:    helper.OsvID(),
 				OsvID: osvIds,
 			}
 
@@ -140,6 +142,30 @@ func (c *neo4jClient) Osv(ctx context.Context, osvSpec *model.OSVSpec) ([]*model
 	return result.([]*model.Osv), nil
 }
 
+func (c *neo4jClient) OsvList(ctx context.Context, osvSpec *model.OSVSpec, after *string, first *int) (*model.OSVConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	sb.WriteString("MATCH (root:Osv)-[:OsvHasID]->(osvID:OsvID)")
+
+	setOSVMatchValues(&sb, osvSpec, &firstMatch, queryValues)
+
+	query := queryPart{match: sb.String(), returnValue: " RETURN osvID.id, id(osvID)"}
+	page, err := listNodes(c, p, query, queryValues, "osvID", func(values []interface{}) *model.Osv {
+		return generateModelOsv(values[0].(string))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.OSVConnection(page), nil
+}
+
 func setOSVMatchValues(sb *strings.Builder, osv *model.OSVSpec, firstMatch *bool, queryValues map[string]any) {
 	if osv != nil {
 		if osv.OsvID != nil {
@@ -188,6 +214,7 @@ RETURN osvID.id`
 func generateModelOsv(id string) *model.Osv {
 	osvID := &model.OSVId{ID: id}
 	osv := model.Osv{
+		ID:    helper.OsvID(),
 		OsvID: []*model.OSVId{osvID},
 	}
 	return &osv
@@ -0,0 +1,291 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Paginated list queries are ordered by a key of the node the results are
+// made of: the identifier generated for the evidence node, or the identifier
+// neo4j assigns to the leaf of a software trie. The cursor of a result encodes
+// this key, so that the next page is matched with `key > $after` instead of
+// skipping the results of the previous pages. Keys are compared as strings.
+//
+// The queries over the evidence are shared with the unpaginated queries: a
+// pager in the context is applied by runQuery to each of the (possibly many)
+// queries issued for a list. For each query, the pager first reads the
+// identifiers of at most one more node than the page size, then the results
+// for these nodes only. Every query returns the start of its own results, so
+// the results of all queries, ordered by identifier, hold the page.
+
+type pagerKey struct{}
+
+type pager struct {
+	// key of the node the page starts after, empty for the first page
+	after string
+	// number of results in the page
+	first int
+	// whether to count the results matching the query
+	count bool
+	// keys of the nodes matching each query run with the pager, by query
+	counted map[string][]string
+}
+
+// queryPart is one of the queries a read query is the UNION of: the clauses
+// matching the results, and the RETURN clause of the values of each result
+type queryPart struct {
+	match       string
+	returnValue string
+}
+
+func newPager(ctx context.Context, after *string, first *int) (*pager, error) {
+	size, err := helper.PageSize(first)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pager{first: size, counted: map[string][]string{}}
+	if after != nil {
+		p.after, err = helper.CursorKey(*after)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, f := range getPreloads(ctx) {
+		if f == "totalCount" {
+			p.count = true
+			break
+		}
+	}
+	return p, nil
+}
+
+func withPager(ctx context.Context, p *pager) context.Context {
+	return context.WithValue(ctx, pagerKey{}, p)
+}
+
+func pagerFromContext(ctx context.Context) *pager {
+	p, _ := ctx.Value(pagerKey{}).(*pager)
+	return p
+}
+
+// runQuery runs a read query, the UNION of queryParts, whose results are made
+// of the evidence nodes bound to label. If the context holds a pager, only
+// the results at the start of the page are returned.
+func runQuery(ctx context.Context, tx neo4j.Transaction, queryParts []queryPart, queryValues map[string]any, label string) (neo4j.Result, error) {
+	p := pagerFromContext(ctx)
+	if p == nil {
+		return tx.Run(unionQuery(queryParts), queryValues)
+	}
+	return p.run(tx, queryParts, queryValues, label+"."+evidenceIDProperty)
+}
+
+// run runs a read query whose results are made of the nodes with the given
+// key, returning the results at the start of the page
+func (p *pager) run(tx neo4j.Transaction, queryParts []queryPart, queryValues map[string]any, key string) (neo4j.Result, error) {
+	values := map[string]any{}
+	for k, v := range queryValues {
+		values[k] = v
+	}
+	values["pageAfter"] = p.after
+	values["pageLimit"] = p.first + 1
+
+	if p.count {
+		keys, err := readKeys(tx, countQuery(queryParts, key), values)
+		if err != nil {
+			return nil, err
+		}
+		p.countKeys(unionQuery(queryParts), keys)
+	}
+
+	keys, err := readKeys(tx, pageKeysQuery(queryParts, key), values)
+	if err != nil {
+		return nil, err
+	}
+	values["pageKeys"] = keys
+
+	return tx.Run(pageQuery(queryParts, key), values)
+}
+
+// countKeys records the keys of the nodes matching query. The keys counted
+// for the same query before are replaced, so that a transaction retried by
+// the driver does not count its results twice.
+func (p *pager) countKeys(query string, keys []string) {
+	p.counted[query] = keys
+}
+
+// totalCount returns the number of nodes matching the queries run with the
+// pager, counting once the nodes matched by many queries
+func (p *pager) totalCount() int {
+	distinct := map[string]bool{}
+	for _, keys := range p.counted {
+		for _, key := range keys {
+			distinct[key] = true
+		}
+	}
+	return len(distinct)
+}
+
+// readKeys runs a query returning a single key per record
+func readKeys(tx neo4j.Transaction, query string, values map[string]any) ([]string, error) {
+	result, err := tx.Run(query, values)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{}
+	for result.Next() {
+		keys = append(keys, result.Record().Values[0].(string))
+	}
+	if err = result.Err(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// unionQuery returns the query that is the UNION of queryParts
+func unionQuery(queryParts []queryPart) string {
+	queries := make([]string, 0, len(queryParts))
+	for _, part := range queryParts {
+		queries = append(queries, part.match+part.returnValue)
+	}
+	return strings.Join(queries, "\nUNION\n")
+}
+
+// keysQuery returns the query listing the distinct keys of the nodes matched
+// by the queryParts, each restricted by the given clause
+func keysQuery(queryParts []queryPart, key string, clause string) string {
+	keyParts := make([]queryPart, 0, len(queryParts))
+	for _, part := range queryParts {
+		keyParts = append(keyParts, queryPart{
+			match:       part.match + clause,
+			returnValue: " RETURN DISTINCT " + key + " AS pageKey",
+		})
+	}
+	return unionQuery(keyParts)
+}
+
+// countQuery returns the query listing the keys of all the nodes matched by
+// the query, counted by the pager
+func countQuery(queryParts []queryPart, key string) string {
+	return keysQuery(queryParts, key, "")
+}
+
+// pageKeysQuery returns the query listing the keys of the nodes at the start
+// of the page
+func pageKeysQuery(queryParts []queryPart, key string) string {
+	query := keysQuery(queryParts, key, " WITH * WHERE "+key+" > $pageAfter")
+	if len(queryParts) > 1 {
+		query = "CALL {\n" + query + "\n} RETURN pageKey"
+	}
+	return query + " ORDER BY pageKey LIMIT $pageLimit"
+}
+
+// pageQuery returns the query restricted to the nodes listed by pageKeysQuery
+func pageQuery(queryParts []queryPart, key string) string {
+	pageParts := make([]queryPart, 0, len(queryParts))
+	for _, part := range queryParts {
+		pageParts = append(pageParts, queryPart{
+			match:       part.match + " WITH * WHERE " + key + " IN $pageKeys",
+			returnValue: part.returnValue,
+		})
+	}
+	return unionQuery(pageParts)
+}
+
+// page returns the page of results read by the queries run with the pager,
+// given the key of the node each result is made of
+func page[T any](p *pager, nodes []T, nodeKeys []string) *helper.Page[T] {
+	order := make([]int, 0, len(nodes))
+	seen := map[string]bool{}
+	for i, key := range nodeKeys {
+		// a node may be returned by many queries of an UNION
+		if key > p.after && !seen[key] {
+			seen[key] = true
+			order = append(order, i)
+		}
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return nodeKeys[order[i]] < nodeKeys[order[j]]
+	})
+
+	pg := &helper.Page[T]{
+		HasNextPage: len(order) > p.first,
+		TotalCount:  p.totalCount(),
+	}
+	if pg.HasNextPage {
+		order = order[:p.first]
+	}
+	for _, i := range order {
+		pg.Nodes = append(pg.Nodes, nodes[i])
+		pg.Cursors = append(pg.Cursors, helper.Cursor(nodeKeys[i]))
+	}
+	return pg
+}
+
+// evidencePage returns the page of evidence nodes of the given type read by
+// the queries run with the pager
+func evidencePage[T any](p *pager, typeName string, nodes []T, id func(T) string) (*helper.Page[T], error) {
+	nodeKeys := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		key, err := helper.EvidenceBackendID(typeName, id(n))
+		if err != nil {
+			return nil, gqlerror.Errorf("%v", err)
+		}
+		nodeKeys = append(nodeKeys, key)
+	}
+	return page(p, nodes, nodeKeys), nil
+}
+
+// listNodes runs a query over the software trees returning the values of a
+// single leaf per record followed by the identifier of the leaf, bound to
+// label, and returns the page of the tries decoded from the records
+func listNodes[T any](c *neo4jClient, p *pager, query queryPart, queryValues map[string]any, label string, decode func(values []interface{}) T) (*helper.Page[T], error) {
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close()
+
+	var nodes []T
+	var nodeKeys []string
+	_, err := session.ReadTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			nodes, nodeKeys = nil, nil
+
+			result, err := p.run(tx, []queryPart{query}, queryValues, "toString(id("+label+"))")
+			if err != nil {
+				return nil, err
+			}
+
+			for result.Next() {
+				values := result.Record().Values
+				nodes = append(nodes, decode(values))
+				nodeKeys = append(nodeKeys, strconv.FormatInt(values[len(values)-1].(int64), 10))
+			}
+			return nil, result.Err()
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return page(p, nodes, nodeKeys), nil
+}
@@ -0,0 +1,201 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+)
+
+func TestPageQueries(t *testing.T) {
+	withVersion := queryPart{
+		match:       "MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name",
+		returnValue: " RETURN name.name, version.version, isDependency",
+	}
+	withoutVersion := queryPart{
+		match:       "MATCH (name:PkgName)-[:subject]-(isDependency:IsDependency)\nWITH *, null AS version WHERE name.name = $name",
+		returnValue: " RETURN name.name, version.version, isDependency",
+	}
+	key := "isDependency.evidenceId"
+
+	tests := []struct {
+		name         string
+		queryParts   []queryPart
+		wantUnion    string
+		wantCount    string
+		wantPageKeys string
+		wantPage     string
+	}{{
+		name:       "single query",
+		queryParts: []queryPart{withVersion},
+		wantUnion: "MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name" +
+			" RETURN name.name, version.version, isDependency",
+		wantCount: "MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name" +
+			" RETURN DISTINCT isDependency.evidenceId AS pageKey",
+		wantPageKeys: "MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name" +
+			" WITH * WHERE isDependency.evidenceId > $pageAfter RETURN DISTINCT isDependency.evidenceId AS pageKey" +
+			" ORDER BY pageKey LIMIT $pageLimit",
+		wantPage: "MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name" +
+			" WITH * WHERE isDependency.evidenceId IN $pageKeys RETURN name.name, version.version, isDependency",
+	}, {
+		name:       "union",
+		queryParts: []queryPart{withVersion, withoutVersion},
+		wantUnion: "MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name" +
+			" RETURN name.name, version.version, isDependency" +
+			"\nUNION\n" +
+			"MATCH (name:PkgName)-[:subject]-(isDependency:IsDependency)\nWITH *, null AS version WHERE name.name = $name" +
+			" RETURN name.name, version.version, isDependency",
+		wantCount: "MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name" +
+			" RETURN DISTINCT isDependency.evidenceId AS pageKey" +
+			"\nUNION\n" +
+			"MATCH (name:PkgName)-[:subject]-(isDependency:IsDependency)\nWITH *, null AS version WHERE name.name = $name" +
+			" RETURN DISTINCT isDependency.evidenceId AS pageKey",
+		wantPageKeys: "CALL {\n" +
+			"MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name" +
+			" WITH * WHERE isDependency.evidenceId > $pageAfter RETURN DISTINCT isDependency.evidenceId AS pageKey" +
+			"\nUNION\n" +
+			"MATCH (name:PkgName)-[:subject]-(isDependency:IsDependency)\nWITH *, null AS version WHERE name.name = $name" +
+			" WITH * WHERE isDependency.evidenceId > $pageAfter RETURN DISTINCT isDependency.evidenceId AS pageKey" +
+			"\n} RETURN pageKey ORDER BY pageKey LIMIT $pageLimit",
+		wantPage: "MATCH (name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)-[:subject]-(isDependency:IsDependency) WHERE name.name = $name" +
+			" WITH * WHERE isDependency.evidenceId IN $pageKeys RETURN name.name, version.version, isDependency" +
+			"\nUNION\n" +
+			"MATCH (name:PkgName)-[:subject]-(isDependency:IsDependency)\nWITH *, null AS version WHERE name.name = $name" +
+			" WITH * WHERE isDependency.evidenceId IN $pageKeys RETURN name.name, version.version, isDependency",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.wantUnion, unionQuery(test.queryParts)); diff != "" {
+				t.Errorf("unexpected union query (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantCount, countQuery(test.queryParts, key)); diff != "" {
+				t.Errorf("unexpected count query (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantPageKeys, pageKeysQuery(test.queryParts, key)); diff != "" {
+				t.Errorf("unexpected page keys query (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantPage, pageQuery(test.queryParts, key)); diff != "" {
+				t.Errorf("unexpected page query (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPage(t *testing.T) {
+	// results of two queries, each returning the start of its own results,
+	// with node "3" returned by both
+	nodes := []string{"node-3", "node-5", "node-1", "node-3", "node-4", "node-2"}
+	nodeKeys := []string{"3", "5", "1", "3", "4", "2"}
+
+	tests := []struct {
+		name        string
+		after       string
+		first       int
+		want        []string
+		wantCursors []string
+		wantNext    bool
+	}{{
+		name:        "first page",
+		first:       2,
+		want:        []string{"node-1", "node-2"},
+		wantCursors: []string{helper.Cursor("1"), helper.Cursor("2")},
+		wantNext:    true,
+	}, {
+		name:        "node returned by many queries",
+		after:       "2",
+		first:       2,
+		want:        []string{"node-3", "node-4"},
+		wantCursors: []string{helper.Cursor("3"), helper.Cursor("4")},
+		wantNext:    true,
+	}, {
+		name:        "last page",
+		after:       "3",
+		first:       2,
+		want:        []string{"node-4", "node-5"},
+		wantCursors: []string{helper.Cursor("4"), helper.Cursor("5")},
+	}, {
+		name:  "after the end",
+		after: "5",
+		first: 2,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &pager{after: test.after, first: test.first, counted: map[string][]string{}}
+			pg := page(p, nodes, nodeKeys)
+			if diff := cmp.Diff(test.want, pg.Nodes); diff != "" {
+				t.Errorf("unexpected nodes (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.wantCursors, pg.Cursors); diff != "" {
+				t.Errorf("unexpected cursors (-want +got):\n%s", diff)
+			}
+			if pg.HasNextPage != test.wantNext {
+				t.Errorf("got hasNextPage %t, want %t", pg.HasNextPage, test.wantNext)
+			}
+		})
+	}
+}
+
+func TestPagerTotalCount(t *testing.T) {
+	type counted struct {
+		query string
+		keys  []string
+	}
+	tests := []struct {
+		name    string
+		counted []counted
+		want    int
+	}{{
+		name: "no query",
+		want: 0,
+	}, {
+		name: "single query",
+		counted: []counted{
+			{"pkg", []string{"1", "2", "3"}},
+		},
+		want: 3,
+	}, {
+		name: "retried query",
+		counted: []counted{
+			{"pkg", []string{"1", "2"}},
+			{"pkg", []string{"1", "2", "3"}},
+		},
+		want: 3,
+	}, {
+		name: "nodes matched by many queries",
+		counted: []counted{
+			{"pkg", []string{"1", "2", "3"}},
+			{"src", []string{"3", "4"}},
+			{"artifact", []string{"1", "5"}},
+		},
+		want: 5,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &pager{first: 2, count: true, counted: map[string][]string{}}
+			for _, c := range test.counted {
+				p.countKeys(c.query, c.keys)
+			}
+			if got := p.totalCount(); got != test.want {
+				t.Errorf("got totalCount %d, want %d", got, test.want)
+			}
+			if got := page(p, []string{}, []string{}).TotalCount; got != test.want {
+				t.Errorf("got page totalCount %d, want %d", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// pathVertex describes how to match the vertices of a kind of the software
+// trees, the vertex being bound to n
+type pathVertex struct {
+	label string
+	match string
+	// properties matched against the key of the node id, in order
+	keys []string
+	// values returned to decode the vertex
+	values string
+	decode func(values []interface{}) model.Node
+}
+
+var pathVertices = map[string]pathVertex{
+	helper.PackageVersionVertex: {
+		label: "PkgVersion",
+		match: "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+			"-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(n:PkgVersion)",
+		keys:   []string{"type.type", "namespace.namespace", "name.name", "n.version", "n.subpath"},
+		values: "type.type, namespace.namespace, name.name, n.version, n.subpath, n.qualifier_list",
+		decode: func(values []interface{}) model.Node {
+			return generateModelPackage(values[0].(string), values[1].(string), values[2].(string), values[3], values[4], values[5])
+		},
+	},
+	helper.PackageNameVertex: {
+		label: "PkgName",
+		match: "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+			"-[:PkgHasName]->(n:PkgName)",
+		keys:   []string{"type.type", "namespace.namespace", "n.name"},
+		values: "type.type, namespace.namespace, n.name",
+		decode: func(values []interface{}) model.Node {
+			return generateModelPackage(values[0].(string), values[1].(string), values[2].(string), nil, nil, nil)
+		},
+	},
+	helper.SourceNameVertex: {
+		label: "SrcName",
+		match: "MATCH (root:Src)-[:SrcHasType]->(type:SrcType)-[:SrcHasNamespace]->(namespace:SrcNamespace)" +
+			"-[:SrcHasName]->(n:SrcName)",
+		keys:   []string{"type.type", "namespace.namespace", "n.name"},
+		values: "type.type, namespace.namespace, n.name, n.commit, n.tag",
+		decode: func(values []interface{}) model.Node {
+			return generateModelSource(values[0].(string), values[1].(string), values[2].(string), values[3], values[4])
+		},
+	},
+	helper.ArtifactVertex: {
+		label:  "Artifact",
+		match:  "MATCH (n:Artifact)",
+		keys:   []string{"n.algorithm", "n.digest"},
+		values: "n.algorithm, n.digest",
+		decode: func(values []interface{}) model.Node {
+			return generateModelArtifact(values[0].(string), values[1].(string))
+		},
+	},
+	helper.BuilderVertex: {
+		label:  "Builder",
+		match:  "MATCH (n:Builder)",
+		keys:   []string{"n.uri"},
+		values: "n.uri",
+		decode: func(values []interface{}) model.Node {
+			return generateModelBuilder(values[0].(string))
+		},
+	},
+	helper.CveVertex: {
+		label:  "CveID",
+		match:  "MATCH (root:Cve)-[:CveIsYear]->(cveYear:CveYear)-[:CveHasID]->(n:CveID)",
+		keys:   []string{"cveYear.year", "n.id"},
+		values: "cveYear.year, n.id",
+		decode: func(values []interface{}) model.Node {
+			return helper.WithVulnerabilityID(generateModelCve(values[0].(string), values[1].(string)))
+		},
+	},
+	helper.GhsaVertex: {
+		label:  "GhsaID",
+		match:  "MATCH (root:Ghsa)-[:GhsaHasID]->(n:GhsaID)",
+		keys:   []string{"n.id"},
+		values: "n.id",
+		decode: func(values []interface{}) model.Node {
+			return helper.WithVulnerabilityID(generateModelGhsa(values[0].(string)))
+		},
+	},
+	helper.OsvVertex: {
+		label:  "OsvID",
+		match:  "MATCH (root:Osv)-[:OsvHasID]->(n:OsvID)",
+		keys:   []string{"n.id"},
+		values: "n.id",
+		decode: func(values []interface{}) model.Node {
+			return helper.WithVulnerabilityID(generateModelOsv(values[0].(string)))
+		},
+	},
+	helper.NoVulnVertex: {
+		label:  "NoVuln",
+		match:  "MATCH (n:NoVuln)",
+		values: "true",
+		decode: func(values []interface{}) model.Node {
+			return generateModelNoVuln()
+		},
+	},
+}
+
+// vertexLabel returns the neo4j label of a kind of vertex, evidence vertices
+// having the type name of the evidence as label
+func vertexLabel(kind string) string {
+	if v, ok := pathVertices[kind]; ok {
+		return v.label
+	}
+	return kind
+}
+
+// labelSteps returns the steps between kinds of vertices as the labels of
+// the neo4j nodes joined by an underscore
+func labelSteps(steps map[helper.Step]bool) []string {
+	labels := []string{}
+	for step := range steps {
+		labels = append(labels, vertexLabel(step[0])+"_"+vertexLabel(step[1]))
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// pathNode is a vertex of a path, identified by its neo4j id. The node is nil
+// until decoded for evidence vertices, which are retrieved by the identifier
+// generated for them.
+type pathNode struct {
+	id         int64
+	label      string
+	evidenceID string
+	node       model.Node
+}
+
+// Query Path
+
+func (c *neo4jClient) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	if err := helper.ValidatePath(subject, target, maxPathLength); err != nil {
+		return nil, err
+	}
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close()
+
+	result, err := session.ReadTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			s, err := findPathNode(tx, subject)
+			if err != nil || s == nil {
+				return nil, err
+			}
+			if subject == target {
+				return []*pathNode{s}, nil
+			}
+			t, err := findPathNode(tx, target)
+			if err != nil || t == nil || maxPathLength == 0 {
+				return nil, err
+			}
+
+			path, err := shortestPath(tx, s, t, maxPathLength, helper.PathSteps(usingOnly))
+			if err != nil {
+				return nil, err
+			}
+			if err := decodePathNodes(tx, path); err != nil {
+				return nil, err
+			}
+			return path, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	path, _ := result.([]*pathNode)
+	return c.pathModels(ctx, path)
+}
+
+// Query Neighbors
+
+func (c *neo4jClient) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close()
+
+	result, err := session.ReadTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			n, err := findPathNode(tx, node)
+			if err != nil || n == nil {
+				return nil, err
+			}
+
+			query := "MATCH (n) WHERE id(n) = $id AND $label IN labels(n)" +
+				"\nMATCH (n)--(m) WHERE $label + '_' + head(labels(m)) IN $steps" +
+				" RETURN DISTINCT id(m), head(labels(m)), m." + evidenceIDProperty + " ORDER BY id(m)"
+			queryValues := map[string]any{
+				"id":    n.id,
+				"label": n.label,
+				"steps": labelSteps(helper.PathSteps(usingOnly)),
+			}
+			result, err := tx.Run(query, queryValues)
+			if err != nil {
+				return nil, err
+			}
+			neighbors := []*pathNode{}
+			for result.Next() {
+				values := result.Record().Values
+				evidenceID, _ := values[2].(string)
+				neighbors = append(neighbors, &pathNode{
+					id:         values[0].(int64),
+					label:      values[1].(string),
+					evidenceID: evidenceID,
+				})
+			}
+			if err := result.Err(); err != nil {
+				return nil, err
+			}
+
+			if err := decodePathNodes(tx, neighbors); err != nil {
+				return nil, err
+			}
+			return neighbors, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors, _ := result.([]*pathNode)
+	return c.pathModels(ctx, neighbors)
+}
+
+// pathModels returns the nodes of decoded vertices, retrieving the evidence
+func (c *neo4jClient) pathModels(ctx context.Context, path []*pathNode) ([]model.Node, error) {
+	nodes := []model.Node{}
+	for _, n := range path {
+		if n.node == nil {
+			// evidence nodes are retrieved by their query
+			var err error
+			n.node, err = helper.Node(ctx, c, helper.EvidenceID(n.label, n.evidenceID))
+			if err != nil {
+				return nil, err
+			}
+		}
+		nodes = append(nodes, n.node)
+	}
+	return nodes, nil
+}
+
+// findPathNode returns the vertex with the given node id, nil if it does not
+// exist. Vertices of the software trees are matched by the key of their id.
+func findPathNode(tx neo4j.Transaction, id string) (*pathNode, error) {
+	kind, err := helper.VertexKind(id)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := pathVertices[kind]
+	if !ok {
+		evidenceID, err := helper.EvidenceBackendID(kind, id)
+		if err != nil {
+			return nil, gqlerror.Errorf("%v", err)
+		}
+		// the kinds of evidence are the neo4j labels of the evidence nodes
+		result, err := tx.Run("MATCH (n:"+kind+") WHERE n."+evidenceIDProperty+" = $id RETURN id(n)", map[string]any{"id": evidenceID})
+		if err != nil {
+			return nil, err
+		}
+		if !result.Next() {
+			return nil, result.Err()
+		}
+		return &pathNode{id: result.Record().Values[0].(int64), label: kind, evidenceID: evidenceID}, nil
+	}
+
+	_, key, err := helper.DecodeID(id)
+	if err != nil {
+		return nil, err
+	}
+	var sb strings.Builder
+	firstMatch := true
+	queryValues := map[string]any{}
+	sb.WriteString(v.match)
+	for i, property := range v.keys {
+		if i >= len(key) {
+			break
+		}
+		whereOrAnd(&sb, &firstMatch)
+		sb.WriteString(fmt.Sprintf("%s = $key%d", property, i))
+		queryValues[fmt.Sprintf("key%d", i)] = key[i]
+	}
+	sb.WriteString(" RETURN id(n), " + v.values)
+
+	result, err := tx.Run(sb.String(), queryValues)
+	if err != nil {
+		return nil, err
+	}
+	for result.Next() {
+		values := result.Record().Values
+		node := v.decode(values[1:])
+		// qualifiers, tags and commits are not part of the match
+		if helper.VertexID(node) == id {
+			return &pathNode{id: values[0].(int64), label: v.label, node: node}, nil
+		}
+	}
+	return nil, result.Err()
+}
+
+// shortestPath returns the vertices of the shortest path between two vertices
+// taking only the given steps, nil if there is none
+func shortestPath(tx neo4j.Transaction, s *pathNode, t *pathNode, maxPathLength int, steps map[helper.Step]bool) ([]*pathNode, error) {
+	// the maximum length of a variable length relationship cannot be a
+	// parameter
+	query := "MATCH (s), (t) WHERE id(s) = $subject AND $subjectLabel IN labels(s)" +
+		" AND id(t) = $target AND $targetLabel IN labels(t)" +
+		fmt.Sprintf("\nMATCH p = shortestPath((s)-[*..%d]-(t))", maxPathLength) +
+		"\nWHERE all(i IN range(0, length(p) - 1) WHERE head(labels(nodes(p)[i])) + '_' + head(labels(nodes(p)[i + 1])) IN $steps)" +
+		" RETURN [n IN nodes(p) | id(n)], [n IN nodes(p) | head(labels(n))], [n IN nodes(p) | n." + evidenceIDProperty + "]"
+	queryValues := map[string]any{
+		"subject":      s.id,
+		"subjectLabel": s.label,
+		"target":       t.id,
+		"targetLabel":  t.label,
+		"steps":        labelSteps(steps),
+	}
+
+	result, err := tx.Run(query, queryValues)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Next() {
+		return nil, result.Err()
+	}
+	ids := result.Record().Values[0].([]interface{})
+	labels := result.Record().Values[1].([]interface{})
+	evidenceIDs := result.Record().Values[2].([]interface{})
+	path := []*pathNode{}
+	for i := range ids {
+		evidenceID, _ := evidenceIDs[i].(string)
+		path = append(path, &pathNode{id: ids[i].(int64), label: labels[i].(string), evidenceID: evidenceID})
+	}
+	path[0].node = s.node
+	path[len(path)-1].node = t.node
+	return path, nil
+}
+
+// decodePathNodes retrieves the nodes of the software trees along a path, one
+// query per kind of vertex
+func decodePathNodes(tx neo4j.Transaction, path []*pathNode) error {
+	for _, v := range pathVertices {
+		byID := map[int64][]*pathNode{}
+		ids := []int64{}
+		for _, n := range path {
+			if n.label == v.label && n.node == nil {
+				if _, ok := byID[n.id]; !ok {
+					ids = append(ids, n.id)
+				}
+				byID[n.id] = append(byID[n.id], n)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		result, err := tx.Run(v.match+" WHERE id(n) IN $ids RETURN id(n), "+v.values, map[string]any{"ids": ids})
+		if err != nil {
+			return err
+		}
+		for result.Next() {
+			values := result.Record().Values
+			node := v.decode(values[1:])
+			for _, n := range byID[values[0].(int64)] {
+				n.node = node
+			}
+		}
+		if err := result.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
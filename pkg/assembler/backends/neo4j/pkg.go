@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 )
@@ -358,6 +359,7 @@ func (c *neo4jClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*
 					Type:       pkgType,
 					Namespaces: collectedPkgNamespaces,
 				}
+				helper.SetPackageIDs(collectedPackage)
 				packages = append(packages, collectedPackage)
 			}
 
@@ -370,6 +372,34 @@ func (c *neo4jClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*
 	return result.([]*model.Package), nil
 }
 
+func (c *neo4jClient) PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	sb.WriteString("MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)-[:PkgHasName]->(name:PkgName)-[:PkgHasVersion]->(version:PkgVersion)")
+
+	setPkgMatchValues(&sb, pkgSpec, false, &firstMatch, queryValues)
+
+	query := queryPart{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, version.version, version.subpath, version.qualifier_list, id(version)"}
+	page, err := listNodes(c, p, query, queryValues, "version", func(values []interface{}) *model.Package {
+		qualifiers := values[5]
+		if qualifiers == nil {
+			qualifiers = []interface{}{}
+		}
+		return generateModelPackage(values[0].(string), values[1].(string), values[2].(string), values[3], values[4], qualifiers)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.PackageConnection(page), nil
+}
+
 func (c *neo4jClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
@@ -401,6 +431,7 @@ func (c *neo4jClient) packagesType(ctx context.Context, pkgSpec *model.PkgSpec)
 					Type:       result.Record().Values[0].(string),
 					Namespaces: []*model.PackageNamespace{},
 				}
+				helper.SetPackageIDs(collectedPackage)
 				packages = append(packages, collectedPackage)
 			}
 			if err = result.Err(); err != nil {
@@ -470,6 +501,7 @@ func (c *neo4jClient) packagesNamespace(ctx context.Context, pkgSpec *model.PkgS
 					Type:       pkgType,
 					Namespaces: namespaces,
 				}
+				helper.SetPackageIDs(collectedPackage)
 				packages = append(packages, collectedPackage)
 			}
 
@@ -557,6 +589,7 @@ func (c *neo4jClient) packagesName(ctx context.Context, pkgSpec *model.PkgSpec)
 					Type:       pkgType,
 					Namespaces: collectedPkgNamespaces,
 				}
+				helper.SetPackageIDs(collectedPackage)
 				packages = append(packages, collectedPackage)
 			}
 
@@ -788,5 +821,6 @@ func generateModelPackage(pkgType, namespaceStr, nameStr string, versionValue, s
 		Type:       pkgType,
 		Namespaces: []*model.PackageNamespace{namespace},
 	}
+	helper.SetPackageIDs(&pkg)
 	return &pkg
 }
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -286,6 +287,7 @@ func (c *neo4jClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec)
 					Type:       srcType,
 					Namespaces: sourceNamespaces,
 				}
+				helper.SetSourceIDs(source)
 				sources = append(sources, source)
 			}
 
@@ -298,6 +300,30 @@ func (c *neo4jClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec)
 	return result.([]*model.Source), nil
 }
 
+func (c *neo4jClient) SourcesList(ctx context.Context, sourceSpec *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	sb.WriteString("MATCH (root:Src)-[:SrcHasType]->(type:SrcType)-[:SrcHasNamespace]->(namespace:SrcNamespace)-[:SrcHasName]->(name:SrcName)")
+
+	setSrcMatchValues(&sb, sourceSpec, false, &firstMatch, queryValues)
+
+	query := queryPart{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, name.commit, name.tag, id(name)"}
+	page, err := listNodes(c, p, query, queryValues, "name", func(values []interface{}) *model.Source {
+		return generateModelSource(values[0].(string), values[1].(string), values[2].(string), values[3], values[4])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return helper.SourceConnection(page), nil
+}
+
 func (c *neo4jClient) sourcesType(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
 	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
 	defer session.Close()
@@ -330,6 +356,7 @@ func (c *neo4jClient) sourcesType(ctx context.Context, sourceSpec *model.SourceS
 					Type:       result.Record().Values[0].(string),
 					Namespaces: []*model.SourceNamespace{},
 				}
+				helper.SetSourceIDs(source)
 
 				sources = append(sources, source)
 			}
@@ -399,6 +426,7 @@ func (c *neo4jClient) sourcesNamespace(ctx context.Context, sourceSpec *model.So
 					Type:       srcType,
 					Namespaces: namespaces,
 				}
+				helper.SetSourceIDs(source)
 				sources = append(sources, source)
 			}
 
@@ -556,5 +584,6 @@ func generateModelSource(srcType, namespaceStr, nameStr string, commitValue, tag
 		Type:       srcType,
 		Namespaces: []*model.SourceNamespace{namespace},
 	}
+	helper.SetSourceIDs(&src)
 	return &src
 }
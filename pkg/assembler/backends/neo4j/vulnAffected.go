@@ -0,0 +1,452 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	rangeTypes       string = "rangeTypes"
+	rangeRepos       string = "rangeRepos"
+	eventRanges      string = "eventRanges"
+	eventTypes       string = "eventTypes"
+	eventVersions    string = "eventVersions"
+	affectedVersions string = "affectedVersions"
+)
+
+// Query VulnAffected
+
+func (c *neo4jClient) VulnAffected(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec) ([]*model.VulnAffected, error) {
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close()
+
+	queryAll, err := helper.ValidateOsvCveOrGhsaQueryInput(vulnAffectedSpec.Vulnerability)
+	if err != nil {
+		return nil, err
+	}
+
+	// affected versions are never attached to NoVuln, noVuln set to false matches any vulnerability
+	queryVulns := queryAll || (vulnAffectedSpec.Vulnerability.NoVuln != nil && !*vulnAffectedSpec.Vulnerability.NoVuln)
+
+	// the package is at the name level, only the type, namespace and name are matched
+	var selectedPkgSpec *model.PkgSpec
+	if vulnAffectedSpec.Package != nil {
+		selectedPkgSpec = &model.PkgSpec{
+			Type:      vulnAffectedSpec.Package.Type,
+			Namespace: vulnAffectedSpec.Package.Namespace,
+			Name:      vulnAffectedSpec.Package.Name,
+		}
+	}
+
+	pkgQuery := "MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+		"-[:PkgHasName]->(name:PkgName)<-[:subject]-(vulnAffected:VulnAffected)"
+
+	aggregateVulnAffected := []*model.VulnAffected{}
+
+	if queryVulns || vulnAffectedSpec.Vulnerability.Cve != nil {
+		var sb strings.Builder
+		var firstMatch bool = true
+		queryValues := map[string]any{}
+
+		query := pkgQuery + "-[:about]->(cveID:CveID)<-[:CveHasID]-(cveYear:CveYear)<-[:CveIsYear]-(rootCve:Cve)"
+		sb.WriteString(query)
+
+		setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+		if !queryVulns {
+			setCveMatchValues(&sb, vulnAffectedSpec.Vulnerability.Cve, &firstMatch, queryValues)
+		}
+		setVulnAffectedValues(&sb, vulnAffectedSpec, &firstMatch, queryValues)
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, vulnAffected, cveYear.year, cveID.id"}}
+
+		result, err := queryVulnAffectedNodes(ctx, session, queryParts, queryValues, func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelCve(values[4].(string), values[5].(string))
+		})
+		if err != nil {
+			return nil, err
+		}
+		aggregateVulnAffected = append(aggregateVulnAffected, result...)
+	}
+
+	if queryVulns || vulnAffectedSpec.Vulnerability.Ghsa != nil {
+		var sb strings.Builder
+		var firstMatch bool = true
+		queryValues := map[string]any{}
+
+		query := pkgQuery + "-[:about]->(ghsaID:GhsaID)<-[:GhsaHasID]-(rootGhsa:Ghsa)"
+		sb.WriteString(query)
+
+		setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+		if !queryVulns {
+			setGhsaMatchValues(&sb, vulnAffectedSpec.Vulnerability.Ghsa, &firstMatch, queryValues)
+		}
+		setVulnAffectedValues(&sb, vulnAffectedSpec, &firstMatch, queryValues)
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, vulnAffected, ghsaID.id"}}
+
+		result, err := queryVulnAffectedNodes(ctx, session, queryParts, queryValues, func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelGhsa(values[4].(string))
+		})
+		if err != nil {
+			return nil, err
+		}
+		aggregateVulnAffected = append(aggregateVulnAffected, result...)
+	}
+
+	if queryVulns || vulnAffectedSpec.Vulnerability.Osv != nil {
+		var sb strings.Builder
+		var firstMatch bool = true
+		queryValues := map[string]any{}
+
+		query := pkgQuery + "-[:about]->(osvID:OsvID)<-[:OsvHasID]-(rootOsv:Osv)"
+		sb.WriteString(query)
+
+		setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+		if !queryVulns {
+			setOSVMatchValues(&sb, vulnAffectedSpec.Vulnerability.Osv, &firstMatch, queryValues)
+		}
+		setVulnAffectedValues(&sb, vulnAffectedSpec, &firstMatch, queryValues)
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN type.type, namespace.namespace, name.name, vulnAffected, osvID.id"}}
+
+		result, err := queryVulnAffectedNodes(ctx, session, queryParts, queryValues, func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelOsv(values[4].(string))
+		})
+		if err != nil {
+			return nil, err
+		}
+		aggregateVulnAffected = append(aggregateVulnAffected, result...)
+	}
+
+	return aggregateVulnAffected, nil
+}
+
+func (c *neo4jClient) VulnAffectedList(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec, after *string, first *int) (*model.VulnAffectedConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.VulnAffected(withPager(ctx, p), vulnAffectedSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "VulnAffected", found, func(n *model.VulnAffected) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnAffectedConnection(page), nil
+}
+
+// queryVulnAffectedNodes runs a query returning the package type, namespace
+// and name and the vulnAffected node first, followed by the values needed by
+// generateVuln to build the vulnerability
+func queryVulnAffectedNodes(ctx context.Context, session neo4j.Session, queryParts []queryPart, queryValues map[string]any,
+	generateVuln func(values []interface{}) model.OsvCveOrGhsa) ([]*model.VulnAffected, error) {
+
+	result, err := session.ReadTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+
+			result, err := runQuery(ctx, tx, queryParts, queryValues, "vulnAffected")
+			if err != nil {
+				return nil, err
+			}
+
+			collectedVulnAffected := []*model.VulnAffected{}
+
+			for result.Next() {
+				vulnAffected, err := generateModelVulnAffectedFromRecord(result.Record().Values, generateVuln)
+				if err != nil {
+					return nil, err
+				}
+				collectedVulnAffected = append(collectedVulnAffected, vulnAffected)
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return collectedVulnAffected, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*model.VulnAffected), nil
+}
+
+func setVulnAffectedValues(sb *strings.Builder, vulnAffectedSpec *model.VulnAffectedSpec, firstMatch *bool, queryValues map[string]any) {
+	if vulnAffectedSpec.ID != nil {
+		matchID(sb, *firstMatch, "vulnAffected", "VulnAffected", *vulnAffectedSpec.ID, queryValues)
+		*firstMatch = false
+	}
+	if vulnAffectedSpec.Origin != nil {
+		matchProperties(sb, *firstMatch, "vulnAffected", origin, "$"+origin)
+		*firstMatch = false
+		queryValues[origin] = vulnAffectedSpec.Origin
+	}
+	if vulnAffectedSpec.Collector != nil {
+		matchProperties(sb, *firstMatch, "vulnAffected", collector, "$"+collector)
+		*firstMatch = false
+		queryValues[collector] = vulnAffectedSpec.Collector
+	}
+}
+
+func generateModelVulnAffectedFromRecord(values []interface{}, generateVuln func(values []interface{}) model.OsvCveOrGhsa) (*model.VulnAffected, error) {
+	typeString := values[0].(string)
+	namespaceString := values[1].(string)
+	nameString := values[2].(string)
+	pkg := generateModelPackage(typeString, namespaceString, nameString, nil, nil, nil)
+
+	vulnAffectedNode, ok := values[3].(dbtype.Node)
+	if !ok {
+		return nil, gqlerror.Errorf("vulnAffected Node not found in neo4j")
+	}
+	return generateModelVulnAffected(pkg, generateVuln(values), vulnAffectedNode)
+}
+
+func generateModelVulnAffected(pkg *model.Package, vuln model.OsvCveOrGhsa, vulnAffectedNode dbtype.Node) (*model.VulnAffected, error) {
+	types := vulnAffectedNode.Props[rangeTypes].([]interface{})
+	repos := vulnAffectedNode.Props[rangeRepos].([]interface{})
+	if len(types) != len(repos) {
+		return nil, gqlerror.Errorf("length of affected ranges do not match")
+	}
+	ranges := []*model.AffectedRange{}
+	for i := range types {
+		ranges = append(ranges, &model.AffectedRange{
+			RangeType: model.AffectedRangeType(types[i].(string)),
+			Repo:      repos[i].(string),
+			Events:    []*model.AffectedEvent{},
+		})
+	}
+
+	// the events of all ranges are flattened, eventRanges holds the index of
+	// the range each event belongs to
+	indexes := vulnAffectedNode.Props[eventRanges].([]interface{})
+	evTypes := vulnAffectedNode.Props[eventTypes].([]interface{})
+	evVersions := vulnAffectedNode.Props[eventVersions].([]interface{})
+	if len(indexes) != len(evTypes) || len(indexes) != len(evVersions) {
+		return nil, gqlerror.Errorf("length of affected events do not match")
+	}
+	for i := range indexes {
+		index := int(indexes[i].(int64))
+		if index < 0 || index >= len(ranges) {
+			return nil, gqlerror.Errorf("affected event refers to an unknown range")
+		}
+		ranges[index].Events = append(ranges[index].Events, &model.AffectedEvent{
+			EventType: model.AffectedEventType(evTypes[i].(string)),
+			Version:   evVersions[i].(string),
+		})
+	}
+
+	return &model.VulnAffected{
+		ID:            evidenceID("VulnAffected", vulnAffectedNode),
+		Package:       pkg,
+		Vulnerability: vuln,
+		Ranges:        ranges,
+		Versions:      toStringList(vulnAffectedNode.Props[affectedVersions].([]interface{})),
+		Origin:        vulnAffectedNode.Props[origin].(string),
+		Collector:     vulnAffectedNode.Props[collector].(string),
+	}, nil
+}
+
+// Query AffectedPackages
+
+func (c *neo4jClient) AffectedPackages(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec) ([]*model.AffectedPackage, error) {
+
+	foundVulnAffected, err := c.VulnAffected(ctx, vulnAffectedSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var affectedPackages []*model.AffectedPackage
+	for _, a := range foundVulnAffected {
+		ns := a.Package.Namespaces[0]
+		pkgSpec := &model.PkgSpec{
+			Type:      &a.Package.Type,
+			Namespace: &ns.Namespace,
+			Name:      &ns.Names[0].Name,
+		}
+		collectedPkg, err := c.Packages(ctx, pkgSpec)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range collectedPkg {
+			for _, n := range p.Namespaces {
+				for _, name := range n.Names {
+					for _, v := range name.Versions {
+						if !helper.IsVersionAffected(p.Type, v.Version, a) {
+							continue
+						}
+						affectedPackages = append(affectedPackages, &model.AffectedPackage{
+							Package: &model.Package{
+								ID:   p.ID,
+								Type: p.Type,
+								Namespaces: []*model.PackageNamespace{{
+									ID:        n.ID,
+									Namespace: n.Namespace,
+									Names: []*model.PackageName{{
+										ID:       name.ID,
+										Name:     name.Name,
+										Versions: []*model.PackageVersion{v},
+									}},
+								}},
+							},
+							VulnAffected: a,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return affectedPackages, nil
+}
+
+func (c *neo4jClient) AffectedPackagesList(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec, after *string, first *int) (*model.AffectedPackageConnection, error) {
+	// affected package versions are matched against the affected versions
+	// outside of neo4j, so they are paginated once all are found
+	found, err := c.AffectedPackages(ctx, vulnAffectedSpec)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return helper.AffectedPackageKey(found[i]) < helper.AffectedPackageKey(found[j])
+	})
+	page, err := helper.Paginate(found, helper.AffectedPackageKey, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.AffectedPackageConnection(page), nil
+}
+
+// Ingest VulnAffected
+
+func (c *neo4jClient) IngestVulnAffected(ctx context.Context, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, vulnAffected model.VulnAffectedInputSpec) (*model.VulnAffected, error) {
+
+	err := helper.ValidateVulnAffectedIngestionInput(vulnerability)
+	if err != nil {
+		return nil, err
+	}
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close()
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	types := []string{}
+	repos := []string{}
+	indexes := []int{}
+	evTypes := []string{}
+	evVersions := []string{}
+	for i, r := range vulnAffected.Ranges {
+		types = append(types, r.RangeType.String())
+		repos = append(repos, r.Repo)
+		for _, e := range r.Events {
+			indexes = append(indexes, i)
+			evTypes = append(evTypes, e.EventType.String())
+			evVersions = append(evVersions, e.Version)
+		}
+	}
+
+	queryValues[rangeTypes] = types
+	queryValues[rangeRepos] = repos
+	queryValues[eventRanges] = indexes
+	queryValues[eventTypes] = evTypes
+	queryValues[eventVersions] = evVersions
+	queryValues[affectedVersions] = vulnAffected.Versions
+	queryValues[origin] = vulnAffected.Origin
+	queryValues[collector] = vulnAffected.Collector
+
+	// affected versions are always recorded at the package name level
+	selectedPkgSpec := &model.PkgSpec{
+		Type:      &pkg.Type,
+		Namespace: pkg.Namespace,
+		Name:      &pkg.Name,
+	}
+
+	sb.WriteString("MATCH (root:Pkg)-[:PkgHasType]->(type:PkgType)-[:PkgHasNamespace]->(namespace:PkgNamespace)" +
+		"-[:PkgHasName]->(name:PkgName)")
+
+	var vulnNode string
+	var returnValue string
+	var generateVuln func(values []interface{}) model.OsvCveOrGhsa
+
+	if vulnerability.Osv != nil {
+		sb.WriteString(", (rootOsv:Osv)-[:OsvHasID]->(osvID:OsvID)")
+		setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+		setOSVMatchValues(&sb, helper.ConvertOsvInputSpecToOsvSpec(vulnerability.Osv), &firstMatch, queryValues)
+		vulnNode = "osvID"
+		returnValue = " RETURN type.type, namespace.namespace, name.name, vulnAffected, osvID.id"
+		generateVuln = func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelOsv(values[4].(string))
+		}
+	} else if vulnerability.Cve != nil {
+		sb.WriteString(", (rootCve:Cve)-[:CveIsYear]->(cveYear:CveYear)-[:CveHasID]->(cveID:CveID)")
+		setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+		setCveMatchValues(&sb, helper.ConvertCveInputSpecToCveSpec(vulnerability.Cve), &firstMatch, queryValues)
+		vulnNode = "cveID"
+		returnValue = " RETURN type.type, namespace.namespace, name.name, vulnAffected, cveYear.year, cveID.id"
+		generateVuln = func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelCve(values[4].(string), values[5].(string))
+		}
+	} else {
+		sb.WriteString(", (rootGhsa:Ghsa)-[:GhsaHasID]->(ghsaID:GhsaID)")
+		setPkgMatchValues(&sb, selectedPkgSpec, false, &firstMatch, queryValues)
+		setGhsaMatchValues(&sb, helper.ConvertGhsaInputSpecToGhsaSpec(vulnerability.Ghsa), &firstMatch, queryValues)
+		vulnNode = "ghsaID"
+		returnValue = " RETURN type.type, namespace.namespace, name.name, vulnAffected, ghsaID.id"
+		generateVuln = func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelGhsa(values[4].(string))
+		}
+	}
+
+	// a newer document from the same origin replaces the affected versions
+	merge := "\nMERGE (name)<-[:subject]-(vulnAffected:VulnAffected{origin:$origin,collector:$collector})-[:about]->(" + vulnNode + ")" +
+		setEvidenceID("vulnAffected") +
+		"\nSET vulnAffected.rangeTypes = $rangeTypes, vulnAffected.rangeRepos = $rangeRepos, " +
+		"vulnAffected.eventRanges = $eventRanges, vulnAffected.eventTypes = $eventTypes, " +
+		"vulnAffected.eventVersions = $eventVersions, vulnAffected.affectedVersions = $affectedVersions"
+	sb.WriteString(merge)
+	sb.WriteString(returnValue)
+
+	result, err := session.WriteTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			// query returns a single record
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			return generateModelVulnAffectedFromRecord(record.Values, generateVuln)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.VulnAffected), nil
+}
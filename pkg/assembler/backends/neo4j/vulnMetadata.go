@@ -0,0 +1,390 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package neo4jBackend
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	summary      string = "summary"
+	scoreTypes   string = "scoreTypes"
+	scoreVectors string = "scoreVectors"
+	scoreValues  string = "scoreValues"
+	cweIds       string = "cweIds"
+	references   string = "references"
+	published    string = "published"
+	modified     string = "modified"
+)
+
+// Query VulnMetadata
+
+func (c *neo4jClient) VulnMetadata(ctx context.Context, vulnMetadataSpec *model.VulnMetadataSpec) ([]*model.VulnMetadata, error) {
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	defer session.Close()
+
+	queryAll, err := helper.ValidateOsvCveOrGhsaQueryInput(vulnMetadataSpec.Vulnerability)
+	if err != nil {
+		return nil, err
+	}
+
+	// metadata is never attached to NoVuln, noVuln set to false matches any vulnerability
+	queryVulns := queryAll || (vulnMetadataSpec.Vulnerability.NoVuln != nil && !*vulnMetadataSpec.Vulnerability.NoVuln)
+
+	aggregateVulnMetadata := []*model.VulnMetadata{}
+
+	if queryVulns || vulnMetadataSpec.Vulnerability.Cve != nil {
+		var sb strings.Builder
+		var firstMatch bool = true
+		queryValues := map[string]any{}
+
+		query := "MATCH (rootCve:Cve)-[:CveIsYear]->(cveYear:CveYear)-[:CveHasID]->(cveID:CveID)" +
+			"<-[:about]-(vulnMetadata:VulnMetadata)"
+		sb.WriteString(query)
+
+		if !queryVulns {
+			setCveMatchValues(&sb, vulnMetadataSpec.Vulnerability.Cve, &firstMatch, queryValues)
+		}
+		setVulnMetadataValues(&sb, vulnMetadataSpec, &firstMatch, queryValues)
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN vulnMetadata, cveYear.year, cveID.id"}}
+
+		result, err := queryVulnMetadataNodes(ctx, session, queryParts, queryValues, func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelCve(values[1].(string), values[2].(string))
+		})
+		if err != nil {
+			return nil, err
+		}
+		aggregateVulnMetadata = append(aggregateVulnMetadata, result...)
+	}
+
+	if queryVulns || vulnMetadataSpec.Vulnerability.Ghsa != nil {
+		var sb strings.Builder
+		var firstMatch bool = true
+		queryValues := map[string]any{}
+
+		query := "MATCH (rootGhsa:Ghsa)-[:GhsaHasID]->(ghsaID:GhsaID)<-[:about]-(vulnMetadata:VulnMetadata)"
+		sb.WriteString(query)
+
+		if !queryVulns {
+			setGhsaMatchValues(&sb, vulnMetadataSpec.Vulnerability.Ghsa, &firstMatch, queryValues)
+		}
+		setVulnMetadataValues(&sb, vulnMetadataSpec, &firstMatch, queryValues)
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN vulnMetadata, ghsaID.id"}}
+
+		result, err := queryVulnMetadataNodes(ctx, session, queryParts, queryValues, func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelGhsa(values[1].(string))
+		})
+		if err != nil {
+			return nil, err
+		}
+		aggregateVulnMetadata = append(aggregateVulnMetadata, result...)
+	}
+
+	if queryVulns || vulnMetadataSpec.Vulnerability.Osv != nil {
+		var sb strings.Builder
+		var firstMatch bool = true
+		queryValues := map[string]any{}
+
+		query := "MATCH (rootOsv:Osv)-[:OsvHasID]->(osvID:OsvID)<-[:about]-(vulnMetadata:VulnMetadata)"
+		sb.WriteString(query)
+
+		if !queryVulns {
+			setOSVMatchValues(&sb, vulnMetadataSpec.Vulnerability.Osv, &firstMatch, queryValues)
+		}
+		setVulnMetadataValues(&sb, vulnMetadataSpec, &firstMatch, queryValues)
+		queryParts := []queryPart{{match: sb.String(), returnValue: " RETURN vulnMetadata, osvID.id"}}
+
+		result, err := queryVulnMetadataNodes(ctx, session, queryParts, queryValues, func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelOsv(values[1].(string))
+		})
+		if err != nil {
+			return nil, err
+		}
+		aggregateVulnMetadata = append(aggregateVulnMetadata, result...)
+	}
+
+	return aggregateVulnMetadata, nil
+}
+
+func (c *neo4jClient) VulnMetadataList(ctx context.Context, vulnMetadataSpec *model.VulnMetadataSpec, after *string, first *int) (*model.VulnMetadataConnection, error) {
+	p, err := newPager(ctx, after, first)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.VulnMetadata(withPager(ctx, p), vulnMetadataSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := evidencePage(p, "VulnMetadata", found, func(n *model.VulnMetadata) string { return n.ID })
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnMetadataConnection(page), nil
+}
+
+// queryVulnMetadataNodes runs a query returning the vulnMetadata node first,
+// followed by the values needed by generateVuln to build the vulnerability
+func queryVulnMetadataNodes(ctx context.Context, session neo4j.Session, queryParts []queryPart, queryValues map[string]any,
+	generateVuln func(values []interface{}) model.OsvCveOrGhsa) ([]*model.VulnMetadata, error) {
+
+	result, err := session.ReadTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+
+			result, err := runQuery(ctx, tx, queryParts, queryValues, "vulnMetadata")
+			if err != nil {
+				return nil, err
+			}
+
+			collectedVulnMetadata := []*model.VulnMetadata{}
+
+			for result.Next() {
+				vulnMetadataNode, ok := result.Record().Values[0].(dbtype.Node)
+				if !ok {
+					return nil, gqlerror.Errorf("vulnMetadata Node not found in neo4j")
+				}
+
+				vulnMetadata, err := generateModelVulnMetadata(generateVuln(result.Record().Values), vulnMetadataNode)
+				if err != nil {
+					return nil, err
+				}
+				collectedVulnMetadata = append(collectedVulnMetadata, vulnMetadata)
+			}
+			if err = result.Err(); err != nil {
+				return nil, err
+			}
+
+			return collectedVulnMetadata, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*model.VulnMetadata), nil
+}
+
+func whereOrAnd(sb *strings.Builder, firstMatch *bool) {
+	if *firstMatch {
+		sb.WriteString(" WHERE ")
+	} else {
+		sb.WriteString(" AND ")
+	}
+	*firstMatch = false
+}
+
+func setVulnMetadataValues(sb *strings.Builder, vulnMetadataSpec *model.VulnMetadataSpec, firstMatch *bool, queryValues map[string]any) {
+	if vulnMetadataSpec.ID != nil {
+		matchID(sb, *firstMatch, "vulnMetadata", "VulnMetadata", *vulnMetadataSpec.ID, queryValues)
+		*firstMatch = false
+	}
+	if vulnMetadataSpec.ScoreType != nil || vulnMetadataSpec.MinScore != nil || vulnMetadataSpec.MaxScore != nil {
+		// at least one score (of the given type) must be in the range
+		whereOrAnd(sb, firstMatch)
+		sb.WriteString("ANY(i IN range(0, size(vulnMetadata.scoreValues)-1) WHERE true")
+		if vulnMetadataSpec.ScoreType != nil {
+			sb.WriteString(" AND vulnMetadata.scoreTypes[i] = $scoreType")
+			queryValues["scoreType"] = vulnMetadataSpec.ScoreType.String()
+		}
+		if vulnMetadataSpec.MinScore != nil {
+			sb.WriteString(" AND vulnMetadata.scoreValues[i] >= $minScore")
+			queryValues["minScore"] = *vulnMetadataSpec.MinScore
+		}
+		if vulnMetadataSpec.MaxScore != nil {
+			sb.WriteString(" AND vulnMetadata.scoreValues[i] <= $maxScore")
+			queryValues["maxScore"] = *vulnMetadataSpec.MaxScore
+		}
+		sb.WriteString(")")
+	}
+	if vulnMetadataSpec.CweID != nil {
+		whereOrAnd(sb, firstMatch)
+		sb.WriteString("$cweId IN vulnMetadata.cweIds")
+		queryValues["cweId"] = vulnMetadataSpec.CweID
+	}
+	if vulnMetadataSpec.PublishedSince != nil {
+		whereOrAnd(sb, firstMatch)
+		sb.WriteString("vulnMetadata.published >= $publishedSince")
+		queryValues["publishedSince"] = vulnMetadataSpec.PublishedSince.UTC()
+	}
+	if vulnMetadataSpec.Origin != nil {
+		matchProperties(sb, *firstMatch, "vulnMetadata", origin, "$"+origin)
+		*firstMatch = false
+		queryValues[origin] = vulnMetadataSpec.Origin
+	}
+	if vulnMetadataSpec.Collector != nil {
+		matchProperties(sb, *firstMatch, "vulnMetadata", collector, "$"+collector)
+		*firstMatch = false
+		queryValues[collector] = vulnMetadataSpec.Collector
+	}
+}
+
+func generateModelVulnMetadata(vuln model.OsvCveOrGhsa, vulnMetadataNode dbtype.Node) (*model.VulnMetadata, error) {
+	types := vulnMetadataNode.Props[scoreTypes].([]interface{})
+	vectors := vulnMetadataNode.Props[scoreVectors].([]interface{})
+	values := vulnMetadataNode.Props[scoreValues].([]interface{})
+	if len(types) != len(vectors) || len(types) != len(values) {
+		return nil, gqlerror.Errorf("length of vulnerability scores do not match")
+	}
+	scores := []*model.VulnScore{}
+	for i := range types {
+		scores = append(scores, &model.VulnScore{
+			ScoreType: model.VulnScoreType(types[i].(string)),
+			Vector:    vectors[i].(string),
+			Score:     values[i].(float64),
+		})
+	}
+
+	vulnMetadata := &model.VulnMetadata{
+		ID:            evidenceID("VulnMetadata", vulnMetadataNode),
+		Vulnerability: vuln,
+		Summary:       vulnMetadataNode.Props[summary].(string),
+		Scores:        scores,
+		CweIds:        toStringList(vulnMetadataNode.Props[cweIds].([]interface{})),
+		References:    toStringList(vulnMetadataNode.Props[references].([]interface{})),
+		Origin:        vulnMetadataNode.Props[origin].(string),
+		Collector:     vulnMetadataNode.Props[collector].(string),
+	}
+	if t, ok := vulnMetadataNode.Props[published].(time.Time); ok {
+		vulnMetadata.Published = &t
+	}
+	if t, ok := vulnMetadataNode.Props[modified].(time.Time); ok {
+		vulnMetadata.Modified = &t
+	}
+	return vulnMetadata, nil
+}
+
+func toStringList(list []interface{}) []string {
+	result := []string{}
+	for _, v := range list {
+		result = append(result, v.(string))
+	}
+	return result
+}
+
+// Ingest VulnMetadata
+
+func (c *neo4jClient) IngestVulnMetadata(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, vulnMetadata model.VulnMetadataInputSpec) (*model.VulnMetadata, error) {
+
+	err := helper.ValidateVulnMetadataIngestionInput(vulnerability)
+	if err != nil {
+		return nil, err
+	}
+
+	session := c.driver.NewSession(neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	defer session.Close()
+
+	var sb strings.Builder
+	var firstMatch bool = true
+	queryValues := map[string]any{}
+
+	types := []string{}
+	vectors := []string{}
+	values := []float64{}
+	for _, s := range vulnMetadata.Scores {
+		types = append(types, s.ScoreType.String())
+		vectors = append(vectors, s.Vector)
+		values = append(values, s.Score)
+	}
+
+	queryValues[summary] = vulnMetadata.Summary
+	queryValues[scoreTypes] = types
+	queryValues[scoreVectors] = vectors
+	queryValues[scoreValues] = values
+	queryValues[cweIds] = vulnMetadata.CweIds
+	queryValues[references] = vulnMetadata.References
+	queryValues[published] = nil
+	if vulnMetadata.Published != nil {
+		queryValues[published] = vulnMetadata.Published.UTC()
+	}
+	queryValues[modified] = nil
+	if vulnMetadata.Modified != nil {
+		queryValues[modified] = vulnMetadata.Modified.UTC()
+	}
+	queryValues[origin] = vulnMetadata.Origin
+	queryValues[collector] = vulnMetadata.Collector
+
+	var vulnNode string
+	var returnValue string
+	var generateVuln func(values []interface{}) model.OsvCveOrGhsa
+
+	if vulnerability.Osv != nil {
+		sb.WriteString("MATCH (rootOsv:Osv)-[:OsvHasID]->(osvID:OsvID)")
+		setOSVMatchValues(&sb, helper.ConvertOsvInputSpecToOsvSpec(vulnerability.Osv), &firstMatch, queryValues)
+		vulnNode = "osvID"
+		returnValue = " RETURN vulnMetadata, osvID.id"
+		generateVuln = func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelOsv(values[1].(string))
+		}
+	} else if vulnerability.Cve != nil {
+		sb.WriteString("MATCH (rootCve:Cve)-[:CveIsYear]->(cveYear:CveYear)-[:CveHasID]->(cveID:CveID)")
+		setCveMatchValues(&sb, helper.ConvertCveInputSpecToCveSpec(vulnerability.Cve), &firstMatch, queryValues)
+		vulnNode = "cveID"
+		returnValue = " RETURN vulnMetadata, cveYear.year, cveID.id"
+		generateVuln = func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelCve(values[1].(string), values[2].(string))
+		}
+	} else {
+		sb.WriteString("MATCH (rootGhsa:Ghsa)-[:GhsaHasID]->(ghsaID:GhsaID)")
+		setGhsaMatchValues(&sb, helper.ConvertGhsaInputSpecToGhsaSpec(vulnerability.Ghsa), &firstMatch, queryValues)
+		vulnNode = "ghsaID"
+		returnValue = " RETURN vulnMetadata, ghsaID.id"
+		generateVuln = func(values []interface{}) model.OsvCveOrGhsa {
+			return generateModelGhsa(values[1].(string))
+		}
+	}
+
+	// a newer document from the same origin replaces the metadata
+	merge := "\nMERGE (" + vulnNode + ")<-[:about]-(vulnMetadata:VulnMetadata{origin:$origin,collector:$collector})" +
+		setEvidenceID("vulnMetadata") +
+		"\nSET vulnMetadata.summary = $summary, vulnMetadata.scoreTypes = $scoreTypes, " +
+		"vulnMetadata.scoreVectors = $scoreVectors, vulnMetadata.scoreValues = $scoreValues, " +
+		"vulnMetadata.cweIds = $cweIds, vulnMetadata.references = $references, " +
+		"vulnMetadata.published = $published, vulnMetadata.modified = $modified"
+	sb.WriteString(merge)
+	sb.WriteString(returnValue)
+
+	result, err := session.WriteTransaction(
+		func(tx neo4j.Transaction) (interface{}, error) {
+			result, err := tx.Run(sb.String(), queryValues)
+			if err != nil {
+				return nil, err
+			}
+
+			// query returns a single record
+			record, err := result.Single()
+			if err != nil {
+				return nil, err
+			}
+
+			vulnMetadataNode, ok := record.Values[0].(dbtype.Node)
+			if !ok {
+				return nil, gqlerror.Errorf("vulnMetadata Node not found in neo4j")
+			}
+
+			return generateModelVulnMetadata(generateVuln(record.Values), vulnMetadataNode)
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*model.VulnMetadata), nil
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -42,6 +43,7 @@ func (c *demoClient) registerArtifact(algorithm, digest string) *model.Artifact
 		}
 	}
 	newArtifact := &model.Artifact{
+		ID:        helper.ArtifactID(lowerCaseAlgorithm, lowerCaseDigest),
 		Digest:    lowerCaseDigest,
 		Algorithm: lowerCaseAlgorithm,
 	}
@@ -78,6 +80,18 @@ func (c *demoClient) Artifacts(ctx context.Context, artifactSpec *model.Artifact
 	return artifacts, nil
 }
 
+func (c *demoClient) ArtifactsList(ctx context.Context, artifactSpec *model.ArtifactSpec, after *string, first *int) (*model.ArtifactConnection, error) {
+	artifacts, err := c.Artifacts(ctx, artifactSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(artifacts, func(n *model.Artifact) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.ArtifactConnection(page), nil
+}
+
 func (c *demoClient) IngestArtifact(ctx context.Context, artifact *model.ArtifactInputSpec) (*model.Artifact, error) {
 	return c.registerArtifact(artifact.Algorithm, artifact.Digest), nil
 }
@@ -16,7 +16,11 @@
 package testing
 
 import (
+	"context"
+	"strconv"
+
 	"github.com/guacsec/guac/pkg/assembler/backends"
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -42,6 +46,12 @@ type demoClient struct {
 	isVulnerability     []*model.IsVulnerability
 	certifyVEXStatement []*model.CertifyVEXStatement
 	hasSLSA             []*model.HasSlsa
+	vulnMetadata        []*model.VulnMetadata
+	vulnAffected        []*model.VulnAffected
+	certifyEOL          []*model.CertifyEol
+
+	// last identifier assigned to an evidence node
+	index uint64
 }
 
 func GetBackend(args backends.BackendArgs) (backends.Backend, error) {
@@ -65,6 +75,9 @@ func GetBackend(args backends.BackendArgs) (backends.Backend, error) {
 		isVulnerability:     []*model.IsVulnerability{},
 		certifyVEXStatement: []*model.CertifyVEXStatement{},
 		hasSLSA:             []*model.HasSlsa{},
+		vulnMetadata:        []*model.VulnMetadata{},
+		vulnAffected:        []*model.VulnAffected{},
+		certifyEOL:          []*model.CertifyEol{},
 	}
 	registerAllPackages(client)
 	registerAllSources(client)
@@ -138,6 +151,99 @@ func GetEmptyBackend(args backends.BackendArgs) (backends.Backend, error) {
 		isVulnerability:     []*model.IsVulnerability{},
 		certifyVEXStatement: []*model.CertifyVEXStatement{},
 		hasSLSA:             []*model.HasSlsa{},
+		vulnMetadata:        []*model.VulnMetadata{},
+		vulnAffected:        []*model.VulnAffected{},
+		certifyEOL:          []*model.CertifyEol{},
 	}
 	return client, nil
 }
+
+func (c *demoClient) evidenceID(typeName string) string {
+	c.index++
+	return helper.EvidenceID(typeName, strconv.FormatUint(c.index, 10))
+}
+
+func (c *demoClient) Node(ctx context.Context, id string) (model.Node, error) {
+	return helper.Node(ctx, c, id)
+}
+
+func (c *demoClient) Nodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	return helper.Nodes(ctx, c, ids)
+}
+
+func (c *demoClient) Path(ctx context.Context, subject string, target string, maxPathLength int, usingOnly []model.Edge) ([]model.Node, error) {
+	if err := helper.ValidatePath(subject, target, maxPathLength); err != nil {
+		return nil, err
+	}
+	if subject == target {
+		node, err := helper.Node(ctx, c, subject)
+		if err != nil {
+			return nil, err
+		}
+		return []model.Node{node}, nil
+	}
+
+	return c.graph().Path(subject, target, maxPathLength, usingOnly), nil
+}
+
+func (c *demoClient) Neighbors(ctx context.Context, node string, usingOnly []model.Edge) ([]model.Node, error) {
+	if _, err := helper.VertexKind(node); err != nil {
+		return nil, err
+	}
+	return c.graph().Neighbors(node, usingOnly), nil
+}
+
+// graph returns the graph of all packages and evidence, for the queries
+// searching it
+func (c *demoClient) graph() *helper.Graph {
+	g := helper.NewGraph()
+	for _, pkg := range helper.FlattenPackages(c.packages) {
+		g.AddNode(pkg)
+	}
+	for _, e := range c.hashEquals {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.isOccurrence {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.hasSBOM {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.isDependency {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.certifyPkg {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.certifyVuln {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.hasSourceAt {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.certifyScorecard {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.certifyBad {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.isVulnerability {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.certifyVEXStatement {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.hasSLSA {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.vulnMetadata {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.vulnAffected {
+		g.AddEvidence(e)
+	}
+	for _, e := range c.certifyEOL {
+		g.AddEvidence(e)
+	}
+	return g
+}
@@ -18,6 +18,7 @@ package testing
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -34,7 +35,7 @@ func (c *demoClient) registerBuilder(uri string) *model.Builder {
 			return b
 		}
 	}
-	newBuilder := &model.Builder{URI: uri}
+	newBuilder := &model.Builder{ID: helper.BuilderID(uri), URI: uri}
 	c.builders = append(c.builders, newBuilder)
 	return newBuilder
 }
@@ -51,6 +52,18 @@ func (c *demoClient) Builders(ctx context.Context, builderSpec *model.BuilderSpe
 	return builders, nil
 }
 
+func (c *demoClient) BuildersList(ctx context.Context, builderSpec *model.BuilderSpec, after *string, first *int) (*model.BuilderConnection, error) {
+	builders, err := c.Builders(ctx, builderSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(builders, func(n *model.Builder) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.BuilderConnection(page), nil
+}
+
 func (c *demoClient) IngestBuilder(ctx context.Context, builder *model.BuilderInputSpec) (*model.Builder, error) {
 	return c.registerBuilder(builder.URI), nil
 }
@@ -95,6 +95,7 @@ func (c *demoClient) registerCertifyBad(selectedPackage *model.Package, selected
 	}
 
 	newCertifyBad := &model.CertifyBad{
+		ID:            c.evidenceID("CertifyBad"),
 		Justification: justification,
 		Origin:        origin,
 		Collector:     collector,
@@ -204,6 +205,10 @@ func (c *demoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Certi
 	for _, h := range c.certifyBad {
 		matchOrSkip := true
 
+		if certifyBadSpec.ID != nil && h.ID != *certifyBadSpec.ID {
+			matchOrSkip = false
+		}
+
 		if certifyBadSpec.Justification != nil && h.Justification != *certifyBadSpec.Justification {
 			matchOrSkip = false
 		}
@@ -266,3 +271,15 @@ func (c *demoClient) CertifyBad(ctx context.Context, certifyBadSpec *model.Certi
 
 	return foundCertifyBad, nil
 }
+
+func (c *demoClient) CertifyBadList(ctx context.Context, certifyBadSpec *model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	found, err := c.CertifyBad(ctx, certifyBadSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.CertifyBad) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyBadConnection(page), nil
+}
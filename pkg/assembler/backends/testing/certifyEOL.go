@@ -0,0 +1,158 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Ingest CertifyEOL
+
+func (c *demoClient) registerCertifyEOL(selectedPackage *model.Package, certifyEol model.CertifyEOLInputSpec) *model.CertifyEol {
+	for _, e := range c.certifyEOL {
+		if reflect.DeepEqual(e.Package, selectedPackage) && e.Product == certifyEol.Product && e.Cycle == certifyEol.Cycle &&
+			e.Origin == certifyEol.Origin && e.Collector == certifyEol.Collector {
+			// a newer check of the same cycle replaces the status
+			e.Status = certifyEol.Status
+			e.Eol = certifyEol.Eol
+			e.Support = certifyEol.Support
+			e.Latest = certifyEol.Latest
+			e.TimeChecked = certifyEol.TimeChecked
+			return e
+		}
+	}
+
+	newCertifyEOL := &model.CertifyEol{
+		ID:          c.evidenceID("CertifyEOL"),
+		Package:     selectedPackage,
+		Product:     certifyEol.Product,
+		Cycle:       certifyEol.Cycle,
+		Status:      certifyEol.Status,
+		Eol:         certifyEol.Eol,
+		Support:     certifyEol.Support,
+		Latest:      certifyEol.Latest,
+		TimeChecked: certifyEol.TimeChecked,
+		Origin:      certifyEol.Origin,
+		Collector:   certifyEol.Collector,
+	}
+	c.certifyEOL = append(c.certifyEOL, newCertifyEOL)
+	return newCertifyEOL
+}
+
+func (c *demoClient) IngestCertifyEol(ctx context.Context, pkg model.PkgInputSpec, certifyEol model.CertifyEOLInputSpec) (*model.CertifyEol, error) {
+
+	selectedPkgSpec := helper.ConvertPkgInputSpecToPkgSpec(&pkg)
+
+	collectedPkg, err := c.Packages(ctx, selectedPkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	if len(collectedPkg) != 1 {
+		return nil, gqlerror.Errorf(
+			"IngestCertifyEol :: package argument must match one, found %d",
+			len(collectedPkg))
+	}
+
+	return c.registerCertifyEOL(collectedPkg[0], certifyEol), nil
+}
+
+// Query CertifyEOL
+
+func (c *demoClient) CertifyEol(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec) ([]*model.CertifyEol, error) {
+	var foundCertifyEOL []*model.CertifyEol
+
+	for _, e := range c.certifyEOL {
+		matchOrSkip := true
+
+		if certifyEOLSpec.ID != nil && e.ID != *certifyEOLSpec.ID {
+			matchOrSkip = false
+		}
+
+		if certifyEOLSpec.Product != nil && e.Product != *certifyEOLSpec.Product {
+			matchOrSkip = false
+		}
+		if certifyEOLSpec.Cycle != nil && e.Cycle != *certifyEOLSpec.Cycle {
+			matchOrSkip = false
+		}
+		if certifyEOLSpec.Status != nil && e.Status != *certifyEOLSpec.Status {
+			matchOrSkip = false
+		}
+		if certifyEOLSpec.EolBefore != nil && (e.Eol == nil || !e.Eol.Before(*certifyEOLSpec.EolBefore)) {
+			matchOrSkip = false
+		}
+		if certifyEOLSpec.Origin != nil && e.Origin != *certifyEOLSpec.Origin {
+			matchOrSkip = false
+		}
+		if certifyEOLSpec.Collector != nil && e.Collector != *certifyEOLSpec.Collector {
+			matchOrSkip = false
+		}
+
+		if certifyEOLSpec.Package != nil {
+			if certifyEOLSpec.Package.Type != nil && e.Package.Type != *certifyEOLSpec.Package.Type {
+				matchOrSkip = false
+			} else if filterPackageNamespace(e.Package, certifyEOLSpec.Package) == nil {
+				matchOrSkip = false
+			}
+		}
+
+		if matchOrSkip {
+			foundCertifyEOL = append(foundCertifyEOL, e)
+		}
+	}
+
+	return foundCertifyEOL, nil
+}
+
+func (c *demoClient) CertifyEOLList(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec, after *string, first *int) (*model.CertifyEOLConnection, error) {
+	found, err := c.CertifyEol(ctx, certifyEOLSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.CertifyEol) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyEOLConnection(page), nil
+}
+
+// Query EOLDependents
+
+func (c *demoClient) EolDependents(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec) ([]*model.IsDependency, error) {
+
+	foundCertifyEOL, err := c.CertifyEol(ctx, certifyEOLSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.EOLDependents(ctx, foundCertifyEOL, c.IsDependency)
+}
+
+func (c *demoClient) EolDependentsList(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	found, err := c.EolDependents(ctx, certifyEOLSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.IsDependency) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsDependencyConnection(page), nil
+}
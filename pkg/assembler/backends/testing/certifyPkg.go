@@ -100,6 +100,7 @@ func (c *demoClient) registerCertifyPkg(selectedPackages []*model.Package, justi
 	}
 
 	newCertifyPkg := &model.CertifyPkg{
+		ID:            c.evidenceID("CertifyPkg"),
 		Packages:      selectedPackages,
 		Justification: justification,
 		Origin:        origin,
@@ -152,6 +153,10 @@ func (c *demoClient) CertifyPkg(ctx context.Context, certifyPkgSpec *model.Certi
 	for _, h := range c.certifyPkg {
 		matchOrSkip := true
 
+		if certifyPkgSpec.ID != nil && h.ID != *certifyPkgSpec.ID {
+			matchOrSkip = false
+		}
+
 		if certifyPkgSpec.Justification != nil && h.Justification != *certifyPkgSpec.Justification {
 			matchOrSkip = false
 		}
@@ -178,6 +183,18 @@ func (c *demoClient) CertifyPkg(ctx context.Context, certifyPkgSpec *model.Certi
 	return certifyPkgs, nil
 }
 
+func (c *demoClient) CertifyPkgList(ctx context.Context, certifyPkgSpec *model.CertifyPkgSpec, after *string, first *int) (*model.CertifyPkgConnection, error) {
+	found, err := c.CertifyPkg(ctx, certifyPkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.CertifyPkg) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyPkgConnection(page), nil
+}
+
 func packagesContain(selectedPackages []*model.Package, queryPackage *model.Package) bool {
 	for _, pkg := range selectedPackages {
 		if reflect.DeepEqual(pkg, queryPackage) {
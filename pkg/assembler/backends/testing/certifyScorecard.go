@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -105,11 +106,16 @@ func (c *demoClient) registerCertifyScorecard(selectedSource *model.Source, time
 			h.Scorecard.AggregateScore == aggregateScore &&
 			h.Scorecard.ScorecardVersion == scorecardVersion &&
 			h.Scorecard.ScorecardCommit == scorecardCommit {
+			// keep the time of the latest scan
+			if timeScanned.After(h.Scorecard.TimeScanned) {
+				h.Scorecard.TimeScanned = timeScanned
+			}
 			return h, nil
 		}
 	}
 
 	newCertifyScorecard := &model.CertifyScorecard{
+		ID:     c.evidenceID("CertifyScorecard"),
 		Source: selectedSource,
 		Scorecard: &model.Scorecard{
 			TimeScanned:      timeScanned,
@@ -145,6 +151,10 @@ func (c *demoClient) Scorecards(ctx context.Context, certifyScorecardSpec *model
 	for _, h := range c.certifyScorecard {
 		matchOrSkip := true
 
+		if certifyScorecardSpec.ID != nil && h.ID != *certifyScorecardSpec.ID {
+			matchOrSkip = false
+		}
+
 		if certifyScorecardSpec.ScorecardVersion != nil &&
 			h.Scorecard.ScorecardVersion != *certifyScorecardSpec.ScorecardVersion {
 			matchOrSkip = false
@@ -182,6 +192,18 @@ func (c *demoClient) Scorecards(ctx context.Context, certifyScorecardSpec *model
 	return collectedHasSourceAt, nil
 }
 
+func (c *demoClient) ScorecardsList(ctx context.Context, certifyScorecardSpec *model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	found, err := c.Scorecards(ctx, certifyScorecardSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.CertifyScorecard) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyScorecardConnection(page), nil
+}
+
 func (c *demoClient) CertifyScorecard(ctx context.Context, source model.SourceInputSpec, scorecard model.ScorecardInputSpec) (*model.CertifyScorecard, error) {
 	sourceSpec := model.SourceSpec{
 		Type:      &source.Type,
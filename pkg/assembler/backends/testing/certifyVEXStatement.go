@@ -50,7 +50,14 @@ func registerAllCertifyVEXStatement(client *demoClient) error {
 	if err != nil {
 		return err
 	}
-	_, err = client.registerCertifyVEXStatement(selectedPackage[0], nil, selectedCve[0], nil, "this package is not vulnerable to this CVE", "testing backend", "testing backend", time.Now())
+	_, err = client.registerCertifyVEXStatement(selectedPackage[0], nil, nil, selectedCve[0], nil, &model.VexStatementInputSpec{
+		Status:           model.VexStatusNotAffected,
+		VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+		Statement:        "this package is not vulnerable to this CVE",
+		KnownSince:       time.Now(),
+		Origin:           "testing backend",
+		Collector:        "testing backend",
+	})
 	if err != nil {
 		return err
 	}
@@ -61,51 +68,42 @@ func registerAllCertifyVEXStatement(client *demoClient) error {
 	if err != nil {
 		return err
 	}
-	_, err = client.registerCertifyVEXStatement(nil, &model.Artifact{Digest: "5a787865sd676dacb0142afa0b83029cd7befd9", Algorithm: "sha1"}, nil, selectedGhsa[0], "this artifact is not vulnerable to this GHSA", "testing backend", "testing backend", time.Now())
+	_, err = client.registerCertifyVEXStatement(nil, &model.Artifact{Digest: "5a787865sd676dacb0142afa0b83029cd7befd9", Algorithm: "sha1"}, nil, nil, selectedGhsa[0], &model.VexStatementInputSpec{
+		Status:           model.VexStatusAffected,
+		VexJustification: model.VexJustificationNotProvided,
+		ActionStatement:  "upgrade to a fixed version",
+		KnownSince:       time.Now(),
+		Origin:           "testing backend",
+		Collector:        "testing backend",
+	})
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// Ingest CertifyPkg
+// Ingest CertifyVEXStatement
 
-func (c *demoClient) registerCertifyVEXStatement(selectedPackage *model.Package, selectedArtifact *model.Artifact, selectedCve *model.Cve, selectedGhsa *model.Ghsa, justification, origin, collector string, timestamp time.Time) (*model.CertifyVEXStatement, error) {
+func (c *demoClient) registerCertifyVEXStatement(selectedPackage *model.Package, selectedArtifact *model.Artifact, selectedOsv *model.Osv, selectedCve *model.Cve,
+	selectedGhsa *model.Ghsa, vexStatement *model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
 
 	if selectedPackage != nil && selectedArtifact != nil {
 		return nil, fmt.Errorf("cannot specify both package and artifact for CertifyVEXStatement")
 	}
 
-	for _, vex := range c.certifyVEXStatement {
-		if vex.Justification == justification {
-			if val, ok := vex.Subject.(model.Package); ok {
-				if reflect.DeepEqual(val, *selectedPackage) {
-					return vex, nil
-				}
-			} else if val, ok := vex.Subject.(model.Artifact); ok {
-				if reflect.DeepEqual(val, *selectedArtifact) {
-					return vex, nil
-				}
-			}
-			if val, ok := vex.Vulnerability.(model.Cve); ok {
-				if reflect.DeepEqual(val, *selectedCve) {
-					return vex, nil
-				}
-			} else if val, ok := vex.Vulnerability.(model.Ghsa); ok {
-				if reflect.DeepEqual(val, *selectedGhsa) {
-					return vex, nil
-				}
-			}
-		}
-	}
-
 	newCertifyVEXStatement := &model.CertifyVEXStatement{
-		KnownSince:    timestamp,
-		Justification: justification,
-		Origin:        origin,
-		Collector:     collector,
+		Status:           vexStatement.Status,
+		VexJustification: vexStatement.VexJustification,
+		Statement:        vexStatement.Statement,
+		StatusNotes:      vexStatement.StatusNotes,
+		ActionStatement:  vexStatement.ActionStatement,
+		KnownSince:       vexStatement.KnownSince,
+		Origin:           vexStatement.Origin,
+		Collector:        vexStatement.Collector,
 	}
-	if selectedCve != nil {
+	if selectedOsv != nil {
+		newCertifyVEXStatement.Vulnerability = selectedOsv
+	} else if selectedCve != nil {
 		newCertifyVEXStatement.Vulnerability = selectedCve
 	} else {
 		newCertifyVEXStatement.Vulnerability = selectedGhsa
@@ -116,20 +114,35 @@ func (c *demoClient) registerCertifyVEXStatement(selectedPackage *model.Package,
 		newCertifyVEXStatement.Subject = selectedArtifact
 	}
 
+	for _, vex := range c.certifyVEXStatement {
+		// the statements are compared without their id
+		existing := *vex
+		existing.ID = ""
+		if reflect.DeepEqual(&existing, newCertifyVEXStatement) {
+			return vex, nil
+		}
+	}
+
+	newCertifyVEXStatement.ID = c.evidenceID("CertifyVEXStatement")
 	c.certifyVEXStatement = append(c.certifyVEXStatement, newCertifyVEXStatement)
 	return newCertifyVEXStatement, nil
 }
 
-func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.OsvCveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
 	err := helper.ValidatePackageOrArtifactInput(&subject, "IngestVEXStatement")
 	if err != nil {
 		return nil, err
 	}
-	err = helper.ValidateCveOrGhsaIngestionInput(vulnerability, "IngestVEXStatement")
+	err = helper.ValidateOsvCveOrGhsaIngestionInput(vulnerability)
 	if err != nil {
 		return nil, err
 	}
+	if vulnerability.NoVuln != nil {
+		return nil, gqlerror.Errorf("IngestVEXStatement :: a VEX statement cannot be about noVuln")
+	}
 
+	var selectedPackage *model.Package
+	var selectedArtifact *model.Artifact
 	if subject.Package != nil {
 		selectedPkgSpec := helper.ConvertPkgInputSpecToPkgSpec(subject.Package)
 
@@ -141,114 +154,69 @@ func (c *demoClient) IngestVEXStatement(ctx context.Context, subject model.Packa
 			return nil, gqlerror.Errorf(
 				"IngestVEXStatement :: multiple packages found")
 		}
-
-		if vulnerability.Cve != nil {
-
-			cveSpec := helper.ConvertCveInputSpecToCveSpec(vulnerability.Cve)
-			collectedCve, err := c.Cve(ctx, cveSpec)
-			if err != nil {
-				return nil, err
-			}
-			if len(collectedCve) != 1 {
-				return nil, gqlerror.Errorf(
-					"IngestVEXStatement :: cve argument must match one, found %d",
-					len(collectedCve))
-			}
-			return c.registerCertifyVEXStatement(
-				collectedPkg[0],
-				nil,
-				collectedCve[0],
-				nil,
-				vexStatement.Justification,
-				vexStatement.Origin,
-				vexStatement.Collector,
-				vexStatement.KnownSince)
+		selectedPackage = collectedPkg[0]
+	} else {
+		collectedArt, err := c.Artifacts(ctx, &model.ArtifactSpec{Algorithm: &subject.Artifact.Algorithm, Digest: &subject.Artifact.Digest})
+		if err != nil {
+			return nil, err
 		}
-
-		if vulnerability.Ghsa != nil {
-			ghsaSpec := helper.ConvertGhsaInputSpecToGhsaSpec(vulnerability.Ghsa)
-
-			collectedGhsa, err := c.Ghsa(ctx, ghsaSpec)
-			if err != nil {
-				return nil, err
-			}
-			if len(collectedGhsa) != 1 {
-				return nil, gqlerror.Errorf(
-					"IngestVEXStatement :: ghsa argument must match one, found %d",
-					len(collectedGhsa))
-			}
-			return c.registerCertifyVEXStatement(
-				collectedPkg[0],
-				nil,
-				nil,
-				collectedGhsa[0],
-				vexStatement.Justification,
-				vexStatement.Origin,
-				vexStatement.Collector,
-				vexStatement.KnownSince)
+		if len(collectedArt) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVEXStatement :: multiple artifacts found")
 		}
+		selectedArtifact = collectedArt[0]
 	}
 
-	if subject.Artifact != nil {
-		collectedArt, err := c.Artifacts(ctx, &model.ArtifactSpec{Algorithm: &subject.Artifact.Algorithm, Digest: &subject.Artifact.Digest})
+	if vulnerability.Osv != nil {
+		osvSpec := helper.ConvertOsvInputSpecToOsvSpec(vulnerability.Osv)
+
+		collectedOsv, err := c.Osv(ctx, osvSpec)
 		if err != nil {
 			return nil, err
 		}
-		if len(collectedArt) != 1 {
+		if len(collectedOsv) != 1 {
 			return nil, gqlerror.Errorf(
-				"IngestVEXStatement :: multiple artifacts found")
+				"IngestVEXStatement :: osv argument must match one, found %d",
+				len(collectedOsv))
 		}
-		if vulnerability.Cve != nil {
+		return c.registerCertifyVEXStatement(selectedPackage, selectedArtifact, collectedOsv[0], nil, nil, &vexStatement)
+	}
 
-			cveSpec := helper.ConvertCveInputSpecToCveSpec(vulnerability.Cve)
-			collectedCve, err := c.Cve(ctx, cveSpec)
-			if err != nil {
-				return nil, err
-			}
-			if len(collectedCve) != 1 {
-				return nil, gqlerror.Errorf(
-					"IngestVEXStatement :: cve argument must match one, found %d",
-					len(collectedCve))
-			}
-			return c.registerCertifyVEXStatement(
-				nil,
-				collectedArt[0],
-				collectedCve[0],
-				nil,
-				vexStatement.Justification,
-				vexStatement.Origin,
-				vexStatement.Collector,
-				vexStatement.KnownSince)
+	if vulnerability.Cve != nil {
+		cveSpec := helper.ConvertCveInputSpecToCveSpec(vulnerability.Cve)
+
+		collectedCve, err := c.Cve(ctx, cveSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedCve) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVEXStatement :: cve argument must match one, found %d",
+				len(collectedCve))
 		}
+		return c.registerCertifyVEXStatement(selectedPackage, selectedArtifact, nil, collectedCve[0], nil, &vexStatement)
+	}
 
-		if vulnerability.Ghsa != nil {
-			ghsaSpec := helper.ConvertGhsaInputSpecToGhsaSpec(vulnerability.Ghsa)
+	if vulnerability.Ghsa != nil {
+		ghsaSpec := helper.ConvertGhsaInputSpecToGhsaSpec(vulnerability.Ghsa)
 
-			collectedGhsa, err := c.Ghsa(ctx, ghsaSpec)
-			if err != nil {
-				return nil, err
-			}
-			if len(collectedGhsa) != 1 {
-				return nil, gqlerror.Errorf(
-					"IngestVEXStatement :: ghsa argument must match one, found %d",
-					len(collectedGhsa))
-			}
-			return c.registerCertifyVEXStatement(
-				nil,
-				collectedArt[0],
-				nil,
-				collectedGhsa[0],
-				vexStatement.Justification,
-				vexStatement.Origin,
-				vexStatement.Collector,
-				vexStatement.KnownSince)
+		collectedGhsa, err := c.Ghsa(ctx, ghsaSpec)
+		if err != nil {
+			return nil, err
 		}
+		if len(collectedGhsa) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVEXStatement :: ghsa argument must match one, found %d",
+				len(collectedGhsa))
+		}
+		return c.registerCertifyVEXStatement(selectedPackage, selectedArtifact, nil, nil, collectedGhsa[0], &vexStatement)
 	}
+
 	// it should never reach here else it failed
 	return nil, gqlerror.Errorf("IngestVEXStatement failed")
 }
 
-// Query CertifyPkg
+// Query CertifyVEXStatement
 
 func (c *demoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
 
@@ -257,10 +225,17 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatemen
 		return nil, err
 	}
 
-	queryVulnAll, err := helper.ValidateCveOrGhsaQueryInput(certifyVEXStatementSpec.Vulnerability)
+	queryVulnAll, err := helper.ValidateOsvCveOrGhsaQueryInput(certifyVEXStatementSpec.Vulnerability)
 	if err != nil {
 		return nil, err
 	}
+	if certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.NoVuln != nil {
+		// a VEX statement is never about NoVuln
+		if *certifyVEXStatementSpec.Vulnerability.NoVuln {
+			return []*model.CertifyVEXStatement{}, nil
+		}
+		queryVulnAll = true
+	}
 
 	queryAll := false
 	if querySubjectAll && queryVulnAll {
@@ -272,7 +247,26 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatemen
 	for _, h := range c.certifyVEXStatement {
 		matchOrSkip := true
 
-		if certifyVEXStatementSpec.Justification != nil && h.Justification != *certifyVEXStatementSpec.Justification {
+		if certifyVEXStatementSpec.ID != nil && h.ID != *certifyVEXStatementSpec.ID {
+			matchOrSkip = false
+		}
+
+		if certifyVEXStatementSpec.Status != nil && h.Status != *certifyVEXStatementSpec.Status {
+			matchOrSkip = false
+		}
+		if certifyVEXStatementSpec.VexJustification != nil && h.VexJustification != *certifyVEXStatementSpec.VexJustification {
+			matchOrSkip = false
+		}
+		if certifyVEXStatementSpec.Statement != nil && h.Statement != *certifyVEXStatementSpec.Statement {
+			matchOrSkip = false
+		}
+		if certifyVEXStatementSpec.StatusNotes != nil && h.StatusNotes != *certifyVEXStatementSpec.StatusNotes {
+			matchOrSkip = false
+		}
+		if certifyVEXStatementSpec.ActionStatement != nil && h.ActionStatement != *certifyVEXStatementSpec.ActionStatement {
+			matchOrSkip = false
+		}
+		if certifyVEXStatementSpec.KnownSince != nil && !h.KnownSince.Equal(*certifyVEXStatementSpec.KnownSince) {
 			matchOrSkip = false
 		}
 		if certifyVEXStatementSpec.Collector != nil && h.Collector != *certifyVEXStatementSpec.Collector {
@@ -313,6 +307,20 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatemen
 			}
 
 			if !queryVulnAll {
+				if certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Osv != nil && h.Vulnerability != nil {
+					if val, ok := h.Vulnerability.(*model.Osv); ok {
+						newOsv, err := filterOSVID(val, certifyVEXStatementSpec.Vulnerability.Osv)
+						if err != nil {
+							return nil, err
+						}
+						if newOsv == nil {
+							matchOrSkip = false
+						}
+					} else {
+						matchOrSkip = false
+					}
+				}
+
 				if certifyVEXStatementSpec.Vulnerability != nil && certifyVEXStatementSpec.Vulnerability.Cve != nil && h.Vulnerability != nil {
 					if val, ok := h.Vulnerability.(*model.Cve); ok {
 						if certifyVEXStatementSpec.Vulnerability.Cve.Year == nil || val.Year == *certifyVEXStatementSpec.Vulnerability.Cve.Year {
@@ -352,3 +360,15 @@ func (c *demoClient) CertifyVEXStatement(ctx context.Context, certifyVEXStatemen
 
 	return foundCertifyVEXStatement, nil
 }
+
+func (c *demoClient) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	found, err := c.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.CertifyVEXStatement) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyVEXStatementConnection(page), nil
+}
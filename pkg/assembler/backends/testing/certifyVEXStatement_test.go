@@ -0,0 +1,71 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+func TestIngestVEXStatement_duplicate(t *testing.T) {
+	ctx := context.Background()
+	b, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("unable to create backend: %v", err)
+	}
+
+	ns := ""
+	version := "4.17.20"
+	pkg := model.PkgInputSpec{Type: "npm", Namespace: &ns, Name: "lodash", Version: &version}
+	if _, err := b.IngestPackage(ctx, &pkg); err != nil {
+		t.Fatalf("unable to ingest package: %v", err)
+	}
+	ghsa := model.GHSAInputSpec{GhsaID: "GHSA-35jh-r3h4-6jhm"}
+	if _, err := b.IngestGhsa(ctx, &ghsa); err != nil {
+		t.Fatalf("unable to ingest ghsa: %v", err)
+	}
+
+	statement := model.VexStatementInputSpec{
+		Status:           model.VexStatusNotAffected,
+		VexJustification: model.VexJustificationVulnerableCodeNotInExecutePath,
+		KnownSince:       time.Date(2023, 4, 11, 0, 0, 0, 0, time.UTC),
+		Origin:           "test",
+		Collector:        "test",
+	}
+	var ids []string
+	for i := 0; i < 2; i++ {
+		vex, err := b.IngestVEXStatement(ctx, model.PackageOrArtifactInput{Package: &pkg}, model.OsvCveOrGhsaInput{Ghsa: &ghsa}, statement)
+		if err != nil {
+			t.Fatalf("IngestVEXStatement() error = %v", err)
+		}
+		ids = append(ids, vex.ID)
+	}
+	if ids[0] != ids[1] {
+		t.Errorf("IngestVEXStatement() returned ids %v, want the same statement twice", ids)
+	}
+
+	found, err := b.CertifyVEXStatement(ctx, &model.CertifyVEXStatementSpec{})
+	if err != nil {
+		t.Fatalf("CertifyVEXStatement() error = %v", err)
+	}
+	if len(found) != 1 {
+		t.Errorf("CertifyVEXStatement() returned %d statements, want 1", len(found))
+	}
+}
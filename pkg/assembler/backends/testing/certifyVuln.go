@@ -81,6 +81,9 @@ func registerAllCertifyVuln(client *demoClient) error {
 	}
 	client.registerCertifyVuln(selectedPackage1[0], nil, nil, selectedGhsa[0], time.Now(), "MITRE", "v1.0.0", "osv.dev", "0.0.14", "testing backend", "testing backend")
 
+	// pkg:pypi/django@1.11.1 scanned with no vulnerability found
+	client.registerCertifyVuln(selectedPackage2[0], nil, nil, nil, time.Now(), "MITRE", "v1.0.1", "osv.dev", "0.0.14", "testing backend", "testing backend")
+
 	return nil
 }
 
@@ -89,20 +92,31 @@ func registerAllCertifyVuln(client *demoClient) error {
 func (c *demoClient) registerCertifyVuln(selectedPackage *model.Package, selectedOsv *model.Osv, selectedCve *model.Cve, selectedGhsa *model.Ghsa, timeScanned time.Time,
 	dbUri, dbVersion, scannerUri, scannerVersion, origin, collector string) *model.CertifyVuln {
 
+	// an existing certification that is scanned again keeps the time of the latest scan
+	rescanned := func(vuln *model.CertifyVuln) *model.CertifyVuln {
+		if timeScanned.After(vuln.Metadata.TimeScanned) {
+			vuln.Metadata.TimeScanned = timeScanned
+		}
+		return vuln
+	}
 	for _, vuln := range c.certifyVuln {
 		if reflect.DeepEqual(vuln.Package, selectedPackage) && vuln.Metadata.DbURI == dbUri && vuln.Metadata.DbVersion == dbVersion &&
 			vuln.Metadata.ScannerURI == scannerUri && vuln.Metadata.ScannerVersion == scannerVersion {
 			if val, ok := vuln.Vulnerability.(model.Osv); ok {
 				if &val == selectedOsv {
-					return vuln
+					return rescanned(vuln)
 				}
 			} else if val, ok := vuln.Vulnerability.(model.Cve); ok {
 				if &val == selectedCve {
-					return vuln
+					return rescanned(vuln)
 				}
 			} else if val, ok := vuln.Vulnerability.(model.Ghsa); ok {
 				if &val == selectedGhsa {
-					return vuln
+					return rescanned(vuln)
+				}
+			} else if _, ok := vuln.Vulnerability.(*model.NoVuln); ok {
+				if selectedOsv == nil && selectedCve == nil && selectedGhsa == nil {
+					return rescanned(vuln)
 				}
 			}
 		}
@@ -119,6 +133,7 @@ func (c *demoClient) registerCertifyVuln(selectedPackage *model.Package, selecte
 	}
 
 	newCertifyVuln := &model.CertifyVuln{
+		ID:       c.evidenceID("CertifyVuln"),
 		Package:  selectedPackage,
 		Metadata: metadata,
 	}
@@ -126,8 +141,10 @@ func (c *demoClient) registerCertifyVuln(selectedPackage *model.Package, selecte
 		newCertifyVuln.Vulnerability = selectedOsv
 	} else if selectedCve != nil {
 		newCertifyVuln.Vulnerability = selectedCve
-	} else {
+	} else if selectedGhsa != nil {
 		newCertifyVuln.Vulnerability = selectedGhsa
+	} else {
+		newCertifyVuln.Vulnerability = &model.NoVuln{ID: helper.NoVulnID(), NoVuln: true}
 	}
 
 	c.certifyVuln = append(c.certifyVuln, newCertifyVuln)
@@ -230,6 +247,20 @@ func (c *demoClient) IngestVulnerability(ctx context.Context, pkg model.PkgInput
 			certifyVuln.Origin,
 			certifyVuln.Collector), nil
 	}
+	if vulnerability.NoVuln != nil {
+		return c.registerCertifyVuln(
+			collectedPkg[0],
+			nil,
+			nil,
+			nil,
+			certifyVuln.TimeScanned,
+			certifyVuln.DbURI,
+			certifyVuln.DbVersion,
+			certifyVuln.ScannerURI,
+			certifyVuln.ScannerVersion,
+			certifyVuln.Origin,
+			certifyVuln.Collector), nil
+	}
 	// it should never reach here else it failed
 	return nil, gqlerror.Errorf("IngestVulnerability failed")
 }
@@ -247,6 +278,10 @@ func (c *demoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Cer
 	for _, h := range c.certifyVuln {
 		matchOrSkip := true
 
+		if certifyVulnSpec.ID != nil && h.ID != *certifyVulnSpec.ID {
+			matchOrSkip = false
+		}
+
 		if certifyVulnSpec.DbURI != nil && h.Metadata.DbURI != *certifyVulnSpec.DbURI {
 			matchOrSkip = false
 		}
@@ -320,6 +355,12 @@ func (c *demoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Cer
 					matchOrSkip = false
 				}
 			}
+
+			if certifyVulnSpec.Vulnerability != nil && certifyVulnSpec.Vulnerability.NoVuln != nil {
+				if _, ok := h.Vulnerability.(*model.NoVuln); ok != *certifyVulnSpec.Vulnerability.NoVuln {
+					matchOrSkip = false
+				}
+			}
 		}
 
 		if matchOrSkip {
@@ -329,3 +370,15 @@ func (c *demoClient) CertifyVuln(ctx context.Context, certifyVulnSpec *model.Cer
 
 	return foundCertifyBad, nil
 }
+
+func (c *demoClient) CertifyVulnList(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	found, err := c.CertifyVuln(ctx, certifyVulnSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.CertifyVuln) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.CertifyVulnConnection(page), nil
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -41,7 +42,7 @@ func (c *demoClient) registerCVE(year, id string) *model.Cve {
 		}
 	}
 
-	newCve := &model.Cve{Year: year}
+	newCve := &model.Cve{ID: helper.CveID(year), Year: year}
 	newCve = registerCveID(newCve, idLower)
 	c.cve = append(c.cve, newCve)
 
@@ -77,6 +78,18 @@ func (c *demoClient) Cve(ctx context.Context, cveSpec *model.CVESpec) ([]*model.
 	return cve, nil
 }
 
+func (c *demoClient) CveList(ctx context.Context, cveSpec *model.CVESpec, after *string, first *int) (*model.CVEConnection, error) {
+	cves, err := c.Cve(ctx, cveSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(helper.FlattenCves(cves), helper.CveKey, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.CVEConnection(page), nil
+}
+
 func filterCVEID(cve *model.Cve, cveSpec *model.CVESpec) (*model.Cve, error) {
 	var cveID []*model.CVEId
 	for _, id := range cve.CveID {
@@ -88,6 +101,7 @@ func filterCVEID(cve *model.Cve, cveSpec *model.CVESpec) (*model.Cve, error) {
 		return nil, nil
 	}
 	return &model.Cve{
+		ID:    cve.ID,
 		Year:  cve.Year,
 		CveID: cveID,
 	}, nil
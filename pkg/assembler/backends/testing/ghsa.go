@@ -19,6 +19,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -37,7 +38,7 @@ func (c *demoClient) registerGhsa(id string) *model.Ghsa {
 		return c.ghsa[i]
 	}
 
-	newGhsa := &model.Ghsa{}
+	newGhsa := &model.Ghsa{ID: helper.GhsaID()}
 	newGhsa = registerGhsaID(newGhsa, idLower)
 	c.ghsa = append(c.ghsa, newGhsa)
 
@@ -71,6 +72,18 @@ func (c *demoClient) Ghsa(ctx context.Context, ghsaSpec *model.GHSASpec) ([]*mod
 	return ghsa, nil
 }
 
+func (c *demoClient) GhsaList(ctx context.Context, ghsaSpec *model.GHSASpec, after *string, first *int) (*model.GHSAConnection, error) {
+	ghsas, err := c.Ghsa(ctx, ghsaSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(helper.FlattenGhsas(ghsas), helper.GhsaKey, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.GHSAConnection(page), nil
+}
+
 func filterGHSAID(ghsa *model.Ghsa, ghsaSpec *model.GHSASpec) (*model.Ghsa, error) {
 	var ghsaID []*model.GHSAId
 	for _, id := range ghsa.GhsaID {
@@ -82,6 +95,7 @@ func filterGHSAID(ghsa *model.Ghsa, ghsaSpec *model.GHSASpec) (*model.Ghsa, erro
 		return nil, nil
 	}
 	return &model.Ghsa{
+		ID:     ghsa.ID,
 		GhsaID: ghsaID,
 	}, nil
 }
@@ -83,6 +83,7 @@ func (c *demoClient) registerHasSBOM(selectedPackage *model.Package, selectedSou
 	}
 
 	newHasSBOM := &model.HasSbom{
+		ID:        c.evidenceID("HasSBOM"),
 		URI:       uri,
 		Origin:    origin,
 		Collector: collector,
@@ -161,6 +162,10 @@ func (c *demoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec
 	for _, h := range c.hasSBOM {
 		matchOrSkip := true
 
+		if hasSBOMSpec.ID != nil && h.ID != *hasSBOMSpec.ID {
+			matchOrSkip = false
+		}
+
 		if hasSBOMSpec.URI != nil && h.URI != *hasSBOMSpec.URI {
 			matchOrSkip = false
 		}
@@ -209,3 +214,15 @@ func (c *demoClient) HasSBOM(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec
 
 	return collectedHasSBOM, nil
 }
+
+func (c *demoClient) HasSBOMList(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	found, err := c.HasSBOM(ctx, hasSBOMSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.HasSbom) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSBOMConnection(page), nil
+}
@@ -49,6 +49,10 @@ func (c *demoClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec
 	for _, h := range c.hasSLSA {
 		matchOrSkip := true
 
+		if hasSLSASpec.ID != nil && h.ID != *hasSLSASpec.ID {
+			matchOrSkip = false
+		}
+
 		slsa := h.Slsa
 		if hasSLSASpec.BuildType != nil && slsa.BuildType != *hasSLSASpec.BuildType {
 			matchOrSkip = false
@@ -120,6 +124,18 @@ func (c *demoClient) HasSlsa(ctx context.Context, hasSLSASpec *model.HasSLSASpec
 	return collectedHasSLSA, nil
 }
 
+func (c *demoClient) HasSLSAList(ctx context.Context, hasSLSASpec *model.HasSLSASpec, after *string, first *int) (*model.HasSLSAConnection, error) {
+	found, err := c.HasSlsa(ctx, hasSLSASpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.HasSlsa) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSLSAConnection(page), nil
+}
+
 // Ingest HasSlsa
 
 func (c *demoClient) IngestMaterials(
@@ -215,6 +231,7 @@ func (c *demoClient) ingestSLSAPackage(
 	}
 
 	newHasSlsa := &model.HasSlsa{
+		ID:      c.evidenceID("HasSLSA"),
 		Subject: subjects[0],
 		Slsa:    newSlsa,
 	}
@@ -250,6 +267,7 @@ func (c *demoClient) ingestSLSASource(
 	}
 
 	newHasSlsa := &model.HasSlsa{
+		ID:      c.evidenceID("HasSLSA"),
 		Subject: subjects[0],
 		Slsa:    newSlsa,
 	}
@@ -285,6 +303,7 @@ func (c *demoClient) ingestSLSAArtifact(
 	}
 
 	newHasSlsa := &model.HasSlsa{
+		ID:      c.evidenceID("HasSLSA"),
 		Subject: subjects[0],
 		Slsa:    newSlsa,
 	}
@@ -101,6 +101,7 @@ func (c *demoClient) registerHasSourceAt(selectedPackage *model.Package, selecte
 		}
 	}
 	newHasSourceAt := &model.HasSourceAt{
+		ID:            c.evidenceID("HasSourceAt"),
 		Package:       selectedPackage,
 		Source:        selectedSource,
 		KnownSince:    since.UTC(),
@@ -164,6 +165,10 @@ func (c *demoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Has
 	for _, h := range c.hasSourceAt {
 		matchOrSkip := true
 
+		if hasSourceAtSpec.ID != nil && h.ID != *hasSourceAtSpec.ID {
+			matchOrSkip = false
+		}
+
 		if hasSourceAtSpec.Justification != nil && h.Justification != *hasSourceAtSpec.Justification {
 			matchOrSkip = false
 		}
@@ -201,3 +206,15 @@ func (c *demoClient) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.Has
 	}
 	return collectedHasSourceAt, nil
 }
+
+func (c *demoClient) HasSourceAtList(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	found, err := c.HasSourceAt(ctx, hasSourceAtSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.HasSourceAt) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.HasSourceAtConnection(page), nil
+}
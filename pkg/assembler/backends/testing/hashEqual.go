@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -43,6 +44,7 @@ func (c *demoClient) registerHashEqual(artifacts []*model.Artifact, justificatio
 	}
 
 	newHashEqual := &model.HashEqual{
+		ID:            c.evidenceID("HashEqual"),
 		Justification: justification,
 		Artifacts:     artifacts,
 		Origin:        origin,
@@ -87,6 +89,10 @@ func (c *demoClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqu
 	for _, h := range c.hashEquals {
 		matchOrSkip := true
 
+		if hashEqualSpec.ID != nil && h.ID != *hashEqualSpec.ID {
+			matchOrSkip = false
+		}
+
 		if hashEqualSpec.Justification != nil && h.Justification != *hashEqualSpec.Justification {
 			matchOrSkip = false
 		}
@@ -109,6 +115,18 @@ func (c *demoClient) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqu
 	return hashEquals, nil
 }
 
+func (c *demoClient) HashEqualList(ctx context.Context, hashEqualSpec *model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	found, err := c.HashEqual(ctx, hashEqualSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.HashEqual) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.HashEqualConnection(page), nil
+}
+
 func filterEqualArtifact(storedArtifacts []*model.Artifact, queryArtifacts []*model.ArtifactSpec) bool {
 	exists := make(map[model.Artifact]bool)
 	for _, value := range storedArtifacts {
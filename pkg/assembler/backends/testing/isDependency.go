@@ -129,6 +129,7 @@ func (c *demoClient) registerIsDependency(selectedPackage *model.Package, depend
 	}
 
 	newIsOccurrence := &model.IsDependency{
+		ID:               c.evidenceID("IsDependency"),
 		Package:          selectedPackage,
 		DependentPackage: dependentPackage,
 		VersionRange:     versionRange,
@@ -187,6 +188,10 @@ func (c *demoClient) IsDependency(ctx context.Context, isDependencySpec *model.I
 	for _, h := range c.isDependency {
 		matchOrSkip := true
 
+		if isDependencySpec.ID != nil && h.ID != *isDependencySpec.ID {
+			matchOrSkip = false
+		}
+
 		if isDependencySpec.Justification != nil && h.Justification != *isDependencySpec.Justification {
 			matchOrSkip = false
 		}
@@ -206,6 +211,8 @@ func (c *demoClient) IsDependency(ctx context.Context, isDependencySpec *model.I
 				if newPkg == nil {
 					matchOrSkip = false
 				}
+			} else {
+				matchOrSkip = false
 			}
 		}
 
@@ -217,6 +224,8 @@ func (c *demoClient) IsDependency(ctx context.Context, isDependencySpec *model.I
 				if newPkg == nil {
 					matchOrSkip = false
 				}
+			} else {
+				matchOrSkip = false
 			}
 		}
 
@@ -227,3 +236,33 @@ func (c *demoClient) IsDependency(ctx context.Context, isDependencySpec *model.I
 
 	return isDependencies, nil
 }
+
+func (c *demoClient) IsDependencyList(ctx context.Context, isDependencySpec *model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	found, err := c.IsDependency(ctx, isDependencySpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.IsDependency) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsDependencyConnection(page), nil
+}
+
+// Query transitive dependencies and dependents
+
+func (c *demoClient) TransitiveDependencies(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error) {
+	return helper.TransitiveDependencies(ctx, c, c.packageVersions, pkgSpec, maxDepth)
+}
+
+func (c *demoClient) Dependents(ctx context.Context, pkgSpec *model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error) {
+	return helper.Dependents(ctx, c, c.packageVersions, pkgSpec, maxDepth)
+}
+
+func (c *demoClient) packageVersions(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
+	pkgs, err := c.Packages(ctx, pkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	return helper.FlattenPackages(pkgs), nil
+}
@@ -84,6 +84,7 @@ func (c *demoClient) registerIsOccurrence(selectedPackage *model.Package, select
 	}
 
 	newIsOccurrence := &model.IsOccurrence{
+		ID:            c.evidenceID("IsOccurrence"),
 		Justification: justification,
 		Artifact:      artifact,
 		Origin:        origin,
@@ -175,6 +176,10 @@ func (c *demoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.I
 	for _, h := range c.isOccurrence {
 		matchOrSkip := true
 
+		if isOccurrenceSpec.ID != nil && h.ID != *isOccurrenceSpec.ID {
+			matchOrSkip = false
+		}
+
 		if isOccurrenceSpec.Justification != nil && h.Justification != *isOccurrenceSpec.Justification {
 			matchOrSkip = false
 		}
@@ -223,3 +228,15 @@ func (c *demoClient) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.I
 
 	return isOccurrences, nil
 }
+
+func (c *demoClient) IsOccurrenceList(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	found, err := c.IsOccurrence(ctx, isOccurrenceSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.IsOccurrence) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsOccurrenceConnection(page), nil
+}
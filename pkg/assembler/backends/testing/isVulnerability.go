@@ -79,6 +79,7 @@ func (c *demoClient) registerIsVulnerability(selectedOsv *model.Osv, selectedCve
 	}
 
 	newIsVuln := &model.IsVulnerability{
+		ID:            c.evidenceID("IsVulnerability"),
 		Osv:           selectedOsv,
 		Justification: justification,
 		Origin:        origin,
@@ -170,6 +171,10 @@ func (c *demoClient) IsVulnerability(ctx context.Context, isVulnerabilitySpec *m
 	for _, h := range c.isVulnerability {
 		matchOrSkip := true
 
+		if isVulnerabilitySpec.ID != nil && h.ID != *isVulnerabilitySpec.ID {
+			matchOrSkip = false
+		}
+
 		if isVulnerabilitySpec.Justification != nil && h.Justification != *isVulnerabilitySpec.Justification {
 			matchOrSkip = false
 		}
@@ -229,3 +234,15 @@ func (c *demoClient) IsVulnerability(ctx context.Context, isVulnerabilitySpec *m
 
 	return foundIsVulnerability, nil
 }
+
+func (c *demoClient) IsVulnerabilityList(ctx context.Context, isVulnerabilitySpec *model.IsVulnerabilitySpec, after *string, first *int) (*model.IsVulnerabilityConnection, error) {
+	found, err := c.IsVulnerability(ctx, isVulnerabilitySpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.IsVulnerability) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.IsVulnerabilityConnection(page), nil
+}
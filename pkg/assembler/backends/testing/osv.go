@@ -19,6 +19,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -39,7 +40,7 @@ func (c *demoClient) registerOSV(id string) *model.Osv {
 		return c.osv[i]
 	}
 
-	newOsv := &model.Osv{}
+	newOsv := &model.Osv{ID: helper.OsvID()}
 	newOsv = registerOsvID(newOsv, idLower)
 	c.osv = append(c.osv, newOsv)
 
@@ -73,6 +74,18 @@ func (c *demoClient) Osv(ctx context.Context, osvSpec *model.OSVSpec) ([]*model.
 	return osv, nil
 }
 
+func (c *demoClient) OsvList(ctx context.Context, osvSpec *model.OSVSpec, after *string, first *int) (*model.OSVConnection, error) {
+	osvs, err := c.Osv(ctx, osvSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(helper.FlattenOsvs(osvs), helper.OsvKey, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.OSVConnection(page), nil
+}
+
 func filterOSVID(ghsa *model.Osv, osvSpec *model.OSVSpec) (*model.Osv, error) {
 	var osvID []*model.OSVId
 	for _, id := range ghsa.OsvID {
@@ -84,6 +97,7 @@ func filterOSVID(ghsa *model.Osv, osvSpec *model.OSVSpec) (*model.Osv, error) {
 		return nil, nil
 	}
 	return &model.Osv{
+		ID:    ghsa.ID,
 		OsvID: osvID,
 	}, nil
 }
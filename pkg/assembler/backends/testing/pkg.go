@@ -18,6 +18,7 @@ package testing
 import (
 	"context"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 )
 
@@ -112,7 +113,7 @@ func (c *demoClient) registerPackage(pkgType, namespace, name, version, subpath
 		}
 	}
 
-	newPkg := &model.Package{Type: pkgType}
+	newPkg := &model.Package{ID: helper.PkgTypeID(pkgType), Type: pkgType}
 	newPkg = registerNamespace(newPkg, namespace, name, version, subpath, qualifiers...)
 	c.packages = append(c.packages, newPkg)
 
@@ -127,7 +128,7 @@ func registerNamespace(p *model.Package, namespace, name, version, subpath strin
 		}
 	}
 
-	newNs := &model.PackageNamespace{Namespace: namespace}
+	newNs := &model.PackageNamespace{ID: helper.PkgNamespaceID(p.ID, namespace), Namespace: namespace}
 	newNs = registerName(newNs, name, version, subpath, qualifiers...)
 	p.Namespaces = append(p.Namespaces, newNs)
 	return p
@@ -141,7 +142,7 @@ func registerName(ns *model.PackageNamespace, name, version, subpath string, qua
 		}
 	}
 
-	newN := &model.PackageName{Name: name}
+	newN := &model.PackageName{ID: helper.PkgNameID(ns.ID, name), Name: name}
 	newN = registerVersion(newN, version, subpath, qualifiers...)
 	ns.Names = append(ns.Names, newN)
 	return ns
@@ -181,6 +182,7 @@ func registerVersion(n *model.PackageName, version, subpath string, qualifiers .
 	}
 
 	newV := &model.PackageVersion{
+		ID:         helper.PkgVersionID(n.ID, version, subpath, inputQualifiers),
 		Version:    version,
 		Subpath:    subpath,
 		Qualifiers: inputQualifiers,
@@ -217,6 +219,18 @@ func (c *demoClient) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*m
 	return packages, nil
 }
 
+func (c *demoClient) PackagesList(ctx context.Context, pkgSpec *model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
+	packages, err := c.Packages(ctx, pkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(helper.FlattenPackages(packages), helper.PackageKey, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.PackageConnection(page), nil
+}
+
 func filterPackageNamespace(pkg *model.Package, pkgSpec *model.PkgSpec) *model.Package {
 	var namespaces []*model.PackageNamespace
 	for _, ns := range pkg.Namespaces {
@@ -231,6 +245,7 @@ func filterPackageNamespace(pkg *model.Package, pkgSpec *model.PkgSpec) *model.P
 		return nil
 	}
 	return &model.Package{
+		ID:         pkg.ID,
 		Type:       pkg.Type,
 		Namespaces: namespaces,
 	}
@@ -250,6 +265,7 @@ func filterPackageName(ns *model.PackageNamespace, pkgSpec *model.PkgSpec) *mode
 		return nil
 	}
 	return &model.PackageNamespace{
+		ID:        ns.ID,
 		Namespace: ns.Namespace,
 		Names:     names,
 	}
@@ -269,6 +285,7 @@ func filterPackageVersion(n *model.PackageName, pkgSpec *model.PkgSpec) *model.P
 		return nil
 	}
 	return &model.PackageName{
+		ID:       n.ID,
 		Name:     n.Name,
 		Versions: versions,
 	}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
 	"github.com/guacsec/guac/pkg/assembler/graphql/model"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
@@ -50,7 +51,7 @@ func (c *demoClient) registerSource(srcType, namespace, name, qualifier string)
 		}
 	}
 
-	newSrc := &model.Source{Type: srcType}
+	newSrc := &model.Source{ID: helper.SrcTypeID(srcType), Type: srcType}
 	newSrc = registerSourceNamespace(newSrc, namespace, name, qualifier)
 	c.sources = append(c.sources, newSrc)
 
@@ -65,7 +66,7 @@ func registerSourceNamespace(s *model.Source, namespace, name, qualifier string)
 		}
 	}
 
-	newNs := &model.SourceNamespace{Namespace: namespace}
+	newNs := &model.SourceNamespace{ID: helper.SrcNamespaceID(s.ID, namespace), Namespace: namespace}
 	newNs = registerSourceName(newNs, name, qualifier)
 	s.Namespaces = append(s.Namespaces, newNs)
 	return s
@@ -81,6 +82,7 @@ func registerSourceName(ns *model.SourceNamespace, name, qualifier string) *mode
 	}
 	newN := &model.SourceName{Name: name}
 	newN = sortQualifier(newN, qualifier)
+	newN.ID = helper.SrcNameID(ns.ID, name, newN.Tag, newN.Commit)
 	ns.Names = append(ns.Names, newN)
 	return ns
 }
@@ -131,6 +133,18 @@ func (c *demoClient) Sources(ctx context.Context, sourceSpec *model.SourceSpec)
 	return sources, nil
 }
 
+func (c *demoClient) SourcesList(ctx context.Context, sourceSpec *model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	sources, err := c.Sources(ctx, sourceSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(helper.FlattenSources(sources), helper.SourceKey, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.SourceConnection(page), nil
+}
+
 func filterSourceNamespace(src *model.Source, sourceSpec *model.SourceSpec) (*model.Source, error) {
 	var namespaces []*model.SourceNamespace
 	for _, ns := range src.Namespaces {
@@ -148,6 +162,7 @@ func filterSourceNamespace(src *model.Source, sourceSpec *model.SourceSpec) (*mo
 		return nil, nil
 	}
 	return &model.Source{
+		ID:         src.ID,
 		Type:       src.Type,
 		Namespaces: namespaces,
 	}, nil
@@ -170,6 +185,7 @@ func filterSourceName(ns *model.SourceNamespace, sourceSpec *model.SourceSpec) (
 		return nil, nil
 	}
 	return &model.SourceNamespace{
+		ID:        ns.ID,
 		Namespace: ns.Namespace,
 		Names:     names,
 	}, nil
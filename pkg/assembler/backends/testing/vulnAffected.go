@@ -0,0 +1,342 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Ingest VulnAffected
+
+func (c *demoClient) registerVulnAffected(selectedPackage *model.Package, selectedVuln model.OsvCveOrGhsa, vulnAffected model.VulnAffectedInputSpec) *model.VulnAffected {
+
+	ranges := []*model.AffectedRange{}
+	for _, r := range vulnAffected.Ranges {
+		events := []*model.AffectedEvent{}
+		for _, e := range r.Events {
+			events = append(events, &model.AffectedEvent{
+				EventType: e.EventType,
+				Version:   e.Version,
+			})
+		}
+		ranges = append(ranges, &model.AffectedRange{
+			RangeType: r.RangeType,
+			Repo:      r.Repo,
+			Events:    events,
+		})
+	}
+
+	for _, a := range c.vulnAffected {
+		if a.Vulnerability == selectedVuln && samePackageName(a.Package, selectedPackage) &&
+			a.Origin == vulnAffected.Origin && a.Collector == vulnAffected.Collector {
+			// a newer document from the same origin replaces the affected versions
+			a.Ranges = ranges
+			a.Versions = vulnAffected.Versions
+			return a
+		}
+	}
+
+	newVulnAffected := &model.VulnAffected{
+		ID:            c.evidenceID("VulnAffected"),
+		Package:       selectedPackage,
+		Vulnerability: selectedVuln,
+		Ranges:        ranges,
+		Versions:      vulnAffected.Versions,
+		Origin:        vulnAffected.Origin,
+		Collector:     vulnAffected.Collector,
+	}
+	c.vulnAffected = append(c.vulnAffected, newVulnAffected)
+	return newVulnAffected
+}
+
+func (c *demoClient) IngestVulnAffected(ctx context.Context, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, vulnAffected model.VulnAffectedInputSpec) (*model.VulnAffected, error) {
+
+	err := helper.ValidateVulnAffectedIngestionInput(vulnerability)
+	if err != nil {
+		return nil, err
+	}
+
+	// affected versions are always recorded at the package name level
+	selectedPkgSpec := &model.PkgSpec{
+		Type:      &pkg.Type,
+		Namespace: pkg.Namespace,
+		Name:      &pkg.Name,
+	}
+	collectedPkg, err := c.Packages(ctx, selectedPkgSpec)
+	if err != nil {
+		return nil, err
+	}
+	if len(collectedPkg) != 1 {
+		return nil, gqlerror.Errorf(
+			"IngestVulnAffected :: package argument must match one, found %d",
+			len(collectedPkg))
+	}
+	selectedPackage := packageNameOnly(collectedPkg[0])
+
+	if vulnerability.Osv != nil {
+		osvSpec := helper.ConvertOsvInputSpecToOsvSpec(vulnerability.Osv)
+
+		collectedOsv, err := c.Osv(ctx, osvSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedOsv) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVulnAffected :: osv argument must match one, found %d",
+				len(collectedOsv))
+		}
+		return c.registerVulnAffected(selectedPackage, collectedOsv[0], vulnAffected), nil
+	}
+
+	if vulnerability.Cve != nil {
+		cveSpec := helper.ConvertCveInputSpecToCveSpec(vulnerability.Cve)
+
+		collectedCve, err := c.Cve(ctx, cveSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedCve) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVulnAffected :: cve argument must match one, found %d",
+				len(collectedCve))
+		}
+		return c.registerVulnAffected(selectedPackage, collectedCve[0], vulnAffected), nil
+	}
+
+	if vulnerability.Ghsa != nil {
+		ghsaSpec := helper.ConvertGhsaInputSpecToGhsaSpec(vulnerability.Ghsa)
+
+		collectedGhsa, err := c.Ghsa(ctx, ghsaSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedGhsa) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVulnAffected :: ghsa argument must match one, found %d",
+				len(collectedGhsa))
+		}
+		return c.registerVulnAffected(selectedPackage, collectedGhsa[0], vulnAffected), nil
+	}
+
+	// it should never reach here else it failed
+	return nil, gqlerror.Errorf("IngestVulnAffected failed")
+}
+
+// Query VulnAffected
+
+func (c *demoClient) VulnAffected(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec) ([]*model.VulnAffected, error) {
+
+	queryAll, err := helper.ValidateOsvCveOrGhsaQueryInput(vulnAffectedSpec.Vulnerability)
+	if err != nil {
+		return nil, err
+	}
+	var foundVulnAffected []*model.VulnAffected
+
+	for _, a := range c.vulnAffected {
+		matchOrSkip := true
+
+		if vulnAffectedSpec.ID != nil && a.ID != *vulnAffectedSpec.ID {
+			matchOrSkip = false
+		}
+
+		if vulnAffectedSpec.Origin != nil && a.Origin != *vulnAffectedSpec.Origin {
+			matchOrSkip = false
+		}
+		if vulnAffectedSpec.Collector != nil && a.Collector != *vulnAffectedSpec.Collector {
+			matchOrSkip = false
+		}
+
+		if vulnAffectedSpec.Package != nil {
+			// the package is stored at the name level, so only the type,
+			// namespace and name are matched
+			pkgSpec := vulnAffectedSpec.Package
+			ns := a.Package.Namespaces[0]
+			if pkgSpec.Type != nil && a.Package.Type != *pkgSpec.Type {
+				matchOrSkip = false
+			}
+			if pkgSpec.Namespace != nil && ns.Namespace != *pkgSpec.Namespace {
+				matchOrSkip = false
+			}
+			if pkgSpec.Name != nil && ns.Names[0].Name != *pkgSpec.Name {
+				matchOrSkip = false
+			}
+		}
+
+		if !queryAll {
+			if vulnAffectedSpec.Vulnerability.Cve != nil {
+				if val, ok := a.Vulnerability.(*model.Cve); ok {
+					if vulnAffectedSpec.Vulnerability.Cve.Year == nil || val.Year == *vulnAffectedSpec.Vulnerability.Cve.Year {
+						newCve, err := filterCVEID(val, vulnAffectedSpec.Vulnerability.Cve)
+						if err != nil {
+							return nil, err
+						}
+						if newCve == nil {
+							matchOrSkip = false
+						}
+					} else {
+						matchOrSkip = false
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+
+			if vulnAffectedSpec.Vulnerability.Osv != nil {
+				if val, ok := a.Vulnerability.(*model.Osv); ok {
+					newOSV, err := filterOSVID(val, vulnAffectedSpec.Vulnerability.Osv)
+					if err != nil {
+						return nil, err
+					}
+					if newOSV == nil {
+						matchOrSkip = false
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+
+			if vulnAffectedSpec.Vulnerability.Ghsa != nil {
+				if val, ok := a.Vulnerability.(*model.Ghsa); ok {
+					newGhsa, err := filterGHSAID(val, vulnAffectedSpec.Vulnerability.Ghsa)
+					if err != nil {
+						return nil, err
+					}
+					if newGhsa == nil {
+						matchOrSkip = false
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+
+			// affected versions are never attached to NoVuln
+			if vulnAffectedSpec.Vulnerability.NoVuln != nil && *vulnAffectedSpec.Vulnerability.NoVuln {
+				matchOrSkip = false
+			}
+		}
+
+		if matchOrSkip {
+			foundVulnAffected = append(foundVulnAffected, a)
+		}
+	}
+
+	return foundVulnAffected, nil
+}
+
+func (c *demoClient) VulnAffectedList(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec, after *string, first *int) (*model.VulnAffectedConnection, error) {
+	found, err := c.VulnAffected(ctx, vulnAffectedSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.VulnAffected) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnAffectedConnection(page), nil
+}
+
+// Query AffectedPackages
+
+func (c *demoClient) AffectedPackages(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec) ([]*model.AffectedPackage, error) {
+
+	foundVulnAffected, err := c.VulnAffected(ctx, vulnAffectedSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	var affectedPackages []*model.AffectedPackage
+	for _, a := range foundVulnAffected {
+		ns := a.Package.Namespaces[0]
+		pkgSpec := &model.PkgSpec{
+			Type:      &a.Package.Type,
+			Namespace: &ns.Namespace,
+			Name:      &ns.Names[0].Name,
+		}
+		collectedPkg, err := c.Packages(ctx, pkgSpec)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range collectedPkg {
+			for _, n := range p.Namespaces {
+				for _, name := range n.Names {
+					for _, v := range name.Versions {
+						if !helper.IsVersionAffected(p.Type, v.Version, a) {
+							continue
+						}
+						affectedPackages = append(affectedPackages, &model.AffectedPackage{
+							Package: &model.Package{
+								ID:   p.ID,
+								Type: p.Type,
+								Namespaces: []*model.PackageNamespace{{
+									ID:        n.ID,
+									Namespace: n.Namespace,
+									Names: []*model.PackageName{{
+										ID:       name.ID,
+										Name:     name.Name,
+										Versions: []*model.PackageVersion{v},
+									}},
+								}},
+							},
+							VulnAffected: a,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return affectedPackages, nil
+}
+
+func (c *demoClient) AffectedPackagesList(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec, after *string, first *int) (*model.AffectedPackageConnection, error) {
+	found, err := c.AffectedPackages(ctx, vulnAffectedSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, helper.AffectedPackageKey, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.AffectedPackageConnection(page), nil
+}
+
+// packageNameOnly returns a copy of the package trie without any version
+func packageNameOnly(pkg *model.Package) *model.Package {
+	ns := pkg.Namespaces[0]
+	return &model.Package{
+		ID:   pkg.ID,
+		Type: pkg.Type,
+		Namespaces: []*model.PackageNamespace{{
+			ID:        ns.ID,
+			Namespace: ns.Namespace,
+			Names: []*model.PackageName{{
+				ID:       ns.Names[0].ID,
+				Name:     ns.Names[0].Name,
+				Versions: []*model.PackageVersion{},
+			}},
+		}},
+	}
+}
+
+func samePackageName(a, b *model.Package) bool {
+	return a.Type == b.Type &&
+		a.Namespaces[0].Namespace == b.Namespaces[0].Namespace &&
+		a.Namespaces[0].Names[0].Name == b.Namespaces[0].Names[0].Name
+}
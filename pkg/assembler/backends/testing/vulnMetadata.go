@@ -0,0 +1,255 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/backends/helper"
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Ingest VulnMetadata
+
+func (c *demoClient) registerVulnMetadata(selectedVuln model.OsvCveOrGhsa, vulnMetadata model.VulnMetadataInputSpec) *model.VulnMetadata {
+
+	scores := []*model.VulnScore{}
+	for _, s := range vulnMetadata.Scores {
+		scores = append(scores, &model.VulnScore{
+			ScoreType: s.ScoreType,
+			Vector:    s.Vector,
+			Score:     s.Score,
+		})
+	}
+
+	for _, m := range c.vulnMetadata {
+		if m.Vulnerability == selectedVuln && m.Origin == vulnMetadata.Origin && m.Collector == vulnMetadata.Collector {
+			// a newer document from the same origin replaces the metadata
+			m.Summary = vulnMetadata.Summary
+			m.Scores = scores
+			m.CweIds = vulnMetadata.CweIds
+			m.References = vulnMetadata.References
+			m.Published = vulnMetadata.Published
+			m.Modified = vulnMetadata.Modified
+			return m
+		}
+	}
+
+	newVulnMetadata := &model.VulnMetadata{
+		ID:            c.evidenceID("VulnMetadata"),
+		Vulnerability: selectedVuln,
+		Summary:       vulnMetadata.Summary,
+		Scores:        scores,
+		CweIds:        vulnMetadata.CweIds,
+		References:    vulnMetadata.References,
+		Published:     vulnMetadata.Published,
+		Modified:      vulnMetadata.Modified,
+		Origin:        vulnMetadata.Origin,
+		Collector:     vulnMetadata.Collector,
+	}
+	c.vulnMetadata = append(c.vulnMetadata, newVulnMetadata)
+	return newVulnMetadata
+}
+
+func (c *demoClient) IngestVulnMetadata(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, vulnMetadata model.VulnMetadataInputSpec) (*model.VulnMetadata, error) {
+
+	err := helper.ValidateVulnMetadataIngestionInput(vulnerability)
+	if err != nil {
+		return nil, err
+	}
+
+	if vulnerability.Osv != nil {
+		osvSpec := helper.ConvertOsvInputSpecToOsvSpec(vulnerability.Osv)
+
+		collectedOsv, err := c.Osv(ctx, osvSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedOsv) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVulnMetadata :: osv argument must match one, found %d",
+				len(collectedOsv))
+		}
+		return c.registerVulnMetadata(collectedOsv[0], vulnMetadata), nil
+	}
+
+	if vulnerability.Cve != nil {
+		cveSpec := helper.ConvertCveInputSpecToCveSpec(vulnerability.Cve)
+
+		collectedCve, err := c.Cve(ctx, cveSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedCve) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVulnMetadata :: cve argument must match one, found %d",
+				len(collectedCve))
+		}
+		return c.registerVulnMetadata(collectedCve[0], vulnMetadata), nil
+	}
+
+	if vulnerability.Ghsa != nil {
+		ghsaSpec := helper.ConvertGhsaInputSpecToGhsaSpec(vulnerability.Ghsa)
+
+		collectedGhsa, err := c.Ghsa(ctx, ghsaSpec)
+		if err != nil {
+			return nil, err
+		}
+		if len(collectedGhsa) != 1 {
+			return nil, gqlerror.Errorf(
+				"IngestVulnMetadata :: ghsa argument must match one, found %d",
+				len(collectedGhsa))
+		}
+		return c.registerVulnMetadata(collectedGhsa[0], vulnMetadata), nil
+	}
+
+	// it should never reach here else it failed
+	return nil, gqlerror.Errorf("IngestVulnMetadata failed")
+}
+
+// Query VulnMetadata
+
+func (c *demoClient) VulnMetadata(ctx context.Context, vulnMetadataSpec *model.VulnMetadataSpec) ([]*model.VulnMetadata, error) {
+
+	queryAll, err := helper.ValidateOsvCveOrGhsaQueryInput(vulnMetadataSpec.Vulnerability)
+	if err != nil {
+		return nil, err
+	}
+	var foundVulnMetadata []*model.VulnMetadata
+
+	for _, m := range c.vulnMetadata {
+		matchOrSkip := true
+
+		if vulnMetadataSpec.ID != nil && m.ID != *vulnMetadataSpec.ID {
+			matchOrSkip = false
+		}
+
+		if vulnMetadataSpec.Origin != nil && m.Origin != *vulnMetadataSpec.Origin {
+			matchOrSkip = false
+		}
+		if vulnMetadataSpec.Collector != nil && m.Collector != *vulnMetadataSpec.Collector {
+			matchOrSkip = false
+		}
+		if vulnMetadataSpec.PublishedSince != nil && (m.Published == nil || m.Published.Before(*vulnMetadataSpec.PublishedSince)) {
+			matchOrSkip = false
+		}
+		if vulnMetadataSpec.CweID != nil && !containsString(m.CweIds, *vulnMetadataSpec.CweID) {
+			matchOrSkip = false
+		}
+		if (vulnMetadataSpec.ScoreType != nil || vulnMetadataSpec.MinScore != nil || vulnMetadataSpec.MaxScore != nil) && !matchVulnScores(m.Scores, vulnMetadataSpec) {
+			matchOrSkip = false
+		}
+
+		if !queryAll {
+			if vulnMetadataSpec.Vulnerability.Cve != nil {
+				if val, ok := m.Vulnerability.(*model.Cve); ok {
+					if vulnMetadataSpec.Vulnerability.Cve.Year == nil || val.Year == *vulnMetadataSpec.Vulnerability.Cve.Year {
+						newCve, err := filterCVEID(val, vulnMetadataSpec.Vulnerability.Cve)
+						if err != nil {
+							return nil, err
+						}
+						if newCve == nil {
+							matchOrSkip = false
+						}
+					} else {
+						matchOrSkip = false
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+
+			if vulnMetadataSpec.Vulnerability.Osv != nil {
+				if val, ok := m.Vulnerability.(*model.Osv); ok {
+					newOSV, err := filterOSVID(val, vulnMetadataSpec.Vulnerability.Osv)
+					if err != nil {
+						return nil, err
+					}
+					if newOSV == nil {
+						matchOrSkip = false
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+
+			if vulnMetadataSpec.Vulnerability.Ghsa != nil {
+				if val, ok := m.Vulnerability.(*model.Ghsa); ok {
+					newGhsa, err := filterGHSAID(val, vulnMetadataSpec.Vulnerability.Ghsa)
+					if err != nil {
+						return nil, err
+					}
+					if newGhsa == nil {
+						matchOrSkip = false
+					}
+				} else {
+					matchOrSkip = false
+				}
+			}
+
+			// metadata is never attached to NoVuln
+			if vulnMetadataSpec.Vulnerability.NoVuln != nil && *vulnMetadataSpec.Vulnerability.NoVuln {
+				matchOrSkip = false
+			}
+		}
+
+		if matchOrSkip {
+			foundVulnMetadata = append(foundVulnMetadata, m)
+		}
+	}
+
+	return foundVulnMetadata, nil
+}
+
+func (c *demoClient) VulnMetadataList(ctx context.Context, vulnMetadataSpec *model.VulnMetadataSpec, after *string, first *int) (*model.VulnMetadataConnection, error) {
+	found, err := c.VulnMetadata(ctx, vulnMetadataSpec)
+	if err != nil {
+		return nil, err
+	}
+	page, err := helper.Paginate(found, func(n *model.VulnMetadata) string { return n.ID }, after, first)
+	if err != nil {
+		return nil, err
+	}
+	return helper.VulnMetadataConnection(page), nil
+}
+
+// matchVulnScores returns true if at least one score of the requested type
+// lies within the requested (inclusive) range
+func matchVulnScores(scores []*model.VulnScore, vulnMetadataSpec *model.VulnMetadataSpec) bool {
+	for _, s := range scores {
+		if vulnMetadataSpec.ScoreType != nil && s.ScoreType != *vulnMetadataSpec.ScoreType {
+			continue
+		}
+		if vulnMetadataSpec.MinScore != nil && s.Score < *vulnMetadataSpec.MinScore {
+			continue
+		}
+		if vulnMetadataSpec.MaxScore != nil && s.Score > *vulnMetadataSpec.MaxScore {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -45,6 +45,65 @@ func GetAssembler(ctx context.Context, gqlclient graphql.Client) func([]assemble
 				return err
 			}
 
+			logger.Infof("assembling HasSLSA: %v", len(p.HasSlsa))
+			if err := ingestHasSlsa(ctx, gqlclient, p.HasSlsa); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling CertifyVuln: %v", len(p.CertifyVuln))
+			if err := ingestCertifyVuln(ctx, gqlclient, p.CertifyVuln); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling IsVuln: %v", len(p.IsVuln))
+			if err := ingestIsVuln(ctx, gqlclient, p.IsVuln); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling HasSourceAt: %v", len(p.HasSourceAt))
+			if err := ingestHasSourceAt(ctx, gqlclient, p.HasSourceAt); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling CertifyBad: %v", len(p.CertifyBad))
+			if err := ingestCertifyBad(ctx, gqlclient, p.CertifyBad); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling HasSBOM: %v", len(p.HasSBOM))
+			if err := ingestHasSBOM(ctx, gqlclient, p.HasSBOM); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling HashEqual: %v", len(p.HashEqual))
+			if err := ingestHashEqual(ctx, gqlclient, p.HashEqual); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling CertifyPkg: %v", len(p.CertifyPkg))
+			if err := ingestCertifyPkg(ctx, gqlclient, p.CertifyPkg); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling VEX: %v", len(p.Vex))
+			if err := ingestVex(ctx, gqlclient, p.Vex); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling VulnMetadata: %v", len(p.VulnMetadata))
+			if err := ingestVulnMetadata(ctx, gqlclient, p.VulnMetadata); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling VulnAffected: %v", len(p.VulnAffected))
+			if err := ingestVulnAffected(ctx, gqlclient, p.VulnAffected); err != nil {
+				return err
+			}
+
+			logger.Infof("assembling CertifyEOL: %v", len(p.CertifyEOL))
+			if err := ingestCertifyEOL(ctx, gqlclient, p.CertifyEOL); err != nil {
+				return err
+			}
 		}
 		return nil
 	}
@@ -52,6 +111,10 @@ func GetAssembler(ctx context.Context, gqlclient graphql.Client) func([]assemble
 
 func ingestCertifyScorecards(ctx context.Context, client graphql.Client, vs []assembler.CertifyScorecardIngest) error {
 	for _, v := range vs {
+		if countNonNil(v.Source != nil, v.Scorecard != nil) != 2 {
+			return fmt.Errorf("unable to create CertifyScorecard without both Source and Scorecard specified")
+		}
+
 		_, err := model.Scorecard(ctx, client, *v.Source, *v.Scorecard)
 		if err != nil {
 			return err
@@ -62,6 +125,10 @@ func ingestCertifyScorecards(ctx context.Context, client graphql.Client, vs []as
 
 func ingestIsDependency(ctx context.Context, client graphql.Client, vs []assembler.IsDependencyIngest) error {
 	for _, v := range vs {
+		if countNonNil(v.Pkg != nil, v.DepPkg != nil, v.IsDependency != nil) != 3 {
+			return fmt.Errorf("unable to create IsDependency without Pkg, DepPkg and IsDependency specified")
+		}
+
 		_, err := model.IsDependency(ctx, client, *v.Pkg, *v.DepPkg, *v.IsDependency)
 		if err != nil {
 			return err
@@ -72,29 +139,294 @@ func ingestIsDependency(ctx context.Context, client graphql.Client, vs []assembl
 
 func ingestIsOccurence(ctx context.Context, client graphql.Client, vs []assembler.IsOccurenceIngest) error {
 	for _, v := range vs {
-		if v.Pkg != nil && v.Src != nil {
-			return fmt.Errorf("unable to create IsOccurence with both Src and Pkg subject specified")
+		if countNonNil(v.Pkg != nil, v.Src != nil) != 1 {
+			return fmt.Errorf("unable to create IsOccurence without exactly one of Pkg or Src subject specified")
+		}
+		if countNonNil(v.Artifact != nil, v.IsOccurence != nil) != 2 {
+			return fmt.Errorf("unable to create IsOccurence without both Artifact and IsOccurence specified")
+		}
+
+		var err error
+		if v.Src != nil {
+			_, err = model.IsOccurrenceSrc(ctx, client, *v.Src, *v.Artifact, *v.IsOccurence)
+		} else {
+			_, err = model.IsOccurrencePkg(ctx, client, *v.Pkg, *v.Artifact, *v.IsOccurence)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestHasSlsa(ctx context.Context, client graphql.Client, vs []assembler.HasSlsaIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.Pkg != nil, v.Src != nil, v.Artifact != nil) != 1 {
+			return fmt.Errorf("unable to create HasSLSA without exactly one of Pkg, Src or Artifact subject specified")
+		}
+		if countNonNil(v.Builder != nil, v.HasSlsa != nil) != 2 {
+			return fmt.Errorf("unable to create HasSLSA without both Builder and HasSlsa specified")
+		}
+
+		var err error
+		switch {
+		case v.Pkg != nil:
+			_, err = model.SLSAForPackage(ctx, client, *v.Pkg, v.Materials, *v.Builder, *v.HasSlsa)
+		case v.Src != nil:
+			_, err = model.SLSAForSource(ctx, client, *v.Src, v.Materials, *v.Builder, *v.HasSlsa)
+		default:
+			_, err = model.SLSAForArtifact(ctx, client, *v.Artifact, v.Materials, *v.Builder, *v.HasSlsa)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestCertifyVuln(ctx context.Context, client graphql.Client, vs []assembler.CertifyVulnIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.OSV != nil, v.CVE != nil, v.GHSA != nil, v.NoVuln) != 1 {
+			return fmt.Errorf("unable to create CertifyVuln without exactly one of OSV, CVE, GHSA or NoVuln specified")
+		}
+		if countNonNil(v.Pkg != nil, v.VulnData != nil) != 2 {
+			return fmt.Errorf("unable to create CertifyVuln without both Pkg and VulnData specified")
+		}
+
+		var err error
+		switch {
+		case v.NoVuln:
+			_, err = model.CertifyNoKnownVuln(ctx, client, *v.Pkg, *v.VulnData)
+		case v.OSV != nil:
+			_, err = model.CertifyOSV(ctx, client, *v.Pkg, *v.OSV, *v.VulnData)
+		case v.CVE != nil:
+			_, err = model.CertifyCVE(ctx, client, *v.Pkg, *v.CVE, *v.VulnData)
+		default:
+			_, err = model.CertifyGHSA(ctx, client, *v.Pkg, *v.GHSA, *v.VulnData)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestIsVuln(ctx context.Context, client graphql.Client, vs []assembler.IsVulnIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.CVE != nil, v.GHSA != nil) != 1 {
+			return fmt.Errorf("unable to create IsVuln without exactly one of CVE or GHSA specified")
+		}
+		if countNonNil(v.OSV != nil, v.IsVuln != nil) != 2 {
+			return fmt.Errorf("unable to create IsVuln without both OSV and IsVuln specified")
+		}
+
+		var err error
+		if v.CVE != nil {
+			_, err = model.IsVulnerabilityCVE(ctx, client, *v.OSV, *v.CVE, *v.IsVuln)
+		} else {
+			_, err = model.IsVulnerabilityGHSA(ctx, client, *v.OSV, *v.GHSA, *v.IsVuln)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestHasSourceAt(ctx context.Context, client graphql.Client, vs []assembler.HasSourceAtIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.Pkg != nil, v.Src != nil, v.HasSourceAt != nil) != 3 {
+			return fmt.Errorf("unable to create HasSourceAt without Pkg, Src and HasSourceAt specified")
+		}
+
+		_, err := model.HasSourceAt(ctx, client, *v.Pkg, v.PkgMatchFlag, *v.Src, *v.HasSourceAt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestCertifyBad(ctx context.Context, client graphql.Client, vs []assembler.CertifyBadIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.Pkg != nil, v.Src != nil, v.Artifact != nil) != 1 {
+			return fmt.Errorf("unable to create CertifyBad without exactly one of Pkg, Src or Artifact subject specified")
+		}
+		if v.CertifyBad == nil {
+			return fmt.Errorf("unable to create CertifyBad without CertifyBad specified")
+		}
+
+		var err error
+		switch {
+		case v.Pkg != nil:
+			_, err = model.CertifyBadPkg(ctx, client, *v.Pkg, &v.PkgMatchFlag, *v.CertifyBad)
+		case v.Src != nil:
+			_, err = model.CertifyBadSrc(ctx, client, *v.Src, *v.CertifyBad)
+		default:
+			_, err = model.CertifyBadArtifact(ctx, client, *v.Artifact, *v.CertifyBad)
+		}
+		if err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if v.Pkg == nil && v.Src == nil {
-			return fmt.Errorf("unable to create IsOccurence without either Src and Pkg subject specified")
+func ingestHasSBOM(ctx context.Context, client graphql.Client, vs []assembler.HasSBOMIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.Pkg != nil, v.Src != nil) != 1 {
+			return fmt.Errorf("unable to create HasSBOM without exactly one of Pkg or Src subject specified")
+		}
+		if v.HasSBOM == nil {
+			return fmt.Errorf("unable to create HasSBOM without HasSBOM specified")
 		}
 
+		var err error
 		if v.Src != nil {
-			_, err := model.IsOccurrenceSrc(ctx, client, *v.Src, *v.Artifact, *v.IsOccurence)
-			if err != nil {
-				return err
-			}
+			_, err = model.HasSBOMSrc(ctx, client, *v.Src, *v.HasSBOM)
 		} else {
-			_, err := model.IsOccurrencePkg(ctx, client, *v.Pkg, *v.Artifact, *v.IsOccurence)
-			if err != nil {
-				return err
-			}
+			_, err = model.HasSBOMPkg(ctx, client, *v.Pkg, *v.HasSBOM)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+func ingestHashEqual(ctx context.Context, client graphql.Client, vs []assembler.HashEqualIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.Artifact != nil, v.EqualArtifact != nil, v.HashEqual != nil) != 3 {
+			return fmt.Errorf("unable to create HashEqual without Artifact, EqualArtifact and HashEqual specified")
 		}
 
+		_, err := model.HashEqual(ctx, client, *v.Artifact, *v.EqualArtifact, *v.HashEqual)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-// TODO(lumjjb): add more ingestion verbs as they come up
+func ingestCertifyPkg(ctx context.Context, client graphql.Client, vs []assembler.CertifyPkgIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.Pkg != nil, v.DepPkg != nil, v.CertifyPkg != nil) != 3 {
+			return fmt.Errorf("unable to create CertifyPkg without Pkg, DepPkg and CertifyPkg specified")
+		}
+
+		_, err := model.CertifyPkg(ctx, client, *v.Pkg, *v.DepPkg, *v.CertifyPkg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestVex(ctx context.Context, client graphql.Client, vs []assembler.VexIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.Pkg != nil, v.Artifact != nil) != 1 {
+			return fmt.Errorf("unable to create VEX without exactly one of Pkg or Artifact subject specified")
+		}
+		if countNonNil(v.OSV != nil, v.CVE != nil, v.GHSA != nil) != 1 {
+			return fmt.Errorf("unable to create VEX without exactly one of OSV, CVE or GHSA specified")
+		}
+		if v.VexData == nil {
+			return fmt.Errorf("unable to create VEX without VexData specified")
+		}
+
+		var err error
+		switch {
+		case v.Pkg != nil && v.OSV != nil:
+			_, err = model.VexPackageAndOsv(ctx, client, *v.Pkg, *v.OSV, *v.VexData)
+		case v.Pkg != nil && v.CVE != nil:
+			_, err = model.VexPackageAndCve(ctx, client, *v.Pkg, *v.CVE, *v.VexData)
+		case v.Pkg != nil:
+			_, err = model.VEXPackageAndGhsa(ctx, client, *v.Pkg, *v.GHSA, *v.VexData)
+		case v.OSV != nil:
+			_, err = model.VexArtifactAndOsv(ctx, client, *v.Artifact, *v.OSV, *v.VexData)
+		case v.CVE != nil:
+			_, err = model.VexArtifactAndCve(ctx, client, *v.Artifact, *v.CVE, *v.VexData)
+		default:
+			_, err = model.VexArtifactAndGhsa(ctx, client, *v.Artifact, *v.GHSA, *v.VexData)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestVulnMetadata(ctx context.Context, client graphql.Client, vs []assembler.VulnMetadataIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.OSV != nil, v.CVE != nil, v.GHSA != nil) != 1 {
+			return fmt.Errorf("unable to create VulnMetadata without exactly one of OSV, CVE or GHSA specified")
+		}
+		if v.VulnMetadata == nil {
+			return fmt.Errorf("unable to create VulnMetadata without VulnMetadata specified")
+		}
+
+		var err error
+		switch {
+		case v.OSV != nil:
+			_, err = model.VulnMetadataOSV(ctx, client, *v.OSV, *v.VulnMetadata)
+		case v.CVE != nil:
+			_, err = model.VulnMetadataCVE(ctx, client, *v.CVE, *v.VulnMetadata)
+		default:
+			_, err = model.VulnMetadataGHSA(ctx, client, *v.GHSA, *v.VulnMetadata)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestVulnAffected(ctx context.Context, client graphql.Client, vs []assembler.VulnAffectedIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.OSV != nil, v.CVE != nil, v.GHSA != nil) != 1 {
+			return fmt.Errorf("unable to create VulnAffected without exactly one of OSV, CVE or GHSA specified")
+		}
+		if countNonNil(v.Pkg != nil, v.VulnAffected != nil) != 2 {
+			return fmt.Errorf("unable to create VulnAffected without both Pkg and VulnAffected specified")
+		}
+
+		var err error
+		switch {
+		case v.OSV != nil:
+			_, err = model.VulnAffectedOSV(ctx, client, *v.Pkg, *v.OSV, *v.VulnAffected)
+		case v.CVE != nil:
+			_, err = model.VulnAffectedCVE(ctx, client, *v.Pkg, *v.CVE, *v.VulnAffected)
+		default:
+			_, err = model.VulnAffectedGHSA(ctx, client, *v.Pkg, *v.GHSA, *v.VulnAffected)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ingestCertifyEOL(ctx context.Context, client graphql.Client, vs []assembler.CertifyEOLIngest) error {
+	for _, v := range vs {
+		if countNonNil(v.Pkg != nil, v.CertifyEOL != nil) != 2 {
+			return fmt.Errorf("unable to create CertifyEOL without both Pkg and CertifyEOL specified")
+		}
+
+		_, err := model.CertifyEOL(ctx, client, *v.Pkg, *v.CertifyEOL)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countNonNil returns how many of the passed presence flags are set
+func countNonNil(present ...bool) int {
+	n := 0
+	for _, p := range present {
+		if p {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,221 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// recordingClient is a graphql.Client that records the operations it is
+// asked to run without sending them anywhere
+type recordingClient struct {
+	ops []string
+}
+
+func (c *recordingClient) MakeRequest(_ context.Context, req *graphql.Request, _ *graphql.Response) error {
+	c.ops = append(c.ops, req.OpName)
+	return nil
+}
+
+func TestGetAssembler(t *testing.T) {
+	pkg := &model.PkgInputSpec{Type: "golang", Name: "foo"}
+	src := &model.SourceInputSpec{Type: "git", Namespace: "github.com", Name: "foo"}
+	art := &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abc"}
+	osv := &model.OSVInputSpec{OsvId: "GHSA-xxxx"}
+	cve := &model.CVEInputSpec{Year: "2023", CveId: "CVE-2023-1"}
+	ghsa := &model.GHSAInputSpec{GhsaId: "GHSA-xxxx"}
+	vulnData := &model.VulnerabilityMetaDataInput{}
+
+	tests := []struct {
+		name    string
+		preds   assembler.IngestPredicates
+		wantOps []string
+		wantErr bool
+	}{{
+		name: "scorecard and dependency",
+		preds: assembler.IngestPredicates{
+			CertifyScorecard: []assembler.CertifyScorecardIngest{{Source: src, Scorecard: &model.ScorecardInputSpec{}}},
+			IsDependency:     []assembler.IsDependencyIngest{{Pkg: pkg, DepPkg: pkg, IsDependency: &model.IsDependencyInputSpec{}}},
+		},
+		wantOps: []string{"Scorecard", "IsDependency"},
+	}, {
+		name: "occurrence per subject",
+		preds: assembler.IngestPredicates{
+			IsOccurence: []assembler.IsOccurenceIngest{
+				{Pkg: pkg, Artifact: art, IsOccurence: &model.IsOccurrenceInputSpec{}},
+				{Src: src, Artifact: art, IsOccurence: &model.IsOccurrenceInputSpec{}},
+			},
+		},
+		wantOps: []string{"IsOccurrencePkg", "IsOccurrenceSrc"},
+	}, {
+		name: "occurrence with both subjects",
+		preds: assembler.IngestPredicates{
+			IsOccurence: []assembler.IsOccurenceIngest{{Pkg: pkg, Src: src, Artifact: art, IsOccurence: &model.IsOccurrenceInputSpec{}}},
+		},
+		wantErr: true,
+	}, {
+		name: "slsa per subject",
+		preds: assembler.IngestPredicates{
+			HasSlsa: []assembler.HasSlsaIngest{
+				{Pkg: pkg, Builder: &model.BuilderInputSpec{}, HasSlsa: &model.SLSAInputSpec{}},
+				{Src: src, Builder: &model.BuilderInputSpec{}, HasSlsa: &model.SLSAInputSpec{}},
+				{Artifact: art, Builder: &model.BuilderInputSpec{}, HasSlsa: &model.SLSAInputSpec{}},
+			},
+		},
+		wantOps: []string{"SLSAForPackage", "SLSAForSource", "SLSAForArtifact"},
+	}, {
+		name: "certify vuln per vulnerability",
+		preds: assembler.IngestPredicates{
+			CertifyVuln: []assembler.CertifyVulnIngest{
+				{Pkg: pkg, NoVuln: true, VulnData: vulnData},
+				{Pkg: pkg, OSV: osv, VulnData: vulnData},
+				{Pkg: pkg, CVE: cve, VulnData: vulnData},
+				{Pkg: pkg, GHSA: ghsa, VulnData: vulnData},
+			},
+		},
+		wantOps: []string{"CertifyNoKnownVuln", "CertifyOSV", "CertifyCVE", "CertifyGHSA"},
+	}, {
+		name: "certify vuln without vulnerability",
+		preds: assembler.IngestPredicates{
+			CertifyVuln: []assembler.CertifyVulnIngest{{Pkg: pkg, VulnData: vulnData}},
+		},
+		wantErr: true,
+	}, {
+		name: "is vuln per alias",
+		preds: assembler.IngestPredicates{
+			IsVuln: []assembler.IsVulnIngest{
+				{OSV: osv, CVE: cve, IsVuln: &model.IsVulnerabilityInputSpec{}},
+				{OSV: osv, GHSA: ghsa, IsVuln: &model.IsVulnerabilityInputSpec{}},
+			},
+		},
+		wantOps: []string{"IsVulnerabilityCVE", "IsVulnerabilityGHSA"},
+	}, {
+		name: "is vuln with both aliases",
+		preds: assembler.IngestPredicates{
+			IsVuln: []assembler.IsVulnIngest{{OSV: osv, CVE: cve, GHSA: ghsa, IsVuln: &model.IsVulnerabilityInputSpec{}}},
+		},
+		wantErr: true,
+	}, {
+		name: "has source at",
+		preds: assembler.IngestPredicates{
+			HasSourceAt: []assembler.HasSourceAtIngest{{Pkg: pkg, Src: src, HasSourceAt: &model.HasSourceAtInputSpec{}}},
+		},
+		wantOps: []string{"HasSourceAt"},
+	}, {
+		name: "has source at without source",
+		preds: assembler.IngestPredicates{
+			HasSourceAt: []assembler.HasSourceAtIngest{{Pkg: pkg, HasSourceAt: &model.HasSourceAtInputSpec{}}},
+		},
+		wantErr: true,
+	}, {
+		name: "certify bad per subject",
+		preds: assembler.IngestPredicates{
+			CertifyBad: []assembler.CertifyBadIngest{
+				{Pkg: pkg, CertifyBad: &model.CertifyBadInputSpec{}},
+				{Src: src, CertifyBad: &model.CertifyBadInputSpec{}},
+				{Artifact: art, CertifyBad: &model.CertifyBadInputSpec{}},
+			},
+		},
+		wantOps: []string{"CertifyBadPkg", "CertifyBadSrc", "CertifyBadArtifact"},
+	}, {
+		name: "sbom, hash equal and certify pkg",
+		preds: assembler.IngestPredicates{
+			HasSBOM: []assembler.HasSBOMIngest{
+				{Pkg: pkg, HasSBOM: &model.HasSBOMInputSpec{}},
+				{Src: src, HasSBOM: &model.HasSBOMInputSpec{}},
+			},
+			HashEqual:  []assembler.HashEqualIngest{{Artifact: art, EqualArtifact: art, HashEqual: &model.HashEqualInputSpec{}}},
+			CertifyPkg: []assembler.CertifyPkgIngest{{Pkg: pkg, DepPkg: pkg, CertifyPkg: &model.CertifyPkgInputSpec{}}},
+		},
+		wantOps: []string{"HasSBOMPkg", "HasSBOMSrc", "HashEqual", "CertifyPkg"},
+	}, {
+		name: "certify pkg without dependency",
+		preds: assembler.IngestPredicates{
+			CertifyPkg: []assembler.CertifyPkgIngest{{Pkg: pkg, CertifyPkg: &model.CertifyPkgInputSpec{}}},
+		},
+		wantErr: true,
+	}, {
+		name: "vex per subject and vulnerability",
+		preds: assembler.IngestPredicates{
+			Vex: []assembler.VexIngest{
+				{Pkg: pkg, OSV: osv, VexData: &model.VexStatementInputSpec{}},
+				{Pkg: pkg, CVE: cve, VexData: &model.VexStatementInputSpec{}},
+				{Pkg: pkg, GHSA: ghsa, VexData: &model.VexStatementInputSpec{}},
+				{Artifact: art, OSV: osv, VexData: &model.VexStatementInputSpec{}},
+				{Artifact: art, CVE: cve, VexData: &model.VexStatementInputSpec{}},
+				{Artifact: art, GHSA: ghsa, VexData: &model.VexStatementInputSpec{}},
+			},
+		},
+		wantOps: []string{"VexPackageAndOsv", "VexPackageAndCve", "VEXPackageAndGhsa", "VexArtifactAndOsv", "VexArtifactAndCve", "VexArtifactAndGhsa"},
+	}, {
+		name: "vex with both subjects",
+		preds: assembler.IngestPredicates{
+			Vex: []assembler.VexIngest{{Pkg: pkg, Artifact: art, OSV: osv, VexData: &model.VexStatementInputSpec{}}},
+		},
+		wantErr: true,
+	}, {
+		name: "vuln metadata, affected and eol",
+		preds: assembler.IngestPredicates{
+			VulnMetadata: []assembler.VulnMetadataIngest{
+				{OSV: osv, VulnMetadata: &model.VulnMetadataInputSpec{}},
+				{CVE: cve, VulnMetadata: &model.VulnMetadataInputSpec{}},
+				{GHSA: ghsa, VulnMetadata: &model.VulnMetadataInputSpec{}},
+			},
+			VulnAffected: []assembler.VulnAffectedIngest{
+				{Pkg: pkg, OSV: osv, VulnAffected: &model.VulnAffectedInputSpec{}},
+				{Pkg: pkg, CVE: cve, VulnAffected: &model.VulnAffectedInputSpec{}},
+				{Pkg: pkg, GHSA: ghsa, VulnAffected: &model.VulnAffectedInputSpec{}},
+			},
+			CertifyEOL: []assembler.CertifyEOLIngest{{Pkg: pkg, CertifyEOL: &model.CertifyEOLInputSpec{}}},
+		},
+		wantOps: []string{
+			"VulnMetadataOSV", "VulnMetadataCVE", "VulnMetadataGHSA",
+			"VulnAffectedOSV", "VulnAffectedCVE", "VulnAffectedGHSA",
+			"CertifyEOL",
+		},
+	}, {
+		name: "certify eol without data",
+		preds: assembler.IngestPredicates{
+			CertifyEOL: []assembler.CertifyEOLIngest{{Pkg: pkg}},
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := &recordingClient{}
+			err := GetAssembler(ctx, client)([]assembler.IngestPredicates{tt.preds})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetAssembler() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if len(client.ops) != 0 {
+					t.Errorf("GetAssembler() issued %v for invalid predicates", client.ops)
+				}
+				return
+			}
+			if diff := cmp.Diff(tt.wantOps, client.ops); diff != "" {
+				t.Errorf("unexpected operations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// Connection is implemented by the results of the paginated list queries,
+// holding a page of edges of type E.
+type Connection[E any] interface {
+	GetTotalCount() int
+	GetPageInfo() model.PageInfo
+	GetEdges() []E
+}
+
+// ListFunc runs a paginated list query for the page starting after the given
+// cursor, nil for the first page.
+type ListFunc[E any, C Connection[E]] func(ctx context.Context, after *string) (C, error)
+
+// ForEachPage runs a paginated list query page by page, calling handle with
+// the edges of each page, until the last page is read or an error occurs. A
+// page announcing a next page without edges or end cursor is an error, as
+// reading on would not make progress.
+//
+// For example, to read all pypi packages:
+//
+//	var list helpers.ListFunc[model.PackagesListPackagesListPackageConnectionEdgesPackageEdge, *model.PackagesListPackagesListPackageConnection] = func(ctx context.Context, after *string) (*model.PackagesListPackagesListPackageConnection, error) {
+//		resp, err := model.PackagesList(ctx, gqlclient, model.PkgSpec{Type: &pypi}, after, nil)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return &resp.PackagesList, nil
+//	}
+//	err := helpers.ForEachPage(ctx, list, handle)
+func ForEachPage[E any, C Connection[E]](ctx context.Context, list ListFunc[E, C], handle func([]E) error) error {
+	var after *string
+	for {
+		conn, err := list(ctx, after)
+		if err != nil {
+			return err
+		}
+		if err := handle(conn.GetEdges()); err != nil {
+			return err
+		}
+		pageInfo := conn.GetPageInfo()
+		if !pageInfo.HasNextPage {
+			return nil
+		}
+		if len(conn.GetEdges()) == 0 || pageInfo.EndCursor == nil {
+			return fmt.Errorf("list query returned a next page without edges or end cursor")
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+// ListAll runs a paginated list query page by page and returns the edges of
+// all pages.
+func ListAll[E any, C Connection[E]](ctx context.Context, list ListFunc[E, C]) ([]E, error) {
+	var edges []E
+	err := ForEachPage(ctx, list, func(page []E) error {
+		edges = append(edges, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return edges, nil
+}
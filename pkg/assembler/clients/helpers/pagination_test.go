@@ -0,0 +1,133 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	gqlgenerated "github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	gqlmodel "github.com/guacsec/guac/pkg/assembler/graphql/model"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+)
+
+type testConnection struct {
+	edges    []string
+	pageInfo model.PageInfo
+}
+
+func (c *testConnection) GetTotalCount() int          { return len(c.edges) }
+func (c *testConnection) GetPageInfo() model.PageInfo { return c.pageInfo }
+func (c *testConnection) GetEdges() []string          { return c.edges }
+
+func TestListAll(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	tests := []struct {
+		name    string
+		pages   map[string]*testConnection
+		want    []string
+		wantErr bool
+	}{{
+		name: "all pages",
+		pages: map[string]*testConnection{
+			"":  {edges: []string{"a", "b"}, pageInfo: model.PageInfo{HasNextPage: true, EndCursor: ptr("b")}},
+			"b": {edges: []string{"c"}, pageInfo: model.PageInfo{EndCursor: ptr("c")}},
+		},
+		want: []string{"a", "b", "c"},
+	}, {
+		name: "next page without end cursor",
+		pages: map[string]*testConnection{
+			"": {edges: []string{"a"}, pageInfo: model.PageInfo{HasNextPage: true}},
+		},
+		wantErr: true,
+	}, {
+		name: "next page without edges",
+		pages: map[string]*testConnection{
+			"": {pageInfo: model.PageInfo{HasNextPage: true, EndCursor: ptr("a")}},
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := func(ctx context.Context, after *string) (*testConnection, error) {
+				cursor := ""
+				if after != nil {
+					cursor = *after
+				}
+				page, ok := tt.pages[cursor]
+				if !ok {
+					t.Fatalf("unexpected cursor %q", cursor)
+				}
+				return page, nil
+			}
+			got, err := ListAll[string, *testConnection](context.Background(), list)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListAll() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListAll_artifacts(t *testing.T) {
+	ctx := context.Background()
+	backend, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("GetEmptyBackend() error = %v", err)
+	}
+	config := gqlgenerated.Config{Resolvers: &resolvers.Resolver{Backend: backend}}
+	srv := httptest.NewServer(handler.NewDefaultServer(gqlgenerated.NewExecutableSchema(config)))
+	defer srv.Close()
+	client := graphql.NewClient(srv.URL, http.DefaultClient)
+
+	want := []string{"sha256:1234", "sha256:5678", "sha256:9abc"}
+	for _, a := range want {
+		algorithm, digest := a[:6], a[7:]
+		if _, err := backend.IngestArtifact(ctx, &gqlmodel.ArtifactInputSpec{Algorithm: algorithm, Digest: digest}); err != nil {
+			t.Fatalf("IngestArtifact() error = %v", err)
+		}
+	}
+
+	// read the artifacts one per page
+	first := 1
+	var list ListFunc[model.ArtifactsListArtifactsListArtifactConnectionEdgesArtifactEdge, *model.ArtifactsListArtifactsListArtifactConnection] = func(ctx context.Context, after *string) (*model.ArtifactsListArtifactsListArtifactConnection, error) {
+		resp, err := model.ArtifactsList(ctx, client, model.ArtifactSpec{}, after, &first)
+		if err != nil {
+			return nil, err
+		}
+		return &resp.ArtifactsList, nil
+	}
+	edges, err := ListAll(ctx, list)
+	if err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+	got := []string{}
+	for _, e := range edges {
+		got = append(got, e.Node.Algorithm+":"+e.Node.Digest)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListAll() mismatch (-want +got):\n%s", diff)
+	}
+}
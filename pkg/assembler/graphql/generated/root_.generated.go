@@ -38,60 +38,224 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	AffectedEvent struct {
+		EventType func(childComplexity int) int
+		Version   func(childComplexity int) int
+	}
+
+	AffectedPackage struct {
+		Package      func(childComplexity int) int
+		VulnAffected func(childComplexity int) int
+	}
+
+	AffectedPackageConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	AffectedPackageEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	AffectedRange struct {
+		Events    func(childComplexity int) int
+		RangeType func(childComplexity int) int
+		Repo      func(childComplexity int) int
+	}
+
 	Artifact struct {
 		Algorithm func(childComplexity int) int
 		Digest    func(childComplexity int) int
+		ID        func(childComplexity int) int
+	}
+
+	ArtifactConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	ArtifactEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	Builder struct {
+		ID  func(childComplexity int) int
 		URI func(childComplexity int) int
 	}
 
+	BuilderConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	BuilderEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CVE struct {
 		CveID func(childComplexity int) int
+		ID    func(childComplexity int) int
 		Year  func(childComplexity int) int
 	}
 
+	CVEConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CVEEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CVEId struct {
 		ID func(childComplexity int) int
 	}
 
 	CertifyBad struct {
 		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		Subject       func(childComplexity int) int
 	}
 
+	CertifyBadConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyBadEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	CertifyEOL struct {
+		Collector   func(childComplexity int) int
+		Cycle       func(childComplexity int) int
+		Eol         func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Latest      func(childComplexity int) int
+		Origin      func(childComplexity int) int
+		Package     func(childComplexity int) int
+		Product     func(childComplexity int) int
+		Status      func(childComplexity int) int
+		Support     func(childComplexity int) int
+		TimeChecked func(childComplexity int) int
+	}
+
+	CertifyEOLConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyEOLEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyPkg struct {
 		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		Packages      func(childComplexity int) int
 	}
 
+	CertifyPkgConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyPkgEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyScorecard struct {
+		ID        func(childComplexity int) int
 		Scorecard func(childComplexity int) int
 		Source    func(childComplexity int) int
 	}
 
+	CertifyScorecardConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyScorecardEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	CertifyVEXStatement struct {
-		Collector     func(childComplexity int) int
-		Justification func(childComplexity int) int
-		KnownSince    func(childComplexity int) int
-		Origin        func(childComplexity int) int
-		Subject       func(childComplexity int) int
-		Vulnerability func(childComplexity int) int
+		ActionStatement  func(childComplexity int) int
+		Collector        func(childComplexity int) int
+		ID               func(childComplexity int) int
+		KnownSince       func(childComplexity int) int
+		Origin           func(childComplexity int) int
+		Statement        func(childComplexity int) int
+		Status           func(childComplexity int) int
+		StatusNotes      func(childComplexity int) int
+		Subject          func(childComplexity int) int
+		VexJustification func(childComplexity int) int
+		Vulnerability    func(childComplexity int) int
+	}
+
+	CertifyVEXStatementConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyVEXStatementEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	CertifyVuln struct {
+		ID            func(childComplexity int) int
 		Metadata      func(childComplexity int) int
 		Package       func(childComplexity int) int
 		Vulnerability func(childComplexity int) int
 	}
 
+	CertifyVulnConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	CertifyVulnEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	GHSA struct {
 		GhsaID func(childComplexity int) int
+		ID     func(childComplexity int) int
+	}
+
+	GHSAConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	GHSAEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
 	}
 
 	GHSAId struct {
@@ -100,18 +264,43 @@ type ComplexityRoot struct {
 
 	HasSBOM struct {
 		Collector func(childComplexity int) int
+		ID        func(childComplexity int) int
 		Origin    func(childComplexity int) int
 		Subject   func(childComplexity int) int
 		URI       func(childComplexity int) int
 	}
 
+	HasSBOMConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSBOMEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HasSLSA struct {
+		ID      func(childComplexity int) int
 		Slsa    func(childComplexity int) int
 		Subject func(childComplexity int) int
 	}
 
+	HasSLSAConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSLSAEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HasSourceAt struct {
 		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		KnownSince    func(childComplexity int) int
 		Origin        func(childComplexity int) int
@@ -119,43 +308,103 @@ type ComplexityRoot struct {
 		Source        func(childComplexity int) int
 	}
 
+	HasSourceAtConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HasSourceAtEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	HashEqual struct {
 		Artifacts     func(childComplexity int) int
 		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
 	}
 
+	HashEqualConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	HashEqualEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	IsDependency struct {
 		Collector        func(childComplexity int) int
 		DependentPackage func(childComplexity int) int
+		ID               func(childComplexity int) int
 		Justification    func(childComplexity int) int
 		Origin           func(childComplexity int) int
 		Package          func(childComplexity int) int
 		VersionRange     func(childComplexity int) int
 	}
 
+	IsDependencyConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	IsDependencyEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	IsOccurrence struct {
 		Artifact      func(childComplexity int) int
 		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		Subject       func(childComplexity int) int
 	}
 
+	IsOccurrenceConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	IsOccurrenceEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	IsVulnerability struct {
 		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
 		Justification func(childComplexity int) int
 		Origin        func(childComplexity int) int
 		Osv           func(childComplexity int) int
 		Vulnerability func(childComplexity int) int
 	}
 
+	IsVulnerabilityConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	IsVulnerabilityEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	Mutation struct {
 		CertifyScorecard      func(childComplexity int, source model.SourceInputSpec, scorecard model.ScorecardInputSpec) int
 		IngestArtifact        func(childComplexity int, artifact *model.ArtifactInputSpec) int
 		IngestBuilder         func(childComplexity int, builder *model.BuilderInputSpec) int
 		IngestCertifyBad      func(childComplexity int, subject model.PackageSourceOrArtifactInput, pkgMatchType *model.MatchFlags, certifyBad model.CertifyBadInputSpec) int
+		IngestCertifyEol      func(childComplexity int, pkg model.PkgInputSpec, certifyEol model.CertifyEOLInputSpec) int
 		IngestCertifyPkg      func(childComplexity int, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, certifyPkg model.CertifyPkgInputSpec) int
 		IngestCve             func(childComplexity int, cve *model.CVEInputSpec) int
 		IngestDependency      func(childComplexity int, pkg model.PkgInputSpec, depPkg model.PkgInputSpec, dependency model.IsDependencyInputSpec) int
@@ -170,29 +419,62 @@ type ComplexityRoot struct {
 		IngestPackage         func(childComplexity int, pkg *model.PkgInputSpec) int
 		IngestSlsa            func(childComplexity int, subject model.PackageSourceOrArtifactInput, builtFrom []*model.PackageSourceOrArtifactInput, builtBy model.BuilderInputSpec, slsa model.SLSAInputSpec) int
 		IngestSource          func(childComplexity int, source *model.SourceInputSpec) int
-		IngestVEXStatement    func(childComplexity int, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) int
+		IngestVEXStatement    func(childComplexity int, subject model.PackageOrArtifactInput, vulnerability model.OsvCveOrGhsaInput, vexStatement model.VexStatementInputSpec) int
+		IngestVulnAffected    func(childComplexity int, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, vulnAffected model.VulnAffectedInputSpec) int
+		IngestVulnMetadata    func(childComplexity int, vulnerability model.OsvCveOrGhsaInput, vulnMetadata model.VulnMetadataInputSpec) int
 		IngestVulnerability   func(childComplexity int, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, certifyVuln model.VulnerabilityMetaDataInput) int
 	}
 
+	NoVuln struct {
+		ID     func(childComplexity int) int
+		NoVuln func(childComplexity int) int
+	}
+
 	OSV struct {
+		ID    func(childComplexity int) int
 		OsvID func(childComplexity int) int
 	}
 
+	OSVConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	OSVEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	OSVId struct {
 		ID func(childComplexity int) int
 	}
 
 	Package struct {
+		ID         func(childComplexity int) int
 		Namespaces func(childComplexity int) int
 		Type       func(childComplexity int) int
 	}
 
+	PackageConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	PackageEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	PackageName struct {
+		ID       func(childComplexity int) int
 		Name     func(childComplexity int) int
 		Versions func(childComplexity int) int
 	}
 
 	PackageNamespace struct {
+		ID        func(childComplexity int) int
 		Names     func(childComplexity int) int
 		Namespace func(childComplexity int) int
 	}
@@ -203,31 +485,73 @@ type ComplexityRoot struct {
 	}
 
 	PackageVersion struct {
+		ID         func(childComplexity int) int
 		Qualifiers func(childComplexity int) int
 		Subpath    func(childComplexity int) int
 		Version    func(childComplexity int) int
 	}
 
+	PageInfo struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+		StartCursor func(childComplexity int) int
+	}
+
 	Query struct {
-		Artifacts           func(childComplexity int, artifactSpec *model.ArtifactSpec) int
-		Builders            func(childComplexity int, builderSpec *model.BuilderSpec) int
-		CertifyBad          func(childComplexity int, certifyBadSpec *model.CertifyBadSpec) int
-		CertifyPkg          func(childComplexity int, certifyPkgSpec *model.CertifyPkgSpec) int
-		CertifyVEXStatement func(childComplexity int, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) int
-		CertifyVuln         func(childComplexity int, certifyVulnSpec *model.CertifyVulnSpec) int
-		Cve                 func(childComplexity int, cveSpec *model.CVESpec) int
-		Ghsa                func(childComplexity int, ghsaSpec *model.GHSASpec) int
-		HasSbom             func(childComplexity int, hasSBOMSpec *model.HasSBOMSpec) int
-		HasSlsa             func(childComplexity int, hasSLSASpec *model.HasSLSASpec) int
-		HasSourceAt         func(childComplexity int, hasSourceAtSpec *model.HasSourceAtSpec) int
-		HashEqual           func(childComplexity int, hashEqualSpec *model.HashEqualSpec) int
-		IsDependency        func(childComplexity int, isDependencySpec *model.IsDependencySpec) int
-		IsOccurrence        func(childComplexity int, isOccurrenceSpec *model.IsOccurrenceSpec) int
-		IsVulnerability     func(childComplexity int, isVulnerabilitySpec *model.IsVulnerabilitySpec) int
-		Osv                 func(childComplexity int, osvSpec *model.OSVSpec) int
-		Packages            func(childComplexity int, pkgSpec *model.PkgSpec) int
-		Scorecards          func(childComplexity int, scorecardSpec *model.CertifyScorecardSpec) int
-		Sources             func(childComplexity int, sourceSpec *model.SourceSpec) int
+		AffectedPackages        func(childComplexity int, vulnAffectedSpec *model.VulnAffectedSpec) int
+		AffectedPackagesList    func(childComplexity int, vulnAffectedSpec model.VulnAffectedSpec, after *string, first *int) int
+		Artifacts               func(childComplexity int, artifactSpec *model.ArtifactSpec) int
+		ArtifactsList           func(childComplexity int, artifactSpec model.ArtifactSpec, after *string, first *int) int
+		Builders                func(childComplexity int, builderSpec *model.BuilderSpec) int
+		BuildersList            func(childComplexity int, builderSpec model.BuilderSpec, after *string, first *int) int
+		CertifyBad              func(childComplexity int, certifyBadSpec *model.CertifyBadSpec) int
+		CertifyBadList          func(childComplexity int, certifyBadSpec model.CertifyBadSpec, after *string, first *int) int
+		CertifyEOLList          func(childComplexity int, certifyEOLSpec model.CertifyEOLSpec, after *string, first *int) int
+		CertifyEol              func(childComplexity int, certifyEOLSpec *model.CertifyEOLSpec) int
+		CertifyPkg              func(childComplexity int, certifyPkgSpec *model.CertifyPkgSpec) int
+		CertifyPkgList          func(childComplexity int, certifyPkgSpec model.CertifyPkgSpec, after *string, first *int) int
+		CertifyVEXStatement     func(childComplexity int, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) int
+		CertifyVEXStatementList func(childComplexity int, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) int
+		CertifyVuln             func(childComplexity int, certifyVulnSpec *model.CertifyVulnSpec) int
+		CertifyVulnList         func(childComplexity int, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) int
+		Cve                     func(childComplexity int, cveSpec *model.CVESpec) int
+		CveList                 func(childComplexity int, cveSpec model.CVESpec, after *string, first *int) int
+		Dependents              func(childComplexity int, pkgSpec model.PkgSpec, maxDepth *int) int
+		EolDependents           func(childComplexity int, certifyEOLSpec *model.CertifyEOLSpec) int
+		EolDependentsList       func(childComplexity int, certifyEOLSpec model.CertifyEOLSpec, after *string, first *int) int
+		Ghsa                    func(childComplexity int, ghsaSpec *model.GHSASpec) int
+		GhsaList                func(childComplexity int, ghsaSpec model.GHSASpec, after *string, first *int) int
+		HasSBOMList             func(childComplexity int, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) int
+		HasSLSAList             func(childComplexity int, hasSLSASpec model.HasSLSASpec, after *string, first *int) int
+		HasSbom                 func(childComplexity int, hasSBOMSpec *model.HasSBOMSpec) int
+		HasSlsa                 func(childComplexity int, hasSLSASpec *model.HasSLSASpec) int
+		HasSourceAt             func(childComplexity int, hasSourceAtSpec *model.HasSourceAtSpec) int
+		HasSourceAtList         func(childComplexity int, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) int
+		HashEqual               func(childComplexity int, hashEqualSpec *model.HashEqualSpec) int
+		HashEqualList           func(childComplexity int, hashEqualSpec model.HashEqualSpec, after *string, first *int) int
+		IsDependency            func(childComplexity int, isDependencySpec *model.IsDependencySpec) int
+		IsDependencyList        func(childComplexity int, isDependencySpec model.IsDependencySpec, after *string, first *int) int
+		IsOccurrence            func(childComplexity int, isOccurrenceSpec *model.IsOccurrenceSpec) int
+		IsOccurrenceList        func(childComplexity int, isOccurrenceSpec model.IsOccurrenceSpec, after *string, first *int) int
+		IsVulnerability         func(childComplexity int, isVulnerabilitySpec *model.IsVulnerabilitySpec) int
+		IsVulnerabilityList     func(childComplexity int, isVulnerabilitySpec model.IsVulnerabilitySpec, after *string, first *int) int
+		Neighbors               func(childComplexity int, node string, usingOnly []model.Edge) int
+		Node                    func(childComplexity int, id string) int
+		Nodes                   func(childComplexity int, ids []string) int
+		Osv                     func(childComplexity int, osvSpec *model.OSVSpec) int
+		OsvList                 func(childComplexity int, osvSpec model.OSVSpec, after *string, first *int) int
+		Packages                func(childComplexity int, pkgSpec *model.PkgSpec) int
+		PackagesList            func(childComplexity int, pkgSpec model.PkgSpec, after *string, first *int) int
+		Path                    func(childComplexity int, subject string, target string, maxPathLength int, usingOnly []model.Edge) int
+		Scorecards              func(childComplexity int, scorecardSpec *model.CertifyScorecardSpec) int
+		ScorecardsList          func(childComplexity int, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) int
+		Sources                 func(childComplexity int, sourceSpec *model.SourceSpec) int
+		SourcesList             func(childComplexity int, sourceSpec model.SourceSpec, after *string, first *int) int
+		TransitiveDependencies  func(childComplexity int, pkgSpec model.PkgSpec, maxDepth *int) int
+		VulnAffected            func(childComplexity int, vulnAffectedSpec *model.VulnAffectedSpec) int
+		VulnAffectedList        func(childComplexity int, vulnAffectedSpec model.VulnAffectedSpec, after *string, first *int) int
+		VulnMetadata            func(childComplexity int, vulnMetadataSpec *model.VulnMetadataSpec) int
+		VulnMetadataList        func(childComplexity int, vulnMetadataSpec model.VulnMetadataSpec, after *string, first *int) int
 	}
 
 	SLSA struct {
@@ -263,21 +587,93 @@ type ComplexityRoot struct {
 	}
 
 	Source struct {
+		ID         func(childComplexity int) int
 		Namespaces func(childComplexity int) int
 		Type       func(childComplexity int) int
 	}
 
+	SourceConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	SourceEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	SourceName struct {
 		Commit func(childComplexity int) int
+		ID     func(childComplexity int) int
 		Name   func(childComplexity int) int
 		Tag    func(childComplexity int) int
 	}
 
 	SourceNamespace struct {
+		ID        func(childComplexity int) int
 		Names     func(childComplexity int) int
 		Namespace func(childComplexity int) int
 	}
 
+	TransitiveDependency struct {
+		Cycle      func(childComplexity int) int
+		Dependency func(childComplexity int) int
+		Depth      func(childComplexity int) int
+		Package    func(childComplexity int) int
+	}
+
+	VulnAffected struct {
+		Collector     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Package       func(childComplexity int) int
+		Ranges        func(childComplexity int) int
+		Versions      func(childComplexity int) int
+		Vulnerability func(childComplexity int) int
+	}
+
+	VulnAffectedConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnAffectedEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	VulnMetadata struct {
+		Collector     func(childComplexity int) int
+		CweIds        func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Modified      func(childComplexity int) int
+		Origin        func(childComplexity int) int
+		Published     func(childComplexity int) int
+		References    func(childComplexity int) int
+		Scores        func(childComplexity int) int
+		Summary       func(childComplexity int) int
+		Vulnerability func(childComplexity int) int
+	}
+
+	VulnMetadataConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	VulnMetadataEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	VulnScore struct {
+		Score     func(childComplexity int) int
+		ScoreType func(childComplexity int) int
+		Vector    func(childComplexity int) int
+	}
+
 	VulnerabilityMetaData struct {
 		Collector      func(childComplexity int) int
 		DbURI          func(childComplexity int) int
@@ -304,977 +700,2469 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
-	case "Artifact.algorithm":
-		if e.complexity.Artifact.Algorithm == nil {
+	case "AffectedEvent.eventType":
+		if e.complexity.AffectedEvent.EventType == nil {
 			break
 		}
 
-		return e.complexity.Artifact.Algorithm(childComplexity), true
+		return e.complexity.AffectedEvent.EventType(childComplexity), true
 
-	case "Artifact.digest":
-		if e.complexity.Artifact.Digest == nil {
+	case "AffectedEvent.version":
+		if e.complexity.AffectedEvent.Version == nil {
 			break
 		}
 
-		return e.complexity.Artifact.Digest(childComplexity), true
+		return e.complexity.AffectedEvent.Version(childComplexity), true
 
-	case "Builder.uri":
-		if e.complexity.Builder.URI == nil {
+	case "AffectedPackage.package":
+		if e.complexity.AffectedPackage.Package == nil {
 			break
 		}
 
-		return e.complexity.Builder.URI(childComplexity), true
+		return e.complexity.AffectedPackage.Package(childComplexity), true
 
-	case "CVE.cveId":
-		if e.complexity.CVE.CveID == nil {
+	case "AffectedPackage.vulnAffected":
+		if e.complexity.AffectedPackage.VulnAffected == nil {
 			break
 		}
 
-		return e.complexity.CVE.CveID(childComplexity), true
+		return e.complexity.AffectedPackage.VulnAffected(childComplexity), true
 
-	case "CVE.year":
-		if e.complexity.CVE.Year == nil {
+	case "AffectedPackageConnection.edges":
+		if e.complexity.AffectedPackageConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.CVE.Year(childComplexity), true
+		return e.complexity.AffectedPackageConnection.Edges(childComplexity), true
 
-	case "CVEId.id":
-		if e.complexity.CVEId.ID == nil {
+	case "AffectedPackageConnection.pageInfo":
+		if e.complexity.AffectedPackageConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.CVEId.ID(childComplexity), true
+		return e.complexity.AffectedPackageConnection.PageInfo(childComplexity), true
 
-	case "CertifyBad.collector":
-		if e.complexity.CertifyBad.Collector == nil {
+	case "AffectedPackageConnection.totalCount":
+		if e.complexity.AffectedPackageConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.CertifyBad.Collector(childComplexity), true
+		return e.complexity.AffectedPackageConnection.TotalCount(childComplexity), true
 
-	case "CertifyBad.justification":
-		if e.complexity.CertifyBad.Justification == nil {
+	case "AffectedPackageEdge.cursor":
+		if e.complexity.AffectedPackageEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.CertifyBad.Justification(childComplexity), true
+		return e.complexity.AffectedPackageEdge.Cursor(childComplexity), true
 
-	case "CertifyBad.origin":
-		if e.complexity.CertifyBad.Origin == nil {
+	case "AffectedPackageEdge.node":
+		if e.complexity.AffectedPackageEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.CertifyBad.Origin(childComplexity), true
+		return e.complexity.AffectedPackageEdge.Node(childComplexity), true
 
-	case "CertifyBad.subject":
-		if e.complexity.CertifyBad.Subject == nil {
+	case "AffectedRange.events":
+		if e.complexity.AffectedRange.Events == nil {
 			break
 		}
 
-		return e.complexity.CertifyBad.Subject(childComplexity), true
+		return e.complexity.AffectedRange.Events(childComplexity), true
 
-	case "CertifyPkg.collector":
-		if e.complexity.CertifyPkg.Collector == nil {
+	case "AffectedRange.rangeType":
+		if e.complexity.AffectedRange.RangeType == nil {
 			break
 		}
 
-		return e.complexity.CertifyPkg.Collector(childComplexity), true
+		return e.complexity.AffectedRange.RangeType(childComplexity), true
 
-	case "CertifyPkg.justification":
-		if e.complexity.CertifyPkg.Justification == nil {
+	case "AffectedRange.repo":
+		if e.complexity.AffectedRange.Repo == nil {
 			break
 		}
 
-		return e.complexity.CertifyPkg.Justification(childComplexity), true
+		return e.complexity.AffectedRange.Repo(childComplexity), true
 
-	case "CertifyPkg.origin":
-		if e.complexity.CertifyPkg.Origin == nil {
+	case "Artifact.algorithm":
+		if e.complexity.Artifact.Algorithm == nil {
 			break
 		}
 
-		return e.complexity.CertifyPkg.Origin(childComplexity), true
+		return e.complexity.Artifact.Algorithm(childComplexity), true
 
-	case "CertifyPkg.packages":
-		if e.complexity.CertifyPkg.Packages == nil {
+	case "Artifact.digest":
+		if e.complexity.Artifact.Digest == nil {
 			break
 		}
 
-		return e.complexity.CertifyPkg.Packages(childComplexity), true
+		return e.complexity.Artifact.Digest(childComplexity), true
 
-	case "CertifyScorecard.scorecard":
-		if e.complexity.CertifyScorecard.Scorecard == nil {
+	case "Artifact.id":
+		if e.complexity.Artifact.ID == nil {
 			break
 		}
 
-		return e.complexity.CertifyScorecard.Scorecard(childComplexity), true
+		return e.complexity.Artifact.ID(childComplexity), true
 
-	case "CertifyScorecard.source":
-		if e.complexity.CertifyScorecard.Source == nil {
+	case "ArtifactConnection.edges":
+		if e.complexity.ArtifactConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.CertifyScorecard.Source(childComplexity), true
+		return e.complexity.ArtifactConnection.Edges(childComplexity), true
 
-	case "CertifyVEXStatement.collector":
-		if e.complexity.CertifyVEXStatement.Collector == nil {
+	case "ArtifactConnection.pageInfo":
+		if e.complexity.ArtifactConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.CertifyVEXStatement.Collector(childComplexity), true
+		return e.complexity.ArtifactConnection.PageInfo(childComplexity), true
 
-	case "CertifyVEXStatement.justification":
-		if e.complexity.CertifyVEXStatement.Justification == nil {
+	case "ArtifactConnection.totalCount":
+		if e.complexity.ArtifactConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.CertifyVEXStatement.Justification(childComplexity), true
+		return e.complexity.ArtifactConnection.TotalCount(childComplexity), true
 
-	case "CertifyVEXStatement.knownSince":
-		if e.complexity.CertifyVEXStatement.KnownSince == nil {
+	case "ArtifactEdge.cursor":
+		if e.complexity.ArtifactEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.CertifyVEXStatement.KnownSince(childComplexity), true
+		return e.complexity.ArtifactEdge.Cursor(childComplexity), true
 
-	case "CertifyVEXStatement.origin":
-		if e.complexity.CertifyVEXStatement.Origin == nil {
+	case "ArtifactEdge.node":
+		if e.complexity.ArtifactEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.CertifyVEXStatement.Origin(childComplexity), true
+		return e.complexity.ArtifactEdge.Node(childComplexity), true
 
-	case "CertifyVEXStatement.subject":
-		if e.complexity.CertifyVEXStatement.Subject == nil {
+	case "Builder.id":
+		if e.complexity.Builder.ID == nil {
 			break
 		}
 
-		return e.complexity.CertifyVEXStatement.Subject(childComplexity), true
+		return e.complexity.Builder.ID(childComplexity), true
 
-	case "CertifyVEXStatement.vulnerability":
-		if e.complexity.CertifyVEXStatement.Vulnerability == nil {
+	case "Builder.uri":
+		if e.complexity.Builder.URI == nil {
 			break
 		}
 
-		return e.complexity.CertifyVEXStatement.Vulnerability(childComplexity), true
+		return e.complexity.Builder.URI(childComplexity), true
 
-	case "CertifyVuln.metadata":
-		if e.complexity.CertifyVuln.Metadata == nil {
+	case "BuilderConnection.edges":
+		if e.complexity.BuilderConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.CertifyVuln.Metadata(childComplexity), true
+		return e.complexity.BuilderConnection.Edges(childComplexity), true
 
-	case "CertifyVuln.package":
-		if e.complexity.CertifyVuln.Package == nil {
+	case "BuilderConnection.pageInfo":
+		if e.complexity.BuilderConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.CertifyVuln.Package(childComplexity), true
+		return e.complexity.BuilderConnection.PageInfo(childComplexity), true
 
-	case "CertifyVuln.vulnerability":
-		if e.complexity.CertifyVuln.Vulnerability == nil {
+	case "BuilderConnection.totalCount":
+		if e.complexity.BuilderConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.CertifyVuln.Vulnerability(childComplexity), true
+		return e.complexity.BuilderConnection.TotalCount(childComplexity), true
 
-	case "GHSA.ghsaId":
-		if e.complexity.GHSA.GhsaID == nil {
+	case "BuilderEdge.cursor":
+		if e.complexity.BuilderEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.GHSA.GhsaID(childComplexity), true
+		return e.complexity.BuilderEdge.Cursor(childComplexity), true
 
-	case "GHSAId.id":
-		if e.complexity.GHSAId.ID == nil {
+	case "BuilderEdge.node":
+		if e.complexity.BuilderEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.GHSAId.ID(childComplexity), true
+		return e.complexity.BuilderEdge.Node(childComplexity), true
 
-	case "HasSBOM.collector":
-		if e.complexity.HasSBOM.Collector == nil {
+	case "CVE.cveId":
+		if e.complexity.CVE.CveID == nil {
 			break
 		}
 
-		return e.complexity.HasSBOM.Collector(childComplexity), true
+		return e.complexity.CVE.CveID(childComplexity), true
 
-	case "HasSBOM.origin":
-		if e.complexity.HasSBOM.Origin == nil {
+	case "CVE.id":
+		if e.complexity.CVE.ID == nil {
 			break
 		}
 
-		return e.complexity.HasSBOM.Origin(childComplexity), true
+		return e.complexity.CVE.ID(childComplexity), true
 
-	case "HasSBOM.subject":
-		if e.complexity.HasSBOM.Subject == nil {
+	case "CVE.year":
+		if e.complexity.CVE.Year == nil {
 			break
 		}
 
-		return e.complexity.HasSBOM.Subject(childComplexity), true
+		return e.complexity.CVE.Year(childComplexity), true
 
-	case "HasSBOM.uri":
-		if e.complexity.HasSBOM.URI == nil {
+	case "CVEConnection.edges":
+		if e.complexity.CVEConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.HasSBOM.URI(childComplexity), true
+		return e.complexity.CVEConnection.Edges(childComplexity), true
 
-	case "HasSLSA.slsa":
-		if e.complexity.HasSLSA.Slsa == nil {
+	case "CVEConnection.pageInfo":
+		if e.complexity.CVEConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.HasSLSA.Slsa(childComplexity), true
+		return e.complexity.CVEConnection.PageInfo(childComplexity), true
 
-	case "HasSLSA.subject":
-		if e.complexity.HasSLSA.Subject == nil {
+	case "CVEConnection.totalCount":
+		if e.complexity.CVEConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.HasSLSA.Subject(childComplexity), true
+		return e.complexity.CVEConnection.TotalCount(childComplexity), true
 
-	case "HasSourceAt.collector":
-		if e.complexity.HasSourceAt.Collector == nil {
+	case "CVEEdge.cursor":
+		if e.complexity.CVEEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Collector(childComplexity), true
+		return e.complexity.CVEEdge.Cursor(childComplexity), true
 
-	case "HasSourceAt.justification":
-		if e.complexity.HasSourceAt.Justification == nil {
+	case "CVEEdge.node":
+		if e.complexity.CVEEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Justification(childComplexity), true
+		return e.complexity.CVEEdge.Node(childComplexity), true
 
-	case "HasSourceAt.knownSince":
-		if e.complexity.HasSourceAt.KnownSince == nil {
+	case "CVEId.id":
+		if e.complexity.CVEId.ID == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.KnownSince(childComplexity), true
+		return e.complexity.CVEId.ID(childComplexity), true
 
-	case "HasSourceAt.origin":
-		if e.complexity.HasSourceAt.Origin == nil {
+	case "CertifyBad.collector":
+		if e.complexity.CertifyBad.Collector == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Origin(childComplexity), true
+		return e.complexity.CertifyBad.Collector(childComplexity), true
+
+	case "CertifyBad.id":
+		if e.complexity.CertifyBad.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyBad.ID(childComplexity), true
+
+	case "CertifyBad.justification":
+		if e.complexity.CertifyBad.Justification == nil {
+			break
+		}
+
+		return e.complexity.CertifyBad.Justification(childComplexity), true
+
+	case "CertifyBad.origin":
+		if e.complexity.CertifyBad.Origin == nil {
+			break
+		}
+
+		return e.complexity.CertifyBad.Origin(childComplexity), true
+
+	case "CertifyBad.subject":
+		if e.complexity.CertifyBad.Subject == nil {
+			break
+		}
+
+		return e.complexity.CertifyBad.Subject(childComplexity), true
+
+	case "CertifyBadConnection.edges":
+		if e.complexity.CertifyBadConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.Edges(childComplexity), true
+
+	case "CertifyBadConnection.pageInfo":
+		if e.complexity.CertifyBadConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.PageInfo(childComplexity), true
+
+	case "CertifyBadConnection.totalCount":
+		if e.complexity.CertifyBadConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadConnection.TotalCount(childComplexity), true
+
+	case "CertifyBadEdge.cursor":
+		if e.complexity.CertifyBadEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadEdge.Cursor(childComplexity), true
+
+	case "CertifyBadEdge.node":
+		if e.complexity.CertifyBadEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyBadEdge.Node(childComplexity), true
+
+	case "CertifyEOL.collector":
+		if e.complexity.CertifyEOL.Collector == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Collector(childComplexity), true
+
+	case "CertifyEOL.cycle":
+		if e.complexity.CertifyEOL.Cycle == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Cycle(childComplexity), true
+
+	case "CertifyEOL.eol":
+		if e.complexity.CertifyEOL.Eol == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Eol(childComplexity), true
+
+	case "CertifyEOL.id":
+		if e.complexity.CertifyEOL.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.ID(childComplexity), true
+
+	case "CertifyEOL.latest":
+		if e.complexity.CertifyEOL.Latest == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Latest(childComplexity), true
+
+	case "CertifyEOL.origin":
+		if e.complexity.CertifyEOL.Origin == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Origin(childComplexity), true
+
+	case "CertifyEOL.package":
+		if e.complexity.CertifyEOL.Package == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Package(childComplexity), true
+
+	case "CertifyEOL.product":
+		if e.complexity.CertifyEOL.Product == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Product(childComplexity), true
+
+	case "CertifyEOL.status":
+		if e.complexity.CertifyEOL.Status == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Status(childComplexity), true
+
+	case "CertifyEOL.support":
+		if e.complexity.CertifyEOL.Support == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.Support(childComplexity), true
+
+	case "CertifyEOL.timeChecked":
+		if e.complexity.CertifyEOL.TimeChecked == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOL.TimeChecked(childComplexity), true
+
+	case "CertifyEOLConnection.edges":
+		if e.complexity.CertifyEOLConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOLConnection.Edges(childComplexity), true
+
+	case "CertifyEOLConnection.pageInfo":
+		if e.complexity.CertifyEOLConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOLConnection.PageInfo(childComplexity), true
+
+	case "CertifyEOLConnection.totalCount":
+		if e.complexity.CertifyEOLConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOLConnection.TotalCount(childComplexity), true
+
+	case "CertifyEOLEdge.cursor":
+		if e.complexity.CertifyEOLEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOLEdge.Cursor(childComplexity), true
+
+	case "CertifyEOLEdge.node":
+		if e.complexity.CertifyEOLEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyEOLEdge.Node(childComplexity), true
+
+	case "CertifyPkg.collector":
+		if e.complexity.CertifyPkg.Collector == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkg.Collector(childComplexity), true
+
+	case "CertifyPkg.id":
+		if e.complexity.CertifyPkg.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkg.ID(childComplexity), true
+
+	case "CertifyPkg.justification":
+		if e.complexity.CertifyPkg.Justification == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkg.Justification(childComplexity), true
+
+	case "CertifyPkg.origin":
+		if e.complexity.CertifyPkg.Origin == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkg.Origin(childComplexity), true
+
+	case "CertifyPkg.packages":
+		if e.complexity.CertifyPkg.Packages == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkg.Packages(childComplexity), true
+
+	case "CertifyPkgConnection.edges":
+		if e.complexity.CertifyPkgConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkgConnection.Edges(childComplexity), true
+
+	case "CertifyPkgConnection.pageInfo":
+		if e.complexity.CertifyPkgConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkgConnection.PageInfo(childComplexity), true
+
+	case "CertifyPkgConnection.totalCount":
+		if e.complexity.CertifyPkgConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkgConnection.TotalCount(childComplexity), true
+
+	case "CertifyPkgEdge.cursor":
+		if e.complexity.CertifyPkgEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkgEdge.Cursor(childComplexity), true
+
+	case "CertifyPkgEdge.node":
+		if e.complexity.CertifyPkgEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyPkgEdge.Node(childComplexity), true
+
+	case "CertifyScorecard.id":
+		if e.complexity.CertifyScorecard.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecard.ID(childComplexity), true
+
+	case "CertifyScorecard.scorecard":
+		if e.complexity.CertifyScorecard.Scorecard == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecard.Scorecard(childComplexity), true
+
+	case "CertifyScorecard.source":
+		if e.complexity.CertifyScorecard.Source == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecard.Source(childComplexity), true
+
+	case "CertifyScorecardConnection.edges":
+		if e.complexity.CertifyScorecardConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.Edges(childComplexity), true
+
+	case "CertifyScorecardConnection.pageInfo":
+		if e.complexity.CertifyScorecardConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.PageInfo(childComplexity), true
+
+	case "CertifyScorecardConnection.totalCount":
+		if e.complexity.CertifyScorecardConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardConnection.TotalCount(childComplexity), true
+
+	case "CertifyScorecardEdge.cursor":
+		if e.complexity.CertifyScorecardEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardEdge.Cursor(childComplexity), true
+
+	case "CertifyScorecardEdge.node":
+		if e.complexity.CertifyScorecardEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyScorecardEdge.Node(childComplexity), true
+
+	case "CertifyVEXStatement.actionStatement":
+		if e.complexity.CertifyVEXStatement.ActionStatement == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.ActionStatement(childComplexity), true
+
+	case "CertifyVEXStatement.collector":
+		if e.complexity.CertifyVEXStatement.Collector == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Collector(childComplexity), true
+
+	case "CertifyVEXStatement.id":
+		if e.complexity.CertifyVEXStatement.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.ID(childComplexity), true
+
+	case "CertifyVEXStatement.knownSince":
+		if e.complexity.CertifyVEXStatement.KnownSince == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.KnownSince(childComplexity), true
+
+	case "CertifyVEXStatement.origin":
+		if e.complexity.CertifyVEXStatement.Origin == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Origin(childComplexity), true
+
+	case "CertifyVEXStatement.statement":
+		if e.complexity.CertifyVEXStatement.Statement == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Statement(childComplexity), true
+
+	case "CertifyVEXStatement.status":
+		if e.complexity.CertifyVEXStatement.Status == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Status(childComplexity), true
+
+	case "CertifyVEXStatement.statusNotes":
+		if e.complexity.CertifyVEXStatement.StatusNotes == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.StatusNotes(childComplexity), true
+
+	case "CertifyVEXStatement.subject":
+		if e.complexity.CertifyVEXStatement.Subject == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Subject(childComplexity), true
+
+	case "CertifyVEXStatement.vexJustification":
+		if e.complexity.CertifyVEXStatement.VexJustification == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.VexJustification(childComplexity), true
+
+	case "CertifyVEXStatement.vulnerability":
+		if e.complexity.CertifyVEXStatement.Vulnerability == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatement.Vulnerability(childComplexity), true
+
+	case "CertifyVEXStatementConnection.edges":
+		if e.complexity.CertifyVEXStatementConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementConnection.Edges(childComplexity), true
+
+	case "CertifyVEXStatementConnection.pageInfo":
+		if e.complexity.CertifyVEXStatementConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementConnection.PageInfo(childComplexity), true
+
+	case "CertifyVEXStatementConnection.totalCount":
+		if e.complexity.CertifyVEXStatementConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementConnection.TotalCount(childComplexity), true
+
+	case "CertifyVEXStatementEdge.cursor":
+		if e.complexity.CertifyVEXStatementEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementEdge.Cursor(childComplexity), true
+
+	case "CertifyVEXStatementEdge.node":
+		if e.complexity.CertifyVEXStatementEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyVEXStatementEdge.Node(childComplexity), true
+
+	case "CertifyVuln.id":
+		if e.complexity.CertifyVuln.ID == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.ID(childComplexity), true
+
+	case "CertifyVuln.metadata":
+		if e.complexity.CertifyVuln.Metadata == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.Metadata(childComplexity), true
+
+	case "CertifyVuln.package":
+		if e.complexity.CertifyVuln.Package == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.Package(childComplexity), true
+
+	case "CertifyVuln.vulnerability":
+		if e.complexity.CertifyVuln.Vulnerability == nil {
+			break
+		}
+
+		return e.complexity.CertifyVuln.Vulnerability(childComplexity), true
+
+	case "CertifyVulnConnection.edges":
+		if e.complexity.CertifyVulnConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.Edges(childComplexity), true
+
+	case "CertifyVulnConnection.pageInfo":
+		if e.complexity.CertifyVulnConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.PageInfo(childComplexity), true
+
+	case "CertifyVulnConnection.totalCount":
+		if e.complexity.CertifyVulnConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnConnection.TotalCount(childComplexity), true
+
+	case "CertifyVulnEdge.cursor":
+		if e.complexity.CertifyVulnEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnEdge.Cursor(childComplexity), true
+
+	case "CertifyVulnEdge.node":
+		if e.complexity.CertifyVulnEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.CertifyVulnEdge.Node(childComplexity), true
+
+	case "GHSA.ghsaId":
+		if e.complexity.GHSA.GhsaID == nil {
+			break
+		}
+
+		return e.complexity.GHSA.GhsaID(childComplexity), true
+
+	case "GHSA.id":
+		if e.complexity.GHSA.ID == nil {
+			break
+		}
+
+		return e.complexity.GHSA.ID(childComplexity), true
+
+	case "GHSAConnection.edges":
+		if e.complexity.GHSAConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.GHSAConnection.Edges(childComplexity), true
+
+	case "GHSAConnection.pageInfo":
+		if e.complexity.GHSAConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.GHSAConnection.PageInfo(childComplexity), true
+
+	case "GHSAConnection.totalCount":
+		if e.complexity.GHSAConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.GHSAConnection.TotalCount(childComplexity), true
+
+	case "GHSAEdge.cursor":
+		if e.complexity.GHSAEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.GHSAEdge.Cursor(childComplexity), true
+
+	case "GHSAEdge.node":
+		if e.complexity.GHSAEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.GHSAEdge.Node(childComplexity), true
+
+	case "GHSAId.id":
+		if e.complexity.GHSAId.ID == nil {
+			break
+		}
+
+		return e.complexity.GHSAId.ID(childComplexity), true
+
+	case "HasSBOM.collector":
+		if e.complexity.HasSBOM.Collector == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.Collector(childComplexity), true
+
+	case "HasSBOM.id":
+		if e.complexity.HasSBOM.ID == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.ID(childComplexity), true
+
+	case "HasSBOM.origin":
+		if e.complexity.HasSBOM.Origin == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.Origin(childComplexity), true
+
+	case "HasSBOM.subject":
+		if e.complexity.HasSBOM.Subject == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.Subject(childComplexity), true
+
+	case "HasSBOM.uri":
+		if e.complexity.HasSBOM.URI == nil {
+			break
+		}
+
+		return e.complexity.HasSBOM.URI(childComplexity), true
+
+	case "HasSBOMConnection.edges":
+		if e.complexity.HasSBOMConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.Edges(childComplexity), true
+
+	case "HasSBOMConnection.pageInfo":
+		if e.complexity.HasSBOMConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.PageInfo(childComplexity), true
+
+	case "HasSBOMConnection.totalCount":
+		if e.complexity.HasSBOMConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMConnection.TotalCount(childComplexity), true
+
+	case "HasSBOMEdge.cursor":
+		if e.complexity.HasSBOMEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMEdge.Cursor(childComplexity), true
+
+	case "HasSBOMEdge.node":
+		if e.complexity.HasSBOMEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSBOMEdge.Node(childComplexity), true
+
+	case "HasSLSA.id":
+		if e.complexity.HasSLSA.ID == nil {
+			break
+		}
+
+		return e.complexity.HasSLSA.ID(childComplexity), true
+
+	case "HasSLSA.slsa":
+		if e.complexity.HasSLSA.Slsa == nil {
+			break
+		}
+
+		return e.complexity.HasSLSA.Slsa(childComplexity), true
+
+	case "HasSLSA.subject":
+		if e.complexity.HasSLSA.Subject == nil {
+			break
+		}
+
+		return e.complexity.HasSLSA.Subject(childComplexity), true
+
+	case "HasSLSAConnection.edges":
+		if e.complexity.HasSLSAConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.Edges(childComplexity), true
+
+	case "HasSLSAConnection.pageInfo":
+		if e.complexity.HasSLSAConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.PageInfo(childComplexity), true
+
+	case "HasSLSAConnection.totalCount":
+		if e.complexity.HasSLSAConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAConnection.TotalCount(childComplexity), true
+
+	case "HasSLSAEdge.cursor":
+		if e.complexity.HasSLSAEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAEdge.Cursor(childComplexity), true
+
+	case "HasSLSAEdge.node":
+		if e.complexity.HasSLSAEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSLSAEdge.Node(childComplexity), true
+
+	case "HasSourceAt.collector":
+		if e.complexity.HasSourceAt.Collector == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Collector(childComplexity), true
+
+	case "HasSourceAt.id":
+		if e.complexity.HasSourceAt.ID == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.ID(childComplexity), true
+
+	case "HasSourceAt.justification":
+		if e.complexity.HasSourceAt.Justification == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Justification(childComplexity), true
+
+	case "HasSourceAt.knownSince":
+		if e.complexity.HasSourceAt.KnownSince == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.KnownSince(childComplexity), true
+
+	case "HasSourceAt.origin":
+		if e.complexity.HasSourceAt.Origin == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Origin(childComplexity), true
 
 	case "HasSourceAt.package":
 		if e.complexity.HasSourceAt.Package == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Package(childComplexity), true
+		return e.complexity.HasSourceAt.Package(childComplexity), true
+
+	case "HasSourceAt.source":
+		if e.complexity.HasSourceAt.Source == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAt.Source(childComplexity), true
+
+	case "HasSourceAtConnection.edges":
+		if e.complexity.HasSourceAtConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.Edges(childComplexity), true
+
+	case "HasSourceAtConnection.pageInfo":
+		if e.complexity.HasSourceAtConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.PageInfo(childComplexity), true
+
+	case "HasSourceAtConnection.totalCount":
+		if e.complexity.HasSourceAtConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtConnection.TotalCount(childComplexity), true
+
+	case "HasSourceAtEdge.cursor":
+		if e.complexity.HasSourceAtEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtEdge.Cursor(childComplexity), true
+
+	case "HasSourceAtEdge.node":
+		if e.complexity.HasSourceAtEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HasSourceAtEdge.Node(childComplexity), true
+
+	case "HashEqual.artifacts":
+		if e.complexity.HashEqual.Artifacts == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Artifacts(childComplexity), true
+
+	case "HashEqual.collector":
+		if e.complexity.HashEqual.Collector == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Collector(childComplexity), true
+
+	case "HashEqual.id":
+		if e.complexity.HashEqual.ID == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.ID(childComplexity), true
+
+	case "HashEqual.justification":
+		if e.complexity.HashEqual.Justification == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Justification(childComplexity), true
+
+	case "HashEqual.origin":
+		if e.complexity.HashEqual.Origin == nil {
+			break
+		}
+
+		return e.complexity.HashEqual.Origin(childComplexity), true
+
+	case "HashEqualConnection.edges":
+		if e.complexity.HashEqualConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.Edges(childComplexity), true
+
+	case "HashEqualConnection.pageInfo":
+		if e.complexity.HashEqualConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.PageInfo(childComplexity), true
+
+	case "HashEqualConnection.totalCount":
+		if e.complexity.HashEqualConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.HashEqualConnection.TotalCount(childComplexity), true
+
+	case "HashEqualEdge.cursor":
+		if e.complexity.HashEqualEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.HashEqualEdge.Cursor(childComplexity), true
+
+	case "HashEqualEdge.node":
+		if e.complexity.HashEqualEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.HashEqualEdge.Node(childComplexity), true
+
+	case "IsDependency.collector":
+		if e.complexity.IsDependency.Collector == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Collector(childComplexity), true
+
+	case "IsDependency.dependentPackage":
+		if e.complexity.IsDependency.DependentPackage == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.DependentPackage(childComplexity), true
+
+	case "IsDependency.id":
+		if e.complexity.IsDependency.ID == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.ID(childComplexity), true
+
+	case "IsDependency.justification":
+		if e.complexity.IsDependency.Justification == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Justification(childComplexity), true
+
+	case "IsDependency.origin":
+		if e.complexity.IsDependency.Origin == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Origin(childComplexity), true
+
+	case "IsDependency.package":
+		if e.complexity.IsDependency.Package == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.Package(childComplexity), true
+
+	case "IsDependency.versionRange":
+		if e.complexity.IsDependency.VersionRange == nil {
+			break
+		}
+
+		return e.complexity.IsDependency.VersionRange(childComplexity), true
+
+	case "IsDependencyConnection.edges":
+		if e.complexity.IsDependencyConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.Edges(childComplexity), true
+
+	case "IsDependencyConnection.pageInfo":
+		if e.complexity.IsDependencyConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.PageInfo(childComplexity), true
+
+	case "IsDependencyConnection.totalCount":
+		if e.complexity.IsDependencyConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyConnection.TotalCount(childComplexity), true
+
+	case "IsDependencyEdge.cursor":
+		if e.complexity.IsDependencyEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyEdge.Cursor(childComplexity), true
+
+	case "IsDependencyEdge.node":
+		if e.complexity.IsDependencyEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.IsDependencyEdge.Node(childComplexity), true
+
+	case "IsOccurrence.artifact":
+		if e.complexity.IsOccurrence.Artifact == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Artifact(childComplexity), true
+
+	case "IsOccurrence.collector":
+		if e.complexity.IsOccurrence.Collector == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Collector(childComplexity), true
+
+	case "IsOccurrence.id":
+		if e.complexity.IsOccurrence.ID == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.ID(childComplexity), true
+
+	case "IsOccurrence.justification":
+		if e.complexity.IsOccurrence.Justification == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Justification(childComplexity), true
+
+	case "IsOccurrence.origin":
+		if e.complexity.IsOccurrence.Origin == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Origin(childComplexity), true
+
+	case "IsOccurrence.subject":
+		if e.complexity.IsOccurrence.Subject == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrence.Subject(childComplexity), true
+
+	case "IsOccurrenceConnection.edges":
+		if e.complexity.IsOccurrenceConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.Edges(childComplexity), true
+
+	case "IsOccurrenceConnection.pageInfo":
+		if e.complexity.IsOccurrenceConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.PageInfo(childComplexity), true
+
+	case "IsOccurrenceConnection.totalCount":
+		if e.complexity.IsOccurrenceConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceConnection.TotalCount(childComplexity), true
+
+	case "IsOccurrenceEdge.cursor":
+		if e.complexity.IsOccurrenceEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceEdge.Cursor(childComplexity), true
+
+	case "IsOccurrenceEdge.node":
+		if e.complexity.IsOccurrenceEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.IsOccurrenceEdge.Node(childComplexity), true
+
+	case "IsVulnerability.collector":
+		if e.complexity.IsVulnerability.Collector == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerability.Collector(childComplexity), true
+
+	case "IsVulnerability.id":
+		if e.complexity.IsVulnerability.ID == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerability.ID(childComplexity), true
+
+	case "IsVulnerability.justification":
+		if e.complexity.IsVulnerability.Justification == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerability.Justification(childComplexity), true
+
+	case "IsVulnerability.origin":
+		if e.complexity.IsVulnerability.Origin == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerability.Origin(childComplexity), true
+
+	case "IsVulnerability.osv":
+		if e.complexity.IsVulnerability.Osv == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerability.Osv(childComplexity), true
+
+	case "IsVulnerability.vulnerability":
+		if e.complexity.IsVulnerability.Vulnerability == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerability.Vulnerability(childComplexity), true
+
+	case "IsVulnerabilityConnection.edges":
+		if e.complexity.IsVulnerabilityConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerabilityConnection.Edges(childComplexity), true
+
+	case "IsVulnerabilityConnection.pageInfo":
+		if e.complexity.IsVulnerabilityConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerabilityConnection.PageInfo(childComplexity), true
+
+	case "IsVulnerabilityConnection.totalCount":
+		if e.complexity.IsVulnerabilityConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerabilityConnection.TotalCount(childComplexity), true
+
+	case "IsVulnerabilityEdge.cursor":
+		if e.complexity.IsVulnerabilityEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerabilityEdge.Cursor(childComplexity), true
+
+	case "IsVulnerabilityEdge.node":
+		if e.complexity.IsVulnerabilityEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.IsVulnerabilityEdge.Node(childComplexity), true
+
+	case "Mutation.certifyScorecard":
+		if e.complexity.Mutation.CertifyScorecard == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_certifyScorecard_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CertifyScorecard(childComplexity, args["source"].(model.SourceInputSpec), args["scorecard"].(model.ScorecardInputSpec)), true
+
+	case "Mutation.ingestArtifact":
+		if e.complexity.Mutation.IngestArtifact == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestArtifact_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestArtifact(childComplexity, args["artifact"].(*model.ArtifactInputSpec)), true
+
+	case "Mutation.ingestBuilder":
+		if e.complexity.Mutation.IngestBuilder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestBuilder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestBuilder(childComplexity, args["builder"].(*model.BuilderInputSpec)), true
+
+	case "Mutation.ingestCertifyBad":
+		if e.complexity.Mutation.IngestCertifyBad == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyBad_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyBad(childComplexity, args["subject"].(model.PackageSourceOrArtifactInput), args["pkgMatchType"].(*model.MatchFlags), args["certifyBad"].(model.CertifyBadInputSpec)), true
+
+	case "Mutation.ingestCertifyEOL":
+		if e.complexity.Mutation.IngestCertifyEol == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyEOL_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyEol(childComplexity, args["pkg"].(model.PkgInputSpec), args["certifyEOL"].(model.CertifyEOLInputSpec)), true
+
+	case "Mutation.ingestCertifyPkg":
+		if e.complexity.Mutation.IngestCertifyPkg == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCertifyPkg_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCertifyPkg(childComplexity, args["pkg"].(model.PkgInputSpec), args["depPkg"].(model.PkgInputSpec), args["certifyPkg"].(model.CertifyPkgInputSpec)), true
+
+	case "Mutation.ingestCVE":
+		if e.complexity.Mutation.IngestCve == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestCVE_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestCve(childComplexity, args["cve"].(*model.CVEInputSpec)), true
+
+	case "Mutation.ingestDependency":
+		if e.complexity.Mutation.IngestDependency == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestDependency_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestDependency(childComplexity, args["pkg"].(model.PkgInputSpec), args["depPkg"].(model.PkgInputSpec), args["dependency"].(model.IsDependencyInputSpec)), true
+
+	case "Mutation.ingestGHSA":
+		if e.complexity.Mutation.IngestGhsa == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestGHSA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestGhsa(childComplexity, args["ghsa"].(*model.GHSAInputSpec)), true
+
+	case "Mutation.ingestHasSBOM":
+		if e.complexity.Mutation.IngestHasSbom == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHasSBOM_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHasSbom(childComplexity, args["subject"].(model.PackageOrSourceInput), args["hasSBOM"].(model.HasSBOMInputSpec)), true
+
+	case "Mutation.ingestHasSourceAt":
+		if e.complexity.Mutation.IngestHasSourceAt == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHasSourceAt_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHasSourceAt(childComplexity, args["pkg"].(model.PkgInputSpec), args["pkgMatchType"].(model.MatchFlags), args["source"].(model.SourceInputSpec), args["hasSourceAt"].(model.HasSourceAtInputSpec)), true
+
+	case "Mutation.ingestHashEqual":
+		if e.complexity.Mutation.IngestHashEqual == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestHashEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestHashEqual(childComplexity, args["artifact"].(model.ArtifactInputSpec), args["equalArtifact"].(model.ArtifactInputSpec), args["hashEqual"].(model.HashEqualInputSpec)), true
+
+	case "Mutation.ingestIsVulnerability":
+		if e.complexity.Mutation.IngestIsVulnerability == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestIsVulnerability_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestIsVulnerability(childComplexity, args["osv"].(model.OSVInputSpec), args["vulnerability"].(model.CveOrGhsaInput), args["isVulnerability"].(model.IsVulnerabilityInputSpec)), true
+
+	case "Mutation.ingestMaterials":
+		if e.complexity.Mutation.IngestMaterials == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestMaterials_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestMaterials(childComplexity, args["materials"].([]*model.PackageSourceOrArtifactInput)), true
+
+	case "Mutation.ingestOccurrence":
+		if e.complexity.Mutation.IngestOccurrence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestOccurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestOccurrence(childComplexity, args["subject"].(model.PackageOrSourceInput), args["artifact"].(model.ArtifactInputSpec), args["occurrence"].(model.IsOccurrenceInputSpec)), true
+
+	case "Mutation.ingestOSV":
+		if e.complexity.Mutation.IngestOsv == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestOSV_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestOsv(childComplexity, args["osv"].(*model.OSVInputSpec)), true
+
+	case "Mutation.ingestPackage":
+		if e.complexity.Mutation.IngestPackage == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestPackage_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IngestPackage(childComplexity, args["pkg"].(*model.PkgInputSpec)), true
+
+	case "Mutation.ingestSLSA":
+		if e.complexity.Mutation.IngestSlsa == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_ingestSLSA_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "HasSourceAt.source":
-		if e.complexity.HasSourceAt.Source == nil {
+		return e.complexity.Mutation.IngestSlsa(childComplexity, args["subject"].(model.PackageSourceOrArtifactInput), args["builtFrom"].([]*model.PackageSourceOrArtifactInput), args["builtBy"].(model.BuilderInputSpec), args["slsa"].(model.SLSAInputSpec)), true
+
+	case "Mutation.ingestSource":
+		if e.complexity.Mutation.IngestSource == nil {
 			break
 		}
 
-		return e.complexity.HasSourceAt.Source(childComplexity), true
+		args, err := ec.field_Mutation_ingestSource_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "HashEqual.artifacts":
-		if e.complexity.HashEqual.Artifacts == nil {
+		return e.complexity.Mutation.IngestSource(childComplexity, args["source"].(*model.SourceInputSpec)), true
+
+	case "Mutation.ingestVEXStatement":
+		if e.complexity.Mutation.IngestVEXStatement == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.Artifacts(childComplexity), true
+		args, err := ec.field_Mutation_ingestVEXStatement_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "HashEqual.collector":
-		if e.complexity.HashEqual.Collector == nil {
+		return e.complexity.Mutation.IngestVEXStatement(childComplexity, args["subject"].(model.PackageOrArtifactInput), args["vulnerability"].(model.OsvCveOrGhsaInput), args["vexStatement"].(model.VexStatementInputSpec)), true
+
+	case "Mutation.ingestVulnAffected":
+		if e.complexity.Mutation.IngestVulnAffected == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.Collector(childComplexity), true
+		args, err := ec.field_Mutation_ingestVulnAffected_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "HashEqual.justification":
-		if e.complexity.HashEqual.Justification == nil {
+		return e.complexity.Mutation.IngestVulnAffected(childComplexity, args["pkg"].(model.PkgInputSpec), args["vulnerability"].(model.OsvCveOrGhsaInput), args["vulnAffected"].(model.VulnAffectedInputSpec)), true
+
+	case "Mutation.ingestVulnMetadata":
+		if e.complexity.Mutation.IngestVulnMetadata == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.Justification(childComplexity), true
+		args, err := ec.field_Mutation_ingestVulnMetadata_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "HashEqual.origin":
-		if e.complexity.HashEqual.Origin == nil {
+		return e.complexity.Mutation.IngestVulnMetadata(childComplexity, args["vulnerability"].(model.OsvCveOrGhsaInput), args["vulnMetadata"].(model.VulnMetadataInputSpec)), true
+
+	case "Mutation.ingestVulnerability":
+		if e.complexity.Mutation.IngestVulnerability == nil {
 			break
 		}
 
-		return e.complexity.HashEqual.Origin(childComplexity), true
+		args, err := ec.field_Mutation_ingestVulnerability_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "IsDependency.collector":
-		if e.complexity.IsDependency.Collector == nil {
+		return e.complexity.Mutation.IngestVulnerability(childComplexity, args["pkg"].(model.PkgInputSpec), args["vulnerability"].(model.OsvCveOrGhsaInput), args["certifyVuln"].(model.VulnerabilityMetaDataInput)), true
+
+	case "NoVuln.id":
+		if e.complexity.NoVuln.ID == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.Collector(childComplexity), true
+		return e.complexity.NoVuln.ID(childComplexity), true
 
-	case "IsDependency.dependentPackage":
-		if e.complexity.IsDependency.DependentPackage == nil {
+	case "NoVuln.noVuln":
+		if e.complexity.NoVuln.NoVuln == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.DependentPackage(childComplexity), true
+		return e.complexity.NoVuln.NoVuln(childComplexity), true
 
-	case "IsDependency.justification":
-		if e.complexity.IsDependency.Justification == nil {
+	case "OSV.id":
+		if e.complexity.OSV.ID == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.Justification(childComplexity), true
+		return e.complexity.OSV.ID(childComplexity), true
 
-	case "IsDependency.origin":
-		if e.complexity.IsDependency.Origin == nil {
+	case "OSV.osvId":
+		if e.complexity.OSV.OsvID == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.Origin(childComplexity), true
+		return e.complexity.OSV.OsvID(childComplexity), true
 
-	case "IsDependency.package":
-		if e.complexity.IsDependency.Package == nil {
+	case "OSVConnection.edges":
+		if e.complexity.OSVConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.Package(childComplexity), true
+		return e.complexity.OSVConnection.Edges(childComplexity), true
 
-	case "IsDependency.versionRange":
-		if e.complexity.IsDependency.VersionRange == nil {
+	case "OSVConnection.pageInfo":
+		if e.complexity.OSVConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.IsDependency.VersionRange(childComplexity), true
+		return e.complexity.OSVConnection.PageInfo(childComplexity), true
 
-	case "IsOccurrence.artifact":
-		if e.complexity.IsOccurrence.Artifact == nil {
+	case "OSVConnection.totalCount":
+		if e.complexity.OSVConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.Artifact(childComplexity), true
+		return e.complexity.OSVConnection.TotalCount(childComplexity), true
 
-	case "IsOccurrence.collector":
-		if e.complexity.IsOccurrence.Collector == nil {
+	case "OSVEdge.cursor":
+		if e.complexity.OSVEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.Collector(childComplexity), true
+		return e.complexity.OSVEdge.Cursor(childComplexity), true
 
-	case "IsOccurrence.justification":
-		if e.complexity.IsOccurrence.Justification == nil {
+	case "OSVEdge.node":
+		if e.complexity.OSVEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.Justification(childComplexity), true
+		return e.complexity.OSVEdge.Node(childComplexity), true
 
-	case "IsOccurrence.origin":
-		if e.complexity.IsOccurrence.Origin == nil {
+	case "OSVId.id":
+		if e.complexity.OSVId.ID == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.Origin(childComplexity), true
+		return e.complexity.OSVId.ID(childComplexity), true
 
-	case "IsOccurrence.subject":
-		if e.complexity.IsOccurrence.Subject == nil {
+	case "Package.id":
+		if e.complexity.Package.ID == nil {
 			break
 		}
 
-		return e.complexity.IsOccurrence.Subject(childComplexity), true
+		return e.complexity.Package.ID(childComplexity), true
 
-	case "IsVulnerability.collector":
-		if e.complexity.IsVulnerability.Collector == nil {
+	case "Package.namespaces":
+		if e.complexity.Package.Namespaces == nil {
 			break
 		}
 
-		return e.complexity.IsVulnerability.Collector(childComplexity), true
+		return e.complexity.Package.Namespaces(childComplexity), true
 
-	case "IsVulnerability.justification":
-		if e.complexity.IsVulnerability.Justification == nil {
+	case "Package.type":
+		if e.complexity.Package.Type == nil {
+			break
+		}
+
+		return e.complexity.Package.Type(childComplexity), true
+
+	case "PackageConnection.edges":
+		if e.complexity.PackageConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.Edges(childComplexity), true
+
+	case "PackageConnection.pageInfo":
+		if e.complexity.PackageConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.PageInfo(childComplexity), true
+
+	case "PackageConnection.totalCount":
+		if e.complexity.PackageConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.TotalCount(childComplexity), true
+
+	case "PackageEdge.cursor":
+		if e.complexity.PackageEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.PackageEdge.Cursor(childComplexity), true
+
+	case "PackageEdge.node":
+		if e.complexity.PackageEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.PackageEdge.Node(childComplexity), true
+
+	case "PackageName.id":
+		if e.complexity.PackageName.ID == nil {
+			break
+		}
+
+		return e.complexity.PackageName.ID(childComplexity), true
+
+	case "PackageName.name":
+		if e.complexity.PackageName.Name == nil {
+			break
+		}
+
+		return e.complexity.PackageName.Name(childComplexity), true
+
+	case "PackageName.versions":
+		if e.complexity.PackageName.Versions == nil {
+			break
+		}
+
+		return e.complexity.PackageName.Versions(childComplexity), true
+
+	case "PackageNamespace.id":
+		if e.complexity.PackageNamespace.ID == nil {
+			break
+		}
+
+		return e.complexity.PackageNamespace.ID(childComplexity), true
+
+	case "PackageNamespace.names":
+		if e.complexity.PackageNamespace.Names == nil {
+			break
+		}
+
+		return e.complexity.PackageNamespace.Names(childComplexity), true
+
+	case "PackageNamespace.namespace":
+		if e.complexity.PackageNamespace.Namespace == nil {
+			break
+		}
+
+		return e.complexity.PackageNamespace.Namespace(childComplexity), true
+
+	case "PackageQualifier.key":
+		if e.complexity.PackageQualifier.Key == nil {
+			break
+		}
+
+		return e.complexity.PackageQualifier.Key(childComplexity), true
+
+	case "PackageQualifier.value":
+		if e.complexity.PackageQualifier.Value == nil {
+			break
+		}
+
+		return e.complexity.PackageQualifier.Value(childComplexity), true
+
+	case "PackageVersion.id":
+		if e.complexity.PackageVersion.ID == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.ID(childComplexity), true
+
+	case "PackageVersion.qualifiers":
+		if e.complexity.PackageVersion.Qualifiers == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.Qualifiers(childComplexity), true
+
+	case "PackageVersion.subpath":
+		if e.complexity.PackageVersion.Subpath == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.Subpath(childComplexity), true
+
+	case "PackageVersion.version":
+		if e.complexity.PackageVersion.Version == nil {
+			break
+		}
+
+		return e.complexity.PackageVersion.Version(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Query.affectedPackages":
+		if e.complexity.Query.AffectedPackages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_affectedPackages_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AffectedPackages(childComplexity, args["vulnAffectedSpec"].(*model.VulnAffectedSpec)), true
+
+	case "Query.affectedPackagesList":
+		if e.complexity.Query.AffectedPackagesList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_affectedPackagesList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AffectedPackagesList(childComplexity, args["vulnAffectedSpec"].(model.VulnAffectedSpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.artifacts":
+		if e.complexity.Query.Artifacts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_artifacts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Artifacts(childComplexity, args["artifactSpec"].(*model.ArtifactSpec)), true
+
+	case "Query.artifactsList":
+		if e.complexity.Query.ArtifactsList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_artifactsList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ArtifactsList(childComplexity, args["artifactSpec"].(model.ArtifactSpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.builders":
+		if e.complexity.Query.Builders == nil {
 			break
 		}
 
-		return e.complexity.IsVulnerability.Justification(childComplexity), true
+		args, err := ec.field_Query_builders_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Builders(childComplexity, args["builderSpec"].(*model.BuilderSpec)), true
 
-	case "IsVulnerability.origin":
-		if e.complexity.IsVulnerability.Origin == nil {
+	case "Query.buildersList":
+		if e.complexity.Query.BuildersList == nil {
 			break
 		}
 
-		return e.complexity.IsVulnerability.Origin(childComplexity), true
-
-	case "IsVulnerability.osv":
-		if e.complexity.IsVulnerability.Osv == nil {
-			break
+		args, err := ec.field_Query_buildersList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.IsVulnerability.Osv(childComplexity), true
+		return e.complexity.Query.BuildersList(childComplexity, args["builderSpec"].(model.BuilderSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "IsVulnerability.vulnerability":
-		if e.complexity.IsVulnerability.Vulnerability == nil {
+	case "Query.CertifyBad":
+		if e.complexity.Query.CertifyBad == nil {
 			break
 		}
 
-		return e.complexity.IsVulnerability.Vulnerability(childComplexity), true
+		args, err := ec.field_Query_CertifyBad_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Mutation.certifyScorecard":
-		if e.complexity.Mutation.CertifyScorecard == nil {
+		return e.complexity.Query.CertifyBad(childComplexity, args["certifyBadSpec"].(*model.CertifyBadSpec)), true
+
+	case "Query.CertifyBadList":
+		if e.complexity.Query.CertifyBadList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_certifyScorecard_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyBadList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.CertifyScorecard(childComplexity, args["source"].(model.SourceInputSpec), args["scorecard"].(model.ScorecardInputSpec)), true
+		return e.complexity.Query.CertifyBadList(childComplexity, args["certifyBadSpec"].(model.CertifyBadSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestArtifact":
-		if e.complexity.Mutation.IngestArtifact == nil {
+	case "Query.CertifyEOLList":
+		if e.complexity.Query.CertifyEOLList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestArtifact_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyEOLList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestArtifact(childComplexity, args["artifact"].(*model.ArtifactInputSpec)), true
+		return e.complexity.Query.CertifyEOLList(childComplexity, args["certifyEOLSpec"].(model.CertifyEOLSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestBuilder":
-		if e.complexity.Mutation.IngestBuilder == nil {
+	case "Query.CertifyEOL":
+		if e.complexity.Query.CertifyEol == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestBuilder_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyEOL_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestBuilder(childComplexity, args["builder"].(*model.BuilderInputSpec)), true
+		return e.complexity.Query.CertifyEol(childComplexity, args["certifyEOLSpec"].(*model.CertifyEOLSpec)), true
 
-	case "Mutation.ingestCertifyBad":
-		if e.complexity.Mutation.IngestCertifyBad == nil {
+	case "Query.CertifyPkg":
+		if e.complexity.Query.CertifyPkg == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestCertifyBad_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyPkg_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestCertifyBad(childComplexity, args["subject"].(model.PackageSourceOrArtifactInput), args["pkgMatchType"].(*model.MatchFlags), args["certifyBad"].(model.CertifyBadInputSpec)), true
+		return e.complexity.Query.CertifyPkg(childComplexity, args["certifyPkgSpec"].(*model.CertifyPkgSpec)), true
 
-	case "Mutation.ingestCertifyPkg":
-		if e.complexity.Mutation.IngestCertifyPkg == nil {
+	case "Query.CertifyPkgList":
+		if e.complexity.Query.CertifyPkgList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestCertifyPkg_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyPkgList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestCertifyPkg(childComplexity, args["pkg"].(model.PkgInputSpec), args["depPkg"].(model.PkgInputSpec), args["certifyPkg"].(model.CertifyPkgInputSpec)), true
+		return e.complexity.Query.CertifyPkgList(childComplexity, args["certifyPkgSpec"].(model.CertifyPkgSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestCVE":
-		if e.complexity.Mutation.IngestCve == nil {
+	case "Query.CertifyVEXStatement":
+		if e.complexity.Query.CertifyVEXStatement == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestCVE_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyVEXStatement_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestCve(childComplexity, args["cve"].(*model.CVEInputSpec)), true
+		return e.complexity.Query.CertifyVEXStatement(childComplexity, args["certifyVEXStatementSpec"].(*model.CertifyVEXStatementSpec)), true
 
-	case "Mutation.ingestDependency":
-		if e.complexity.Mutation.IngestDependency == nil {
+	case "Query.CertifyVEXStatementList":
+		if e.complexity.Query.CertifyVEXStatementList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestDependency_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyVEXStatementList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestDependency(childComplexity, args["pkg"].(model.PkgInputSpec), args["depPkg"].(model.PkgInputSpec), args["dependency"].(model.IsDependencyInputSpec)), true
+		return e.complexity.Query.CertifyVEXStatementList(childComplexity, args["certifyVEXStatementSpec"].(model.CertifyVEXStatementSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestGHSA":
-		if e.complexity.Mutation.IngestGhsa == nil {
+	case "Query.CertifyVuln":
+		if e.complexity.Query.CertifyVuln == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestGHSA_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyVuln_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestGhsa(childComplexity, args["ghsa"].(*model.GHSAInputSpec)), true
+		return e.complexity.Query.CertifyVuln(childComplexity, args["certifyVulnSpec"].(*model.CertifyVulnSpec)), true
 
-	case "Mutation.ingestHasSBOM":
-		if e.complexity.Mutation.IngestHasSbom == nil {
+	case "Query.CertifyVulnList":
+		if e.complexity.Query.CertifyVulnList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestHasSBOM_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_CertifyVulnList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestHasSbom(childComplexity, args["subject"].(model.PackageOrSourceInput), args["hasSBOM"].(model.HasSBOMInputSpec)), true
+		return e.complexity.Query.CertifyVulnList(childComplexity, args["certifyVulnSpec"].(model.CertifyVulnSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestHasSourceAt":
-		if e.complexity.Mutation.IngestHasSourceAt == nil {
+	case "Query.cve":
+		if e.complexity.Query.Cve == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestHasSourceAt_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_cve_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestHasSourceAt(childComplexity, args["pkg"].(model.PkgInputSpec), args["pkgMatchType"].(model.MatchFlags), args["source"].(model.SourceInputSpec), args["hasSourceAt"].(model.HasSourceAtInputSpec)), true
+		return e.complexity.Query.Cve(childComplexity, args["cveSpec"].(*model.CVESpec)), true
 
-	case "Mutation.ingestHashEqual":
-		if e.complexity.Mutation.IngestHashEqual == nil {
+	case "Query.cveList":
+		if e.complexity.Query.CveList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestHashEqual_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_cveList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestHashEqual(childComplexity, args["artifact"].(model.ArtifactInputSpec), args["equalArtifact"].(model.ArtifactInputSpec), args["hashEqual"].(model.HashEqualInputSpec)), true
+		return e.complexity.Query.CveList(childComplexity, args["cveSpec"].(model.CVESpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestIsVulnerability":
-		if e.complexity.Mutation.IngestIsVulnerability == nil {
+	case "Query.dependents":
+		if e.complexity.Query.Dependents == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestIsVulnerability_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_dependents_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestIsVulnerability(childComplexity, args["osv"].(model.OSVInputSpec), args["vulnerability"].(model.CveOrGhsaInput), args["isVulnerability"].(model.IsVulnerabilityInputSpec)), true
+		return e.complexity.Query.Dependents(childComplexity, args["pkgSpec"].(model.PkgSpec), args["maxDepth"].(*int)), true
 
-	case "Mutation.ingestMaterials":
-		if e.complexity.Mutation.IngestMaterials == nil {
+	case "Query.eolDependents":
+		if e.complexity.Query.EolDependents == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestMaterials_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_eolDependents_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestMaterials(childComplexity, args["materials"].([]*model.PackageSourceOrArtifactInput)), true
+		return e.complexity.Query.EolDependents(childComplexity, args["certifyEOLSpec"].(*model.CertifyEOLSpec)), true
 
-	case "Mutation.ingestOccurrence":
-		if e.complexity.Mutation.IngestOccurrence == nil {
+	case "Query.eolDependentsList":
+		if e.complexity.Query.EolDependentsList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestOccurrence_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_eolDependentsList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestOccurrence(childComplexity, args["subject"].(model.PackageOrSourceInput), args["artifact"].(model.ArtifactInputSpec), args["occurrence"].(model.IsOccurrenceInputSpec)), true
+		return e.complexity.Query.EolDependentsList(childComplexity, args["certifyEOLSpec"].(model.CertifyEOLSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestOSV":
-		if e.complexity.Mutation.IngestOsv == nil {
+	case "Query.ghsa":
+		if e.complexity.Query.Ghsa == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestOSV_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_ghsa_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestOsv(childComplexity, args["osv"].(*model.OSVInputSpec)), true
+		return e.complexity.Query.Ghsa(childComplexity, args["ghsaSpec"].(*model.GHSASpec)), true
 
-	case "Mutation.ingestPackage":
-		if e.complexity.Mutation.IngestPackage == nil {
+	case "Query.ghsaList":
+		if e.complexity.Query.GhsaList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestPackage_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_ghsaList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestPackage(childComplexity, args["pkg"].(*model.PkgInputSpec)), true
+		return e.complexity.Query.GhsaList(childComplexity, args["ghsaSpec"].(model.GHSASpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestSLSA":
-		if e.complexity.Mutation.IngestSlsa == nil {
+	case "Query.HasSBOMList":
+		if e.complexity.Query.HasSBOMList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestSLSA_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_HasSBOMList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestSlsa(childComplexity, args["subject"].(model.PackageSourceOrArtifactInput), args["builtFrom"].([]*model.PackageSourceOrArtifactInput), args["builtBy"].(model.BuilderInputSpec), args["slsa"].(model.SLSAInputSpec)), true
+		return e.complexity.Query.HasSBOMList(childComplexity, args["hasSBOMSpec"].(model.HasSBOMSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestSource":
-		if e.complexity.Mutation.IngestSource == nil {
+	case "Query.HasSLSAList":
+		if e.complexity.Query.HasSLSAList == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestSource_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_HasSLSAList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestSource(childComplexity, args["source"].(*model.SourceInputSpec)), true
+		return e.complexity.Query.HasSLSAList(childComplexity, args["hasSLSASpec"].(model.HasSLSASpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Mutation.ingestVEXStatement":
-		if e.complexity.Mutation.IngestVEXStatement == nil {
+	case "Query.HasSBOM":
+		if e.complexity.Query.HasSbom == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestVEXStatement_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_HasSBOM_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestVEXStatement(childComplexity, args["subject"].(model.PackageOrArtifactInput), args["vulnerability"].(model.CveOrGhsaInput), args["vexStatement"].(model.VexStatementInputSpec)), true
+		return e.complexity.Query.HasSbom(childComplexity, args["hasSBOMSpec"].(*model.HasSBOMSpec)), true
 
-	case "Mutation.ingestVulnerability":
-		if e.complexity.Mutation.IngestVulnerability == nil {
+	case "Query.HasSLSA":
+		if e.complexity.Query.HasSlsa == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_ingestVulnerability_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_HasSLSA_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Mutation.IngestVulnerability(childComplexity, args["pkg"].(model.PkgInputSpec), args["vulnerability"].(model.OsvCveOrGhsaInput), args["certifyVuln"].(model.VulnerabilityMetaDataInput)), true
+		return e.complexity.Query.HasSlsa(childComplexity, args["hasSLSASpec"].(*model.HasSLSASpec)), true
 
-	case "OSV.osvId":
-		if e.complexity.OSV.OsvID == nil {
+	case "Query.HasSourceAt":
+		if e.complexity.Query.HasSourceAt == nil {
 			break
 		}
 
-		return e.complexity.OSV.OsvID(childComplexity), true
-
-	case "OSVId.id":
-		if e.complexity.OSVId.ID == nil {
-			break
+		args, err := ec.field_Query_HasSourceAt_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.OSVId.ID(childComplexity), true
+		return e.complexity.Query.HasSourceAt(childComplexity, args["hasSourceAtSpec"].(*model.HasSourceAtSpec)), true
 
-	case "Package.namespaces":
-		if e.complexity.Package.Namespaces == nil {
+	case "Query.HasSourceAtList":
+		if e.complexity.Query.HasSourceAtList == nil {
 			break
 		}
 
-		return e.complexity.Package.Namespaces(childComplexity), true
-
-	case "Package.type":
-		if e.complexity.Package.Type == nil {
-			break
+		args, err := ec.field_Query_HasSourceAtList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.Package.Type(childComplexity), true
+		return e.complexity.Query.HasSourceAtList(childComplexity, args["hasSourceAtSpec"].(model.HasSourceAtSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "PackageName.name":
-		if e.complexity.PackageName.Name == nil {
+	case "Query.HashEqual":
+		if e.complexity.Query.HashEqual == nil {
 			break
 		}
 
-		return e.complexity.PackageName.Name(childComplexity), true
-
-	case "PackageName.versions":
-		if e.complexity.PackageName.Versions == nil {
-			break
+		args, err := ec.field_Query_HashEqual_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PackageName.Versions(childComplexity), true
+		return e.complexity.Query.HashEqual(childComplexity, args["hashEqualSpec"].(*model.HashEqualSpec)), true
 
-	case "PackageNamespace.names":
-		if e.complexity.PackageNamespace.Names == nil {
+	case "Query.HashEqualList":
+		if e.complexity.Query.HashEqualList == nil {
 			break
 		}
 
-		return e.complexity.PackageNamespace.Names(childComplexity), true
-
-	case "PackageNamespace.namespace":
-		if e.complexity.PackageNamespace.Namespace == nil {
-			break
+		args, err := ec.field_Query_HashEqualList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PackageNamespace.Namespace(childComplexity), true
+		return e.complexity.Query.HashEqualList(childComplexity, args["hashEqualSpec"].(model.HashEqualSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "PackageQualifier.key":
-		if e.complexity.PackageQualifier.Key == nil {
+	case "Query.IsDependency":
+		if e.complexity.Query.IsDependency == nil {
 			break
 		}
 
-		return e.complexity.PackageQualifier.Key(childComplexity), true
-
-	case "PackageQualifier.value":
-		if e.complexity.PackageQualifier.Value == nil {
-			break
+		args, err := ec.field_Query_IsDependency_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
 		}
 
-		return e.complexity.PackageQualifier.Value(childComplexity), true
+		return e.complexity.Query.IsDependency(childComplexity, args["isDependencySpec"].(*model.IsDependencySpec)), true
 
-	case "PackageVersion.qualifiers":
-		if e.complexity.PackageVersion.Qualifiers == nil {
+	case "Query.IsDependencyList":
+		if e.complexity.Query.IsDependencyList == nil {
 			break
 		}
 
-		return e.complexity.PackageVersion.Qualifiers(childComplexity), true
+		args, err := ec.field_Query_IsDependencyList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PackageVersion.subpath":
-		if e.complexity.PackageVersion.Subpath == nil {
+		return e.complexity.Query.IsDependencyList(childComplexity, args["isDependencySpec"].(model.IsDependencySpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.IsOccurrence":
+		if e.complexity.Query.IsOccurrence == nil {
 			break
 		}
 
-		return e.complexity.PackageVersion.Subpath(childComplexity), true
+		args, err := ec.field_Query_IsOccurrence_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "PackageVersion.version":
-		if e.complexity.PackageVersion.Version == nil {
+		return e.complexity.Query.IsOccurrence(childComplexity, args["isOccurrenceSpec"].(*model.IsOccurrenceSpec)), true
+
+	case "Query.IsOccurrenceList":
+		if e.complexity.Query.IsOccurrenceList == nil {
 			break
 		}
 
-		return e.complexity.PackageVersion.Version(childComplexity), true
+		args, err := ec.field_Query_IsOccurrenceList_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "Query.artifacts":
-		if e.complexity.Query.Artifacts == nil {
+		return e.complexity.Query.IsOccurrenceList(childComplexity, args["isOccurrenceSpec"].(model.IsOccurrenceSpec), args["after"].(*string), args["first"].(*int)), true
+
+	case "Query.IsVulnerability":
+		if e.complexity.Query.IsVulnerability == nil {
 			break
 		}
 
-		args, err := ec.field_Query_artifacts_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_IsVulnerability_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Artifacts(childComplexity, args["artifactSpec"].(*model.ArtifactSpec)), true
+		return e.complexity.Query.IsVulnerability(childComplexity, args["isVulnerabilitySpec"].(*model.IsVulnerabilitySpec)), true
 
-	case "Query.builders":
-		if e.complexity.Query.Builders == nil {
+	case "Query.IsVulnerabilityList":
+		if e.complexity.Query.IsVulnerabilityList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_builders_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_IsVulnerabilityList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Builders(childComplexity, args["builderSpec"].(*model.BuilderSpec)), true
+		return e.complexity.Query.IsVulnerabilityList(childComplexity, args["isVulnerabilitySpec"].(model.IsVulnerabilitySpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Query.CertifyBad":
-		if e.complexity.Query.CertifyBad == nil {
+	case "Query.neighbors":
+		if e.complexity.Query.Neighbors == nil {
 			break
 		}
 
-		args, err := ec.field_Query_CertifyBad_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_neighbors_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyBad(childComplexity, args["certifyBadSpec"].(*model.CertifyBadSpec)), true
+		return e.complexity.Query.Neighbors(childComplexity, args["node"].(string), args["usingOnly"].([]model.Edge)), true
 
-	case "Query.CertifyPkg":
-		if e.complexity.Query.CertifyPkg == nil {
+	case "Query.node":
+		if e.complexity.Query.Node == nil {
 			break
 		}
 
-		args, err := ec.field_Query_CertifyPkg_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_node_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyPkg(childComplexity, args["certifyPkgSpec"].(*model.CertifyPkgSpec)), true
+		return e.complexity.Query.Node(childComplexity, args["id"].(string)), true
 
-	case "Query.CertifyVEXStatement":
-		if e.complexity.Query.CertifyVEXStatement == nil {
+	case "Query.nodes":
+		if e.complexity.Query.Nodes == nil {
 			break
 		}
 
-		args, err := ec.field_Query_CertifyVEXStatement_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_nodes_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyVEXStatement(childComplexity, args["certifyVEXStatementSpec"].(*model.CertifyVEXStatementSpec)), true
+		return e.complexity.Query.Nodes(childComplexity, args["ids"].([]string)), true
 
-	case "Query.CertifyVuln":
-		if e.complexity.Query.CertifyVuln == nil {
+	case "Query.osv":
+		if e.complexity.Query.Osv == nil {
 			break
 		}
 
-		args, err := ec.field_Query_CertifyVuln_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_osv_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.CertifyVuln(childComplexity, args["certifyVulnSpec"].(*model.CertifyVulnSpec)), true
+		return e.complexity.Query.Osv(childComplexity, args["osvSpec"].(*model.OSVSpec)), true
 
-	case "Query.cve":
-		if e.complexity.Query.Cve == nil {
+	case "Query.osvList":
+		if e.complexity.Query.OsvList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_cve_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_osvList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Cve(childComplexity, args["cveSpec"].(*model.CVESpec)), true
+		return e.complexity.Query.OsvList(childComplexity, args["osvSpec"].(model.OSVSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Query.ghsa":
-		if e.complexity.Query.Ghsa == nil {
+	case "Query.packages":
+		if e.complexity.Query.Packages == nil {
 			break
 		}
 
-		args, err := ec.field_Query_ghsa_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_packages_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Ghsa(childComplexity, args["ghsaSpec"].(*model.GHSASpec)), true
+		return e.complexity.Query.Packages(childComplexity, args["pkgSpec"].(*model.PkgSpec)), true
 
-	case "Query.HasSBOM":
-		if e.complexity.Query.HasSbom == nil {
+	case "Query.packagesList":
+		if e.complexity.Query.PackagesList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_HasSBOM_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_packagesList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.HasSbom(childComplexity, args["hasSBOMSpec"].(*model.HasSBOMSpec)), true
+		return e.complexity.Query.PackagesList(childComplexity, args["pkgSpec"].(model.PkgSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Query.HasSLSA":
-		if e.complexity.Query.HasSlsa == nil {
+	case "Query.path":
+		if e.complexity.Query.Path == nil {
 			break
 		}
 
-		args, err := ec.field_Query_HasSLSA_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_path_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.HasSlsa(childComplexity, args["hasSLSASpec"].(*model.HasSLSASpec)), true
+		return e.complexity.Query.Path(childComplexity, args["subject"].(string), args["target"].(string), args["maxPathLength"].(int), args["usingOnly"].([]model.Edge)), true
 
-	case "Query.HasSourceAt":
-		if e.complexity.Query.HasSourceAt == nil {
+	case "Query.scorecards":
+		if e.complexity.Query.Scorecards == nil {
 			break
 		}
 
-		args, err := ec.field_Query_HasSourceAt_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_scorecards_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.HasSourceAt(childComplexity, args["hasSourceAtSpec"].(*model.HasSourceAtSpec)), true
+		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(*model.CertifyScorecardSpec)), true
 
-	case "Query.HashEqual":
-		if e.complexity.Query.HashEqual == nil {
+	case "Query.scorecardsList":
+		if e.complexity.Query.ScorecardsList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_HashEqual_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_scorecardsList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.HashEqual(childComplexity, args["hashEqualSpec"].(*model.HashEqualSpec)), true
+		return e.complexity.Query.ScorecardsList(childComplexity, args["scorecardSpec"].(model.CertifyScorecardSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Query.IsDependency":
-		if e.complexity.Query.IsDependency == nil {
+	case "Query.sources":
+		if e.complexity.Query.Sources == nil {
 			break
 		}
 
-		args, err := ec.field_Query_IsDependency_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_sources_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.IsDependency(childComplexity, args["isDependencySpec"].(*model.IsDependencySpec)), true
+		return e.complexity.Query.Sources(childComplexity, args["sourceSpec"].(*model.SourceSpec)), true
 
-	case "Query.IsOccurrence":
-		if e.complexity.Query.IsOccurrence == nil {
+	case "Query.sourcesList":
+		if e.complexity.Query.SourcesList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_IsOccurrence_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_sourcesList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.IsOccurrence(childComplexity, args["isOccurrenceSpec"].(*model.IsOccurrenceSpec)), true
+		return e.complexity.Query.SourcesList(childComplexity, args["sourceSpec"].(model.SourceSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Query.IsVulnerability":
-		if e.complexity.Query.IsVulnerability == nil {
+	case "Query.transitiveDependencies":
+		if e.complexity.Query.TransitiveDependencies == nil {
 			break
 		}
 
-		args, err := ec.field_Query_IsVulnerability_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_transitiveDependencies_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.IsVulnerability(childComplexity, args["isVulnerabilitySpec"].(*model.IsVulnerabilitySpec)), true
+		return e.complexity.Query.TransitiveDependencies(childComplexity, args["pkgSpec"].(model.PkgSpec), args["maxDepth"].(*int)), true
 
-	case "Query.osv":
-		if e.complexity.Query.Osv == nil {
+	case "Query.VulnAffected":
+		if e.complexity.Query.VulnAffected == nil {
 			break
 		}
 
-		args, err := ec.field_Query_osv_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_VulnAffected_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Osv(childComplexity, args["osvSpec"].(*model.OSVSpec)), true
+		return e.complexity.Query.VulnAffected(childComplexity, args["vulnAffectedSpec"].(*model.VulnAffectedSpec)), true
 
-	case "Query.packages":
-		if e.complexity.Query.Packages == nil {
+	case "Query.VulnAffectedList":
+		if e.complexity.Query.VulnAffectedList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_packages_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_VulnAffectedList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Packages(childComplexity, args["pkgSpec"].(*model.PkgSpec)), true
+		return e.complexity.Query.VulnAffectedList(childComplexity, args["vulnAffectedSpec"].(model.VulnAffectedSpec), args["after"].(*string), args["first"].(*int)), true
 
-	case "Query.scorecards":
-		if e.complexity.Query.Scorecards == nil {
+	case "Query.VulnMetadata":
+		if e.complexity.Query.VulnMetadata == nil {
 			break
 		}
 
-		args, err := ec.field_Query_scorecards_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_VulnMetadata_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Scorecards(childComplexity, args["scorecardSpec"].(*model.CertifyScorecardSpec)), true
+		return e.complexity.Query.VulnMetadata(childComplexity, args["vulnMetadataSpec"].(*model.VulnMetadataSpec)), true
 
-	case "Query.sources":
-		if e.complexity.Query.Sources == nil {
+	case "Query.VulnMetadataList":
+		if e.complexity.Query.VulnMetadataList == nil {
 			break
 		}
 
-		args, err := ec.field_Query_sources_args(context.TODO(), rawArgs)
+		args, err := ec.field_Query_VulnMetadataList_args(context.TODO(), rawArgs)
 		if err != nil {
 			return 0, false
 		}
 
-		return e.complexity.Query.Sources(childComplexity, args["sourceSpec"].(*model.SourceSpec)), true
+		return e.complexity.Query.VulnMetadataList(childComplexity, args["vulnMetadataSpec"].(model.VulnMetadataSpec), args["after"].(*string), args["first"].(*int)), true
 
 	case "SLSA.buildType":
 		if e.complexity.SLSA.BuildType == nil {
@@ -1395,75 +3283,369 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Scorecard.ScorecardVersion(childComplexity), true
 
-	case "Scorecard.timeScanned":
-		if e.complexity.Scorecard.TimeScanned == nil {
+	case "Scorecard.timeScanned":
+		if e.complexity.Scorecard.TimeScanned == nil {
+			break
+		}
+
+		return e.complexity.Scorecard.TimeScanned(childComplexity), true
+
+	case "ScorecardCheck.check":
+		if e.complexity.ScorecardCheck.Check == nil {
+			break
+		}
+
+		return e.complexity.ScorecardCheck.Check(childComplexity), true
+
+	case "ScorecardCheck.score":
+		if e.complexity.ScorecardCheck.Score == nil {
+			break
+		}
+
+		return e.complexity.ScorecardCheck.Score(childComplexity), true
+
+	case "Source.id":
+		if e.complexity.Source.ID == nil {
+			break
+		}
+
+		return e.complexity.Source.ID(childComplexity), true
+
+	case "Source.namespaces":
+		if e.complexity.Source.Namespaces == nil {
+			break
+		}
+
+		return e.complexity.Source.Namespaces(childComplexity), true
+
+	case "Source.type":
+		if e.complexity.Source.Type == nil {
+			break
+		}
+
+		return e.complexity.Source.Type(childComplexity), true
+
+	case "SourceConnection.edges":
+		if e.complexity.SourceConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.Edges(childComplexity), true
+
+	case "SourceConnection.pageInfo":
+		if e.complexity.SourceConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.PageInfo(childComplexity), true
+
+	case "SourceConnection.totalCount":
+		if e.complexity.SourceConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SourceConnection.TotalCount(childComplexity), true
+
+	case "SourceEdge.cursor":
+		if e.complexity.SourceEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.SourceEdge.Cursor(childComplexity), true
+
+	case "SourceEdge.node":
+		if e.complexity.SourceEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SourceEdge.Node(childComplexity), true
+
+	case "SourceName.commit":
+		if e.complexity.SourceName.Commit == nil {
+			break
+		}
+
+		return e.complexity.SourceName.Commit(childComplexity), true
+
+	case "SourceName.id":
+		if e.complexity.SourceName.ID == nil {
+			break
+		}
+
+		return e.complexity.SourceName.ID(childComplexity), true
+
+	case "SourceName.name":
+		if e.complexity.SourceName.Name == nil {
+			break
+		}
+
+		return e.complexity.SourceName.Name(childComplexity), true
+
+	case "SourceName.tag":
+		if e.complexity.SourceName.Tag == nil {
+			break
+		}
+
+		return e.complexity.SourceName.Tag(childComplexity), true
+
+	case "SourceNamespace.id":
+		if e.complexity.SourceNamespace.ID == nil {
+			break
+		}
+
+		return e.complexity.SourceNamespace.ID(childComplexity), true
+
+	case "SourceNamespace.names":
+		if e.complexity.SourceNamespace.Names == nil {
+			break
+		}
+
+		return e.complexity.SourceNamespace.Names(childComplexity), true
+
+	case "SourceNamespace.namespace":
+		if e.complexity.SourceNamespace.Namespace == nil {
+			break
+		}
+
+		return e.complexity.SourceNamespace.Namespace(childComplexity), true
+
+	case "TransitiveDependency.cycle":
+		if e.complexity.TransitiveDependency.Cycle == nil {
+			break
+		}
+
+		return e.complexity.TransitiveDependency.Cycle(childComplexity), true
+
+	case "TransitiveDependency.dependency":
+		if e.complexity.TransitiveDependency.Dependency == nil {
+			break
+		}
+
+		return e.complexity.TransitiveDependency.Dependency(childComplexity), true
+
+	case "TransitiveDependency.depth":
+		if e.complexity.TransitiveDependency.Depth == nil {
+			break
+		}
+
+		return e.complexity.TransitiveDependency.Depth(childComplexity), true
+
+	case "TransitiveDependency.package":
+		if e.complexity.TransitiveDependency.Package == nil {
+			break
+		}
+
+		return e.complexity.TransitiveDependency.Package(childComplexity), true
+
+	case "VulnAffected.collector":
+		if e.complexity.VulnAffected.Collector == nil {
+			break
+		}
+
+		return e.complexity.VulnAffected.Collector(childComplexity), true
+
+	case "VulnAffected.id":
+		if e.complexity.VulnAffected.ID == nil {
+			break
+		}
+
+		return e.complexity.VulnAffected.ID(childComplexity), true
+
+	case "VulnAffected.origin":
+		if e.complexity.VulnAffected.Origin == nil {
+			break
+		}
+
+		return e.complexity.VulnAffected.Origin(childComplexity), true
+
+	case "VulnAffected.package":
+		if e.complexity.VulnAffected.Package == nil {
+			break
+		}
+
+		return e.complexity.VulnAffected.Package(childComplexity), true
+
+	case "VulnAffected.ranges":
+		if e.complexity.VulnAffected.Ranges == nil {
+			break
+		}
+
+		return e.complexity.VulnAffected.Ranges(childComplexity), true
+
+	case "VulnAffected.versions":
+		if e.complexity.VulnAffected.Versions == nil {
+			break
+		}
+
+		return e.complexity.VulnAffected.Versions(childComplexity), true
+
+	case "VulnAffected.vulnerability":
+		if e.complexity.VulnAffected.Vulnerability == nil {
+			break
+		}
+
+		return e.complexity.VulnAffected.Vulnerability(childComplexity), true
+
+	case "VulnAffectedConnection.edges":
+		if e.complexity.VulnAffectedConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.VulnAffectedConnection.Edges(childComplexity), true
+
+	case "VulnAffectedConnection.pageInfo":
+		if e.complexity.VulnAffectedConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.VulnAffectedConnection.PageInfo(childComplexity), true
+
+	case "VulnAffectedConnection.totalCount":
+		if e.complexity.VulnAffectedConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.VulnAffectedConnection.TotalCount(childComplexity), true
+
+	case "VulnAffectedEdge.cursor":
+		if e.complexity.VulnAffectedEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.VulnAffectedEdge.Cursor(childComplexity), true
+
+	case "VulnAffectedEdge.node":
+		if e.complexity.VulnAffectedEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.VulnAffectedEdge.Node(childComplexity), true
+
+	case "VulnMetadata.collector":
+		if e.complexity.VulnMetadata.Collector == nil {
+			break
+		}
+
+		return e.complexity.VulnMetadata.Collector(childComplexity), true
+
+	case "VulnMetadata.cweIds":
+		if e.complexity.VulnMetadata.CweIds == nil {
+			break
+		}
+
+		return e.complexity.VulnMetadata.CweIds(childComplexity), true
+
+	case "VulnMetadata.id":
+		if e.complexity.VulnMetadata.ID == nil {
+			break
+		}
+
+		return e.complexity.VulnMetadata.ID(childComplexity), true
+
+	case "VulnMetadata.modified":
+		if e.complexity.VulnMetadata.Modified == nil {
+			break
+		}
+
+		return e.complexity.VulnMetadata.Modified(childComplexity), true
+
+	case "VulnMetadata.origin":
+		if e.complexity.VulnMetadata.Origin == nil {
+			break
+		}
+
+		return e.complexity.VulnMetadata.Origin(childComplexity), true
+
+	case "VulnMetadata.published":
+		if e.complexity.VulnMetadata.Published == nil {
+			break
+		}
+
+		return e.complexity.VulnMetadata.Published(childComplexity), true
+
+	case "VulnMetadata.references":
+		if e.complexity.VulnMetadata.References == nil {
+			break
+		}
+
+		return e.complexity.VulnMetadata.References(childComplexity), true
+
+	case "VulnMetadata.scores":
+		if e.complexity.VulnMetadata.Scores == nil {
+			break
+		}
+
+		return e.complexity.VulnMetadata.Scores(childComplexity), true
+
+	case "VulnMetadata.summary":
+		if e.complexity.VulnMetadata.Summary == nil {
 			break
 		}
 
-		return e.complexity.Scorecard.TimeScanned(childComplexity), true
+		return e.complexity.VulnMetadata.Summary(childComplexity), true
 
-	case "ScorecardCheck.check":
-		if e.complexity.ScorecardCheck.Check == nil {
+	case "VulnMetadata.vulnerability":
+		if e.complexity.VulnMetadata.Vulnerability == nil {
 			break
 		}
 
-		return e.complexity.ScorecardCheck.Check(childComplexity), true
+		return e.complexity.VulnMetadata.Vulnerability(childComplexity), true
 
-	case "ScorecardCheck.score":
-		if e.complexity.ScorecardCheck.Score == nil {
+	case "VulnMetadataConnection.edges":
+		if e.complexity.VulnMetadataConnection.Edges == nil {
 			break
 		}
 
-		return e.complexity.ScorecardCheck.Score(childComplexity), true
+		return e.complexity.VulnMetadataConnection.Edges(childComplexity), true
 
-	case "Source.namespaces":
-		if e.complexity.Source.Namespaces == nil {
+	case "VulnMetadataConnection.pageInfo":
+		if e.complexity.VulnMetadataConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.Source.Namespaces(childComplexity), true
+		return e.complexity.VulnMetadataConnection.PageInfo(childComplexity), true
 
-	case "Source.type":
-		if e.complexity.Source.Type == nil {
+	case "VulnMetadataConnection.totalCount":
+		if e.complexity.VulnMetadataConnection.TotalCount == nil {
 			break
 		}
 
-		return e.complexity.Source.Type(childComplexity), true
+		return e.complexity.VulnMetadataConnection.TotalCount(childComplexity), true
 
-	case "SourceName.commit":
-		if e.complexity.SourceName.Commit == nil {
+	case "VulnMetadataEdge.cursor":
+		if e.complexity.VulnMetadataEdge.Cursor == nil {
 			break
 		}
 
-		return e.complexity.SourceName.Commit(childComplexity), true
+		return e.complexity.VulnMetadataEdge.Cursor(childComplexity), true
 
-	case "SourceName.name":
-		if e.complexity.SourceName.Name == nil {
+	case "VulnMetadataEdge.node":
+		if e.complexity.VulnMetadataEdge.Node == nil {
 			break
 		}
 
-		return e.complexity.SourceName.Name(childComplexity), true
+		return e.complexity.VulnMetadataEdge.Node(childComplexity), true
 
-	case "SourceName.tag":
-		if e.complexity.SourceName.Tag == nil {
+	case "VulnScore.score":
+		if e.complexity.VulnScore.Score == nil {
 			break
 		}
 
-		return e.complexity.SourceName.Tag(childComplexity), true
+		return e.complexity.VulnScore.Score(childComplexity), true
 
-	case "SourceNamespace.names":
-		if e.complexity.SourceNamespace.Names == nil {
+	case "VulnScore.scoreType":
+		if e.complexity.VulnScore.ScoreType == nil {
 			break
 		}
 
-		return e.complexity.SourceNamespace.Names(childComplexity), true
+		return e.complexity.VulnScore.ScoreType(childComplexity), true
 
-	case "SourceNamespace.namespace":
-		if e.complexity.SourceNamespace.Namespace == nil {
+	case "VulnScore.vector":
+		if e.complexity.VulnScore.Vector == nil {
 			break
 		}
 
-		return e.complexity.SourceNamespace.Namespace(childComplexity), true
+		return e.complexity.VulnScore.Vector(childComplexity), true
 
 	case "VulnerabilityMetaData.collector":
 		if e.complexity.VulnerabilityMetaData.Collector == nil {
@@ -1522,6 +3704,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputAffectedEventInputSpec,
+		ec.unmarshalInputAffectedRangeInputSpec,
 		ec.unmarshalInputArtifactInputSpec,
 		ec.unmarshalInputArtifactSpec,
 		ec.unmarshalInputBuilderInputSpec,
@@ -1530,6 +3714,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputCVESpec,
 		ec.unmarshalInputCertifyBadInputSpec,
 		ec.unmarshalInputCertifyBadSpec,
+		ec.unmarshalInputCertifyEOLInputSpec,
+		ec.unmarshalInputCertifyEOLSpec,
 		ec.unmarshalInputCertifyPkgInputSpec,
 		ec.unmarshalInputCertifyPkgSpec,
 		ec.unmarshalInputCertifyScorecardSpec,
@@ -1577,6 +3763,11 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputSourceInputSpec,
 		ec.unmarshalInputSourceSpec,
 		ec.unmarshalInputVexStatementInputSpec,
+		ec.unmarshalInputVulnAffectedInputSpec,
+		ec.unmarshalInputVulnAffectedSpec,
+		ec.unmarshalInputVulnMetadataInputSpec,
+		ec.unmarshalInputVulnMetadataSpec,
+		ec.unmarshalInputVulnScoreInputSpec,
 		ec.unmarshalInputVulnerabilityMetaDataInput,
 	)
 	first := true
@@ -1668,6 +3859,7 @@ If having a ` + "`" + `checksum` + "`" + ` Go object, ` + "`" + `algorithm` + "`
 ` + "`" + `checksum.Value` + "`" + `.
 """
 type Artifact {
+  id: ID!
   algorithm: String!
   digest: String!
 }
@@ -1692,9 +3884,27 @@ input ArtifactInputSpec {
   digest: String!
 }
 
+"""
+ArtifactConnection is a page of the results of artifactsList, see PageInfo.
+Each edge holds an artifact.
+"""
+type ArtifactConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [ArtifactEdge!]!
+}
+
+"ArtifactEdge is an artifact with its cursor"
+type ArtifactEdge {
+  cursor: ID!
+  node: Artifact!
+}
+
 extend type Query {
   "Returns all artifacts"
-  artifacts(artifactSpec: ArtifactSpec): [Artifact!]!
+  artifacts(artifactSpec: ArtifactSpec): [Artifact!]! @deprecated(reason: "Use artifactsList, which returns a page of the results")
+  "Same as artifacts, returning a page of the results, see PageInfo"
+  artifactsList(artifactSpec: ArtifactSpec!, after: ID, first: Int): ArtifactConnection!
 }
 
 extend type Mutation {
@@ -1727,6 +3937,7 @@ Builder represents the builder such as (FRSCA or github actions).
 Currently builders are identified by the ` + "`" + `uri` + "`" + ` field, which is mandatory.
 """
 type Builder {
+  id: ID!
   uri: String!
 }
 
@@ -1744,9 +3955,27 @@ input BuilderInputSpec {
   uri: String!
 }
 
+"""
+BuilderConnection is a page of the results of buildersList, see PageInfo.
+Each edge holds a builder.
+"""
+type BuilderConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [BuilderEdge!]!
+}
+
+"BuilderEdge is a builder with its cursor"
+type BuilderEdge {
+  cursor: ID!
+  node: Builder!
+}
+
 extend type Query {
   "Returns all builders"
-  builders(builderSpec: BuilderSpec): [Builder!]!
+  builders(builderSpec: BuilderSpec): [Builder!]! @deprecated(reason: "Use buildersList, which returns a page of the results")
+  "Same as builders, returning a page of the results, see PageInfo"
+  buildersList(builderSpec: BuilderSpec!, after: ID, first: Int): BuilderConnection!
 }
 
 extend type Mutation {
@@ -1784,6 +4013,7 @@ collector (property) - the GUAC collector that collected the document that gener
 Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
 """
 type CertifyBad {
+  id: ID!
   subject: PackageSourceOrArtifact!
   justification: String!
   origin: String!
@@ -1797,6 +4027,7 @@ For package - a PackageName or PackageVersion must be specified (name or name, v
 For source - a SourceName must be specified (name, tag or commit)
 """
 input CertifyBadSpec {
+  id: ID
   subject: PackageSourceOrArtifactSpec
   justification: String
   origin: String
@@ -1828,15 +4059,175 @@ input MatchFlags {
   pkg: PkgMatchType!
 }
 
+"""
+CertifyBadConnection is a page of the results of CertifyBadList, see PageInfo.
+Each edge holds a CertifyBad.
+"""
+type CertifyBadConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyBadEdge!]!
+}
+
+"CertifyBadEdge is a CertifyBad with its cursor"
+type CertifyBadEdge {
+  cursor: ID!
+  node: CertifyBad!
+}
+
 extend type Query {
   "Returns all CertifyBad"
-  CertifyBad(certifyBadSpec: CertifyBadSpec): [CertifyBad!]!
+  CertifyBad(certifyBadSpec: CertifyBadSpec): [CertifyBad!]! @deprecated(reason: "Use CertifyBadList, which returns a page of the results")
+  "Same as CertifyBad, returning a page of the results, see PageInfo"
+  CertifyBadList(certifyBadSpec: CertifyBadSpec!, after: ID, first: Int): CertifyBadConnection!
 }
 
 extend type Mutation {
   "Adds a certification that two packages are similar"
   ingestCertifyBad(subject: PackageSourceOrArtifactInput!, pkgMatchType: MatchFlags, certifyBad: CertifyBadInputSpec!): CertifyBad!
 }
+`, BuiltIn: false},
+	{Name: "../schema/certifyEOL.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the CertifyEOL. It contains the package, the product and release cycle it
+# belongs to, the support status of the cycle, its end of life and end of active support dates, the latest
+# version of the cycle, the time the status was checked, origin and collector
+
+"""
+SupportStatus is the support status of a release cycle of a product.
+
+SUPPORTED cycles are actively supported, SECURITY_ONLY cycles are past the
+end of active support and only receive security fixes, EOL cycles are past
+their end of life.
+"""
+enum SupportStatus {
+  SUPPORTED
+  SECURITY_ONLY
+  EOL
+}
+
+"""
+CertifyEOL is an attestation that a package version belongs to a release
+cycle of a product (e.g. a runtime, a framework or the distribution of a
+container image) with the given support status, as published by
+endoflife.date or a dataset of the same shape.
+
+The status depends on the time it was checked: a cycle reaches its end of
+life at the eol date.
+"""
+type CertifyEOL {
+  "id - opaque, stable identifier of the certification"
+  id: ID!
+  "package (subject) - the package version"
+  package: Package!
+  "product (property) - the product, e.g. python or debian"
+  product: String!
+  "cycle (property) - the release cycle of the product, e.g. 3.7 or 11"
+  cycle: String!
+  "status (property) - the support status of the cycle when checked"
+  status: SupportStatus!
+  "eol (property) - the end of life date of the cycle, if known"
+  eol: Time
+  "support (property) - the end of active support date of the cycle, if known"
+  support: Time
+  "latest (property) - the latest version of the cycle"
+  latest: String!
+  "timeChecked (property) - timestamp of when the status was checked"
+  timeChecked: Time!
+  "origin (property) - where this attestation was generated from (based on which document)"
+  origin: String!
+  "collector (property) - the GUAC collector that collected the document that generated this attestation"
+  collector: String!
+}
+
+"""
+CertifyEOLSpec allows filtering the list of CertifyEOL to return.
+
+eolBefore matches the cycles with an end of life date strictly before the
+given time.
+"""
+input CertifyEOLSpec {
+  id: ID
+  package: PkgSpec
+  product: String
+  cycle: String
+  status: SupportStatus
+  eolBefore: Time
+  origin: String
+  collector: String
+}
+
+"""
+CertifyEOLInputSpec is the same as CertifyEOL but for mutation input.
+
+The eol and support dates are optional, all other fields are required.
+"""
+input CertifyEOLInputSpec {
+  product: String!
+  cycle: String!
+  status: SupportStatus!
+  eol: Time
+  support: Time
+  latest: String!
+  timeChecked: Time!
+  origin: String!
+  collector: String!
+}
+
+"""
+CertifyEOLConnection is a page of the results of CertifyEOLList, see PageInfo.
+Each edge holds a CertifyEOL.
+"""
+type CertifyEOLConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyEOLEdge!]!
+}
+
+"CertifyEOLEdge is a CertifyEOL with its cursor"
+type CertifyEOLEdge {
+  cursor: ID!
+  node: CertifyEOL!
+}
+
+extend type Query {
+  "Returns all CertifyEOL matching the filter"
+  CertifyEOL(certifyEOLSpec: CertifyEOLSpec): [CertifyEOL!]! @deprecated(reason: "Use CertifyEOLList, which returns a page of the results")
+  "Same as CertifyEOL, returning a page of the results, see PageInfo"
+  CertifyEOLList(certifyEOLSpec: CertifyEOLSpec!, after: ID, first: Int): CertifyEOLConnection!
+  """
+  Returns the IsDependency of the packages depending on a package with a
+  CertifyEOL matching the filter, e.g. everything depending on an EOL runtime.
+
+  Dependencies are recorded at the package name level, so the dependency
+  matches on the type, namespace and name of the certified package, and its
+  version range must include one of the certified versions. Version ranges
+  that cannot be parsed are kept.
+  """
+  eolDependents(certifyEOLSpec: CertifyEOLSpec): [IsDependency!]! @deprecated(reason: "Use eolDependentsList, which returns a page of the results")
+  "Same as eolDependents, returning a page of the results, see PageInfo"
+  eolDependentsList(certifyEOLSpec: CertifyEOLSpec!, after: ID, first: Int): IsDependencyConnection!
+}
+
+extend type Mutation {
+  "Certifies the support status of the release cycle a package version belongs to"
+  ingestCertifyEOL(pkg: PkgInputSpec!, certifyEOL: CertifyEOLInputSpec!): CertifyEOL!
+}
 `, BuiltIn: false},
 	{Name: "../schema/certifyPkg.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -1866,6 +4257,7 @@ origin (property) - where this attestation was generated from (based on which do
 collector (property) - the GUAC collector that collected the document that generated this attestation
 """
 type CertifyPkg {
+  id: ID!
   packages: [Package!]!
   justification: String!
   origin: String!
@@ -1878,6 +4270,7 @@ CertifyPkgSpec allows filtering the list of CertifyPkg to return.
 Specifying just the package allows to query for all similar packages (if they exist)
 """
 input CertifyPkgSpec {
+  id: ID
   packages: [PkgSpec]
   justification: String
   origin: String
@@ -1895,9 +4288,27 @@ input CertifyPkgInputSpec {
   collector: String!
 }
 
+"""
+CertifyPkgConnection is a page of the results of CertifyPkgList, see PageInfo.
+Each edge holds a CertifyPkg.
+"""
+type CertifyPkgConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyPkgEdge!]!
+}
+
+"CertifyPkgEdge is a CertifyPkg with its cursor"
+type CertifyPkgEdge {
+  cursor: ID!
+  node: CertifyPkg!
+}
+
 extend type Query {
   "Returns all CertifyPkg"
-  CertifyPkg(certifyPkgSpec: CertifyPkgSpec): [CertifyPkg!]!
+  CertifyPkg(certifyPkgSpec: CertifyPkgSpec): [CertifyPkg!]! @deprecated(reason: "Use CertifyPkgList, which returns a page of the results")
+  "Same as CertifyPkg, returning a page of the results, see PageInfo"
+  CertifyPkgList(certifyPkgSpec: CertifyPkgSpec!, after: ID, first: Int): CertifyPkgConnection!
 }
 
 extend type Mutation {
@@ -1939,6 +4350,8 @@ CertifyScorecard is an attestation which represents the scorecard of a
 particular source repository.
 """
 type CertifyScorecard {
+  "Opaque, stable identifier of the certification"
+  id: ID!
   "The source repository that is being scanned (attestation subject)"
   source: Source!
   "The Scorecard attached to the repository (attestation object)"
@@ -1992,6 +4405,7 @@ type ScorecardCheck {
 
 "CertifyScorecardSpec allows filtering the list of CertifyScorecard to return."
 input CertifyScorecardSpec {
+  id: ID
   source: SourceSpec
   timeScanned: Time
   aggregateScore: Float
@@ -2031,9 +4445,27 @@ input ScorecardCheckInputSpec {
   score: Int!
 }
 
+"""
+CertifyScorecardConnection is a page of the results of scorecardsList, see PageInfo.
+Each edge holds a CertifyScorecard.
+"""
+type CertifyScorecardConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyScorecardEdge!]!
+}
+
+"CertifyScorecardEdge is a CertifyScorecard with its cursor"
+type CertifyScorecardEdge {
+  cursor: ID!
+  node: CertifyScorecard!
+}
+
 extend type Query {
   "Returns all Scorecard certifications matching the filter"
-  scorecards(scorecardSpec: CertifyScorecardSpec): [CertifyScorecard!]!
+  scorecards(scorecardSpec: CertifyScorecardSpec): [CertifyScorecard!]! @deprecated(reason: "Use scorecardsList, which returns a page of the results")
+  "Same as scorecards, returning a page of the results, see PageInfo"
+  scorecardsList(scorecardSpec: CertifyScorecardSpec!, after: ID, first: Int): CertifyScorecardConnection!
 }
 
 extend type Mutation {
@@ -2059,7 +4491,7 @@ extend type Mutation {
 # NOTE: This is experimental and might change in the future!
 
 # Defines a GraphQL schema for the CertifyVEXStatement. It contains a subject which can be a package or artifact object, vulnerability that can be of type
-# cve or ghsa, justification, origin and collector
+# osv, cve or ghsa, status, justification, statements, origin and collector
 
 """
 PackageOrArtifact is a union of Package and Artifact. Any of these objects can be specified
@@ -2077,19 +4509,53 @@ input PackageOrArtifactSpec {
 }
 
 """
-CertifyVEXStatement is an attestation that represents when a package or artifact has a VEX about a specific vulnerability (CVE or GHSA)
+VexStatus is the status of a product with respect to a vulnerability, as defined
+by the VEX minimum requirements.
+"""
+enum VexStatus {
+  NOT_AFFECTED
+  AFFECTED
+  FIXED
+  UNDER_INVESTIGATION
+}
+
+"""
+VexJustification is the justification for a NOT_AFFECTED status, as defined by
+the VEX minimum requirements. NOT_PROVIDED is used for all other statuses or
+when the document does not give a machine readable justification.
+"""
+enum VexJustification {
+  COMPONENT_NOT_PRESENT
+  VULNERABLE_CODE_NOT_PRESENT
+  VULNERABLE_CODE_NOT_IN_EXECUTE_PATH
+  VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY
+  INLINE_MITIGATIONS_ALREADY_EXIST
+  NOT_PROVIDED
+}
+
+"""
+CertifyVEXStatement is an attestation that represents when a package or artifact has a VEX about a specific vulnerability (OSV, CVE or GHSA)
 
 subject - union type that represents a package or artifact
-vulnerability (object) - union type that consists of cve or ghsa
-justification (property) - justification for VEX
+vulnerability (object) - union type that consists of osv, cve or ghsa
+status (property) - the status of the subject with respect to the vulnerability
+vexJustification (property) - justification for a NOT_AFFECTED status
+statement (property) - free text impact statement explaining the status
+statusNotes (property) - free text notes about the status
+actionStatement (property) - action to take to remediate or mitigate the vulnerability (AFFECTED status)
 knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 origin (property) - where this attestation was generated from (based on which document)
 collector (property) - the GUAC collector that collected the document that generated this attestation
 """
 type CertifyVEXStatement {
+  id: ID!
   subject: PackageOrArtifact!
-  vulnerability: CveOrGhsa!
-  justification: String!
+  vulnerability: OsvCveOrGhsa!
+  status: VexStatus!
+  vexJustification: VexJustification!
+  statement: String!
+  statusNotes: String!
+  actionStatement: String!
   knownSince: Time!
   origin: String!
   collector: String!
@@ -2097,12 +4563,17 @@ type CertifyVEXStatement {
 
 """
 CertifyVEXStatementSpec allows filtering the list of CertifyVEXStatement to return.
-Only package or artifact and CVE or GHSA can be specified at once.
+Only package or artifact and OSV, CVE or GHSA can be specified at once.
 """
 input CertifyVEXStatementSpec {
+  id: ID
   subject: PackageOrArtifactSpec
-  vulnerability: CveOrGhsaSpec
-  justification: String
+  vulnerability: OsvCveOrGhsaSpec
+  status: VexStatus
+  vexJustification: VexJustification
+  statement: String
+  statusNotes: String
+  actionStatement: String
   knownSince: Time
   origin: String
   collector: String
@@ -2114,7 +4585,11 @@ VexStatementInputSpec is the same as CertifyVEXStatement but for mutation input.
 All fields are required.
 """
 input VexStatementInputSpec {
-  justification: String!
+  status: VexStatus!
+  vexJustification: VexJustification!
+  statement: String!
+  statusNotes: String!
+  actionStatement: String!
   knownSince: Time!
   origin: String!
   collector: String!
@@ -2130,14 +4605,32 @@ input PackageOrArtifactInput {
   artifact: ArtifactInputSpec
 }
 
+"""
+CertifyVEXStatementConnection is a page of the results of CertifyVEXStatementList, see PageInfo.
+Each edge holds a CertifyVEXStatement.
+"""
+type CertifyVEXStatementConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyVEXStatementEdge!]!
+}
+
+"CertifyVEXStatementEdge is a CertifyVEXStatement with its cursor"
+type CertifyVEXStatementEdge {
+  cursor: ID!
+  node: CertifyVEXStatement!
+}
+
 extend type Query {
   "Returns all CertifyVEXStatement"
-  CertifyVEXStatement(certifyVEXStatementSpec: CertifyVEXStatementSpec): [CertifyVEXStatement!]!
+  CertifyVEXStatement(certifyVEXStatementSpec: CertifyVEXStatementSpec): [CertifyVEXStatement!]! @deprecated(reason: "Use CertifyVEXStatementList, which returns a page of the results")
+  "Same as CertifyVEXStatement, returning a page of the results, see PageInfo"
+  CertifyVEXStatementList(certifyVEXStatementSpec: CertifyVEXStatementSpec!, after: ID, first: Int): CertifyVEXStatementConnection!
 }
 
 extend type Mutation {
-  "certify that an either a package or artifact has an associated VEX for a CVE or GHSA"
-  ingestVEXStatement(subject: PackageOrArtifactInput!, vulnerability: CveOrGhsaInput!, vexStatement: VexStatementInputSpec!): CertifyVEXStatement!
+  "certify that an either a package or artifact has an associated VEX for a OSV, CVE or GHSA"
+  ingestVEXStatement(subject: PackageOrArtifactInput!, vulnerability: OsvCveOrGhsaInput!, vexStatement: VexStatementInputSpec!): CertifyVEXStatement!
 }
 `, BuiltIn: false},
 	{Name: "../schema/certifyVuln.graphql", Input: `#
@@ -2158,15 +4651,20 @@ extend type Mutation {
 # NOTE: This is experimental and might change in the future!
 
 # Defines a GraphQL schema for the CertifyVuln. It contains a package, vulnerability that can be of type
-# cve, ghsa or osv, time scanned, db uri, db version, scanner uri, scanner version, origin and collector
+# cve, ghsa, osv or noVuln, time scanned, db uri, db version, scanner uri, scanner version, origin and collector
 """
 CertifyVuln is an attestation that represents when a package has a vulnerability
 
+A package that was scanned and found to have no vulnerability is certified with
+NoVuln as the vulnerability. A package with no CertifyVuln at all has never been
+scanned.
 """
 type CertifyVuln {
+  "id - opaque, stable identifier of the certification"
+  id: ID!
   "package (subject) - the package object type that represents the package"
   package: Package!
-  "vulnerability (object) - union type that consists of osv, cve or ghsa"
+  "vulnerability (object) - union type that consists of osv, cve, ghsa or noVuln"
   vulnerability: OsvCveOrGhsa!
   "metadata (property) - contains all the vulnerability metadata "
   metadata: VulnerabilityMetaData!
@@ -2190,9 +4688,20 @@ type VulnerabilityMetaData {
 }
 
 """
-OsvCveGhsaObject is a union of OSV, CVE and GHSA. Any of these objects can be specified for vulnerability
+NoVuln is a special vulnerability signaling that a package was scanned and no
+vulnerability was found. It can only be used in CertifyVuln.
+"""
+type NoVuln {
+  "id - identifier of the NoVuln singleton"
+  id: ID!
+  "noVuln (property) - always true"
+  noVuln: Boolean!
+}
+
+"""
+OsvCveGhsaObject is a union of OSV, CVE, GHSA and NoVuln. Any of these objects can be specified for vulnerability
 """
-union OsvCveOrGhsa = OSV | CVE | GHSA
+union OsvCveOrGhsa = OSV | CVE | GHSA | NoVuln
 
 """
 OsvCveOrGhsaSpec allows using OsvCveOrGhsa union as
@@ -2203,6 +4712,8 @@ input OsvCveOrGhsaSpec {
   osv: OSVSpec
   cve: CVESpec
   ghsa: GHSASpec
+  "noVuln set to true matches only NoVuln, set to false matches any vulnerability except NoVuln"
+  noVuln: Boolean
 }
 
 """
@@ -2212,6 +4723,7 @@ Specifying just the package allows to query for all vulnerabilities associated w
 Only OSV, CVE or GHSA can be specified at once
 """
 input CertifyVulnSpec {
+  id: ID
   package: PkgSpec
   vulnerability: OsvCveOrGhsaSpec
   timeScanned: Time
@@ -2247,15 +4759,35 @@ input OsvCveOrGhsaInput {
   osv: OSVInputSpec
   cve: CVEInputSpec
   ghsa: GHSAInputSpec
+  "noVuln must be true when set"
+  noVuln: Boolean
+}
+
+"""
+CertifyVulnConnection is a page of the results of CertifyVulnList, see PageInfo.
+Each edge holds a CertifyVuln.
+"""
+type CertifyVulnConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CertifyVulnEdge!]!
+}
+
+"CertifyVulnEdge is a CertifyVuln with its cursor"
+type CertifyVulnEdge {
+  cursor: ID!
+  node: CertifyVuln!
 }
 
 extend type Query {
   "Returns all CertifyVuln"
-  CertifyVuln(certifyVulnSpec: CertifyVulnSpec): [CertifyVuln!]!
+  CertifyVuln(certifyVulnSpec: CertifyVulnSpec): [CertifyVuln!]! @deprecated(reason: "Use CertifyVulnList, which returns a page of the results")
+  "Same as CertifyVuln, returning a page of the results, see PageInfo"
+  CertifyVulnList(certifyVulnSpec: CertifyVulnSpec!, after: ID, first: Int): CertifyVulnConnection!
 }
 
 extend type Mutation {
-  "certify that a package is vulnerable to a vulnerability (OSV, CVE or GHSA)"
+  "certify that a package is vulnerable to a vulnerability (OSV, CVE or GHSA) or was scanned clean (NoVuln)"
   ingestVulnerability(pkg: PkgInputSpec!, vulnerability: OsvCveOrGhsaInput!, certifyVuln: VulnerabilityMetaDataInput!): CertifyVuln!
 }
 `, BuiltIn: false},
@@ -2289,6 +4821,7 @@ This node is a singleton: backends guarantee that there is exactly one node
 with the same ` + "`" + `year` + "`" + ` value.
 """
 type CVE {
+  id: ID!
   year: String!
   cveId: [CVEId!]!
 }
@@ -2320,9 +4853,27 @@ input CVEInputSpec {
   cveId: String!
 }
 
+"""
+CVEConnection is a page of the results of cveList, see PageInfo.
+Each edge holds a CVE trie with a single CVE id.
+"""
+type CVEConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [CVEEdge!]!
+}
+
+"CVEEdge is a CVE trie with a single CVE id with its cursor"
+type CVEEdge {
+  cursor: ID!
+  node: CVE!
+}
+
 extend type Query {
   "Returns all CVEs"
-  cve(cveSpec: CVESpec): [CVE!]!
+  cve(cveSpec: CVESpec): [CVE!]! @deprecated(reason: "Use cveList, which returns a page of the results")
+  "Same as cve, returning a page of the results, see PageInfo"
+  cveList(cveSpec: CVESpec!, after: ID, first: Int): CVEConnection!
 }
 
 extend type Mutation {
@@ -2357,6 +4908,7 @@ GHSA represents GitHub security advisories.
 We create a separate node to allow retrieving all GHSAs.
 """
 type GHSA {
+  id: ID!
   ghsaId: [GHSAId!]!
 }
 
@@ -2387,9 +4939,27 @@ input GHSAInputSpec {
   ghsaId: String!
 }
 
+"""
+GHSAConnection is a page of the results of ghsaList, see PageInfo.
+Each edge holds a GHSA trie with a single GHSA id.
+"""
+type GHSAConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [GHSAEdge!]!
+}
+
+"GHSAEdge is a GHSA trie with a single GHSA id with its cursor"
+type GHSAEdge {
+  cursor: ID!
+  node: GHSA!
+}
+
 extend type Query {
   "Returns all GHSA nodes"
-  ghsa(ghsaSpec: GHSASpec): [GHSA!]!
+  ghsa(ghsaSpec: GHSASpec): [GHSA!]! @deprecated(reason: "Use ghsaList, which returns a page of the results")
+  "Same as ghsa, returning a page of the results, see PageInfo"
+  ghsaList(ghsaSpec: GHSASpec!, after: ID, first: Int): GHSAConnection!
 }
 
 extend type Mutation {
@@ -2426,6 +4996,7 @@ collector (property) - the GUAC collector that collected the document that gener
 Note: Only package object or source object can be defined. Not both.
 """
 type HasSBOM {
+  id: ID!
   subject: PackageOrSource!
   uri: String!
   origin: String!
@@ -2439,6 +5010,7 @@ Only the package or source can be added, not both. HasSourceAt will be used to c
 relationship.
 """
 input HasSBOMSpec {
+  id: ID
   subject: PackageOrSourceSpec
   uri: String
   origin: String
@@ -2456,9 +5028,27 @@ input HasSBOMInputSpec {
   collector: String!
 }
 
+"""
+HasSBOMConnection is a page of the results of HasSBOMList, see PageInfo.
+Each edge holds a HasSBOM.
+"""
+type HasSBOMConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSBOMEdge!]!
+}
+
+"HasSBOMEdge is a HasSBOM with its cursor"
+type HasSBOMEdge {
+  cursor: ID!
+  node: HasSBOM!
+}
+
 extend type Query {
   "Returns all HasSBOM"
-  HasSBOM(hasSBOMSpec: HasSBOMSpec): [HasSBOM!]!
+  HasSBOM(hasSBOMSpec: HasSBOMSpec): [HasSBOM!]! @deprecated(reason: "Use HasSBOMList, which returns a page of the results")
+  "Same as HasSBOM, returning a page of the results, see PageInfo"
+  HasSBOMList(hasSBOMSpec: HasSBOMSpec!, after: ID, first: Int): HasSBOMConnection!
 }
 
 extend type Mutation {
@@ -2515,6 +5105,8 @@ input PackageSourceOrArtifactInput {
 
 "HasSLSA records that a subject node has a SLSA attestation."
 type HasSLSA {
+  "Opaque, stable identifier of the attestation."
+  id: ID!
   "The subject of SLSA attestation: package, source, or artifact."
   subject: PackageSourceOrArtifact!
   "The SLSA attestation."
@@ -2588,6 +5180,7 @@ type SLSAPredicate {
 
 "HasSLSASpec allows filtering the list of HasSLSA to return."
 input HasSLSASpec {
+  id: ID
   subject: PackageSourceOrArtifactSpec
   builtFrom: [PackageSourceOrArtifactSpec!]
   builtBy: BuilderSpec
@@ -2630,9 +5223,27 @@ input SLSAPredicateInputSpec {
   value: String!
 }
 
+"""
+HasSLSAConnection is a page of the results of HasSLSAList, see PageInfo.
+Each edge holds a HasSLSA.
+"""
+type HasSLSAConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSLSAEdge!]!
+}
+
+"HasSLSAEdge is a HasSLSA with its cursor"
+type HasSLSAEdge {
+  cursor: ID!
+  node: HasSLSA!
+}
+
 extend type Query {
   "Returns all SLSA attestations matching the filter"
-  HasSLSA(hasSLSASpec: HasSLSASpec): [HasSLSA!]!
+  HasSLSA(hasSLSASpec: HasSLSASpec): [HasSLSA!]! @deprecated(reason: "Use HasSLSAList, which returns a page of the results")
+  "Same as HasSLSA, returning a page of the results, see PageInfo"
+  HasSLSAList(hasSLSASpec: HasSLSASpec!, after: ID, first: Int): HasSLSAConnection!
 }
 
 extend type Mutation {
@@ -2684,6 +5295,7 @@ origin (property) - where this attestation was generated from (based on which do
 collector (property) - the GUAC collector that collected the document that generated this attestation
 """
 type HasSourceAt {
+  id: ID!
   package: Package!
   source: Source!
   knownSince: Time!
@@ -2696,6 +5308,7 @@ type HasSourceAt {
 HasSourceAtSpec allows filtering the list of HasSourceAt to return.
 """
 input HasSourceAtSpec {
+  id: ID
   package: PkgSpec
   source: SourceSpec
   knownSince: Time
@@ -2717,9 +5330,27 @@ input HasSourceAtInputSpec {
 }
 
 
+"""
+HasSourceAtConnection is a page of the results of HasSourceAtList, see PageInfo.
+Each edge holds a HasSourceAt.
+"""
+type HasSourceAtConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HasSourceAtEdge!]!
+}
+
+"HasSourceAtEdge is a HasSourceAt with its cursor"
+type HasSourceAtEdge {
+  cursor: ID!
+  node: HasSourceAt!
+}
+
 extend type Query {
   "Returns all HasSourceAt"
-  HasSourceAt(hasSourceAtSpec: HasSourceAtSpec): [HasSourceAt!]!
+  HasSourceAt(hasSourceAtSpec: HasSourceAtSpec): [HasSourceAt!]! @deprecated(reason: "Use HasSourceAtList, which returns a page of the results")
+  "Same as HasSourceAt, returning a page of the results, see PageInfo"
+  HasSourceAtList(hasSourceAtSpec: HasSourceAtSpec!, after: ID, first: Int): HasSourceAtConnection!
 }
 
 extend type Mutation {
@@ -2754,6 +5385,7 @@ origin (property) - where this attestation was generated from (based on which do
 collector (property) - the GUAC collector that collected the document that generated this attestation
 """
 type HashEqual {
+  id: ID!
   artifacts: [Artifact!]!
   justification: String!
   origin: String!
@@ -2766,6 +5398,7 @@ HashEqualSpec allows filtering the list of HashEqual to return.
 Specifying just the artifacts allows to query for all equivalent artifacts (if they exist)
 """
 input HashEqualSpec {
+  id: ID
   artifacts: [ArtifactSpec]
   justification: String
   origin: String
@@ -2785,9 +5418,27 @@ input HashEqualInputSpec {
 }
 
 
+"""
+HashEqualConnection is a page of the results of HashEqualList, see PageInfo.
+Each edge holds a HashEqual.
+"""
+type HashEqualConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [HashEqualEdge!]!
+}
+
+"HashEqualEdge is a HashEqual with its cursor"
+type HashEqualEdge {
+  cursor: ID!
+  node: HashEqual!
+}
+
 extend type Query {
   "Returns all HashEqual"
-  HashEqual(hashEqualSpec: HashEqualSpec): [HashEqual!]!
+  HashEqual(hashEqualSpec: HashEqualSpec): [HashEqual!]! @deprecated(reason: "Use HashEqualList, which returns a page of the results")
+  "Same as HashEqual, returning a page of the results, see PageInfo"
+  HashEqualList(hashEqualSpec: HashEqualSpec!, after: ID, first: Int): HashEqualConnection!
 }
 
 extend type Mutation {
@@ -2826,6 +5477,7 @@ origin (property) - where this attestation was generated from (based on which do
 collector (property) - the GUAC collector that collected the document that generated this attestation
 """
 type IsDependency {
+  id: ID!
   package: Package!
   dependentPackage: Package!
   versionRange: String!
@@ -2841,6 +5493,7 @@ Note: the package object must be defined to return its dependent packages.
 Dependent Packages must represent the packageName (cannot be the packageVersion)
 """
 input IsDependencySpec {
+  id: ID
   package: PkgSpec
   dependentPackage: PkgNameSpec
   versionRange: String
@@ -2872,9 +5525,70 @@ input IsDependencyInputSpec {
   collector: String!
 }
 
+"""
+TransitiveDependency is a package reached by following IsDependency from the
+package versions a transitive query starts from.
+
+The version range of each IsDependency is resolved to the ingested versions of
+the dependent package it matches. When the range cannot be parsed or matches no
+ingested version, the dependency is the package name and is not followed
+further.
+
+package - the package version reached, or the package name if the version range is not resolved
+dependency - the IsDependency followed to reach the package
+depth - the number of IsDependency followed, 1 for direct dependencies
+cycle - true if the package is the package it is reached from or leads back to
+  it through the IsDependency found within maxDepth, a package version already
+  reached is not followed again
+"""
+type TransitiveDependency {
+  package: Package!
+  dependency: IsDependency!
+  depth: Int!
+  cycle: Boolean!
+}
+
+"""
+IsDependencyConnection is a page of the results of IsDependencyList, see PageInfo.
+Each edge holds an IsDependency.
+"""
+type IsDependencyConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [IsDependencyEdge!]!
+}
+
+"IsDependencyEdge is an IsDependency with its cursor"
+type IsDependencyEdge {
+  cursor: ID!
+  node: IsDependency!
+}
+
 extend type Query {
   "Returns all IsDependency"
-  IsDependency(isDependencySpec: IsDependencySpec): [IsDependency!]!
+  IsDependency(isDependencySpec: IsDependencySpec): [IsDependency!]! @deprecated(reason: "Use IsDependencyList, which returns a page of the results")
+  "Same as IsDependency, returning a page of the results, see PageInfo"
+  IsDependencyList(isDependencySpec: IsDependencySpec!, after: ID, first: Int): IsDependencyConnection!
+  """
+  Returns the transitive closure of the dependencies of the package versions
+  matching the filter, in breadth first order, following up to maxDepth
+  IsDependency (all if not set).
+
+  Each package version is followed once, from the shallowest depth it is
+  reached at, so each IsDependency is returned once for each package it
+  resolves to.
+  """
+  transitiveDependencies(pkgSpec: PkgSpec!, maxDepth: Int): [TransitiveDependency!]!
+  """
+  Returns the package versions depending on the package versions matching the
+  filter, directly or not, in breadth first order and up to maxDepth
+  IsDependency (all if not set). The package of each result is the dependent
+  package version.
+
+  A package version depends on another if the version range of its
+  IsDependency matches the version, or cannot be parsed.
+  """
+  dependents(pkgSpec: PkgSpec!, maxDepth: Int): [TransitiveDependency!]!
 }
 
 extend type Mutation {
@@ -2914,6 +5628,8 @@ Note: Package or Source must be specified but not both at the same time.
 Attestation must occur at the PackageVersion or at the SourceName.
 """
 type IsOccurrence {
+  "id - opaque, stable identifier of the attestation"
+  id: ID!
   "subject - union type that can be either a package or source object type"
   subject: PackageOrSource!
   "artifact (object) - artifact that represent the the package or source"
@@ -2934,6 +5650,7 @@ or it defaults to empty string for version, subpath and empty list for qualifier
 For source - a SourceName must be specified (name, tag or commit)
 """
 input IsOccurrenceSpec {
+  id: ID
   subject: PackageOrSourceSpec
   artifact: ArtifactSpec
   justification: String
@@ -2973,9 +5690,27 @@ input IsOccurrenceInputSpec {
   collector: String!
 }
 
+"""
+IsOccurrenceConnection is a page of the results of IsOccurrenceList, see PageInfo.
+Each edge holds an IsOccurrence.
+"""
+type IsOccurrenceConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [IsOccurrenceEdge!]!
+}
+
+"IsOccurrenceEdge is an IsOccurrence with its cursor"
+type IsOccurrenceEdge {
+  cursor: ID!
+  node: IsOccurrence!
+}
+
 extend type Query {
   "Returns all IsOccurrence"
-  IsOccurrence(isOccurrenceSpec: IsOccurrenceSpec): [IsOccurrence!]!
+  IsOccurrence(isOccurrenceSpec: IsOccurrenceSpec): [IsOccurrence!]! @deprecated(reason: "Use IsOccurrenceList, which returns a page of the results")
+  "Same as IsOccurrence, returning a page of the results, see PageInfo"
+  IsOccurrenceList(isOccurrenceSpec: IsOccurrenceSpec!, after: ID, first: Int): IsOccurrenceConnection!
 }
 
 extend type Mutation {
@@ -3027,6 +5762,7 @@ origin (property) - where this attestation was generated from (based on which do
 collector (property) - the GUAC collector that collected the document that generated this attestation
 """
 type IsVulnerability {
+  id: ID!
   osv: OSV!
   vulnerability: CveOrGhsa!
   justification: String!
@@ -3039,6 +5775,7 @@ IsVulnerabilitySpec allows filtering the list of IsVulnerability to return.
 Only CVE or GHSA can be specified at once.
 """
 input IsVulnerabilitySpec {
+  id: ID
   osv: OSVSpec
   vulnerability: CveOrGhsaSpec
   justification: String
@@ -3057,25 +5794,110 @@ input IsVulnerabilityInputSpec {
   collector: String!
 }
 
-"""
-CveOrGhsaInput allows using CveOrGhsa union as
-input type to be used in mutations.
-Exactly one of the value must be set to non-nil.
-"""
-input CveOrGhsaInput {
-  cve: CVEInputSpec
-  ghsa: GHSAInputSpec
-}
+"""
+CveOrGhsaInput allows using CveOrGhsa union as
+input type to be used in mutations.
+Exactly one of the value must be set to non-nil.
+"""
+input CveOrGhsaInput {
+  cve: CVEInputSpec
+  ghsa: GHSAInputSpec
+}
+
+
+"""
+IsVulnerabilityConnection is a page of the results of IsVulnerabilityList, see PageInfo.
+Each edge holds an IsVulnerability.
+"""
+type IsVulnerabilityConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [IsVulnerabilityEdge!]!
+}
+
+"IsVulnerabilityEdge is an IsVulnerability with its cursor"
+type IsVulnerabilityEdge {
+  cursor: ID!
+  node: IsVulnerability!
+}
+
+extend type Query {
+  "Returns all IsVulnerability"
+  IsVulnerability(isVulnerabilitySpec: IsVulnerabilitySpec): [IsVulnerability!]! @deprecated(reason: "Use IsVulnerabilityList, which returns a page of the results")
+  "Same as IsVulnerability, returning a page of the results, see PageInfo"
+  IsVulnerabilityList(isVulnerabilitySpec: IsVulnerabilitySpec!, after: ID, first: Int): IsVulnerabilityConnection!
+}
+
+extend type Mutation {
+  "certify that a OSV is associated with either a CVE or GHSA"
+  ingestIsVulnerability(osv: OSVInputSpec!, vulnerability: CveOrGhsaInput!, isVulnerability: IsVulnerabilityInputSpec!): IsVulnerability!
+}
+`, BuiltIn: false},
+	{Name: "../schema/node.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
 
+# Defines a GraphQL schema to refer to any node of the GUAC graph by its id.
+
+"""
+Node is any node of the GUAC graph, software tree or evidence.
+
+Every node has an opaque ` + "`" + `id` + "`" + ` which stays the same for as long as the node
+exists. The ids of the software trees (packages, sources, artifacts, builders
+and vulnerabilities) are derived from their content, so they are the same
+across backends and after the node is ingested again. The ids of the evidence
+are generated by the backend when the evidence is first ingested, kept when it
+is ingested again and never reused for another evidence.
+
+Querying the id of a namespace, name or version of a package or source trie
+returns the trie filtered down to that node. ` + "`" + `CVEId` + "`" + `, ` + "`" + `GHSAId` + "`" + ` and ` + "`" + `OSVId` + "`" + ` have
+no node id, their ` + "`" + `id` + "`" + ` field being the identifier of the vulnerability. Paths
+through a vulnerability return the vulnerability trie holding only that
+vulnerability, with an id of its own.
+"""
+union Node =
+    Package
+  | Source
+  | Artifact
+  | Builder
+  | OSV
+  | CVE
+  | GHSA
+  | NoVuln
+  | IsOccurrence
+  | IsDependency
+  | IsVulnerability
+  | CertifyVEXStatement
+  | HashEqual
+  | CertifyBad
+  | CertifyPkg
+  | CertifyScorecard
+  | CertifyVuln
+  | HasSourceAt
+  | HasSBOM
+  | HasSLSA
+  | VulnMetadata
+  | VulnAffected
+  | CertifyEOL
 
 extend type Query {
-  "Returns all IsVulnerability"
-  IsVulnerability(isVulnerabilitySpec: IsVulnerabilitySpec): [IsVulnerability!]!
-}
-
-extend type Mutation {
-  "certify that a OSV is associated with either a CVE or GHSA"
-  ingestIsVulnerability(osv: OSVInputSpec!, vulnerability: CveOrGhsaInput!, isVulnerability: IsVulnerabilityInputSpec!): IsVulnerability!
+  "Returns the node with the given id"
+  node(id: ID!): Node!
+  "Returns the nodes with the given ids, in the same order"
+  nodes(ids: [ID!]!): [Node!]!
 }
 `, BuiltIn: false},
 	{Name: "../schema/osv.graphql", Input: `#
@@ -3104,6 +5926,7 @@ OSV represents an Open Source Vulnerability.
 We create a separate node to allow retrieving all OSVs.
 """
 type OSV {
+  id: ID!
   osvId: [OSVId!]!
 }
 
@@ -3135,9 +5958,27 @@ input OSVInputSpec {
   osvId: String!
 }
 
+"""
+OSVConnection is a page of the results of osvList, see PageInfo.
+Each edge holds an OSV trie with a single OSV id.
+"""
+type OSVConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [OSVEdge!]!
+}
+
+"OSVEdge is an OSV trie with a single OSV id with its cursor"
+type OSVEdge {
+  cursor: ID!
+  node: OSV!
+}
+
 extend type Query {
   "Returns all OSV"
-  osv(osvSpec: OSVSpec): [OSV!]!
+  osv(osvSpec: OSVSpec): [OSV!]! @deprecated(reason: "Use osvList, which returns a page of the results")
+  "Same as osv, returning a page of the results, see PageInfo"
+  osvList(osvSpec: OSVSpec!, after: ID, first: Int): OSVConnection!
 }
 
 extend type Mutation {
@@ -3183,6 +6024,7 @@ Also note that this is named ` + "`" + `Package` + "`" + `, not ` + "`" + `Packa
 queries more readable.
 """
 type Package {
+  id: ID!
   type: String!
   namespaces: [PackageNamespace!]!
 }
@@ -3197,6 +6039,7 @@ Namespaces are optional and type specific. Because they are optional, we use
 empty string to denote missing namespaces.
 """
 type PackageNamespace {
+  id: ID!
   namespace: String!
   names: [PackageName!]!
 }
@@ -3213,6 +6056,7 @@ This is the first node in the trie that can be referred to by other parts of
 GUAC.
 """
 type PackageName {
+  id: ID!
   name: String!
   versions: [PackageVersion!]!
 }
@@ -3237,6 +6081,7 @@ a subset of the qualifier of the other also mean two different packages in the
 trie.
 """
 type PackageVersion {
+  id: ID!
   version: String!
   qualifiers: [PackageQualifier!]!
   subpath: String!
@@ -3330,15 +6175,221 @@ input PackageQualifierInputSpec {
   value: String!
 }
 
+"""
+PackageConnection is a page of the results of packagesList, see PageInfo.
+Each edge holds a package trie with a single version.
+"""
+type PackageConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [PackageEdge!]!
+}
+
+"PackageEdge is a package trie with a single version with its cursor"
+type PackageEdge {
+  cursor: ID!
+  node: Package!
+}
+
 extend type Query {
   "Returns all packages"
-  packages(pkgSpec: PkgSpec): [Package!]!
+  packages(pkgSpec: PkgSpec): [Package!]! @deprecated(reason: "Use packagesList, which returns a page of the results")
+  "Same as packages, returning a page of the results, see PageInfo"
+  packagesList(pkgSpec: PkgSpec!, after: ID, first: Int): PackageConnection!
 }
 
 extend type Mutation {
   "Ingest a new package. Returns the ingested package trie"
   ingestPackage(pkg: PkgInputSpec): Package!
 }
+`, BuiltIn: false},
+	{Name: "../schema/pagination.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines the GraphQL types shared by the paginated list queries.
+
+"""
+PageInfo describes a page of the results of a list query.
+
+Every query returning a list of nodes has a paginated variant, suffixed with
+` + "`" + `List` + "`" + `, taking the same filter and returning a connection. The connection holds
+the ` + "`" + `totalCount` + "`" + ` of results matching the filter, the ` + "`" + `edges` + "`" + ` of the page (each
+with the node and its cursor) and the ` + "`" + `pageInfo` + "`" + `. The unpaginated queries are
+deprecated: they return all the results at once, however many match.
+
+` + "`" + `first` + "`" + ` sets the number of results in the page, at least 1 (100 if not set),
+and ` + "`" + `after` + "`" + ` the cursor of the result the page starts after. To read all
+results, pass the ` + "`" + `endCursor` + "`" + ` of each page as ` + "`" + `after` + "`" + ` of the next query until
+` + "`" + `hasNextPage` + "`" + ` is false. Results are returned in a stable order, so pages do not overlap nor skip
+results ingested before the first page is read.
+
+Cursors are opaque and only valid with the backend that returned them.
+"""
+type PageInfo {
+  "Whether there are results after the end of the page"
+  hasNextPage: Boolean!
+  "Cursor of the first result of the page, null if the page is empty"
+  startCursor: ID
+  "Cursor of the last result of the page, null if the page is empty"
+  endCursor: ID
+}
+`, BuiltIn: false},
+	{Name: "../schema/path.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema to find how the nodes of the GUAC graph are connected.
+
+"""
+Edge is a link between two nodes of the GUAC graph, named after the type of
+the node it starts from and the type of the node it ends at.
+
+Every evidence node is linked to the nodes of the software trees it refers
+to, in both directions (e.g. ` + "`" + `IS_OCCURRENCE_ARTIFACT` + "`" + ` and
+` + "`" + `ARTIFACT_IS_OCCURRENCE` + "`" + `). Package edges start or end at the version or the
+name of the package, depending on the evidence. ` + "`" + `PACKAGE_NAME_PACKAGE_VERSION` + "`" + `
+and ` + "`" + `PACKAGE_VERSION_PACKAGE_NAME` + "`" + ` link the versions of a package to its name,
+so a path can go from a package version to a dependency on its name.
+"""
+enum Edge {
+  PACKAGE_NAME_PACKAGE_VERSION
+  PACKAGE_VERSION_PACKAGE_NAME
+  PACKAGE_IS_OCCURRENCE
+  PACKAGE_IS_DEPENDENCY
+  PACKAGE_CERTIFY_VEX_STATEMENT
+  PACKAGE_CERTIFY_BAD
+  PACKAGE_CERTIFY_PKG
+  PACKAGE_CERTIFY_VULN
+  PACKAGE_HAS_SOURCE_AT
+  PACKAGE_HAS_SBOM
+  PACKAGE_HAS_SLSA
+  PACKAGE_VULN_AFFECTED
+  PACKAGE_CERTIFY_EOL
+  SOURCE_IS_OCCURRENCE
+  SOURCE_CERTIFY_BAD
+  SOURCE_CERTIFY_SCORECARD
+  SOURCE_HAS_SOURCE_AT
+  SOURCE_HAS_SBOM
+  SOURCE_HAS_SLSA
+  ARTIFACT_IS_OCCURRENCE
+  ARTIFACT_CERTIFY_VEX_STATEMENT
+  ARTIFACT_HASH_EQUAL
+  ARTIFACT_CERTIFY_BAD
+  ARTIFACT_HAS_SLSA
+  BUILDER_HAS_SLSA
+  CVE_IS_VULNERABILITY
+  CVE_CERTIFY_VEX_STATEMENT
+  CVE_CERTIFY_VULN
+  CVE_VULN_METADATA
+  CVE_VULN_AFFECTED
+  GHSA_IS_VULNERABILITY
+  GHSA_CERTIFY_VEX_STATEMENT
+  GHSA_CERTIFY_VULN
+  GHSA_VULN_METADATA
+  GHSA_VULN_AFFECTED
+  OSV_IS_VULNERABILITY
+  OSV_CERTIFY_VEX_STATEMENT
+  OSV_CERTIFY_VULN
+  OSV_VULN_METADATA
+  OSV_VULN_AFFECTED
+  NO_VULN_CERTIFY_VULN
+  IS_OCCURRENCE_PACKAGE
+  IS_OCCURRENCE_SOURCE
+  IS_OCCURRENCE_ARTIFACT
+  IS_DEPENDENCY_PACKAGE
+  IS_VULNERABILITY_OSV
+  IS_VULNERABILITY_CVE
+  IS_VULNERABILITY_GHSA
+  CERTIFY_VEX_STATEMENT_PACKAGE
+  CERTIFY_VEX_STATEMENT_ARTIFACT
+  CERTIFY_VEX_STATEMENT_CVE
+  CERTIFY_VEX_STATEMENT_GHSA
+  CERTIFY_VEX_STATEMENT_OSV
+  HASH_EQUAL_ARTIFACT
+  CERTIFY_BAD_PACKAGE
+  CERTIFY_BAD_SOURCE
+  CERTIFY_BAD_ARTIFACT
+  CERTIFY_PKG_PACKAGE
+  CERTIFY_SCORECARD_SOURCE
+  CERTIFY_VULN_PACKAGE
+  CERTIFY_VULN_CVE
+  CERTIFY_VULN_GHSA
+  CERTIFY_VULN_OSV
+  CERTIFY_VULN_NO_VULN
+  HAS_SOURCE_AT_PACKAGE
+  HAS_SOURCE_AT_SOURCE
+  HAS_SBOM_PACKAGE
+  HAS_SBOM_SOURCE
+  HAS_SLSA_PACKAGE
+  HAS_SLSA_SOURCE
+  HAS_SLSA_ARTIFACT
+  HAS_SLSA_BUILDER
+  VULN_METADATA_CVE
+  VULN_METADATA_GHSA
+  VULN_METADATA_OSV
+  VULN_AFFECTED_PACKAGE
+  VULN_AFFECTED_CVE
+  VULN_AFFECTED_GHSA
+  VULN_AFFECTED_OSV
+  CERTIFY_EOL_PACKAGE
+}
+
+extend type Query {
+  """
+  Returns the shortest path between the subject and target nodes, from the
+  subject to the target, both included.
+
+  The subject and target are ids of evidence or of the nodes of the software
+  trees a path goes through: package versions and names, source names,
+  artifacts, builders, NoVuln and single vulnerabilities (` + "`" + `CVEId` + "`" + `, ` + "`" + `GHSAId` + "`" + ` or
+  ` + "`" + `OSVId` + "`" + ` ids).
+
+  The path has at most maxPathLength edges. If usingOnly is not empty, the path
+  only goes through the given edges. The result is empty if there is no such
+  path.
+  """
+  path(
+    subject: ID!
+    target: ID!
+    maxPathLength: Int!
+    usingOnly: [Edge!]
+  ): [Node!]!
+  """
+  Returns the nodes directly linked to the given node, following only the
+  given edges if usingOnly is not empty.
+
+  The node is an id of the same kinds as the ends of a path.
+  """
+  neighbors(node: ID!, usingOnly: [Edge!]): [Node!]!
+}
 `, BuiltIn: false},
 	{Name: "../schema/source.graphql", Input: `#
 # Copyright 2023 The GUAC Authors.
@@ -3373,6 +6424,7 @@ Also note that this is named ` + "`" + `Source` + "`" + `, not ` + "`" + `Source
 queries more readable.
 """
 type Source {
+  id: ID!
   type: String!
   namespaces: [SourceNamespace!]!
 }
@@ -3385,6 +6437,7 @@ This is the location of the repository (such as github/gitlab/bitbucket).
 The ` + "`" + `namespace` + "`" + ` field is mandatory.
 """
 type SourceNamespace {
+  id: ID!
   namespace: String!
   names: [SourceName!]!
 }
@@ -3399,6 +6452,7 @@ This is the only source trie node that can be referenced by other parts of
 GUAC.
 """
 type SourceName {
+  id: ID!
   name: String!
   tag: String
   commit: String
@@ -3440,15 +6494,376 @@ input SourceInputSpec {
   commit: String = ""
 }
 
+"""
+SourceConnection is a page of the results of sourcesList, see PageInfo.
+Each edge holds a source trie with a single name.
+"""
+type SourceConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [SourceEdge!]!
+}
+
+"SourceEdge is a source trie with a single name with its cursor"
+type SourceEdge {
+  cursor: ID!
+  node: Source!
+}
+
 extend type Query {
   "Returns all sources"
-  sources(sourceSpec: SourceSpec): [Source!]!
+  sources(sourceSpec: SourceSpec): [Source!]! @deprecated(reason: "Use sourcesList, which returns a page of the results")
+  "Same as sources, returning a page of the results, see PageInfo"
+  sourcesList(sourceSpec: SourceSpec!, after: ID, first: Int): SourceConnection!
 }
 
 extend type Mutation {
   "Ingest a new source. Returns the ingested source trie"
   ingestSource(source: SourceInputSpec): Source!
 }
+`, BuiltIn: false},
+	{Name: "../schema/vulnAffected.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the VulnAffected. It contains a vulnerability that can be of type
+# osv, cve or ghsa, the package name that is affected, the affected version ranges and versions,
+# origin and collector
+
+"""
+AffectedRangeType is the enumeration of the kinds of version ranges, as
+defined by OSV.
+
+SEMVER ranges use semantic versions, ECOSYSTEM ranges use the versioning
+scheme of the package ecosystem, and GIT ranges use commit hashes.
+"""
+enum AffectedRangeType {
+  SEMVER
+  ECOSYSTEM
+  GIT
+}
+
+"""
+AffectedEventType is the enumeration of the events of a version range, as
+defined by OSV.
+"""
+enum AffectedEventType {
+  INTRODUCED
+  FIXED
+  LAST_AFFECTED
+  LIMIT
+}
+
+"""
+AffectedEvent is an event of a version range, e.g. the version in which the
+vulnerability was introduced or fixed.
+
+This node cannot be directly referred by other parts of GUAC.
+"""
+type AffectedEvent {
+  eventType: AffectedEventType!
+  version: String!
+}
+
+"""
+AffectedRange is a range of affected versions, described by a list of events.
+The repo is only set for GIT ranges.
+
+This node cannot be directly referred by other parts of GUAC.
+"""
+type AffectedRange {
+  rangeType: AffectedRangeType!
+  repo: String!
+  events: [AffectedEvent!]!
+}
+
+"""
+VulnAffected is an attestation that represents the versions of a package that
+are affected by a vulnerability, as stated by an advisory.
+
+The package is always at the name level (no version). The affected versions
+are given as ranges of versions and as a list of explicit versions.
+The vulnerability can only be an OSV, CVE or GHSA (NoVuln is not allowed).
+"""
+type VulnAffected {
+  "id - opaque, stable identifier of the evidence"
+  id: ID!
+  "package (subject) - the package name that is affected"
+  package: Package!
+  "vulnerability (object) - union type that consists of osv, cve or ghsa"
+  vulnerability: OsvCveOrGhsa!
+  "ranges (property) - the ranges of affected versions"
+  ranges: [AffectedRange!]!
+  "versions (property) - explicitly listed affected versions"
+  versions: [String!]!
+  "origin (property) - where this attestation was generated from (based on which document)"
+  origin: String!
+  "collector (property) - the GUAC collector that collected the document that generated this attestation"
+  collector: String!
+}
+
+"""
+VulnAffectedSpec allows filtering the list of VulnAffected to return.
+
+Only the type, namespace and name of the package are used.
+"""
+input VulnAffectedSpec {
+  id: ID
+  package: PkgSpec
+  vulnerability: OsvCveOrGhsaSpec
+  origin: String
+  collector: String
+}
+
+"AffectedEventInputSpec is the same as AffectedEvent but for mutation input."
+input AffectedEventInputSpec {
+  eventType: AffectedEventType!
+  version: String!
+}
+
+"AffectedRangeInputSpec is the same as AffectedRange but for mutation input."
+input AffectedRangeInputSpec {
+  rangeType: AffectedRangeType!
+  repo: String!
+  events: [AffectedEventInputSpec!]!
+}
+
+"""
+VulnAffectedInputSpec is the same as VulnAffected but for mutation input.
+
+All fields are required.
+"""
+input VulnAffectedInputSpec {
+  ranges: [AffectedRangeInputSpec!]!
+  versions: [String!]!
+  origin: String!
+  collector: String!
+}
+
+"""
+AffectedPackage is a package version present in GUAC that falls in the
+affected versions of a VulnAffected.
+"""
+type AffectedPackage {
+  "package - the affected package version"
+  package: Package!
+  "vulnAffected - the affected versions that the package version falls in"
+  vulnAffected: VulnAffected!
+}
+
+"""
+VulnAffectedConnection is a page of the results of VulnAffectedList, see PageInfo.
+Each edge holds a VulnAffected.
+"""
+type VulnAffectedConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [VulnAffectedEdge!]!
+}
+
+"VulnAffectedEdge is a VulnAffected with its cursor"
+type VulnAffectedEdge {
+  cursor: ID!
+  node: VulnAffected!
+}
+
+"""
+AffectedPackageConnection is a page of the results of affectedPackagesList, see PageInfo.
+Each edge holds an affected package version with its VulnAffected.
+"""
+type AffectedPackageConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [AffectedPackageEdge!]!
+}
+
+"AffectedPackageEdge is an affected package version with its VulnAffected with its cursor"
+type AffectedPackageEdge {
+  cursor: ID!
+  node: AffectedPackage!
+}
+
+extend type Query {
+  "Returns all VulnAffected matching the filter"
+  VulnAffected(vulnAffectedSpec: VulnAffectedSpec): [VulnAffected!]! @deprecated(reason: "Use VulnAffectedList, which returns a page of the results")
+  "Same as VulnAffected, returning a page of the results, see PageInfo"
+  VulnAffectedList(vulnAffectedSpec: VulnAffectedSpec!, after: ID, first: Int): VulnAffectedConnection!
+  """
+  Returns the package versions in GUAC affected by the VulnAffected matching
+  the filter. Versions are compared with the versioning scheme of the package
+  ecosystem (npm, PyPI, Maven, Go, deb and rpm), GIT ranges are not matched.
+  """
+  affectedPackages(vulnAffectedSpec: VulnAffectedSpec): [AffectedPackage!]! @deprecated(reason: "Use affectedPackagesList, which returns a page of the results")
+  "Same as affectedPackages, returning a page of the results, see PageInfo"
+  affectedPackagesList(vulnAffectedSpec: VulnAffectedSpec!, after: ID, first: Int): AffectedPackageConnection!
+}
+
+extend type Mutation {
+  """
+  Records the affected versions of a package (OSV, CVE or GHSA). Only the
+  type, namespace and name of the package are used.
+  """
+  ingestVulnAffected(pkg: PkgInputSpec!, vulnerability: OsvCveOrGhsaInput!, vulnAffected: VulnAffectedInputSpec!): VulnAffected!
+}
+`, BuiltIn: false},
+	{Name: "../schema/vulnMetadata.graphql", Input: `#
+# Copyright 2023 The GUAC Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# NOTE: This is experimental and might change in the future!
+
+# Defines a GraphQL schema for the VulnMetadata. It contains a vulnerability that can be of type
+# osv, cve or ghsa, summary, scores, cwe ids, references, published and modified time, origin and collector
+
+"""
+VulnScoreType is the enumeration of the supported vulnerability scoring systems.
+"""
+enum VulnScoreType {
+  CVSS_V2
+  CVSS_V3
+  CVSS_V4
+  EPSS
+}
+
+"""
+VulnScore is a single score of a vulnerability, in a given scoring system.
+
+For CVSS scores, vector holds the CVSS vector string and score the base score.
+For EPSS, score holds the probability of exploitation (between 0 and 1) and
+vector is empty.
+This node cannot be directly referred by other parts of GUAC.
+"""
+type VulnScore {
+  scoreType: VulnScoreType!
+  vector: String!
+  score: Float!
+}
+
+"""
+VulnMetadata is an attestation that attaches severity, scoring and descriptive
+metadata to a vulnerability.
+
+The vulnerability can only be an OSV, CVE or GHSA (NoVuln is not allowed).
+"""
+type VulnMetadata {
+  "id - opaque, stable identifier of the metadata"
+  id: ID!
+  "vulnerability (subject) - union type that consists of osv, cve or ghsa"
+  vulnerability: OsvCveOrGhsa!
+  "summary (property) - short description of the vulnerability"
+  summary: String!
+  "scores (property) - CVSS and EPSS scores for the vulnerability"
+  scores: [VulnScore!]!
+  "cweIds (property) - CWE identifiers (e.g. CWE-79) of the weakness"
+  cweIds: [String!]!
+  "references (property) - URLs with further information about the vulnerability"
+  references: [String!]!
+  "published (property) - timestamp of when the vulnerability was published"
+  published: Time
+  "modified (property) - timestamp of when the vulnerability was last modified"
+  modified: Time
+  "origin (property) - where this attestation was generated from (based on which document)"
+  origin: String!
+  "collector (property) - the GUAC collector that collected the document that generated this attestation"
+  collector: String!
+}
+
+"""
+VulnMetadataSpec allows filtering the list of VulnMetadata to return.
+
+minScore and maxScore match the metadata if at least one score (of scoreType,
+if specified) lies in the inclusive range. cweId matches if it is one of the
+CWE ids of the vulnerability. publishedSince matches vulnerabilities published
+at or after the given time.
+"""
+input VulnMetadataSpec {
+  id: ID
+  vulnerability: OsvCveOrGhsaSpec
+  scoreType: VulnScoreType
+  minScore: Float
+  maxScore: Float
+  cweId: String
+  publishedSince: Time
+  origin: String
+  collector: String
+}
+
+"""
+VulnScoreInputSpec is the same as VulnScore but for mutation input.
+"""
+input VulnScoreInputSpec {
+  scoreType: VulnScoreType!
+  vector: String!
+  score: Float!
+}
+
+"""
+VulnMetadataInputSpec is the same as VulnMetadata but for mutation input.
+
+Published and modified are optional, all other fields are required.
+"""
+input VulnMetadataInputSpec {
+  summary: String!
+  scores: [VulnScoreInputSpec!]!
+  cweIds: [String!]!
+  references: [String!]!
+  published: Time
+  modified: Time
+  origin: String!
+  collector: String!
+}
+
+"""
+VulnMetadataConnection is a page of the results of VulnMetadataList, see PageInfo.
+Each edge holds a VulnMetadata.
+"""
+type VulnMetadataConnection {
+  totalCount: Int!
+  pageInfo: PageInfo!
+  edges: [VulnMetadataEdge!]!
+}
+
+"VulnMetadataEdge is a VulnMetadata with its cursor"
+type VulnMetadataEdge {
+  cursor: ID!
+  node: VulnMetadata!
+}
+
+extend type Query {
+  "Returns all VulnMetadata matching the filter"
+  VulnMetadata(vulnMetadataSpec: VulnMetadataSpec): [VulnMetadata!]! @deprecated(reason: "Use VulnMetadataList, which returns a page of the results")
+  "Same as VulnMetadata, returning a page of the results, see PageInfo"
+  VulnMetadataList(vulnMetadataSpec: VulnMetadataSpec!, after: ID, first: Int): VulnMetadataConnection!
+}
+
+extend type Mutation {
+  "Attaches severity and scoring metadata to a vulnerability (OSV, CVE or GHSA)"
+  ingestVulnMetadata(vulnerability: OsvCveOrGhsaInput!, vulnMetadata: VulnMetadataInputSpec!): VulnMetadata!
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
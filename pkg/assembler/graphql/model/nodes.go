@@ -14,7 +14,25 @@ type CveOrGhsa interface {
 	IsCveOrGhsa()
 }
 
-// OsvCveGhsaObject is a union of OSV, CVE and GHSA. Any of these objects can be specified for vulnerability
+// Node is any node of the GUAC graph, software tree or evidence.
+//
+// Every node has an opaque `id` which stays the same for as long as the node
+// exists. The ids of the software trees (packages, sources, artifacts, builders
+// and vulnerabilities) are derived from their content, so they are the same
+// across backends and after the node is ingested again. The ids of the evidence
+// are generated by the backend when the evidence is first ingested, kept when it
+// is ingested again and never reused for another evidence.
+//
+// Querying the id of a namespace, name or version of a package or source trie
+// returns the trie filtered down to that node. `CVEId`, `GHSAId` and `OSVId` have
+// no node id, their `id` field being the identifier of the vulnerability. Paths
+// through a vulnerability return the vulnerability trie holding only that
+// vulnerability, with an id of its own.
+type Node interface {
+	IsNode()
+}
+
+// OsvCveGhsaObject is a union of OSV, CVE, GHSA and NoVuln. Any of these objects can be specified for vulnerability
 type OsvCveOrGhsa interface {
 	IsOsvCveOrGhsa()
 }
@@ -34,6 +52,61 @@ type PackageSourceOrArtifact interface {
 	IsPackageSourceOrArtifact()
 }
 
+// AffectedEvent is an event of a version range, e.g. the version in which the
+// vulnerability was introduced or fixed.
+//
+// This node cannot be directly referred by other parts of GUAC.
+type AffectedEvent struct {
+	EventType AffectedEventType `json:"eventType"`
+	Version   string            `json:"version"`
+}
+
+// AffectedEventInputSpec is the same as AffectedEvent but for mutation input.
+type AffectedEventInputSpec struct {
+	EventType AffectedEventType `json:"eventType"`
+	Version   string            `json:"version"`
+}
+
+// AffectedPackage is a package version present in GUAC that falls in the
+// affected versions of a VulnAffected.
+type AffectedPackage struct {
+	// package - the affected package version
+	Package *Package `json:"package"`
+	// vulnAffected - the affected versions that the package version falls in
+	VulnAffected *VulnAffected `json:"vulnAffected"`
+}
+
+// AffectedPackageConnection is a page of the results of affectedPackagesList, see PageInfo.
+// Each edge holds an affected package version with its VulnAffected.
+type AffectedPackageConnection struct {
+	TotalCount int                    `json:"totalCount"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	Edges      []*AffectedPackageEdge `json:"edges"`
+}
+
+// AffectedPackageEdge is an affected package version with its VulnAffected with its cursor
+type AffectedPackageEdge struct {
+	Cursor string           `json:"cursor"`
+	Node   *AffectedPackage `json:"node"`
+}
+
+// AffectedRange is a range of affected versions, described by a list of events.
+// The repo is only set for GIT ranges.
+//
+// This node cannot be directly referred by other parts of GUAC.
+type AffectedRange struct {
+	RangeType AffectedRangeType `json:"rangeType"`
+	Repo      string            `json:"repo"`
+	Events    []*AffectedEvent  `json:"events"`
+}
+
+// AffectedRangeInputSpec is the same as AffectedRange but for mutation input.
+type AffectedRangeInputSpec struct {
+	RangeType AffectedRangeType         `json:"rangeType"`
+	Repo      string                    `json:"repo"`
+	Events    []*AffectedEventInputSpec `json:"events"`
+}
+
 // Artifact represents the artifact and contains a digest field
 //
 // Both field are mandatory and canonicalized to be lowercase.
@@ -42,6 +115,7 @@ type PackageSourceOrArtifact interface {
 // `strings.ToLower(string(checksum.Algorithm))` and `digest` can be
 // `checksum.Value`.
 type Artifact struct {
+	ID        string `json:"id"`
 	Algorithm string `json:"algorithm"`
 	Digest    string `json:"digest"`
 }
@@ -50,6 +124,22 @@ func (Artifact) IsPackageOrArtifact() {}
 
 func (Artifact) IsPackageSourceOrArtifact() {}
 
+func (Artifact) IsNode() {}
+
+// ArtifactConnection is a page of the results of artifactsList, see PageInfo.
+// Each edge holds an artifact.
+type ArtifactConnection struct {
+	TotalCount int             `json:"totalCount"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	Edges      []*ArtifactEdge `json:"edges"`
+}
+
+// ArtifactEdge is an artifact with its cursor
+type ArtifactEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Artifact `json:"node"`
+}
+
 // ArtifactInputSpec is the same as Artifact, but used as mutation input.
 //
 // Both arguments will be canonicalized to lowercase.
@@ -70,9 +160,26 @@ type ArtifactSpec struct {
 //
 // Currently builders are identified by the `uri` field, which is mandatory.
 type Builder struct {
+	ID  string `json:"id"`
 	URI string `json:"uri"`
 }
 
+func (Builder) IsNode() {}
+
+// BuilderConnection is a page of the results of buildersList, see PageInfo.
+// Each edge holds a builder.
+type BuilderConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*BuilderEdge `json:"edges"`
+}
+
+// BuilderEdge is a builder with its cursor
+type BuilderEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Builder `json:"node"`
+}
+
 // BuilderInputSpec is the same as Builder, but used for mutation ingestion.
 type BuilderInputSpec struct {
 	URI string `json:"uri"`
@@ -91,6 +198,7 @@ type BuilderSpec struct {
 // This node is a singleton: backends guarantee that there is exactly one node
 // with the same `year` value.
 type Cve struct {
+	ID    string   `json:"id"`
 	Year  string   `json:"year"`
 	CveID []*CVEId `json:"cveId"`
 }
@@ -99,6 +207,22 @@ func (Cve) IsOsvCveOrGhsa() {}
 
 func (Cve) IsCveOrGhsa() {}
 
+func (Cve) IsNode() {}
+
+// CVEConnection is a page of the results of cveList, see PageInfo.
+// Each edge holds a CVE trie with a single CVE id.
+type CVEConnection struct {
+	TotalCount int        `json:"totalCount"`
+	PageInfo   *PageInfo  `json:"pageInfo"`
+	Edges      []*CVEEdge `json:"edges"`
+}
+
+// CVEEdge is a CVE trie with a single CVE id with its cursor
+type CVEEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Cve   `json:"node"`
+}
+
 // CVEId is the actual ID that is given to a specific vulnerability
 //
 // The `id` field is mandatory and canonicalized to be lowercase.
@@ -129,12 +253,29 @@ type CVESpec struct {
 //
 // Note: Attestation must occur at the PackageName or the PackageVersion or at the SourceName.
 type CertifyBad struct {
+	ID            string                  `json:"id"`
 	Subject       PackageSourceOrArtifact `json:"subject"`
 	Justification string                  `json:"justification"`
 	Origin        string                  `json:"origin"`
 	Collector     string                  `json:"collector"`
 }
 
+func (CertifyBad) IsNode() {}
+
+// CertifyBadConnection is a page of the results of CertifyBadList, see PageInfo.
+// Each edge holds a CertifyBad.
+type CertifyBadConnection struct {
+	TotalCount int               `json:"totalCount"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	Edges      []*CertifyBadEdge `json:"edges"`
+}
+
+// CertifyBadEdge is a CertifyBad with its cursor
+type CertifyBadEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *CertifyBad `json:"node"`
+}
+
 // CertifyBadInputSpec is the same as CertifyBad but for mutation input.
 //
 // All fields are required.
@@ -149,12 +290,91 @@ type CertifyBadInputSpec struct {
 // For package - a PackageName or PackageVersion must be specified (name or name, version, qualifiers and subpath)
 // For source - a SourceName must be specified (name, tag or commit)
 type CertifyBadSpec struct {
+	ID            *string                      `json:"id"`
 	Subject       *PackageSourceOrArtifactSpec `json:"subject"`
 	Justification *string                      `json:"justification"`
 	Origin        *string                      `json:"origin"`
 	Collector     *string                      `json:"collector"`
 }
 
+// CertifyEOL is an attestation that a package version belongs to a release
+// cycle of a product (e.g. a runtime, a framework or the distribution of a
+// container image) with the given support status, as published by
+// endoflife.date or a dataset of the same shape.
+//
+// The status depends on the time it was checked: a cycle reaches its end of
+// life at the eol date.
+type CertifyEol struct {
+	// id - opaque, stable identifier of the certification
+	ID string `json:"id"`
+	// package (subject) - the package version
+	Package *Package `json:"package"`
+	// product (property) - the product, e.g. python or debian
+	Product string `json:"product"`
+	// cycle (property) - the release cycle of the product, e.g. 3.7 or 11
+	Cycle string `json:"cycle"`
+	// status (property) - the support status of the cycle when checked
+	Status SupportStatus `json:"status"`
+	// eol (property) - the end of life date of the cycle, if known
+	Eol *time.Time `json:"eol"`
+	// support (property) - the end of active support date of the cycle, if known
+	Support *time.Time `json:"support"`
+	// latest (property) - the latest version of the cycle
+	Latest string `json:"latest"`
+	// timeChecked (property) - timestamp of when the status was checked
+	TimeChecked time.Time `json:"timeChecked"`
+	// origin (property) - where this attestation was generated from (based on which document)
+	Origin string `json:"origin"`
+	// collector (property) - the GUAC collector that collected the document that generated this attestation
+	Collector string `json:"collector"`
+}
+
+func (CertifyEol) IsNode() {}
+
+// CertifyEOLConnection is a page of the results of CertifyEOLList, see PageInfo.
+// Each edge holds a CertifyEOL.
+type CertifyEOLConnection struct {
+	TotalCount int               `json:"totalCount"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	Edges      []*CertifyEOLEdge `json:"edges"`
+}
+
+// CertifyEOLEdge is a CertifyEOL with its cursor
+type CertifyEOLEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *CertifyEol `json:"node"`
+}
+
+// CertifyEOLInputSpec is the same as CertifyEOL but for mutation input.
+//
+// The eol and support dates are optional, all other fields are required.
+type CertifyEOLInputSpec struct {
+	Product     string        `json:"product"`
+	Cycle       string        `json:"cycle"`
+	Status      SupportStatus `json:"status"`
+	Eol         *time.Time    `json:"eol"`
+	Support     *time.Time    `json:"support"`
+	Latest      string        `json:"latest"`
+	TimeChecked time.Time     `json:"timeChecked"`
+	Origin      string        `json:"origin"`
+	Collector   string        `json:"collector"`
+}
+
+// CertifyEOLSpec allows filtering the list of CertifyEOL to return.
+//
+// eolBefore matches the cycles with an end of life date strictly before the
+// given time.
+type CertifyEOLSpec struct {
+	ID        *string        `json:"id"`
+	Package   *PkgSpec       `json:"package"`
+	Product   *string        `json:"product"`
+	Cycle     *string        `json:"cycle"`
+	Status    *SupportStatus `json:"status"`
+	EolBefore *time.Time     `json:"eolBefore"`
+	Origin    *string        `json:"origin"`
+	Collector *string        `json:"collector"`
+}
+
 // CertifyPkg is an attestation that represents when a package objects are similar
 //
 // packages (subject) - list of package objects
@@ -162,12 +382,29 @@ type CertifyBadSpec struct {
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
 type CertifyPkg struct {
+	ID            string     `json:"id"`
 	Packages      []*Package `json:"packages"`
 	Justification string     `json:"justification"`
 	Origin        string     `json:"origin"`
 	Collector     string     `json:"collector"`
 }
 
+func (CertifyPkg) IsNode() {}
+
+// CertifyPkgConnection is a page of the results of CertifyPkgList, see PageInfo.
+// Each edge holds a CertifyPkg.
+type CertifyPkgConnection struct {
+	TotalCount int               `json:"totalCount"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	Edges      []*CertifyPkgEdge `json:"edges"`
+}
+
+// CertifyPkgEdge is a CertifyPkg with its cursor
+type CertifyPkgEdge struct {
+	Cursor string      `json:"cursor"`
+	Node   *CertifyPkg `json:"node"`
+}
+
 // CertifyPkgInputSpec is the same as CertifyPkg but for mutation input.
 //
 // All fields are required.
@@ -181,6 +418,7 @@ type CertifyPkgInputSpec struct {
 //
 // Specifying just the package allows to query for all similar packages (if they exist)
 type CertifyPkgSpec struct {
+	ID            *string    `json:"id"`
 	Packages      []*PkgSpec `json:"packages"`
 	Justification *string    `json:"justification"`
 	Origin        *string    `json:"origin"`
@@ -190,14 +428,33 @@ type CertifyPkgSpec struct {
 // CertifyScorecard is an attestation which represents the scorecard of a
 // particular source repository.
 type CertifyScorecard struct {
+	// Opaque, stable identifier of the certification
+	ID string `json:"id"`
 	// The source repository that is being scanned (attestation subject)
 	Source *Source `json:"source"`
 	// The Scorecard attached to the repository (attestation object)
 	Scorecard *Scorecard `json:"scorecard"`
 }
 
+func (CertifyScorecard) IsNode() {}
+
+// CertifyScorecardConnection is a page of the results of scorecardsList, see PageInfo.
+// Each edge holds a CertifyScorecard.
+type CertifyScorecardConnection struct {
+	TotalCount int                     `json:"totalCount"`
+	PageInfo   *PageInfo               `json:"pageInfo"`
+	Edges      []*CertifyScorecardEdge `json:"edges"`
+}
+
+// CertifyScorecardEdge is a CertifyScorecard with its cursor
+type CertifyScorecardEdge struct {
+	Cursor string            `json:"cursor"`
+	Node   *CertifyScorecard `json:"node"`
+}
+
 // CertifyScorecardSpec allows filtering the list of CertifyScorecard to return.
 type CertifyScorecardSpec struct {
+	ID               *string               `json:"id"`
 	Source           *SourceSpec           `json:"source"`
 	TimeScanned      *time.Time            `json:"timeScanned"`
 	AggregateScore   *float64              `json:"aggregateScore"`
@@ -208,49 +465,102 @@ type CertifyScorecardSpec struct {
 	Collector        *string               `json:"collector"`
 }
 
-// CertifyVEXStatement is an attestation that represents when a package or artifact has a VEX about a specific vulnerability (CVE or GHSA)
+// CertifyVEXStatement is an attestation that represents when a package or artifact has a VEX about a specific vulnerability (OSV, CVE or GHSA)
 //
 // subject - union type that represents a package or artifact
-// vulnerability (object) - union type that consists of cve or ghsa
-// justification (property) - justification for VEX
+// vulnerability (object) - union type that consists of osv, cve or ghsa
+// status (property) - the status of the subject with respect to the vulnerability
+// vexJustification (property) - justification for a NOT_AFFECTED status
+// statement (property) - free text impact statement explaining the status
+// statusNotes (property) - free text notes about the status
+// actionStatement (property) - action to take to remediate or mitigate the vulnerability (AFFECTED status)
 // knownSince (property) - timestamp of the VEX (exact time in RFC 3339 format)
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
 type CertifyVEXStatement struct {
-	Subject       PackageOrArtifact `json:"subject"`
-	Vulnerability CveOrGhsa         `json:"vulnerability"`
-	Justification string            `json:"justification"`
-	KnownSince    time.Time         `json:"knownSince"`
-	Origin        string            `json:"origin"`
-	Collector     string            `json:"collector"`
+	ID               string            `json:"id"`
+	Subject          PackageOrArtifact `json:"subject"`
+	Vulnerability    OsvCveOrGhsa      `json:"vulnerability"`
+	Status           VexStatus         `json:"status"`
+	VexJustification VexJustification  `json:"vexJustification"`
+	Statement        string            `json:"statement"`
+	StatusNotes      string            `json:"statusNotes"`
+	ActionStatement  string            `json:"actionStatement"`
+	KnownSince       time.Time         `json:"knownSince"`
+	Origin           string            `json:"origin"`
+	Collector        string            `json:"collector"`
+}
+
+func (CertifyVEXStatement) IsNode() {}
+
+// CertifyVEXStatementConnection is a page of the results of CertifyVEXStatementList, see PageInfo.
+// Each edge holds a CertifyVEXStatement.
+type CertifyVEXStatementConnection struct {
+	TotalCount int                        `json:"totalCount"`
+	PageInfo   *PageInfo                  `json:"pageInfo"`
+	Edges      []*CertifyVEXStatementEdge `json:"edges"`
+}
+
+// CertifyVEXStatementEdge is a CertifyVEXStatement with its cursor
+type CertifyVEXStatementEdge struct {
+	Cursor string               `json:"cursor"`
+	Node   *CertifyVEXStatement `json:"node"`
 }
 
 // CertifyVEXStatementSpec allows filtering the list of CertifyVEXStatement to return.
-// Only package or artifact and CVE or GHSA can be specified at once.
+// Only package or artifact and OSV, CVE or GHSA can be specified at once.
 type CertifyVEXStatementSpec struct {
-	Subject       *PackageOrArtifactSpec `json:"subject"`
-	Vulnerability *CveOrGhsaSpec         `json:"vulnerability"`
-	Justification *string                `json:"justification"`
-	KnownSince    *time.Time             `json:"knownSince"`
-	Origin        *string                `json:"origin"`
-	Collector     *string                `json:"collector"`
+	ID               *string                `json:"id"`
+	Subject          *PackageOrArtifactSpec `json:"subject"`
+	Vulnerability    *OsvCveOrGhsaSpec      `json:"vulnerability"`
+	Status           *VexStatus             `json:"status"`
+	VexJustification *VexJustification      `json:"vexJustification"`
+	Statement        *string                `json:"statement"`
+	StatusNotes      *string                `json:"statusNotes"`
+	ActionStatement  *string                `json:"actionStatement"`
+	KnownSince       *time.Time             `json:"knownSince"`
+	Origin           *string                `json:"origin"`
+	Collector        *string                `json:"collector"`
 }
 
 // CertifyVuln is an attestation that represents when a package has a vulnerability
+//
+// A package that was scanned and found to have no vulnerability is certified with
+// NoVuln as the vulnerability. A package with no CertifyVuln at all has never been
+// scanned.
 type CertifyVuln struct {
+	// id - opaque, stable identifier of the certification
+	ID string `json:"id"`
 	// package (subject) - the package object type that represents the package
 	Package *Package `json:"package"`
-	// vulnerability (object) - union type that consists of osv, cve or ghsa
+	// vulnerability (object) - union type that consists of osv, cve, ghsa or noVuln
 	Vulnerability OsvCveOrGhsa `json:"vulnerability"`
 	// metadata (property) - contains all the vulnerability metadata
 	Metadata *VulnerabilityMetaData `json:"metadata"`
 }
 
+func (CertifyVuln) IsNode() {}
+
+// CertifyVulnConnection is a page of the results of CertifyVulnList, see PageInfo.
+// Each edge holds a CertifyVuln.
+type CertifyVulnConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*CertifyVulnEdge `json:"edges"`
+}
+
+// CertifyVulnEdge is a CertifyVuln with its cursor
+type CertifyVulnEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *CertifyVuln `json:"node"`
+}
+
 // CertifyVulnSpec allows filtering the list of CertifyVuln to return.
 //
 // Specifying just the package allows to query for all vulnerabilities associated with the package.
 // Only OSV, CVE or GHSA can be specified at once
 type CertifyVulnSpec struct {
+	ID             *string           `json:"id"`
 	Package        *PkgSpec          `json:"package"`
 	Vulnerability  *OsvCveOrGhsaSpec `json:"vulnerability"`
 	TimeScanned    *time.Time        `json:"timeScanned"`
@@ -282,6 +592,7 @@ type CveOrGhsaSpec struct {
 //
 // We create a separate node to allow retrieving all GHSAs.
 type Ghsa struct {
+	ID     string    `json:"id"`
 	GhsaID []*GHSAId `json:"ghsaId"`
 }
 
@@ -289,6 +600,22 @@ func (Ghsa) IsOsvCveOrGhsa() {}
 
 func (Ghsa) IsCveOrGhsa() {}
 
+func (Ghsa) IsNode() {}
+
+// GHSAConnection is a page of the results of ghsaList, see PageInfo.
+// Each edge holds a GHSA trie with a single GHSA id.
+type GHSAConnection struct {
+	TotalCount int         `json:"totalCount"`
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	Edges      []*GHSAEdge `json:"edges"`
+}
+
+// GHSAEdge is a GHSA trie with a single GHSA id with its cursor
+type GHSAEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Ghsa  `json:"node"`
+}
+
 // GHSAId is the actual ID that is given to a specific vulnerability on GitHub
 //
 // The `id` field is mandatory and canonicalized to be lowercase.
@@ -319,12 +646,29 @@ type GHSASpec struct {
 //
 // Note: Only package object or source object can be defined. Not both.
 type HasSbom struct {
+	ID        string          `json:"id"`
 	Subject   PackageOrSource `json:"subject"`
 	URI       string          `json:"uri"`
 	Origin    string          `json:"origin"`
 	Collector string          `json:"collector"`
 }
 
+func (HasSbom) IsNode() {}
+
+// HasSBOMConnection is a page of the results of HasSBOMList, see PageInfo.
+// Each edge holds a HasSBOM.
+type HasSBOMConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*HasSBOMEdge `json:"edges"`
+}
+
+// HasSBOMEdge is a HasSBOM with its cursor
+type HasSBOMEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *HasSbom `json:"node"`
+}
+
 // HasSBOMInputSpec is the same as HasSBOM but for mutation input.
 //
 // All fields are required.
@@ -339,6 +683,7 @@ type HasSBOMInputSpec struct {
 // Only the package or source can be added, not both. HasSourceAt will be used to create the package to source
 // relationship.
 type HasSBOMSpec struct {
+	ID        *string              `json:"id"`
 	Subject   *PackageOrSourceSpec `json:"subject"`
 	URI       *string              `json:"uri"`
 	Origin    *string              `json:"origin"`
@@ -347,14 +692,33 @@ type HasSBOMSpec struct {
 
 // HasSLSA records that a subject node has a SLSA attestation.
 type HasSlsa struct {
+	// Opaque, stable identifier of the attestation.
+	ID string `json:"id"`
 	// The subject of SLSA attestation: package, source, or artifact.
 	Subject PackageSourceOrArtifact `json:"subject"`
 	// The SLSA attestation.
 	Slsa *Slsa `json:"slsa"`
 }
 
+func (HasSlsa) IsNode() {}
+
+// HasSLSAConnection is a page of the results of HasSLSAList, see PageInfo.
+// Each edge holds a HasSLSA.
+type HasSLSAConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*HasSLSAEdge `json:"edges"`
+}
+
+// HasSLSAEdge is a HasSLSA with its cursor
+type HasSLSAEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *HasSlsa `json:"node"`
+}
+
 // HasSLSASpec allows filtering the list of HasSLSA to return.
 type HasSLSASpec struct {
+	ID          *string                        `json:"id"`
 	Subject     *PackageSourceOrArtifactSpec   `json:"subject"`
 	BuiltFrom   []*PackageSourceOrArtifactSpec `json:"builtFrom"`
 	BuiltBy     *BuilderSpec                   `json:"builtBy"`
@@ -376,6 +740,7 @@ type HasSLSASpec struct {
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
 type HasSourceAt struct {
+	ID            string    `json:"id"`
 	Package       *Package  `json:"package"`
 	Source        *Source   `json:"source"`
 	KnownSince    time.Time `json:"knownSince"`
@@ -384,6 +749,22 @@ type HasSourceAt struct {
 	Collector     string    `json:"collector"`
 }
 
+func (HasSourceAt) IsNode() {}
+
+// HasSourceAtConnection is a page of the results of HasSourceAtList, see PageInfo.
+// Each edge holds a HasSourceAt.
+type HasSourceAtConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	Edges      []*HasSourceAtEdge `json:"edges"`
+}
+
+// HasSourceAtEdge is a HasSourceAt with its cursor
+type HasSourceAtEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *HasSourceAt `json:"node"`
+}
+
 // HasSourceAtInputSpec is the same as HasSourceAt but for mutation input.
 //
 // All fields are required.
@@ -396,6 +777,7 @@ type HasSourceAtInputSpec struct {
 
 // HasSourceAtSpec allows filtering the list of HasSourceAt to return.
 type HasSourceAtSpec struct {
+	ID            *string     `json:"id"`
 	Package       *PkgSpec    `json:"package"`
 	Source        *SourceSpec `json:"source"`
 	KnownSince    *time.Time  `json:"knownSince"`
@@ -411,12 +793,29 @@ type HasSourceAtSpec struct {
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
 type HashEqual struct {
+	ID            string      `json:"id"`
 	Artifacts     []*Artifact `json:"artifacts"`
 	Justification string      `json:"justification"`
 	Origin        string      `json:"origin"`
 	Collector     string      `json:"collector"`
 }
 
+func (HashEqual) IsNode() {}
+
+// HashEqualConnection is a page of the results of HashEqualList, see PageInfo.
+// Each edge holds a HashEqual.
+type HashEqualConnection struct {
+	TotalCount int              `json:"totalCount"`
+	PageInfo   *PageInfo        `json:"pageInfo"`
+	Edges      []*HashEqualEdge `json:"edges"`
+}
+
+// HashEqualEdge is a HashEqual with its cursor
+type HashEqualEdge struct {
+	Cursor string     `json:"cursor"`
+	Node   *HashEqual `json:"node"`
+}
+
 // HashEqualInputSpec is the same as HashEqual but for mutation input.
 //
 // All fields are required.
@@ -430,6 +829,7 @@ type HashEqualInputSpec struct {
 //
 // Specifying just the artifacts allows to query for all equivalent artifacts (if they exist)
 type HashEqualSpec struct {
+	ID            *string         `json:"id"`
 	Artifacts     []*ArtifactSpec `json:"artifacts"`
 	Justification *string         `json:"justification"`
 	Origin        *string         `json:"origin"`
@@ -445,6 +845,7 @@ type HashEqualSpec struct {
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
 type IsDependency struct {
+	ID               string   `json:"id"`
 	Package          *Package `json:"package"`
 	DependentPackage *Package `json:"dependentPackage"`
 	VersionRange     string   `json:"versionRange"`
@@ -453,6 +854,22 @@ type IsDependency struct {
 	Collector        string   `json:"collector"`
 }
 
+func (IsDependency) IsNode() {}
+
+// IsDependencyConnection is a page of the results of IsDependencyList, see PageInfo.
+// Each edge holds an IsDependency.
+type IsDependencyConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*IsDependencyEdge `json:"edges"`
+}
+
+// IsDependencyEdge is an IsDependency with its cursor
+type IsDependencyEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *IsDependency `json:"node"`
+}
+
 // IsDependencyInputSpec is the same as IsDependency but for mutation input.
 //
 // All fields are required.
@@ -468,6 +885,7 @@ type IsDependencyInputSpec struct {
 // Note: the package object must be defined to return its dependent packages.
 // Dependent Packages must represent the packageName (cannot be the packageVersion)
 type IsDependencySpec struct {
+	ID               *string      `json:"id"`
 	Package          *PkgSpec     `json:"package"`
 	DependentPackage *PkgNameSpec `json:"dependentPackage"`
 	VersionRange     *string      `json:"versionRange"`
@@ -481,6 +899,8 @@ type IsDependencySpec struct {
 // Note: Package or Source must be specified but not both at the same time.
 // Attestation must occur at the PackageVersion or at the SourceName.
 type IsOccurrence struct {
+	// id - opaque, stable identifier of the attestation
+	ID string `json:"id"`
 	// subject - union type that can be either a package or source object type
 	Subject PackageOrSource `json:"subject"`
 	// artifact (object) - artifact that represent the the package or source
@@ -493,6 +913,22 @@ type IsOccurrence struct {
 	Collector string `json:"collector"`
 }
 
+func (IsOccurrence) IsNode() {}
+
+// IsOccurrenceConnection is a page of the results of IsOccurrenceList, see PageInfo.
+// Each edge holds an IsOccurrence.
+type IsOccurrenceConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*IsOccurrenceEdge `json:"edges"`
+}
+
+// IsOccurrenceEdge is an IsOccurrence with its cursor
+type IsOccurrenceEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *IsOccurrence `json:"node"`
+}
+
 // IsOccurrenceInputSpec is the same as IsOccurrence but for mutation input.
 //
 // All fields are required.
@@ -508,6 +944,7 @@ type IsOccurrenceInputSpec struct {
 // or it defaults to empty string for version, subpath and empty list for qualifiers
 // For source - a SourceName must be specified (name, tag or commit)
 type IsOccurrenceSpec struct {
+	ID            *string              `json:"id"`
 	Subject       *PackageOrSourceSpec `json:"subject"`
 	Artifact      *ArtifactSpec        `json:"artifact"`
 	Justification *string              `json:"justification"`
@@ -523,6 +960,7 @@ type IsOccurrenceSpec struct {
 // origin (property) - where this attestation was generated from (based on which document)
 // collector (property) - the GUAC collector that collected the document that generated this attestation
 type IsVulnerability struct {
+	ID            string    `json:"id"`
 	Osv           *Osv      `json:"osv"`
 	Vulnerability CveOrGhsa `json:"vulnerability"`
 	Justification string    `json:"justification"`
@@ -530,6 +968,22 @@ type IsVulnerability struct {
 	Collector     string    `json:"collector"`
 }
 
+func (IsVulnerability) IsNode() {}
+
+// IsVulnerabilityConnection is a page of the results of IsVulnerabilityList, see PageInfo.
+// Each edge holds an IsVulnerability.
+type IsVulnerabilityConnection struct {
+	TotalCount int                    `json:"totalCount"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	Edges      []*IsVulnerabilityEdge `json:"edges"`
+}
+
+// IsVulnerabilityEdge is an IsVulnerability with its cursor
+type IsVulnerabilityEdge struct {
+	Cursor string           `json:"cursor"`
+	Node   *IsVulnerability `json:"node"`
+}
+
 // IsVulnerabilityInputSpec is the same as IsVulnerability but for mutation input.
 //
 // All fields are required.
@@ -542,6 +996,7 @@ type IsVulnerabilityInputSpec struct {
 // IsVulnerabilitySpec allows filtering the list of IsVulnerability to return.
 // Only CVE or GHSA can be specified at once.
 type IsVulnerabilitySpec struct {
+	ID            *string        `json:"id"`
 	Osv           *OSVSpec       `json:"osv"`
 	Vulnerability *CveOrGhsaSpec `json:"vulnerability"`
 	Justification *string        `json:"justification"`
@@ -554,15 +1009,45 @@ type MatchFlags struct {
 	Pkg PkgMatchType `json:"pkg"`
 }
 
+// NoVuln is a special vulnerability signaling that a package was scanned and no
+// vulnerability was found. It can only be used in CertifyVuln.
+type NoVuln struct {
+	// id - identifier of the NoVuln singleton
+	ID string `json:"id"`
+	// noVuln (property) - always true
+	NoVuln bool `json:"noVuln"`
+}
+
+func (NoVuln) IsOsvCveOrGhsa() {}
+
+func (NoVuln) IsNode() {}
+
 // OSV represents an Open Source Vulnerability.
 //
 // We create a separate node to allow retrieving all OSVs.
 type Osv struct {
+	ID    string   `json:"id"`
 	OsvID []*OSVId `json:"osvId"`
 }
 
 func (Osv) IsOsvCveOrGhsa() {}
 
+func (Osv) IsNode() {}
+
+// OSVConnection is a page of the results of osvList, see PageInfo.
+// Each edge holds an OSV trie with a single OSV id.
+type OSVConnection struct {
+	TotalCount int        `json:"totalCount"`
+	PageInfo   *PageInfo  `json:"pageInfo"`
+	Edges      []*OSVEdge `json:"edges"`
+}
+
+// OSVEdge is an OSV trie with a single OSV id with its cursor
+type OSVEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Osv   `json:"node"`
+}
+
 // OSVId is the actual ID that is given to a specific vulnerability.
 //
 // The `id` field is mandatory and canonicalized to be lowercase.
@@ -592,6 +1077,8 @@ type OsvCveOrGhsaInput struct {
 	Osv  *OSVInputSpec  `json:"osv"`
 	Cve  *CVEInputSpec  `json:"cve"`
 	Ghsa *GHSAInputSpec `json:"ghsa"`
+	// noVuln must be true when set
+	NoVuln *bool `json:"noVuln"`
 }
 
 // OsvCveOrGhsaSpec allows using OsvCveOrGhsa union as
@@ -601,6 +1088,8 @@ type OsvCveOrGhsaSpec struct {
 	Osv  *OSVSpec  `json:"osv"`
 	Cve  *CVESpec  `json:"cve"`
 	Ghsa *GHSASpec `json:"ghsa"`
+	// noVuln set to true matches only NoVuln, set to false matches any vulnerability except NoVuln
+	NoVuln *bool `json:"noVuln"`
 }
 
 // Package represents a package.
@@ -616,6 +1105,7 @@ type OsvCveOrGhsaSpec struct {
 // Also note that this is named `Package`, not `PackageType`. This is only to make
 // queries more readable.
 type Package struct {
+	ID         string              `json:"id"`
 	Type       string              `json:"type"`
 	Namespaces []*PackageNamespace `json:"namespaces"`
 }
@@ -626,6 +1116,22 @@ func (Package) IsPackageSourceOrArtifact() {}
 
 func (Package) IsPackageOrSource() {}
 
+func (Package) IsNode() {}
+
+// PackageConnection is a page of the results of packagesList, see PageInfo.
+// Each edge holds a package trie with a single version.
+type PackageConnection struct {
+	TotalCount int            `json:"totalCount"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	Edges      []*PackageEdge `json:"edges"`
+}
+
+// PackageEdge is a package trie with a single version with its cursor
+type PackageEdge struct {
+	Cursor string   `json:"cursor"`
+	Node   *Package `json:"node"`
+}
+
 // PackageName is a name for packages.
 //
 // In the pURL representation, each PackageName matches the
@@ -636,6 +1142,7 @@ func (Package) IsPackageOrSource() {}
 // This is the first node in the trie that can be referred to by other parts of
 // GUAC.
 type PackageName struct {
+	ID       string            `json:"id"`
 	Name     string            `json:"name"`
 	Versions []*PackageVersion `json:"versions"`
 }
@@ -648,6 +1155,7 @@ type PackageName struct {
 // Namespaces are optional and type specific. Because they are optional, we use
 // empty string to denote missing namespaces.
 type PackageNamespace struct {
+	ID        string         `json:"id"`
 	Namespace string         `json:"namespace"`
 	Names     []*PackageName `json:"names"`
 }
@@ -765,11 +1273,36 @@ type PackageSourceOrArtifactSpec struct {
 // a subset of the qualifier of the other also mean two different packages in the
 // trie.
 type PackageVersion struct {
+	ID         string              `json:"id"`
 	Version    string              `json:"version"`
 	Qualifiers []*PackageQualifier `json:"qualifiers"`
 	Subpath    string              `json:"subpath"`
 }
 
+// PageInfo describes a page of the results of a list query.
+//
+// Every query returning a list of nodes has a paginated variant, suffixed with
+// `List`, taking the same filter and returning a connection. The connection holds
+// the `totalCount` of results matching the filter, the `edges` of the page (each
+// with the node and its cursor) and the `pageInfo`. The unpaginated queries are
+// deprecated: they return all the results at once, however many match.
+//
+// `first` sets the number of results in the page, at least 1 (100 if not set),
+// and `after` the cursor of the result the page starts after. To read all
+// results, pass the `endCursor` of each page as `after` of the next query until
+// `hasNextPage` is false. Results are returned in a stable order, so pages do not overlap nor skip
+// results ingested before the first page is read.
+//
+// Cursors are opaque and only valid with the backend that returned them.
+type PageInfo struct {
+	// Whether there are results after the end of the page
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor of the first result of the page, null if the page is empty
+	StartCursor *string `json:"startCursor"`
+	// Cursor of the last result of the page, null if the page is empty
+	EndCursor *string `json:"endCursor"`
+}
+
 // PkgInputSpec specifies a package for a mutation.
 //
 // This is different than PkgSpec because we want to encode mandatory fields:
@@ -981,6 +1514,7 @@ type ScorecardInputSpec struct {
 // Also note that this is named `Source`, not `SourceType`. This is only to make
 // queries more readable.
 type Source struct {
+	ID         string             `json:"id"`
 	Type       string             `json:"type"`
 	Namespaces []*SourceNamespace `json:"namespaces"`
 }
@@ -989,6 +1523,22 @@ func (Source) IsPackageSourceOrArtifact() {}
 
 func (Source) IsPackageOrSource() {}
 
+func (Source) IsNode() {}
+
+// SourceConnection is a page of the results of sourcesList, see PageInfo.
+// Each edge holds a source trie with a single name.
+type SourceConnection struct {
+	TotalCount int           `json:"totalCount"`
+	PageInfo   *PageInfo     `json:"pageInfo"`
+	Edges      []*SourceEdge `json:"edges"`
+}
+
+// SourceEdge is a source trie with a single name with its cursor
+type SourceEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Source `json:"node"`
+}
+
 // SourceInputSpec specifies a source for a mutation.
 //
 // This is different than SourceSpec because we want to encode that all fields
@@ -1013,6 +1563,7 @@ type SourceInputSpec struct {
 // This is the only source trie node that can be referenced by other parts of
 // GUAC.
 type SourceName struct {
+	ID     string  `json:"id"`
 	Name   string  `json:"name"`
 	Tag    *string `json:"tag"`
 	Commit *string `json:"commit"`
@@ -1024,6 +1575,7 @@ type SourceName struct {
 //
 // The `namespace` field is mandatory.
 type SourceNamespace struct {
+	ID        string        `json:"id"`
 	Namespace string        `json:"namespace"`
 	Names     []*SourceName `json:"names"`
 }
@@ -1044,14 +1596,194 @@ type SourceSpec struct {
 	Commit    *string `json:"commit"`
 }
 
+// TransitiveDependency is a package reached by following IsDependency from the
+// package versions a transitive query starts from.
+//
+// The version range of each IsDependency is resolved to the ingested versions of
+// the dependent package it matches. When the range cannot be parsed or matches no
+// ingested version, the dependency is the package name and is not followed
+// further.
+//
+// package - the package version reached, or the package name if the version range is not resolved
+// dependency - the IsDependency followed to reach the package
+// depth - the number of IsDependency followed, 1 for direct dependencies
+// cycle - true if the package is the package it is reached from or leads back to
+//
+//	it through the IsDependency found within maxDepth, a package version already
+//	reached is not followed again
+type TransitiveDependency struct {
+	Package    *Package      `json:"package"`
+	Dependency *IsDependency `json:"dependency"`
+	Depth      int           `json:"depth"`
+	Cycle      bool          `json:"cycle"`
+}
+
 // VexStatementInputSpec is the same as CertifyVEXStatement but for mutation input.
 //
 // All fields are required.
 type VexStatementInputSpec struct {
-	Justification string    `json:"justification"`
-	KnownSince    time.Time `json:"knownSince"`
-	Origin        string    `json:"origin"`
-	Collector     string    `json:"collector"`
+	Status           VexStatus        `json:"status"`
+	VexJustification VexJustification `json:"vexJustification"`
+	Statement        string           `json:"statement"`
+	StatusNotes      string           `json:"statusNotes"`
+	ActionStatement  string           `json:"actionStatement"`
+	KnownSince       time.Time        `json:"knownSince"`
+	Origin           string           `json:"origin"`
+	Collector        string           `json:"collector"`
+}
+
+// VulnAffected is an attestation that represents the versions of a package that
+// are affected by a vulnerability, as stated by an advisory.
+//
+// The package is always at the name level (no version). The affected versions
+// are given as ranges of versions and as a list of explicit versions.
+// The vulnerability can only be an OSV, CVE or GHSA (NoVuln is not allowed).
+type VulnAffected struct {
+	// id - opaque, stable identifier of the evidence
+	ID string `json:"id"`
+	// package (subject) - the package name that is affected
+	Package *Package `json:"package"`
+	// vulnerability (object) - union type that consists of osv, cve or ghsa
+	Vulnerability OsvCveOrGhsa `json:"vulnerability"`
+	// ranges (property) - the ranges of affected versions
+	Ranges []*AffectedRange `json:"ranges"`
+	// versions (property) - explicitly listed affected versions
+	Versions []string `json:"versions"`
+	// origin (property) - where this attestation was generated from (based on which document)
+	Origin string `json:"origin"`
+	// collector (property) - the GUAC collector that collected the document that generated this attestation
+	Collector string `json:"collector"`
+}
+
+func (VulnAffected) IsNode() {}
+
+// VulnAffectedConnection is a page of the results of VulnAffectedList, see PageInfo.
+// Each edge holds a VulnAffected.
+type VulnAffectedConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*VulnAffectedEdge `json:"edges"`
+}
+
+// VulnAffectedEdge is a VulnAffected with its cursor
+type VulnAffectedEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *VulnAffected `json:"node"`
+}
+
+// VulnAffectedInputSpec is the same as VulnAffected but for mutation input.
+//
+// All fields are required.
+type VulnAffectedInputSpec struct {
+	Ranges    []*AffectedRangeInputSpec `json:"ranges"`
+	Versions  []string                  `json:"versions"`
+	Origin    string                    `json:"origin"`
+	Collector string                    `json:"collector"`
+}
+
+// VulnAffectedSpec allows filtering the list of VulnAffected to return.
+//
+// Only the type, namespace and name of the package are used.
+type VulnAffectedSpec struct {
+	ID            *string           `json:"id"`
+	Package       *PkgSpec          `json:"package"`
+	Vulnerability *OsvCveOrGhsaSpec `json:"vulnerability"`
+	Origin        *string           `json:"origin"`
+	Collector     *string           `json:"collector"`
+}
+
+// VulnMetadata is an attestation that attaches severity, scoring and descriptive
+// metadata to a vulnerability.
+//
+// The vulnerability can only be an OSV, CVE or GHSA (NoVuln is not allowed).
+type VulnMetadata struct {
+	// id - opaque, stable identifier of the metadata
+	ID string `json:"id"`
+	// vulnerability (subject) - union type that consists of osv, cve or ghsa
+	Vulnerability OsvCveOrGhsa `json:"vulnerability"`
+	// summary (property) - short description of the vulnerability
+	Summary string `json:"summary"`
+	// scores (property) - CVSS and EPSS scores for the vulnerability
+	Scores []*VulnScore `json:"scores"`
+	// cweIds (property) - CWE identifiers (e.g. CWE-79) of the weakness
+	CweIds []string `json:"cweIds"`
+	// references (property) - URLs with further information about the vulnerability
+	References []string `json:"references"`
+	// published (property) - timestamp of when the vulnerability was published
+	Published *time.Time `json:"published"`
+	// modified (property) - timestamp of when the vulnerability was last modified
+	Modified *time.Time `json:"modified"`
+	// origin (property) - where this attestation was generated from (based on which document)
+	Origin string `json:"origin"`
+	// collector (property) - the GUAC collector that collected the document that generated this attestation
+	Collector string `json:"collector"`
+}
+
+func (VulnMetadata) IsNode() {}
+
+// VulnMetadataConnection is a page of the results of VulnMetadataList, see PageInfo.
+// Each edge holds a VulnMetadata.
+type VulnMetadataConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	Edges      []*VulnMetadataEdge `json:"edges"`
+}
+
+// VulnMetadataEdge is a VulnMetadata with its cursor
+type VulnMetadataEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *VulnMetadata `json:"node"`
+}
+
+// VulnMetadataInputSpec is the same as VulnMetadata but for mutation input.
+//
+// Published and modified are optional, all other fields are required.
+type VulnMetadataInputSpec struct {
+	Summary    string                `json:"summary"`
+	Scores     []*VulnScoreInputSpec `json:"scores"`
+	CweIds     []string              `json:"cweIds"`
+	References []string              `json:"references"`
+	Published  *time.Time            `json:"published"`
+	Modified   *time.Time            `json:"modified"`
+	Origin     string                `json:"origin"`
+	Collector  string                `json:"collector"`
+}
+
+// VulnMetadataSpec allows filtering the list of VulnMetadata to return.
+//
+// minScore and maxScore match the metadata if at least one score (of scoreType,
+// if specified) lies in the inclusive range. cweId matches if it is one of the
+// CWE ids of the vulnerability. publishedSince matches vulnerabilities published
+// at or after the given time.
+type VulnMetadataSpec struct {
+	ID             *string           `json:"id"`
+	Vulnerability  *OsvCveOrGhsaSpec `json:"vulnerability"`
+	ScoreType      *VulnScoreType    `json:"scoreType"`
+	MinScore       *float64          `json:"minScore"`
+	MaxScore       *float64          `json:"maxScore"`
+	CweID          *string           `json:"cweId"`
+	PublishedSince *time.Time        `json:"publishedSince"`
+	Origin         *string           `json:"origin"`
+	Collector      *string           `json:"collector"`
+}
+
+// VulnScore is a single score of a vulnerability, in a given scoring system.
+//
+// For CVSS scores, vector holds the CVSS vector string and score the base score.
+// For EPSS, score holds the probability of exploitation (between 0 and 1) and
+// vector is empty.
+// This node cannot be directly referred by other parts of GUAC.
+type VulnScore struct {
+	ScoreType VulnScoreType `json:"scoreType"`
+	Vector    string        `json:"vector"`
+	Score     float64       `json:"score"`
+}
+
+// VulnScoreInputSpec is the same as VulnScore but for mutation input.
+type VulnScoreInputSpec struct {
+	ScoreType VulnScoreType `json:"scoreType"`
+	Vector    string        `json:"vector"`
+	Score     float64       `json:"score"`
 }
 
 type VulnerabilityMetaData struct {
@@ -1084,6 +1816,307 @@ type VulnerabilityMetaDataInput struct {
 	Collector      string    `json:"collector"`
 }
 
+// AffectedEventType is the enumeration of the events of a version range, as
+// defined by OSV.
+type AffectedEventType string
+
+const (
+	AffectedEventTypeIntroduced   AffectedEventType = "INTRODUCED"
+	AffectedEventTypeFixed        AffectedEventType = "FIXED"
+	AffectedEventTypeLastAffected AffectedEventType = "LAST_AFFECTED"
+	AffectedEventTypeLimit        AffectedEventType = "LIMIT"
+)
+
+var AllAffectedEventType = []AffectedEventType{
+	AffectedEventTypeIntroduced,
+	AffectedEventTypeFixed,
+	AffectedEventTypeLastAffected,
+	AffectedEventTypeLimit,
+}
+
+func (e AffectedEventType) IsValid() bool {
+	switch e {
+	case AffectedEventTypeIntroduced, AffectedEventTypeFixed, AffectedEventTypeLastAffected, AffectedEventTypeLimit:
+		return true
+	}
+	return false
+}
+
+func (e AffectedEventType) String() string {
+	return string(e)
+}
+
+func (e *AffectedEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AffectedEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AffectedEventType", str)
+	}
+	return nil
+}
+
+func (e AffectedEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// AffectedRangeType is the enumeration of the kinds of version ranges, as
+// defined by OSV.
+//
+// SEMVER ranges use semantic versions, ECOSYSTEM ranges use the versioning
+// scheme of the package ecosystem, and GIT ranges use commit hashes.
+type AffectedRangeType string
+
+const (
+	AffectedRangeTypeSemver    AffectedRangeType = "SEMVER"
+	AffectedRangeTypeEcosystem AffectedRangeType = "ECOSYSTEM"
+	AffectedRangeTypeGit       AffectedRangeType = "GIT"
+)
+
+var AllAffectedRangeType = []AffectedRangeType{
+	AffectedRangeTypeSemver,
+	AffectedRangeTypeEcosystem,
+	AffectedRangeTypeGit,
+}
+
+func (e AffectedRangeType) IsValid() bool {
+	switch e {
+	case AffectedRangeTypeSemver, AffectedRangeTypeEcosystem, AffectedRangeTypeGit:
+		return true
+	}
+	return false
+}
+
+func (e AffectedRangeType) String() string {
+	return string(e)
+}
+
+func (e *AffectedRangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AffectedRangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AffectedRangeType", str)
+	}
+	return nil
+}
+
+func (e AffectedRangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Edge is a link between two nodes of the GUAC graph, named after the type of
+// the node it starts from and the type of the node it ends at.
+//
+// Every evidence node is linked to the nodes of the software trees it refers
+// to, in both directions (e.g. `IS_OCCURRENCE_ARTIFACT` and
+// `ARTIFACT_IS_OCCURRENCE`). Package edges start or end at the version or the
+// name of the package, depending on the evidence. `PACKAGE_NAME_PACKAGE_VERSION`
+// and `PACKAGE_VERSION_PACKAGE_NAME` link the versions of a package to its name,
+// so a path can go from a package version to a dependency on its name.
+type Edge string
+
+const (
+	EdgePackageNamePackageVersion   Edge = "PACKAGE_NAME_PACKAGE_VERSION"
+	EdgePackageVersionPackageName   Edge = "PACKAGE_VERSION_PACKAGE_NAME"
+	EdgePackageIsOccurrence         Edge = "PACKAGE_IS_OCCURRENCE"
+	EdgePackageIsDependency         Edge = "PACKAGE_IS_DEPENDENCY"
+	EdgePackageCertifyVexStatement  Edge = "PACKAGE_CERTIFY_VEX_STATEMENT"
+	EdgePackageCertifyBad           Edge = "PACKAGE_CERTIFY_BAD"
+	EdgePackageCertifyPkg           Edge = "PACKAGE_CERTIFY_PKG"
+	EdgePackageCertifyVuln          Edge = "PACKAGE_CERTIFY_VULN"
+	EdgePackageHasSourceAt          Edge = "PACKAGE_HAS_SOURCE_AT"
+	EdgePackageHasSbom              Edge = "PACKAGE_HAS_SBOM"
+	EdgePackageHasSlsa              Edge = "PACKAGE_HAS_SLSA"
+	EdgePackageVulnAffected         Edge = "PACKAGE_VULN_AFFECTED"
+	EdgePackageCertifyEol           Edge = "PACKAGE_CERTIFY_EOL"
+	EdgeSourceIsOccurrence          Edge = "SOURCE_IS_OCCURRENCE"
+	EdgeSourceCertifyBad            Edge = "SOURCE_CERTIFY_BAD"
+	EdgeSourceCertifyScorecard      Edge = "SOURCE_CERTIFY_SCORECARD"
+	EdgeSourceHasSourceAt           Edge = "SOURCE_HAS_SOURCE_AT"
+	EdgeSourceHasSbom               Edge = "SOURCE_HAS_SBOM"
+	EdgeSourceHasSlsa               Edge = "SOURCE_HAS_SLSA"
+	EdgeArtifactIsOccurrence        Edge = "ARTIFACT_IS_OCCURRENCE"
+	EdgeArtifactCertifyVexStatement Edge = "ARTIFACT_CERTIFY_VEX_STATEMENT"
+	EdgeArtifactHashEqual           Edge = "ARTIFACT_HASH_EQUAL"
+	EdgeArtifactCertifyBad          Edge = "ARTIFACT_CERTIFY_BAD"
+	EdgeArtifactHasSlsa             Edge = "ARTIFACT_HAS_SLSA"
+	EdgeBuilderHasSlsa              Edge = "BUILDER_HAS_SLSA"
+	EdgeCveIsVulnerability          Edge = "CVE_IS_VULNERABILITY"
+	EdgeCveCertifyVexStatement      Edge = "CVE_CERTIFY_VEX_STATEMENT"
+	EdgeCveCertifyVuln              Edge = "CVE_CERTIFY_VULN"
+	EdgeCveVulnMetadata             Edge = "CVE_VULN_METADATA"
+	EdgeCveVulnAffected             Edge = "CVE_VULN_AFFECTED"
+	EdgeGhsaIsVulnerability         Edge = "GHSA_IS_VULNERABILITY"
+	EdgeGhsaCertifyVexStatement     Edge = "GHSA_CERTIFY_VEX_STATEMENT"
+	EdgeGhsaCertifyVuln             Edge = "GHSA_CERTIFY_VULN"
+	EdgeGhsaVulnMetadata            Edge = "GHSA_VULN_METADATA"
+	EdgeGhsaVulnAffected            Edge = "GHSA_VULN_AFFECTED"
+	EdgeOsvIsVulnerability          Edge = "OSV_IS_VULNERABILITY"
+	EdgeOsvCertifyVexStatement      Edge = "OSV_CERTIFY_VEX_STATEMENT"
+	EdgeOsvCertifyVuln              Edge = "OSV_CERTIFY_VULN"
+	EdgeOsvVulnMetadata             Edge = "OSV_VULN_METADATA"
+	EdgeOsvVulnAffected             Edge = "OSV_VULN_AFFECTED"
+	EdgeNoVulnCertifyVuln           Edge = "NO_VULN_CERTIFY_VULN"
+	EdgeIsOccurrencePackage         Edge = "IS_OCCURRENCE_PACKAGE"
+	EdgeIsOccurrenceSource          Edge = "IS_OCCURRENCE_SOURCE"
+	EdgeIsOccurrenceArtifact        Edge = "IS_OCCURRENCE_ARTIFACT"
+	EdgeIsDependencyPackage         Edge = "IS_DEPENDENCY_PACKAGE"
+	EdgeIsVulnerabilityOsv          Edge = "IS_VULNERABILITY_OSV"
+	EdgeIsVulnerabilityCve          Edge = "IS_VULNERABILITY_CVE"
+	EdgeIsVulnerabilityGhsa         Edge = "IS_VULNERABILITY_GHSA"
+	EdgeCertifyVexStatementPackage  Edge = "CERTIFY_VEX_STATEMENT_PACKAGE"
+	EdgeCertifyVexStatementArtifact Edge = "CERTIFY_VEX_STATEMENT_ARTIFACT"
+	EdgeCertifyVexStatementCve      Edge = "CERTIFY_VEX_STATEMENT_CVE"
+	EdgeCertifyVexStatementGhsa     Edge = "CERTIFY_VEX_STATEMENT_GHSA"
+	EdgeCertifyVexStatementOsv      Edge = "CERTIFY_VEX_STATEMENT_OSV"
+	EdgeHashEqualArtifact           Edge = "HASH_EQUAL_ARTIFACT"
+	EdgeCertifyBadPackage           Edge = "CERTIFY_BAD_PACKAGE"
+	EdgeCertifyBadSource            Edge = "CERTIFY_BAD_SOURCE"
+	EdgeCertifyBadArtifact          Edge = "CERTIFY_BAD_ARTIFACT"
+	EdgeCertifyPkgPackage           Edge = "CERTIFY_PKG_PACKAGE"
+	EdgeCertifyScorecardSource      Edge = "CERTIFY_SCORECARD_SOURCE"
+	EdgeCertifyVulnPackage          Edge = "CERTIFY_VULN_PACKAGE"
+	EdgeCertifyVulnCve              Edge = "CERTIFY_VULN_CVE"
+	EdgeCertifyVulnGhsa             Edge = "CERTIFY_VULN_GHSA"
+	EdgeCertifyVulnOsv              Edge = "CERTIFY_VULN_OSV"
+	EdgeCertifyVulnNoVuln           Edge = "CERTIFY_VULN_NO_VULN"
+	EdgeHasSourceAtPackage          Edge = "HAS_SOURCE_AT_PACKAGE"
+	EdgeHasSourceAtSource           Edge = "HAS_SOURCE_AT_SOURCE"
+	EdgeHasSbomPackage              Edge = "HAS_SBOM_PACKAGE"
+	EdgeHasSbomSource               Edge = "HAS_SBOM_SOURCE"
+	EdgeHasSlsaPackage              Edge = "HAS_SLSA_PACKAGE"
+	EdgeHasSlsaSource               Edge = "HAS_SLSA_SOURCE"
+	EdgeHasSlsaArtifact             Edge = "HAS_SLSA_ARTIFACT"
+	EdgeHasSlsaBuilder              Edge = "HAS_SLSA_BUILDER"
+	EdgeVulnMetadataCve             Edge = "VULN_METADATA_CVE"
+	EdgeVulnMetadataGhsa            Edge = "VULN_METADATA_GHSA"
+	EdgeVulnMetadataOsv             Edge = "VULN_METADATA_OSV"
+	EdgeVulnAffectedPackage         Edge = "VULN_AFFECTED_PACKAGE"
+	EdgeVulnAffectedCve             Edge = "VULN_AFFECTED_CVE"
+	EdgeVulnAffectedGhsa            Edge = "VULN_AFFECTED_GHSA"
+	EdgeVulnAffectedOsv             Edge = "VULN_AFFECTED_OSV"
+	EdgeCertifyEolPackage           Edge = "CERTIFY_EOL_PACKAGE"
+)
+
+var AllEdge = []Edge{
+	EdgePackageNamePackageVersion,
+	EdgePackageVersionPackageName,
+	EdgePackageIsOccurrence,
+	EdgePackageIsDependency,
+	EdgePackageCertifyVexStatement,
+	EdgePackageCertifyBad,
+	EdgePackageCertifyPkg,
+	EdgePackageCertifyVuln,
+	EdgePackageHasSourceAt,
+	EdgePackageHasSbom,
+	EdgePackageHasSlsa,
+	EdgePackageVulnAffected,
+	EdgePackageCertifyEol,
+	EdgeSourceIsOccurrence,
+	EdgeSourceCertifyBad,
+	EdgeSourceCertifyScorecard,
+	EdgeSourceHasSourceAt,
+	EdgeSourceHasSbom,
+	EdgeSourceHasSlsa,
+	EdgeArtifactIsOccurrence,
+	EdgeArtifactCertifyVexStatement,
+	EdgeArtifactHashEqual,
+	EdgeArtifactCertifyBad,
+	EdgeArtifactHasSlsa,
+	EdgeBuilderHasSlsa,
+	EdgeCveIsVulnerability,
+	EdgeCveCertifyVexStatement,
+	EdgeCveCertifyVuln,
+	EdgeCveVulnMetadata,
+	EdgeCveVulnAffected,
+	EdgeGhsaIsVulnerability,
+	EdgeGhsaCertifyVexStatement,
+	EdgeGhsaCertifyVuln,
+	EdgeGhsaVulnMetadata,
+	EdgeGhsaVulnAffected,
+	EdgeOsvIsVulnerability,
+	EdgeOsvCertifyVexStatement,
+	EdgeOsvCertifyVuln,
+	EdgeOsvVulnMetadata,
+	EdgeOsvVulnAffected,
+	EdgeNoVulnCertifyVuln,
+	EdgeIsOccurrencePackage,
+	EdgeIsOccurrenceSource,
+	EdgeIsOccurrenceArtifact,
+	EdgeIsDependencyPackage,
+	EdgeIsVulnerabilityOsv,
+	EdgeIsVulnerabilityCve,
+	EdgeIsVulnerabilityGhsa,
+	EdgeCertifyVexStatementPackage,
+	EdgeCertifyVexStatementArtifact,
+	EdgeCertifyVexStatementCve,
+	EdgeCertifyVexStatementGhsa,
+	EdgeCertifyVexStatementOsv,
+	EdgeHashEqualArtifact,
+	EdgeCertifyBadPackage,
+	EdgeCertifyBadSource,
+	EdgeCertifyBadArtifact,
+	EdgeCertifyPkgPackage,
+	EdgeCertifyScorecardSource,
+	EdgeCertifyVulnPackage,
+	EdgeCertifyVulnCve,
+	EdgeCertifyVulnGhsa,
+	EdgeCertifyVulnOsv,
+	EdgeCertifyVulnNoVuln,
+	EdgeHasSourceAtPackage,
+	EdgeHasSourceAtSource,
+	EdgeHasSbomPackage,
+	EdgeHasSbomSource,
+	EdgeHasSlsaPackage,
+	EdgeHasSlsaSource,
+	EdgeHasSlsaArtifact,
+	EdgeHasSlsaBuilder,
+	EdgeVulnMetadataCve,
+	EdgeVulnMetadataGhsa,
+	EdgeVulnMetadataOsv,
+	EdgeVulnAffectedPackage,
+	EdgeVulnAffectedCve,
+	EdgeVulnAffectedGhsa,
+	EdgeVulnAffectedOsv,
+	EdgeCertifyEolPackage,
+}
+
+func (e Edge) IsValid() bool {
+	switch e {
+	case EdgePackageNamePackageVersion, EdgePackageVersionPackageName, EdgePackageIsOccurrence, EdgePackageIsDependency, EdgePackageCertifyVexStatement, EdgePackageCertifyBad, EdgePackageCertifyPkg, EdgePackageCertifyVuln, EdgePackageHasSourceAt, EdgePackageHasSbom, EdgePackageHasSlsa, EdgePackageVulnAffected, EdgePackageCertifyEol, EdgeSourceIsOccurrence, EdgeSourceCertifyBad, EdgeSourceCertifyScorecard, EdgeSourceHasSourceAt, EdgeSourceHasSbom, EdgeSourceHasSlsa, EdgeArtifactIsOccurrence, EdgeArtifactCertifyVexStatement, EdgeArtifactHashEqual, EdgeArtifactCertifyBad, EdgeArtifactHasSlsa, EdgeBuilderHasSlsa, EdgeCveIsVulnerability, EdgeCveCertifyVexStatement, EdgeCveCertifyVuln, EdgeCveVulnMetadata, EdgeCveVulnAffected, EdgeGhsaIsVulnerability, EdgeGhsaCertifyVexStatement, EdgeGhsaCertifyVuln, EdgeGhsaVulnMetadata, EdgeGhsaVulnAffected, EdgeOsvIsVulnerability, EdgeOsvCertifyVexStatement, EdgeOsvCertifyVuln, EdgeOsvVulnMetadata, EdgeOsvVulnAffected, EdgeNoVulnCertifyVuln, EdgeIsOccurrencePackage, EdgeIsOccurrenceSource, EdgeIsOccurrenceArtifact, EdgeIsDependencyPackage, EdgeIsVulnerabilityOsv, EdgeIsVulnerabilityCve, EdgeIsVulnerabilityGhsa, EdgeCertifyVexStatementPackage, EdgeCertifyVexStatementArtifact, EdgeCertifyVexStatementCve, EdgeCertifyVexStatementGhsa, EdgeCertifyVexStatementOsv, EdgeHashEqualArtifact, EdgeCertifyBadPackage, EdgeCertifyBadSource, EdgeCertifyBadArtifact, EdgeCertifyPkgPackage, EdgeCertifyScorecardSource, EdgeCertifyVulnPackage, EdgeCertifyVulnCve, EdgeCertifyVulnGhsa, EdgeCertifyVulnOsv, EdgeCertifyVulnNoVuln, EdgeHasSourceAtPackage, EdgeHasSourceAtSource, EdgeHasSbomPackage, EdgeHasSbomSource, EdgeHasSlsaPackage, EdgeHasSlsaSource, EdgeHasSlsaArtifact, EdgeHasSlsaBuilder, EdgeVulnMetadataCve, EdgeVulnMetadataGhsa, EdgeVulnMetadataOsv, EdgeVulnAffectedPackage, EdgeVulnAffectedCve, EdgeVulnAffectedGhsa, EdgeVulnAffectedOsv, EdgeCertifyEolPackage:
+		return true
+	}
+	return false
+}
+
+func (e Edge) String() string {
+	return string(e)
+}
+
+func (e *Edge) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Edge(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Edge", str)
+	}
+	return nil
+}
+
+func (e Edge) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // PkgMatchType is an enum to determine if the attestation should be done at the
 // specific version or package name
 type PkgMatchType string
@@ -1126,3 +2159,196 @@ func (e *PkgMatchType) UnmarshalGQL(v interface{}) error {
 func (e PkgMatchType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// SupportStatus is the support status of a release cycle of a product.
+//
+// SUPPORTED cycles are actively supported, SECURITY_ONLY cycles are past the
+// end of active support and only receive security fixes, EOL cycles are past
+// their end of life.
+type SupportStatus string
+
+const (
+	SupportStatusSupported    SupportStatus = "SUPPORTED"
+	SupportStatusSecurityOnly SupportStatus = "SECURITY_ONLY"
+	SupportStatusEol          SupportStatus = "EOL"
+)
+
+var AllSupportStatus = []SupportStatus{
+	SupportStatusSupported,
+	SupportStatusSecurityOnly,
+	SupportStatusEol,
+}
+
+func (e SupportStatus) IsValid() bool {
+	switch e {
+	case SupportStatusSupported, SupportStatusSecurityOnly, SupportStatusEol:
+		return true
+	}
+	return false
+}
+
+func (e SupportStatus) String() string {
+	return string(e)
+}
+
+func (e *SupportStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SupportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SupportStatus", str)
+	}
+	return nil
+}
+
+func (e SupportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// VexJustification is the justification for a NOT_AFFECTED status, as defined by
+// the VEX minimum requirements. NOT_PROVIDED is used for all other statuses or
+// when the document does not give a machine readable justification.
+type VexJustification string
+
+const (
+	VexJustificationComponentNotPresent                         VexJustification = "COMPONENT_NOT_PRESENT"
+	VexJustificationVulnerableCodeNotPresent                    VexJustification = "VULNERABLE_CODE_NOT_PRESENT"
+	VexJustificationVulnerableCodeNotInExecutePath              VexJustification = "VULNERABLE_CODE_NOT_IN_EXECUTE_PATH"
+	VexJustificationVulnerableCodeCannotBeControlledByAdversary VexJustification = "VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY"
+	VexJustificationInlineMitigationsAlreadyExist               VexJustification = "INLINE_MITIGATIONS_ALREADY_EXIST"
+	VexJustificationNotProvided                                 VexJustification = "NOT_PROVIDED"
+)
+
+var AllVexJustification = []VexJustification{
+	VexJustificationComponentNotPresent,
+	VexJustificationVulnerableCodeNotPresent,
+	VexJustificationVulnerableCodeNotInExecutePath,
+	VexJustificationVulnerableCodeCannotBeControlledByAdversary,
+	VexJustificationInlineMitigationsAlreadyExist,
+	VexJustificationNotProvided,
+}
+
+func (e VexJustification) IsValid() bool {
+	switch e {
+	case VexJustificationComponentNotPresent, VexJustificationVulnerableCodeNotPresent, VexJustificationVulnerableCodeNotInExecutePath, VexJustificationVulnerableCodeCannotBeControlledByAdversary, VexJustificationInlineMitigationsAlreadyExist, VexJustificationNotProvided:
+		return true
+	}
+	return false
+}
+
+func (e VexJustification) String() string {
+	return string(e)
+}
+
+func (e *VexJustification) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VexJustification(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VexJustification", str)
+	}
+	return nil
+}
+
+func (e VexJustification) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// VexStatus is the status of a product with respect to a vulnerability, as defined
+// by the VEX minimum requirements.
+type VexStatus string
+
+const (
+	VexStatusNotAffected        VexStatus = "NOT_AFFECTED"
+	VexStatusAffected           VexStatus = "AFFECTED"
+	VexStatusFixed              VexStatus = "FIXED"
+	VexStatusUnderInvestigation VexStatus = "UNDER_INVESTIGATION"
+)
+
+var AllVexStatus = []VexStatus{
+	VexStatusNotAffected,
+	VexStatusAffected,
+	VexStatusFixed,
+	VexStatusUnderInvestigation,
+}
+
+func (e VexStatus) IsValid() bool {
+	switch e {
+	case VexStatusNotAffected, VexStatusAffected, VexStatusFixed, VexStatusUnderInvestigation:
+		return true
+	}
+	return false
+}
+
+func (e VexStatus) String() string {
+	return string(e)
+}
+
+func (e *VexStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VexStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VexStatus", str)
+	}
+	return nil
+}
+
+func (e VexStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// VulnScoreType is the enumeration of the supported vulnerability scoring systems.
+type VulnScoreType string
+
+const (
+	VulnScoreTypeCvssV2 VulnScoreType = "CVSS_V2"
+	VulnScoreTypeCvssV3 VulnScoreType = "CVSS_V3"
+	VulnScoreTypeCvssV4 VulnScoreType = "CVSS_V4"
+	VulnScoreTypeEpss   VulnScoreType = "EPSS"
+)
+
+var AllVulnScoreType = []VulnScoreType{
+	VulnScoreTypeCvssV2,
+	VulnScoreTypeCvssV3,
+	VulnScoreTypeCvssV4,
+	VulnScoreTypeEpss,
+}
+
+func (e VulnScoreType) IsValid() bool {
+	switch e {
+	case VulnScoreTypeCvssV2, VulnScoreTypeCvssV3, VulnScoreTypeCvssV4, VulnScoreTypeEpss:
+		return true
+	}
+	return false
+}
+
+func (e VulnScoreType) String() string {
+	return string(e)
+}
+
+func (e *VulnScoreType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VulnScoreType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VulnScoreType", str)
+	}
+	return nil
+}
+
+func (e VulnScoreType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestCertifyBad(ctx context.Context, subject model.P
 func (r *queryResolver) CertifyBad(ctx context.Context, certifyBadSpec *model.CertifyBadSpec) ([]*model.CertifyBad, error) {
 	return r.Backend.CertifyBad(ctx, certifyBadSpec)
 }
+
+// CertifyBadList is the resolver for the CertifyBadList field.
+func (r *queryResolver) CertifyBadList(ctx context.Context, certifyBadSpec model.CertifyBadSpec, after *string, first *int) (*model.CertifyBadConnection, error) {
+	return r.Backend.CertifyBadList(ctx, &certifyBadSpec, after, first)
+}
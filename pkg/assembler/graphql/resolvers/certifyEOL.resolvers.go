@@ -0,0 +1,36 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.25
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestCertifyEol is the resolver for the ingestCertifyEOL field.
+func (r *mutationResolver) IngestCertifyEol(ctx context.Context, pkg model.PkgInputSpec, certifyEol model.CertifyEOLInputSpec) (*model.CertifyEol, error) {
+	return r.Backend.IngestCertifyEol(ctx, pkg, certifyEol)
+}
+
+// CertifyEol is the resolver for the CertifyEOL field.
+func (r *queryResolver) CertifyEol(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec) ([]*model.CertifyEol, error) {
+	return r.Backend.CertifyEol(ctx, certifyEOLSpec)
+}
+
+// CertifyEOLList is the resolver for the CertifyEOLList field.
+func (r *queryResolver) CertifyEOLList(ctx context.Context, certifyEOLSpec model.CertifyEOLSpec, after *string, first *int) (*model.CertifyEOLConnection, error) {
+	return r.Backend.CertifyEOLList(ctx, &certifyEOLSpec, after, first)
+}
+
+// EolDependents is the resolver for the eolDependents field.
+func (r *queryResolver) EolDependents(ctx context.Context, certifyEOLSpec *model.CertifyEOLSpec) ([]*model.IsDependency, error) {
+	return r.Backend.EolDependents(ctx, certifyEOLSpec)
+}
+
+// EolDependentsList is the resolver for the eolDependentsList field.
+func (r *queryResolver) EolDependentsList(ctx context.Context, certifyEOLSpec model.CertifyEOLSpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	return r.Backend.EolDependentsList(ctx, &certifyEOLSpec, after, first)
+}
@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestCertifyPkg(ctx context.Context, pkg model.PkgIn
 func (r *queryResolver) CertifyPkg(ctx context.Context, certifyPkgSpec *model.CertifyPkgSpec) ([]*model.CertifyPkg, error) {
 	return r.Backend.CertifyPkg(ctx, certifyPkgSpec)
 }
+
+// CertifyPkgList is the resolver for the CertifyPkgList field.
+func (r *queryResolver) CertifyPkgList(ctx context.Context, certifyPkgSpec model.CertifyPkgSpec, after *string, first *int) (*model.CertifyPkgConnection, error) {
+	return r.Backend.CertifyPkgList(ctx, &certifyPkgSpec, after, first)
+}
@@ -19,3 +19,8 @@ func (r *mutationResolver) CertifyScorecard(ctx context.Context, source model.So
 func (r *queryResolver) Scorecards(ctx context.Context, scorecardSpec *model.CertifyScorecardSpec) ([]*model.CertifyScorecard, error) {
 	return r.Backend.Scorecards(ctx, scorecardSpec)
 }
+
+// ScorecardsList is the resolver for the scorecardsList field.
+func (r *queryResolver) ScorecardsList(ctx context.Context, scorecardSpec model.CertifyScorecardSpec, after *string, first *int) (*model.CertifyScorecardConnection, error) {
+	return r.Backend.ScorecardsList(ctx, &scorecardSpec, after, first)
+}
@@ -11,7 +11,7 @@ import (
 )
 
 // IngestVEXStatement is the resolver for the ingestVEXStatement field.
-func (r *mutationResolver) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.CveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
+func (r *mutationResolver) IngestVEXStatement(ctx context.Context, subject model.PackageOrArtifactInput, vulnerability model.OsvCveOrGhsaInput, vexStatement model.VexStatementInputSpec) (*model.CertifyVEXStatement, error) {
 	return r.Backend.IngestVEXStatement(ctx, subject, vulnerability, vexStatement)
 }
 
@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestVEXStatement(ctx context.Context, subject model
 func (r *queryResolver) CertifyVEXStatement(ctx context.Context, certifyVEXStatementSpec *model.CertifyVEXStatementSpec) ([]*model.CertifyVEXStatement, error) {
 	return r.Backend.CertifyVEXStatement(ctx, certifyVEXStatementSpec)
 }
+
+// CertifyVEXStatementList is the resolver for the CertifyVEXStatementList field.
+func (r *queryResolver) CertifyVEXStatementList(ctx context.Context, certifyVEXStatementSpec model.CertifyVEXStatementSpec, after *string, first *int) (*model.CertifyVEXStatementConnection, error) {
+	return r.Backend.CertifyVEXStatementList(ctx, &certifyVEXStatementSpec, after, first)
+}
@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestVulnerability(ctx context.Context, pkg model.Pk
 func (r *queryResolver) CertifyVuln(ctx context.Context, certifyVulnSpec *model.CertifyVulnSpec) ([]*model.CertifyVuln, error) {
 	return r.Backend.CertifyVuln(ctx, certifyVulnSpec)
 }
+
+// CertifyVulnList is the resolver for the CertifyVulnList field.
+func (r *queryResolver) CertifyVulnList(ctx context.Context, certifyVulnSpec model.CertifyVulnSpec, after *string, first *int) (*model.CertifyVulnConnection, error) {
+	return r.Backend.CertifyVulnList(ctx, &certifyVulnSpec, after, first)
+}
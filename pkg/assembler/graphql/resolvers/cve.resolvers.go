@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestCve(ctx context.Context, cve *model.CVEInputSpe
 func (r *queryResolver) Cve(ctx context.Context, cveSpec *model.CVESpec) ([]*model.Cve, error) {
 	return r.Backend.Cve(ctx, cveSpec)
 }
+
+// CveList is the resolver for the cveList field.
+func (r *queryResolver) CveList(ctx context.Context, cveSpec model.CVESpec, after *string, first *int) (*model.CVEConnection, error) {
+	return r.Backend.CveList(ctx, &cveSpec, after, first)
+}
@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestGhsa(ctx context.Context, ghsa *model.GHSAInput
 func (r *queryResolver) Ghsa(ctx context.Context, ghsaSpec *model.GHSASpec) ([]*model.Ghsa, error) {
 	return r.Backend.Ghsa(ctx, ghsaSpec)
 }
+
+// GhsaList is the resolver for the ghsaList field.
+func (r *queryResolver) GhsaList(ctx context.Context, ghsaSpec model.GHSASpec, after *string, first *int) (*model.GHSAConnection, error) {
+	return r.Backend.GhsaList(ctx, &ghsaSpec, after, first)
+}
@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestHasSbom(ctx context.Context, subject model.Pack
 func (r *queryResolver) HasSbom(ctx context.Context, hasSBOMSpec *model.HasSBOMSpec) ([]*model.HasSbom, error) {
 	return r.Backend.HasSBOM(ctx, hasSBOMSpec)
 }
+
+// HasSBOMList is the resolver for the HasSBOMList field.
+func (r *queryResolver) HasSBOMList(ctx context.Context, hasSBOMSpec model.HasSBOMSpec, after *string, first *int) (*model.HasSBOMConnection, error) {
+	return r.Backend.HasSBOMList(ctx, &hasSBOMSpec, after, first)
+}
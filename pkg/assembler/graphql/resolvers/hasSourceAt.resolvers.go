@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestHasSourceAt(ctx context.Context, pkg model.PkgI
 func (r *queryResolver) HasSourceAt(ctx context.Context, hasSourceAtSpec *model.HasSourceAtSpec) ([]*model.HasSourceAt, error) {
 	return r.Backend.HasSourceAt(ctx, hasSourceAtSpec)
 }
+
+// HasSourceAtList is the resolver for the HasSourceAtList field.
+func (r *queryResolver) HasSourceAtList(ctx context.Context, hasSourceAtSpec model.HasSourceAtSpec, after *string, first *int) (*model.HasSourceAtConnection, error) {
+	return r.Backend.HasSourceAtList(ctx, &hasSourceAtSpec, after, first)
+}
@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestHashEqual(ctx context.Context, artifact model.A
 func (r *queryResolver) HashEqual(ctx context.Context, hashEqualSpec *model.HashEqualSpec) ([]*model.HashEqual, error) {
 	return r.Backend.HashEqual(ctx, hashEqualSpec)
 }
+
+// HashEqualList is the resolver for the HashEqualList field.
+func (r *queryResolver) HashEqualList(ctx context.Context, hashEqualSpec model.HashEqualSpec, after *string, first *int) (*model.HashEqualConnection, error) {
+	return r.Backend.HashEqualList(ctx, &hashEqualSpec, after, first)
+}
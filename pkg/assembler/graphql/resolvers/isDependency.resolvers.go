@@ -19,3 +19,18 @@ func (r *mutationResolver) IngestDependency(ctx context.Context, pkg model.PkgIn
 func (r *queryResolver) IsDependency(ctx context.Context, isDependencySpec *model.IsDependencySpec) ([]*model.IsDependency, error) {
 	return r.Backend.IsDependency(ctx, isDependencySpec)
 }
+
+// IsDependencyList is the resolver for the IsDependencyList field.
+func (r *queryResolver) IsDependencyList(ctx context.Context, isDependencySpec model.IsDependencySpec, after *string, first *int) (*model.IsDependencyConnection, error) {
+	return r.Backend.IsDependencyList(ctx, &isDependencySpec, after, first)
+}
+
+// TransitiveDependencies is the resolver for the transitiveDependencies field.
+func (r *queryResolver) TransitiveDependencies(ctx context.Context, pkgSpec model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error) {
+	return r.Backend.TransitiveDependencies(ctx, &pkgSpec, maxDepth)
+}
+
+// Dependents is the resolver for the dependents field.
+func (r *queryResolver) Dependents(ctx context.Context, pkgSpec model.PkgSpec, maxDepth *int) ([]*model.TransitiveDependency, error) {
+	return r.Backend.Dependents(ctx, &pkgSpec, maxDepth)
+}
@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestOccurrence(ctx context.Context, subject model.P
 func (r *queryResolver) IsOccurrence(ctx context.Context, isOccurrenceSpec *model.IsOccurrenceSpec) ([]*model.IsOccurrence, error) {
 	return r.Backend.IsOccurrence(ctx, isOccurrenceSpec)
 }
+
+// IsOccurrenceList is the resolver for the IsOccurrenceList field.
+func (r *queryResolver) IsOccurrenceList(ctx context.Context, isOccurrenceSpec model.IsOccurrenceSpec, after *string, first *int) (*model.IsOccurrenceConnection, error) {
+	return r.Backend.IsOccurrenceList(ctx, &isOccurrenceSpec, after, first)
+}
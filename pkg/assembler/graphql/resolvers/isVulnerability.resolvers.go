@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestIsVulnerability(ctx context.Context, osv model.
 func (r *queryResolver) IsVulnerability(ctx context.Context, isVulnerabilitySpec *model.IsVulnerabilitySpec) ([]*model.IsVulnerability, error) {
 	return r.Backend.IsVulnerability(ctx, isVulnerabilitySpec)
 }
+
+// IsVulnerabilityList is the resolver for the IsVulnerabilityList field.
+func (r *queryResolver) IsVulnerabilityList(ctx context.Context, isVulnerabilitySpec model.IsVulnerabilitySpec, after *string, first *int) (*model.IsVulnerabilityConnection, error) {
+	return r.Backend.IsVulnerabilityList(ctx, &isVulnerabilitySpec, after, first)
+}
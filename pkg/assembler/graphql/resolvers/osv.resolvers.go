@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestOsv(ctx context.Context, osv *model.OSVInputSpe
 func (r *queryResolver) Osv(ctx context.Context, osvSpec *model.OSVSpec) ([]*model.Osv, error) {
 	return r.Backend.Osv(ctx, osvSpec)
 }
+
+// OsvList is the resolver for the osvList field.
+func (r *queryResolver) OsvList(ctx context.Context, osvSpec model.OSVSpec, after *string, first *int) (*model.OSVConnection, error) {
+	return r.Backend.OsvList(ctx, &osvSpec, after, first)
+}
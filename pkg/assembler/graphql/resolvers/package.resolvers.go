@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestPackage(ctx context.Context, pkg *model.PkgInpu
 func (r *queryResolver) Packages(ctx context.Context, pkgSpec *model.PkgSpec) ([]*model.Package, error) {
 	return r.Backend.Packages(ctx, pkgSpec)
 }
+
+// PackagesList is the resolver for the packagesList field.
+func (r *queryResolver) PackagesList(ctx context.Context, pkgSpec model.PkgSpec, after *string, first *int) (*model.PackageConnection, error) {
+	return r.Backend.PackagesList(ctx, &pkgSpec, after, first)
+}
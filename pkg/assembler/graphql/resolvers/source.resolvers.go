@@ -19,3 +19,8 @@ func (r *mutationResolver) IngestSource(ctx context.Context, source *model.Sourc
 func (r *queryResolver) Sources(ctx context.Context, sourceSpec *model.SourceSpec) ([]*model.Source, error) {
 	return r.Backend.Sources(ctx, sourceSpec)
 }
+
+// SourcesList is the resolver for the sourcesList field.
+func (r *queryResolver) SourcesList(ctx context.Context, sourceSpec model.SourceSpec, after *string, first *int) (*model.SourceConnection, error) {
+	return r.Backend.SourcesList(ctx, &sourceSpec, after, first)
+}
@@ -0,0 +1,36 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.25
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestVulnAffected is the resolver for the ingestVulnAffected field.
+func (r *mutationResolver) IngestVulnAffected(ctx context.Context, pkg model.PkgInputSpec, vulnerability model.OsvCveOrGhsaInput, vulnAffected model.VulnAffectedInputSpec) (*model.VulnAffected, error) {
+	return r.Backend.IngestVulnAffected(ctx, pkg, vulnerability, vulnAffected)
+}
+
+// VulnAffected is the resolver for the VulnAffected field.
+func (r *queryResolver) VulnAffected(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec) ([]*model.VulnAffected, error) {
+	return r.Backend.VulnAffected(ctx, vulnAffectedSpec)
+}
+
+// VulnAffectedList is the resolver for the VulnAffectedList field.
+func (r *queryResolver) VulnAffectedList(ctx context.Context, vulnAffectedSpec model.VulnAffectedSpec, after *string, first *int) (*model.VulnAffectedConnection, error) {
+	return r.Backend.VulnAffectedList(ctx, &vulnAffectedSpec, after, first)
+}
+
+// AffectedPackages is the resolver for the affectedPackages field.
+func (r *queryResolver) AffectedPackages(ctx context.Context, vulnAffectedSpec *model.VulnAffectedSpec) ([]*model.AffectedPackage, error) {
+	return r.Backend.AffectedPackages(ctx, vulnAffectedSpec)
+}
+
+// AffectedPackagesList is the resolver for the affectedPackagesList field.
+func (r *queryResolver) AffectedPackagesList(ctx context.Context, vulnAffectedSpec model.VulnAffectedSpec, after *string, first *int) (*model.AffectedPackageConnection, error) {
+	return r.Backend.AffectedPackagesList(ctx, &vulnAffectedSpec, after, first)
+}
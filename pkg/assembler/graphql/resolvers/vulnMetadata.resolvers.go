@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.25
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler/graphql/model"
+)
+
+// IngestVulnMetadata is the resolver for the ingestVulnMetadata field.
+func (r *mutationResolver) IngestVulnMetadata(ctx context.Context, vulnerability model.OsvCveOrGhsaInput, vulnMetadata model.VulnMetadataInputSpec) (*model.VulnMetadata, error) {
+	return r.Backend.IngestVulnMetadata(ctx, vulnerability, vulnMetadata)
+}
+
+// VulnMetadata is the resolver for the VulnMetadata field.
+func (r *queryResolver) VulnMetadata(ctx context.Context, vulnMetadataSpec *model.VulnMetadataSpec) ([]*model.VulnMetadata, error) {
+	return r.Backend.VulnMetadata(ctx, vulnMetadataSpec)
+}
+
+// VulnMetadataList is the resolver for the VulnMetadataList field.
+func (r *queryResolver) VulnMetadataList(ctx context.Context, vulnMetadataSpec model.VulnMetadataSpec, after *string, first *int) (*model.VulnMetadataConnection, error) {
+	return r.Backend.VulnMetadataList(ctx, &vulnMetadataSpec, after, first)
+}
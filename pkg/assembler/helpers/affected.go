@@ -0,0 +1,159 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"sort"
+	"strings"
+
+	purl "github.com/package-url/packageurl-go"
+)
+
+// AffectedEvent is an event of an OSV version range
+// (https://ossf.github.io/osv-schema/#affectedrangesevents-fields), only one
+// of the fields is set.
+type AffectedEvent struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"`
+}
+
+// AffectedRange is a range of affected versions of an OSV entry
+// (https://ossf.github.io/osv-schema/#affectedranges-field)
+type AffectedRange struct {
+	Type   string          `json:"type"`
+	Repo   string          `json:"repo,omitempty"`
+	Events []AffectedEvent `json:"events"`
+}
+
+func (e AffectedEvent) version() string {
+	switch {
+	case e.Introduced != "":
+		return e.Introduced
+	case e.Fixed != "":
+		return e.Fixed
+	case e.LastAffected != "":
+		return e.LastAffected
+	default:
+		return e.Limit
+	}
+}
+
+// IsVersionInRange evaluates the events of a range following the OSV
+// specification: the events are sorted by version and replayed, an
+// introduced event starts an affected interval and a fixed or last_affected
+// event closes it. Versions at or above a limit event are never affected.
+// Versions are compared with compare.
+func IsVersionInRange(version string, events []AffectedEvent, compare func(a, b string) int) bool {
+	sorted := make([]AffectedEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return compareEvents(sorted[i], sorted[j], compare) < 0
+	})
+
+	affected := false
+	for _, e := range sorted {
+		switch {
+		case e.Introduced != "":
+			if e.Introduced == "0" || compare(version, e.Introduced) >= 0 {
+				affected = true
+			}
+		case e.Fixed != "":
+			if compare(version, e.Fixed) >= 0 {
+				affected = false
+			}
+		case e.LastAffected != "":
+			if compare(version, e.LastAffected) > 0 {
+				affected = false
+			}
+		case e.Limit != "":
+			if compare(version, e.Limit) >= 0 {
+				return false
+			}
+		}
+	}
+	return affected
+}
+
+// compareEvents orders events by version, an introduced "0" event (all
+// versions) comes before any other event
+func compareEvents(a, b AffectedEvent, compare func(a, b string) int) int {
+	aZero := a.Introduced == "0"
+	bZero := b.Introduced == "0"
+	switch {
+	case aZero && bZero:
+		return 0
+	case aZero:
+		return -1
+	case bZero:
+		return 1
+	}
+	return compare(a.version(), b.version())
+}
+
+// IsOSVVersionAffected returns true if the version of a package of the given
+// purl type is listed in versions or falls in one of the SEMVER or ECOSYSTEM
+// ranges of an OSV affected entry. GIT ranges refer to commits and are never
+// matched.
+func IsOSVVersionAffected(pkgType, version string, versions []string, ranges []AffectedRange) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	for _, r := range ranges {
+		var compare func(a, b string) int
+		switch r.Type {
+		case "SEMVER":
+			compare = CompareSemver
+		case "ECOSYSTEM":
+			compare = func(a, b string) int { return CompareVersions(pkgType, a, b) }
+		default:
+			continue
+		}
+		if IsVersionInRange(version, r.Events, compare) {
+			return true
+		}
+	}
+	return false
+}
+
+// OSVPackageKey returns the PackageKey of the package of an OSV affected
+// entry, given either by its purl or by its ecosystem and name.
+func OSVPackageKey(ecosystem, name, packageURL string) (string, error) {
+	if packageURL != "" {
+		p, err := purl.FromString(packageURL)
+		if err != nil {
+			return "", err
+		}
+		return PackageKey(p.Type, p.Namespace, p.Name), nil
+	}
+	p, err := OSVToPkg(ecosystem, name)
+	if err != nil {
+		return "", err
+	}
+	return PackageKey(p.Type, *p.Namespace, p.Name), nil
+}
+
+// PackageKey identifies a package, without version, by its purl type,
+// namespace and name. PyPI names are normalized.
+func PackageKey(pkgType, namespace, name string) string {
+	if pkgType == purl.TypePyPi {
+		name = NormalizePyPIName(name)
+	}
+	return strings.Join([]string{pkgType, namespace, name}, "/")
+}
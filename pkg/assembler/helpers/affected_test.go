@@ -0,0 +1,85 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import "testing"
+
+func TestIsOSVVersionAffected(t *testing.T) {
+	log4j := []AffectedRange{{
+		Type:   "ECOSYSTEM",
+		Events: []AffectedEvent{{Introduced: "2.13.0"}, {Fixed: "2.15.0"}, {Introduced: "2.0-beta9"}, {Fixed: "2.12.2"}},
+	}}
+	lodash := []AffectedRange{{
+		Type:   "SEMVER",
+		Events: []AffectedEvent{{Introduced: "0"}, {Fixed: "4.17.21"}},
+	}}
+	commits := []AffectedRange{{
+		Type:   "GIT",
+		Events: []AffectedEvent{{Introduced: "0"}},
+	}}
+	tests := []struct {
+		name     string
+		pkgType  string
+		version  string
+		versions []string
+		ranges   []AffectedRange
+		want     bool
+	}{
+		{name: "maven in first interval", pkgType: "maven", version: "2.14.1", ranges: log4j, want: true},
+		{name: "maven in second interval", pkgType: "maven", version: "2.12.1", ranges: log4j, want: true},
+		{name: "maven fixed", pkgType: "maven", version: "2.12.2", ranges: log4j, want: false},
+		{name: "maven above", pkgType: "maven", version: "2.15.0", ranges: log4j, want: false},
+		{name: "semver affected", pkgType: "npm", version: "4.17.20", ranges: lodash, want: true},
+		{name: "semver fixed", pkgType: "npm", version: "4.17.21", ranges: lodash, want: false},
+		{name: "listed version", pkgType: "pypi", version: "1.11.1", versions: []string{"1.11.1", "1.11.2"}, want: true},
+		{name: "unlisted version", pkgType: "pypi", version: "1.11.3", versions: []string{"1.11.1", "1.11.2"}, want: false},
+		{name: "git ranges ignored", pkgType: "golang", version: "v1.0.0", ranges: commits, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsOSVVersionAffected(tt.pkgType, tt.version, tt.versions, tt.ranges); got != tt.want {
+				t.Errorf("IsOSVVersionAffected() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOSVPackageKey(t *testing.T) {
+	tests := []struct {
+		ecosystem string
+		name      string
+		purl      string
+		want      string
+		wantErr   bool
+	}{
+		{ecosystem: "Maven", name: "org.apache.logging.log4j:log4j-core", want: "maven/org.apache.logging.log4j/log4j-core"},
+		{ecosystem: "PyPI", name: "Typing_Extensions", want: "pypi//typing-extensions"},
+		{purl: "pkg:pypi/Typing.Extensions@4.0.0", want: "pypi//typing-extensions"},
+		{purl: "pkg:npm/%40angular/core", ecosystem: "OSS-Fuzz", want: "npm/@angular/core"},
+		{ecosystem: "OSS-Fuzz", name: "openssl", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ecosystem+"/"+tt.name+tt.purl, func(t *testing.T) {
+			got, err := OSVPackageKey(tt.ecosystem, tt.name, tt.purl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OSVPackageKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("OSVPackageKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+var cvssV3Weights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"PR": {"N": 0.85, "L": 0.62, "H": 0.27},
+	"UI": {"N": 0.85, "R": 0.62},
+	"S":  {"U": 0, "C": 0},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+// privileges required weigh more when the scope is changed
+var cvssV3ChangedScopePR = map[string]float64{"N": 0.85, "L": 0.68, "H": 0.5}
+
+var cvssV2Weights = map[string]map[string]float64{
+	"AV": {"L": 0.395, "A": 0.646, "N": 1.0},
+	"AC": {"H": 0.35, "M": 0.61, "L": 0.71},
+	"Au": {"M": 0.45, "S": 0.56, "N": 0.704},
+	"C":  {"N": 0, "P": 0.275, "C": 0.660},
+	"I":  {"N": 0, "P": 0.275, "C": 0.660},
+	"A":  {"N": 0, "P": 0.275, "C": 0.660},
+}
+
+// CvssScore computes the base score of a CVSS v2 or v3.x vector string, e.g.
+// "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H" or "AV:N/AC:L/Au:N/C:P/I:P/A:P",
+// and returns it along with the CVSS version of the vector. CVSS v4 vectors
+// are recognized but their score cannot be computed.
+func CvssScore(vector string) (model.VulnScoreType, float64, error) {
+	switch {
+	case strings.HasPrefix(vector, "CVSS:3."):
+		score, err := cvssV3BaseScore(vector)
+		return model.VulnScoreTypeCvssV3, score, err
+	case strings.HasPrefix(vector, "CVSS:4."):
+		return model.VulnScoreTypeCvssV4, 0, fmt.Errorf("computing CVSS v4 scores is not supported: %q", vector)
+	default:
+		score, err := cvssV2BaseScore(strings.TrimPrefix(strings.Trim(vector, "()"), "CVSS:2.0/"))
+		return model.VulnScoreTypeCvssV2, score, err
+	}
+}
+
+// parseCvssMetrics splits a vector into its metrics, checking that all
+// required base metrics are present with known values
+func parseCvssMetrics(metrics []string, weights map[string]map[string]float64, vector string) (map[string]string, error) {
+	values := map[string]string{}
+	for _, m := range metrics {
+		kv := strings.SplitN(m, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("malformed CVSS vector %q", vector)
+		}
+		values[kv[0]] = kv[1]
+	}
+	for metric, allowed := range weights {
+		if _, ok := allowed[values[metric]]; !ok {
+			return nil, fmt.Errorf("missing or invalid metric %s in CVSS vector %q", metric, vector)
+		}
+	}
+	return values, nil
+}
+
+func cvssV3BaseScore(vector string) (float64, error) {
+	m, err := parseCvssMetrics(strings.Split(vector, "/")[1:], cvssV3Weights, vector)
+	if err != nil {
+		return 0, err
+	}
+
+	changed := m["S"] == "C"
+	pr := cvssV3Weights["PR"][m["PR"]]
+	if changed {
+		pr = cvssV3ChangedScopePR[m["PR"]]
+	}
+
+	iss := 1 - (1-cvssV3Weights["C"][m["C"]])*(1-cvssV3Weights["I"][m["I"]])*(1-cvssV3Weights["A"][m["A"]])
+	var impact float64
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	} else {
+		impact = 6.42 * iss
+	}
+	exploitability := 8.22 * cvssV3Weights["AV"][m["AV"]] * cvssV3Weights["AC"][m["AC"]] * pr * cvssV3Weights["UI"][m["UI"]]
+
+	if impact <= 0 {
+		return 0, nil
+	}
+	if changed {
+		return cvssRoundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+	}
+	return cvssRoundUp(math.Min(impact+exploitability, 10)), nil
+}
+
+// cvssRoundUp is the Roundup function of the CVSS v3.1 specification, which
+// avoids floating point errors when rounding up to one decimal
+func cvssRoundUp(value float64) float64 {
+	intInput := int64(math.Round(value * 100000))
+	if intInput%10000 == 0 {
+		return float64(intInput) / 100000
+	}
+	return float64(intInput/10000+1) / 10
+}
+
+func cvssV2BaseScore(vector string) (float64, error) {
+	m, err := parseCvssMetrics(strings.Split(vector, "/"), cvssV2Weights, vector)
+	if err != nil {
+		return 0, err
+	}
+
+	impact := 10.41 * (1 - (1-cvssV2Weights["C"][m["C"]])*(1-cvssV2Weights["I"][m["I"]])*(1-cvssV2Weights["A"][m["A"]]))
+	exploitability := 20 * cvssV2Weights["AV"][m["AV"]] * cvssV2Weights["AC"][m["AC"]] * cvssV2Weights["Au"][m["Au"]]
+	if impact == 0 {
+		return 0, nil
+	}
+	return math.Round((0.6*impact+0.4*exploitability-1.5)*1.176*10) / 10, nil
+}
@@ -0,0 +1,85 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func TestCvssScore(t *testing.T) {
+	testCases := []struct {
+		vector   string
+		wantType model.VulnScoreType
+		want     float64
+		wantErr  bool
+	}{{
+		vector:   "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H",
+		wantType: model.VulnScoreTypeCvssV3,
+		want:     10.0,
+	}, {
+		vector:   "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		wantType: model.VulnScoreTypeCvssV3,
+		want:     9.8,
+	}, {
+		vector:   "CVSS:3.0/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N",
+		wantType: model.VulnScoreTypeCvssV3,
+		want:     6.1,
+	}, {
+		vector:   "CVSS:3.1/AV:L/AC:H/PR:H/UI:R/S:U/C:L/I:N/A:N/E:U",
+		wantType: model.VulnScoreTypeCvssV3,
+		want:     1.8,
+	}, {
+		vector:   "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N",
+		wantType: model.VulnScoreTypeCvssV3,
+		want:     0,
+	}, {
+		vector:   "AV:N/AC:L/Au:N/C:P/I:P/A:P",
+		wantType: model.VulnScoreTypeCvssV2,
+		want:     7.5,
+	}, {
+		vector:   "(AV:N/AC:M/Au:N/C:N/I:P/A:N)",
+		wantType: model.VulnScoreTypeCvssV2,
+		want:     4.3,
+	}, {
+		vector:   "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N",
+		wantType: model.VulnScoreTypeCvssV4,
+		wantErr:  true,
+	}, {
+		vector:   "CVSS:3.1/AV:N/AC:L",
+		wantType: model.VulnScoreTypeCvssV3,
+		wantErr:  true,
+	}, {
+		vector:   "AV:X/AC:L/Au:N/C:P/I:P/A:P",
+		wantType: model.VulnScoreTypeCvssV2,
+		wantErr:  true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.vector, func(t *testing.T) {
+			gotType, got, err := CvssScore(tt.vector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CvssScore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotType != tt.wantType {
+				t.Errorf("CvssScore() type = %q, want %q", gotType, tt.wantType)
+			}
+			if got != tt.want {
+				t.Errorf("CvssScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// osvEcosystems maps the OSV ecosystems
+// (https://ossf.github.io/osv-schema/#affectedpackage-field) to purl types
+var osvEcosystems = map[string]string{
+	"Alpine":    "apk",
+	"CRAN":      "cran",
+	"crates.io": "cargo",
+	"Debian":    "deb",
+	"Go":        "golang",
+	"Hackage":   "hackage",
+	"Hex":       "hex",
+	"Maven":     "maven",
+	"npm":       "npm",
+	"NuGet":     "nuget",
+	"Packagist": "composer",
+	"Pub":       "pub",
+	"PyPI":      "pypi",
+	"RubyGems":  "gem",
+	"SwiftURL":  "swift",
+	"Ubuntu":    "deb",
+}
+
+var pypiNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// OSVToPkg converts the package of an OSV affected entry, given by its
+// ecosystem and name, into a graphql package node without version. The
+// ecosystem may carry a release suffix (e.g. "Debian:11") which is ignored.
+func OSVToPkg(ecosystem, name string) (*model.PkgInputSpec, error) {
+	base, _, _ := strings.Cut(ecosystem, ":")
+	pkgType, ok := osvEcosystems[base]
+	if !ok {
+		return nil, fmt.Errorf("unhandled OSV ecosystem: %s", ecosystem)
+	}
+
+	namespace := ""
+	switch base {
+	case "Maven":
+		// group:artifact
+		if i := strings.LastIndex(name, ":"); i >= 0 {
+			namespace, name = name[:i], name[i+1:]
+		}
+	case "npm", "Go", "Packagist", "SwiftURL":
+		// @scope/name, module paths, vendor/name and repository URLs
+		if i := strings.LastIndex(name, "/"); i >= 0 {
+			namespace, name = name[:i], name[i+1:]
+		}
+	case "Debian", "Ubuntu", "Alpine":
+		// the distribution is the namespace of the purl
+		namespace = strings.ToLower(base)
+	case "PyPI":
+		name = NormalizePyPIName(name)
+	}
+
+	return &model.PkgInputSpec{
+		Type:      pkgType,
+		Namespace: &namespace,
+		Name:      name,
+	}, nil
+}
+
+// NormalizePyPIName normalizes the name of a python package as described in
+// PEP 503, so that names differing only by case or separators compare equal.
+func NormalizePyPIName(name string) string {
+	return pypiNameSeparators.ReplaceAllString(strings.ToLower(name), "-")
+}
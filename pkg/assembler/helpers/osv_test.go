@@ -0,0 +1,83 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func TestOSVToPkg(t *testing.T) {
+	tests := []struct {
+		ecosystem string
+		name      string
+		want      *model.PkgInputSpec
+		wantErr   bool
+	}{{
+		ecosystem: "Maven",
+		name:      "org.apache.logging.log4j:log4j-core",
+		want:      osvPkg("maven", "org.apache.logging.log4j", "log4j-core"),
+	}, {
+		ecosystem: "npm",
+		name:      "@angular/core",
+		want:      osvPkg("npm", "@angular", "core"),
+	}, {
+		ecosystem: "npm",
+		name:      "lodash",
+		want:      osvPkg("npm", "", "lodash"),
+	}, {
+		ecosystem: "Go",
+		name:      "golang.org/x/net",
+		want:      osvPkg("golang", "golang.org/x", "net"),
+	}, {
+		ecosystem: "PyPI",
+		name:      "Typing_Extensions",
+		want:      osvPkg("pypi", "", "typing-extensions"),
+	}, {
+		ecosystem: "Debian:11",
+		name:      "openssl",
+		want:      osvPkg("deb", "debian", "openssl"),
+	}, {
+		ecosystem: "crates.io",
+		name:      "tokio",
+		want:      osvPkg("cargo", "", "tokio"),
+	}, {
+		ecosystem: "OSS-Fuzz",
+		name:      "openssl",
+		wantErr:   true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.ecosystem+"/"+tt.name, func(t *testing.T) {
+			got, err := OSVToPkg(tt.ecosystem, tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OSVToPkg() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("OSVToPkg() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func osvPkg(typ, namespace, name string) *model.PkgInputSpec {
+	return &model.PkgInputSpec{
+		Type:      typ,
+		Namespace: &namespace,
+		Name:      name,
+	}
+}
@@ -75,7 +75,7 @@ func purlConvert(p purl.PackageURL) (*model.PkgInputSpec, error) {
 		}
 
 		delete(qs, "repository_url")
-		ns = strings.TrimRight(ns, "/"+p.Name)
+		ns = strings.TrimSuffix(ns, "/"+p.Name)
 		r := pkg(p.Type, ns, p.Name, p.Version, p.Subpath, qs)
 		return r, nil
 	case purl.TypeDocker:
@@ -133,6 +133,66 @@ func pkg(typ, namespace, name, version, subpath string, qualifiers map[string]st
 	return p
 }
 
+// PkgToPurl converts the fields of a package version into a purl URI
+// string. It is the reverse of PurlToPkg: for OCI, the namespace is encoded
+// back as the repository_url qualifier, and guac purls are not escaped, as
+// GuacPkgPurl does, so that image names keep their tag.
+func PkgToPurl(typ, namespace, name, version, subpath string, qualifiers map[string]string) string {
+	qs := map[string]string{}
+	for k, v := range qualifiers {
+		qs[k] = v
+	}
+	if typ == purl.TypeOCI && namespace != "" {
+		qs["repository_url"] = namespace + "/" + name
+		namespace = ""
+	}
+	p := purl.NewPackageURL(typ, namespace, name, version, purl.QualifiersFromMap(qs), subpath)
+	if typ != PurlTypeGuac {
+		return p.ToString()
+	}
+
+	s := "pkg:" + PurlTypeGuac + "/"
+	if namespace != "" {
+		s += namespace + "/"
+	}
+	s += name
+	if version != "" {
+		s += "@" + version
+	}
+	if len(p.Qualifiers) > 0 {
+		s += "?" + p.Qualifiers.String()
+	}
+	if subpath != "" {
+		s += "#" + subpath
+	}
+	return s
+}
+
+// AllPkgTreeToPurls returns the purls of the package versions of a package
+// tree returned by the GraphQL queries
+func AllPkgTreeToPurls(pkg *model.AllPkgTree) []string {
+	var purls []string
+	for _, ns := range pkg.Namespaces {
+		for _, name := range ns.Names {
+			for _, v := range name.Versions {
+				purls = append(purls, PkgVersionToPurl(pkg.Type, ns.Namespace, name.Name, &v))
+			}
+		}
+	}
+	return purls
+}
+
+// PkgVersionToPurl converts a package version of a package tree returned by
+// the GraphQL queries into a purl, given the type, namespace and name of the
+// package
+func PkgVersionToPurl(typ, namespace, name string, v *model.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion) string {
+	qualifiers := map[string]string{}
+	for _, qualifier := range v.Qualifiers {
+		qualifiers[qualifier.Key] = qualifier.Value
+	}
+	return PkgToPurl(typ, namespace, name, v.Version, v.Subpath, qualifiers)
+}
+
 func GuacPkgPurl(pkgName string, pkgVersion *string) string {
 	if pkgVersion == nil {
 		return fmt.Sprintf("pkg:guac/%s", pkgName)
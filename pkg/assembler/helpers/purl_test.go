@@ -164,6 +164,11 @@ func TestPurlConvert(t *testing.T) {
 			expected: pkg("oci", "ghcr.io", "debian", "sha256:244fd47e07d10", "", map[string]string{
 				"tag": "bullseye",
 			}),
+		}, {
+			purlUri: "pkg:oci/static@sha256%3A244fd47e07d10?repository_url=gcr.io/distroless/static&tag=nonroot",
+			expected: pkg("oci", "gcr.io/distroless", "static", "sha256:244fd47e07d10", "", map[string]string{
+				"tag": "nonroot",
+			}),
 		}, {
 			purlUri: "pkg:oci/hello-wasm@sha256%3A244fd47e07d10?tag=v1",
 			expected: pkg("oci", "", "hello-wasm", "sha256:244fd47e07d10", "", map[string]string{
@@ -207,6 +212,69 @@ func TestPurlConvert(t *testing.T) {
 	}
 }
 
+func TestPkgToPurl(t *testing.T) {
+	testCases := []string{
+		"pkg:alpm/arch/pacman@6.0.1-1?arch=x86_64",
+		"pkg:cocoapods/ShareKit@2.0#Twitter",
+		"pkg:conan/openssl.org/openssl@3.0.3?channel=stable&user=bincrafters",
+		"pkg:golang/google.golang.org/genproto#googleapis/api/annotations",
+		"pkg:guac/oci/docker.io/library/python:3.7",
+		"pkg:guac/spdx/alpine@3.16",
+		"pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=sources&type=zip",
+		"pkg:oci/debian@sha256:244fd47e07d10?arch=amd64&repository_url=docker.io/library/debian",
+		"pkg:pypi/django@1.11.1",
+	}
+
+	for _, purlUri := range testCases {
+		t.Run(fmt.Sprintf("processing %v", purlUri), func(t *testing.T) {
+			pkgSpec, err := PurlToPkg(purlUri)
+			if err != nil {
+				t.Fatalf("unable to parse purl %v: %v", purlUri, err)
+			}
+			qualifiers := map[string]string{}
+			for _, q := range pkgSpec.Qualifiers {
+				qualifiers[q.Key] = q.Value
+			}
+			got := PkgToPurl(pkgSpec.Type, *pkgSpec.Namespace, pkgSpec.Name, *pkgSpec.Version, *pkgSpec.Subpath, qualifiers)
+			roundTrip, err := PurlToPkg(got)
+			if err != nil {
+				t.Fatalf("unable to parse purl %v: %v", got, err)
+			}
+			if diff := cmp.Diff(pkgSpec, roundTrip, cmpOpts...); diff != "" {
+				t.Errorf("model Package mismatch after PkgToPurl %v (-want +got):\n%s", got, diff)
+			}
+		})
+	}
+}
+
+func TestAllPkgTreeToPurls(t *testing.T) {
+	pkg := &model.AllPkgTree{
+		Type: "maven",
+		Namespaces: []model.AllPkgTreeNamespacesPackageNamespace{{
+			Namespace: "org.apache.xmlgraphics",
+			Names: []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageName{{
+				Name: "batik-anim",
+				Versions: []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersion{{
+					Version: "1.9.1",
+					Qualifiers: []model.AllPkgTreeNamespacesPackageNamespaceNamesPackageNameVersionsPackageVersionQualifiersPackageQualifier{
+						{Key: "type", Value: "zip"},
+						{Key: "classifier", Value: "sources"},
+					},
+				}, {
+					Version: "1.10",
+				}},
+			}},
+		}},
+	}
+	want := []string{
+		"pkg:maven/org.apache.xmlgraphics/batik-anim@1.9.1?classifier=sources&type=zip",
+		"pkg:maven/org.apache.xmlgraphics/batik-anim@1.10",
+	}
+	if diff := cmp.Diff(want, AllPkgTreeToPurls(pkg)); diff != "" {
+		t.Errorf("AllPkgTreeToPurls() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestGuacPkgPurl(t *testing.T) {
 	testCases := []struct {
 		pkgName    string
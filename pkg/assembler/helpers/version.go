@@ -0,0 +1,596 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CompareVersions compares two versions of a package following the
+// versioning scheme of the package ecosystem, identified by its purl type. It
+// returns a negative number if a < b, 0 if a == b and a positive number if
+// a > b.
+//
+// npm, Go and Cargo versions are compared as semantic versions, PyPI
+// versions following PEP 440, Maven versions following Maven's
+// ComparableVersion, and deb and rpm versions as dpkg and rpm do. Versions
+// of other ecosystems, or versions that do not follow their ecosystem's
+// scheme, are compared segment by segment.
+func CompareVersions(pkgType, a, b string) int {
+	switch strings.ToLower(pkgType) {
+	case "npm", "golang", "cargo":
+		return CompareSemver(a, b)
+	case "pypi":
+		return comparePEP440(a, b)
+	case "maven":
+		return compareMaven(a, b)
+	case "deb":
+		return compareDebian(a, b)
+	case "rpm":
+		return compareRPM(a, b)
+	default:
+		return compareGeneric(a, b)
+	}
+}
+
+// semver
+var semverRegexp = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// CompareSemver compares two semantic versions, ignoring build metadata. A
+// leading "v" is allowed and missing minor or patch numbers are 0. Versions
+// that are not semantic versions are compared segment by segment.
+func CompareSemver(a, b string) int {
+	ma := semverRegexp.FindStringSubmatch(strings.TrimSpace(a))
+	mb := semverRegexp.FindStringSubmatch(strings.TrimSpace(b))
+	if ma == nil || mb == nil {
+		return compareGeneric(a, b)
+	}
+	for i := 1; i <= 3; i++ {
+		if c := compareNumeric(ma[i], mb[i]); c != 0 {
+			return c
+		}
+	}
+	// a version without prerelease has precedence over one with
+	switch {
+	case ma[4] == "" && mb[4] == "":
+		return 0
+	case ma[4] == "":
+		return 1
+	case mb[4] == "":
+		return -1
+	}
+	pa := strings.Split(ma[4], ".")
+	pb := strings.Split(mb[4], ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, errA := strconv.ParseUint(pa[i], 10, 64)
+		nb, errB := strconv.ParseUint(pb[i], 10, 64)
+		var c int
+		switch {
+		case errA == nil && errB == nil:
+			c = compareInts(na, nb)
+		case errA == nil:
+			// numeric identifiers have lower precedence
+			c = -1
+		case errB == nil:
+			c = 1
+		default:
+			c = strings.Compare(pa[i], pb[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return len(pa) - len(pb)
+}
+
+// PEP 440
+var pep440Regexp = regexp.MustCompile(`^v?(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(a|b|c|rc|alpha|beta|pre|preview)[-_.]?(\d+)?)?` +
+	`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d+)?)?` +
+	`(?:[-_.]?(dev)[-_.]?(\d+)?)?` +
+	`(?:\+([a-z0-9]+(?:[-_.][a-z0-9]+)*))?$`)
+
+type pep440Version struct {
+	epoch   string
+	release []string
+	// preRank is -1 for dev releases without pre or post release, 3 when
+	// there is no pre release, and the rank of a, b and rc otherwise
+	preRank int
+	pre     string
+	hasPost bool
+	post    string
+	hasDev  bool
+	dev     string
+	local   string
+}
+
+func parsePEP440(v string) *pep440Version {
+	m := pep440Regexp.FindStringSubmatch(strings.ToLower(strings.TrimSpace(v)))
+	if m == nil {
+		return nil
+	}
+	p := &pep440Version{
+		epoch:   m[1],
+		release: strings.Split(m[2], "."),
+		preRank: 3,
+		pre:     m[4],
+		local:   m[10],
+	}
+	switch m[3] {
+	case "a", "alpha":
+		p.preRank = 0
+	case "b", "beta":
+		p.preRank = 1
+	case "c", "rc", "pre", "preview":
+		p.preRank = 2
+	}
+	if m[5] != "" || m[6] != "" {
+		p.hasPost = true
+		p.post = m[5] + m[7]
+	}
+	if m[8] != "" {
+		p.hasDev = true
+		p.dev = m[9]
+	}
+	if m[3] == "" && !p.hasPost && p.hasDev {
+		p.preRank = -1
+	}
+	return p
+}
+
+func comparePEP440(a, b string) int {
+	pa := parsePEP440(a)
+	pb := parsePEP440(b)
+	if pa == nil || pb == nil {
+		return compareGeneric(a, b)
+	}
+	if c := compareNumeric(pa.epoch, pb.epoch); c != 0 {
+		return c
+	}
+	for i := 0; i < len(pa.release) || i < len(pb.release); i++ {
+		var ra, rb string
+		if i < len(pa.release) {
+			ra = pa.release[i]
+		}
+		if i < len(pb.release) {
+			rb = pb.release[i]
+		}
+		if c := compareNumeric(ra, rb); c != 0 {
+			return c
+		}
+	}
+	if pa.preRank != pb.preRank {
+		return pa.preRank - pb.preRank
+	}
+	if c := compareNumeric(pa.pre, pb.pre); c != 0 {
+		return c
+	}
+	// no post release sorts before any post release
+	if pa.hasPost != pb.hasPost {
+		return compareBools(pa.hasPost, pb.hasPost)
+	}
+	if c := compareNumeric(pa.post, pb.post); c != 0 {
+		return c
+	}
+	// no dev release sorts after any dev release
+	if pa.hasDev != pb.hasDev {
+		return compareBools(pb.hasDev, pa.hasDev)
+	}
+	if c := compareNumeric(pa.dev, pb.dev); c != 0 {
+		return c
+	}
+	return compareGeneric(pa.local, pb.local)
+}
+
+// Maven
+var mavenQualifiers = map[string]int{
+	"alpha":     0,
+	"beta":      1,
+	"milestone": 2,
+	"rc":        3,
+	"snapshot":  4,
+	"":          5,
+	"sp":        6,
+}
+
+var mavenAliases = map[string]string{
+	"a":       "alpha",
+	"b":       "beta",
+	"m":       "milestone",
+	"cr":      "rc",
+	"ga":      "",
+	"final":   "",
+	"release": "",
+}
+
+type mavenItem struct {
+	numeric bool
+	value   string
+}
+
+// tokenizeMaven splits a Maven version on dots, dashes and transitions
+// between digits and letters, dropping the trailing null items
+func tokenizeMaven(v string) []mavenItem {
+	var items []mavenItem
+	var current strings.Builder
+	currentDigits := false
+	flush := func() {
+		s := current.String()
+		current.Reset()
+		if currentDigits {
+			items = append(items, mavenItem{numeric: true, value: s})
+			return
+		}
+		if alias, ok := mavenAliases[s]; ok {
+			s = alias
+		}
+		items = append(items, mavenItem{value: s})
+	}
+	for i, r := range strings.ToLower(strings.TrimSpace(v)) {
+		isDigit := r >= '0' && r <= '9'
+		switch {
+		case r == '.' || r == '-':
+			flush()
+			currentDigits = false
+		case i > 0 && current.Len() > 0 && isDigit != currentDigits:
+			flush()
+			currentDigits = isDigit
+			current.WriteRune(r)
+		default:
+			if current.Len() == 0 {
+				currentDigits = isDigit
+			}
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	for len(items) > 0 && items[len(items)-1].isNull() {
+		items = items[:len(items)-1]
+	}
+	return items
+}
+
+func (i mavenItem) isNull() bool {
+	if i.numeric {
+		return strings.TrimLeft(i.value, "0") == ""
+	}
+	return i.value == ""
+}
+
+func compareMavenItems(a, b *mavenItem) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -compareMavenItems(b, nil)
+	case a.numeric && b == nil:
+		return compareNumeric(a.value, "")
+	case b == nil:
+		return compareMavenQualifiers(a.value, "")
+	case a.numeric && b.numeric:
+		return compareNumeric(a.value, b.value)
+	case a.numeric:
+		// numbers are newer than qualifiers
+		return 1
+	case b.numeric:
+		return -1
+	default:
+		return compareMavenQualifiers(a.value, b.value)
+	}
+}
+
+func compareMavenQualifiers(a, b string) int {
+	ra, knownA := mavenQualifiers[a]
+	rb, knownB := mavenQualifiers[b]
+	switch {
+	case knownA && knownB:
+		return ra - rb
+	case knownA:
+		// unknown qualifiers are newer than the known ones
+		return -1
+	case knownB:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareMaven(a, b string) int {
+	ia := tokenizeMaven(a)
+	ib := tokenizeMaven(b)
+	for i := 0; i < len(ia) || i < len(ib); i++ {
+		var itemA, itemB *mavenItem
+		if i < len(ia) {
+			itemA = &ia[i]
+		}
+		if i < len(ib) {
+			itemB = &ib[i]
+		}
+		if c := compareMavenItems(itemA, itemB); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// Debian
+func compareDebian(a, b string) int {
+	ea, ua, ra := splitDebian(a)
+	eb, ub, rb := splitDebian(b)
+	if c := compareNumeric(ea, eb); c != 0 {
+		return c
+	}
+	if c := verrevcmp(ua, ub); c != 0 {
+		return c
+	}
+	return verrevcmp(ra, rb)
+}
+
+// splitDebian splits a Debian version into its epoch, upstream version and
+// revision
+func splitDebian(v string) (string, string, string) {
+	v = strings.TrimSpace(v)
+	epoch := ""
+	if i := strings.Index(v, ":"); i >= 0 {
+		epoch, v = v[:i], v[i+1:]
+	}
+	revision := ""
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		v, revision = v[:i], v[i+1:]
+	}
+	return epoch, v, revision
+}
+
+// debianOrder is the sort weight of a character of a version in dpkg: '~'
+// sorts before everything, even the end of the version, and letters sort
+// before the other characters
+func debianOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	c := s[i]
+	switch {
+	case c >= '0' && c <= '9':
+		return 0
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+func isDigit(s string, i int) bool {
+	return i < len(s) && s[i] >= '0' && s[i] <= '9'
+}
+
+// verrevcmp is the dpkg comparison of version parts, alternating non-digit
+// and digit segments
+func verrevcmp(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for (i < len(a) && !isDigit(a, i)) || (j < len(b) && !isDigit(b, j)) {
+			oa, ob := debianOrder(a, i), debianOrder(b, j)
+			if oa != ob {
+				return oa - ob
+			}
+			i++
+			j++
+		}
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+		firstDiff := 0
+		for isDigit(a, i) && isDigit(b, j) {
+			if firstDiff == 0 {
+				firstDiff = int(a[i]) - int(b[j])
+			}
+			i++
+			j++
+		}
+		if isDigit(a, i) {
+			return 1
+		}
+		if isDigit(b, j) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return firstDiff
+		}
+	}
+	return 0
+}
+
+// RPM
+func compareRPM(a, b string) int {
+	ea, va, ra := splitRPM(a)
+	eb, vb, rb := splitRPM(b)
+	if c := compareNumeric(ea, eb); c != 0 {
+		return c
+	}
+	if c := rpmvercmp(va, vb); c != 0 {
+		return c
+	}
+	// a missing release matches any release
+	if ra == "" || rb == "" {
+		return 0
+	}
+	return rpmvercmp(ra, rb)
+}
+
+// splitRPM splits an rpm version into its epoch, version and release
+func splitRPM(v string) (string, string, string) {
+	v = strings.TrimSpace(v)
+	epoch := ""
+	if i := strings.Index(v, ":"); i >= 0 {
+		epoch, v = v[:i], v[i+1:]
+	}
+	release := ""
+	if i := strings.LastIndex(v, "-"); i >= 0 {
+		v, release = v[:i], v[i+1:]
+	}
+	return epoch, v, release
+}
+
+func isRPMSeparator(c byte) bool {
+	return !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && c != '~' && c != '^'
+}
+
+// rpmvercmp is the rpm comparison of versions and releases, comparing
+// alphanumeric segments. '~' sorts before everything, '^' after the end of
+// the version but before anything else.
+func rpmvercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && isRPMSeparator(a[i]) {
+			i++
+		}
+		for j < len(b) && isRPMSeparator(b[j]) {
+			j++
+		}
+
+		tildeA, tildeB := i < len(a) && a[i] == '~', j < len(b) && b[j] == '~'
+		if tildeA || tildeB {
+			if !tildeA {
+				return 1
+			}
+			if !tildeB {
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		caretA, caretB := i < len(a) && a[i] == '^', j < len(b) && b[j] == '^'
+		if caretA || caretB {
+			switch {
+			case i >= len(a):
+				return -1
+			case j >= len(b):
+				return 1
+			case !caretA:
+				return 1
+			case !caretB:
+				return -1
+			}
+			i++
+			j++
+			continue
+		}
+
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+
+		numeric := isDigit(a, i)
+		startA, startB := i, j
+		if numeric {
+			for isDigit(a, i) {
+				i++
+			}
+			for isDigit(b, j) {
+				j++
+			}
+		} else {
+			for i < len(a) && isAlpha(a[i]) {
+				i++
+			}
+			for j < len(b) && isAlpha(b[j]) {
+				j++
+			}
+		}
+		segA, segB := a[startA:i], b[startB:j]
+		if segB == "" {
+			// numeric segments are newer than alphabetic ones
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+		var c int
+		if numeric {
+			c = compareNumeric(segA, segB)
+		} else {
+			c = strings.Compare(segA, segB)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i >= len(a):
+		return -1
+	default:
+		return 1
+	}
+}
+
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// compareGeneric compares versions of unknown schemes: the digit segments
+// are compared numerically and the other segments as dpkg does
+func compareGeneric(a, b string) int {
+	return verrevcmp(strings.TrimPrefix(strings.TrimSpace(a), "v"), strings.TrimPrefix(strings.TrimSpace(b), "v"))
+}
+
+// compareNumeric compares two strings of digits of any length, an empty
+// string being 0
+func compareNumeric(a, b string) int {
+	na, okA := new(big.Int).SetString("0"+a, 10)
+	nb, okB := new(big.Int).SetString("0"+b, 10)
+	if !okA || !okB {
+		return strings.Compare(a, b)
+	}
+	return na.Cmp(nb)
+}
+
+func compareInts(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,112 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	// each pair is expected to be strictly ordered, lower version first
+	testCases := []struct {
+		pkgType string
+		lower   string
+		higher  string
+	}{
+		// semver
+		{"npm", "1.2.3", "1.2.4"},
+		{"npm", "1.2.3", "1.10.0"},
+		{"npm", "1.0.0-alpha", "1.0.0"},
+		{"npm", "1.0.0-alpha", "1.0.0-alpha.1"},
+		{"npm", "1.0.0-alpha.1", "1.0.0-alpha.beta"},
+		{"npm", "1.0.0-beta.2", "1.0.0-beta.11"},
+		{"npm", "1.0.0-rc.1", "1.0.0"},
+		{"golang", "v0.0.0-20210220033124-5f55cee0dc0d", "v0.1.0"},
+		{"golang", "v1.9.9", "v1.10.0"},
+		// PEP 440
+		{"pypi", "1.0.dev0", "1.0a1"},
+		{"pypi", "1.0a1", "1.0b1"},
+		{"pypi", "1.0b2", "1.0rc1"},
+		{"pypi", "1.0rc1", "1.0"},
+		{"pypi", "1.0", "1.0.post1"},
+		{"pypi", "1.0.post1.dev0", "1.0.post1"},
+		{"pypi", "1.11.1", "1.11.29"},
+		{"pypi", "2.2", "2.10"},
+		{"pypi", "2.0", "1!0.1"},
+		// Maven
+		{"maven", "1.0-alpha-1", "1.0-beta-1"},
+		{"maven", "1.0-beta-1", "1.0-M1"},
+		{"maven", "1.0-RC1", "1.0-SNAPSHOT"},
+		{"maven", "1.0-SNAPSHOT", "1.0"},
+		{"maven", "1.0", "1.0-sp1"},
+		{"maven", "2.8.1", "2.15.0"},
+		{"maven", "2.14.1", "2.15.0-rc1"},
+		// deb
+		{"deb", "1.0~rc1", "1.0"},
+		{"deb", "1.0", "1.0-1"},
+		{"deb", "1.0-1", "1.0-2"},
+		{"deb", "1.0-9", "1.0-10"},
+		{"deb", "2.0", "1:1.0"},
+		{"deb", "3.0.7-1", "3.0.7-1+deb12u1"},
+		// rpm
+		{"rpm", "1.0~rc1", "1.0"},
+		{"rpm", "1.0", "1.0^git1"},
+		{"rpm", "1.0^git1", "1.0.1"},
+		{"rpm", "3.0.7-1", "3.0.7-2"},
+		{"rpm", "1.0a", "1.0.1"},
+		{"rpm", "2.0", "1:1.0"},
+		// unknown ecosystem
+		{"generic", "1.9", "1.10"},
+		{"generic", "v1.2", "1.3"},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.pkgType+":"+tt.lower+"<"+tt.higher, func(t *testing.T) {
+			if c := CompareVersions(tt.pkgType, tt.lower, tt.higher); c >= 0 {
+				t.Errorf("CompareVersions(%q, %q, %q) = %d, want < 0", tt.pkgType, tt.lower, tt.higher, c)
+			}
+			if c := CompareVersions(tt.pkgType, tt.higher, tt.lower); c <= 0 {
+				t.Errorf("CompareVersions(%q, %q, %q) = %d, want > 0", tt.pkgType, tt.higher, tt.lower, c)
+			}
+			if c := CompareVersions(tt.pkgType, tt.lower, tt.lower); c != 0 {
+				t.Errorf("CompareVersions(%q, %q, %q) = %d, want 0", tt.pkgType, tt.lower, tt.lower, c)
+			}
+		})
+	}
+}
+
+func TestCompareVersionsEqual(t *testing.T) {
+	testCases := []struct {
+		pkgType string
+		a       string
+		b       string
+	}{
+		{"npm", "1.0.0+build1", "1.0.0+build2"},
+		{"golang", "v1.2.0", "1.2.0"},
+		{"pypi", "1.0", "1.0.0"},
+		{"pypi", "1.0RC1", "1.0rc1"},
+		{"maven", "1.0", "1.0.0"},
+		{"maven", "1.0-ga", "1.0"},
+		{"deb", "0:1.0", "1.0"},
+		{"rpm", "1.0", "1.0-1"},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.pkgType+":"+tt.a+"="+tt.b, func(t *testing.T) {
+			if c := CompareVersions(tt.pkgType, tt.a, tt.b); c != 0 {
+				t.Errorf("CompareVersions(%q, %q, %q) = %d, want 0", tt.pkgType, tt.a, tt.b, c)
+			}
+		})
+	}
+}
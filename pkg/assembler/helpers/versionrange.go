@@ -0,0 +1,232 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MatchVersionRange reports whether a version of a package of the given purl
+// type satisfies the version range of a dependency, versions being compared
+// with CompareVersions. ok is false if the range cannot be parsed.
+//
+// A range is a list of alternatives separated by "||", each a list of
+// constraints separated by commas or spaces that must all hold. A constraint
+// is a version with an optional operator: =, ==, ===, !=, <, <=, >, >=, ~=
+// (PEP 440 compatible release), and ~ and ^ (npm and Cargo tilde and caret
+// ranges). The last segments of a version can be * or x wildcards and
+// "1.0 - 2.0" is an inclusive npm hyphen range. An empty range or * matches
+// any version. Maven ranges such as [1.0,2.0) or (,1.0],[1.2,) are supported
+// too.
+func MatchVersionRange(pkgType, version, versionRange string) (match bool, ok bool) {
+	versionRange = strings.TrimSpace(versionRange)
+	compare := func(a, b string) int { return CompareVersions(pkgType, a, b) }
+	if strings.HasPrefix(versionRange, "[") || strings.HasPrefix(versionRange, "(") {
+		return matchMavenRange(version, versionRange, compare)
+	}
+
+	for _, alternative := range strings.Split(versionRange, "||") {
+		constraints, ok := parseConstraints(alternative)
+		if !ok {
+			return false, false
+		}
+		matchAll := true
+		for _, c := range constraints {
+			if !c.match(version, compare) {
+				matchAll = false
+			}
+		}
+		if matchAll {
+			match = true
+		}
+	}
+	return match, true
+}
+
+// versionConstraint is a comparison of a version with the version of the
+// constraint
+type versionConstraint struct {
+	op      string
+	version string
+}
+
+func (c versionConstraint) match(version string, compare func(a, b string) int) bool {
+	cmp := compare(version, c.version)
+	switch c.op {
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	default:
+		return cmp == 0
+	}
+}
+
+var (
+	constraintOperatorRegexp = regexp.MustCompile(`^(===|==|!=|~=|<=|>=|<|>|=|\^|~)`)
+	constraintVersionRegexp  = regexp.MustCompile(`^v?\d`)
+	releaseRegexp            = regexp.MustCompile(`^v?(\d+(?:\.\d+)*)`)
+)
+
+// parseConstraints parses the constraints of an alternative of a range into
+// comparisons, nil matching any version
+func parseConstraints(alternative string) ([]versionConstraint, bool) {
+	alternative = strings.TrimSpace(alternative)
+	if alternative == "" || alternative == "*" || alternative == "x" {
+		return nil, true
+	}
+	if lower, upper, found := strings.Cut(alternative, " - "); found {
+		lower, upper = strings.TrimSpace(lower), strings.TrimSpace(upper)
+		if !constraintVersionRegexp.MatchString(lower) || !constraintVersionRegexp.MatchString(upper) {
+			return nil, false
+		}
+		return []versionConstraint{{">=", lower}, {"<=", upper}}, true
+	}
+
+	tokens := strings.FieldsFunc(alternative, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	var constraints []versionConstraint
+	for i := 0; i < len(tokens); i++ {
+		op := constraintOperatorRegexp.FindString(tokens[i])
+		version := tokens[i][len(op):]
+		// the operator may be separated from the version
+		if version == "" && i+1 < len(tokens) {
+			i++
+			version = tokens[i]
+		}
+		expanded, ok := expandConstraint(op, version)
+		if !ok {
+			return nil, false
+		}
+		constraints = append(constraints, expanded...)
+	}
+	return constraints, true
+}
+
+// expandConstraint returns the comparisons a constraint stands for
+func expandConstraint(op string, version string) ([]versionConstraint, bool) {
+	if prefix, wildcard := wildcardPrefix(version); wildcard {
+		if op != "" && op != "=" && op != "==" {
+			return nil, false
+		}
+		if len(prefix) == 0 {
+			return nil, true
+		}
+		return []versionConstraint{
+			{">=", strings.Join(prefix, ".")},
+			{"<", bumpRelease(prefix, len(prefix)-1)},
+		}, true
+	}
+	if !constraintVersionRegexp.MatchString(version) {
+		return nil, false
+	}
+
+	release := strings.Split(releaseRegexp.FindStringSubmatch(version)[1], ".")
+	switch op {
+	case "", "=", "==", "===":
+		return []versionConstraint{{"==", version}}, true
+	case "^":
+		// the first non-zero segment cannot change
+		i := 0
+		for i < len(release)-1 && i < 2 && release[i] == "0" {
+			i++
+		}
+		return []versionConstraint{{">=", version}, {"<", bumpRelease(release, i)}}, true
+	case "~":
+		// the minor version cannot change if given, else the major version
+		i := 1
+		if len(release) < 2 {
+			i = 0
+		}
+		return []versionConstraint{{">=", version}, {"<", bumpRelease(release, i)}}, true
+	case "~=":
+		if len(release) < 2 {
+			return nil, false
+		}
+		return []versionConstraint{{">=", version}, {"<", bumpRelease(release, len(release)-2)}}, true
+	}
+	return []versionConstraint{{op, version}}, true
+}
+
+// wildcardPrefix returns the segments of a version before a * or x wildcard
+// segment, and whether there is such a segment
+func wildcardPrefix(version string) ([]string, bool) {
+	segments := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	for i, s := range segments {
+		if s == "*" || s == "x" || s == "X" {
+			return segments[:i], true
+		}
+	}
+	return nil, false
+}
+
+// bumpRelease returns the release whose segment i is one more than in the
+// given release, the segments after i being dropped
+func bumpRelease(release []string, i int) string {
+	n, _ := strconv.Atoi(release[i])
+	bumped := append(append([]string{}, release[:i]...), strconv.Itoa(n+1))
+	return strings.Join(bumped, ".")
+}
+
+var mavenRangeRegexp = regexp.MustCompile(`^([\[(])\s*([^,\[\]()]*?)\s*(,\s*([^,\[\]()]*?)\s*)?([\])])`)
+
+// matchMavenRange matches a version against a Maven version range, a list
+// of intervals separated by commas
+func matchMavenRange(version string, versionRange string, compare func(a, b string) int) (bool, bool) {
+	match := false
+	for rest := versionRange; rest != ""; {
+		m := mavenRangeRegexp.FindStringSubmatch(rest)
+		if m == nil {
+			return false, false
+		}
+		rest = strings.TrimLeft(rest[len(m[0]):], ", ")
+
+		open, lower, hasComma, upper, closing := m[1], m[2], m[3] != "", m[4], m[5]
+		if !hasComma {
+			// [1.0] is an exact version
+			if open != "[" || closing != "]" || lower == "" {
+				return false, false
+			}
+			if compare(version, lower) == 0 {
+				match = true
+			}
+			continue
+		}
+		inInterval := true
+		if lower != "" {
+			c := compare(version, lower)
+			inInterval = inInterval && (c > 0 || c == 0 && open == "[")
+		}
+		if upper != "" {
+			c := compare(version, upper)
+			inInterval = inInterval && (c < 0 || c == 0 && closing == "]")
+		}
+		if inInterval {
+			match = true
+		}
+	}
+	return match, true
+}
@@ -0,0 +1,85 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+)
+
+func TestMatchVersionRange(t *testing.T) {
+	testCases := []struct {
+		pkgType      string
+		versionRange string
+		version      string
+		want         bool
+		wantOK       bool
+	}{
+		// any version
+		{"npm", "", "1.0.0", true, true},
+		{"npm", "*", "1.0.0", true, true},
+		// exact versions
+		{"pypi", "1.11.1", "1.11.1", true, true},
+		{"pypi", "==1.11.1", "1.11.10", false, true},
+		{"pypi", "== 1.0", "1.0.0", true, true},
+		{"pypi", "!=1.0", "1.0.1", true, true},
+		// comparisons
+		{"pypi", ">=0.2", "0.4.1", true, true},
+		{"pypi", ">=1.0,<2.0", "2.0", false, true},
+		{"pypi", ">= 1.0, < 2.0", "1.9", true, true},
+		{"npm", ">=1.0.0 <2.0.0", "1.5.0", true, true},
+		{"npm", "<1.0.0 || >=2.0.0", "1.5.0", false, true},
+		{"npm", "<1.0.0 || >=2.0.0", "2.1.0", true, true},
+		// caret and tilde
+		{"npm", "^1.2.3", "1.9.0", true, true},
+		{"npm", "^1.2.3", "2.0.0", false, true},
+		{"npm", "^1.2.3", "1.2.2", false, true},
+		{"cargo", "^0.2.3", "0.2.9", true, true},
+		{"cargo", "^0.2.3", "0.3.0", false, true},
+		{"cargo", "^0.0.3", "0.0.4", false, true},
+		{"npm", "~1.2.3", "1.2.9", true, true},
+		{"npm", "~1.2.3", "1.3.0", false, true},
+		{"npm", "~1", "1.9.0", true, true},
+		{"pypi", "~=1.4.5", "1.4.9", true, true},
+		{"pypi", "~=1.4.5", "1.5.0", false, true},
+		{"pypi", "~=2.2", "2.9", true, true},
+		{"pypi", "~=2.2", "3.0", false, true},
+		// wildcards and hyphen ranges
+		{"pypi", "==1.2.*", "1.2.7", true, true},
+		{"npm", "1.x", "1.9.9", true, true},
+		{"npm", "1.x", "2.0.0", false, true},
+		{"npm", "1.2.3 - 2.3.4", "2.3.4", true, true},
+		{"npm", "1.2.3 - 2.3.4", "2.3.5", false, true},
+		// Maven ranges
+		{"maven", "[1.0,2.0)", "1.5", true, true},
+		{"maven", "[1.0,2.0)", "2.0", false, true},
+		{"maven", "(,1.0],[1.2,)", "1.1", false, true},
+		{"maven", "(,1.0],[1.2,)", "1.3", true, true},
+		{"maven", "[1.0]", "1.0", true, true},
+		// invalid ranges
+		{"npm", "latest", "1.0.0", false, false},
+		{"pypi", ">=", "1.0", false, false},
+		{"pypi", "~=1", "1.0", false, false},
+		{"maven", "[1.0", "1.0", false, false},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.pkgType+":"+tt.version+" in "+tt.versionRange, func(t *testing.T) {
+			got, ok := MatchVersionRange(tt.pkgType, tt.version, tt.versionRange)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("MatchVersionRange(%q, %q, %q) = %v, %v, want %v, %v", tt.pkgType, tt.version, tt.versionRange, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
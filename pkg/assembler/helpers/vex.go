@@ -0,0 +1,66 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+var vexStatuses = []model.VexStatus{
+	model.VexStatusNotAffected,
+	model.VexStatusAffected,
+	model.VexStatusFixed,
+	model.VexStatusUnderInvestigation,
+}
+
+var vexJustifications = []model.VexJustification{
+	model.VexJustificationComponentNotPresent,
+	model.VexJustificationVulnerableCodeNotPresent,
+	model.VexJustificationVulnerableCodeNotInExecutePath,
+	model.VexJustificationVulnerableCodeCannotBeControlledByAdversary,
+	model.VexJustificationInlineMitigationsAlreadyExist,
+	model.VexJustificationNotProvided,
+}
+
+// VexStatus converts a VEX status as spelled by the VEX formats, e.g.
+// "not_affected" or "notAffected", to its enum value
+func VexStatus(status string) (model.VexStatus, error) {
+	for _, s := range vexStatuses {
+		if normalizeVexValue(string(s)) == normalizeVexValue(status) {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unknown VEX status %q", status)
+}
+
+// VexJustification converts a VEX justification as spelled by the VEX
+// formats, e.g. "component_not_present" or "componentNotPresent", to its enum
+// value. Missing or unknown justifications are NOT_PROVIDED.
+func VexJustification(justification string) model.VexJustification {
+	for _, j := range vexJustifications {
+		if normalizeVexValue(string(j)) == normalizeVexValue(justification) {
+			return j
+		}
+	}
+	return model.VexJustificationNotProvided
+}
+
+func normalizeVexValue(v string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "", " ", "").Replace(v))
+}
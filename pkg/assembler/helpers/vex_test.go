@@ -0,0 +1,85 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func TestVexStatus(t *testing.T) {
+	testCases := []struct {
+		status  string
+		want    model.VexStatus
+		wantErr bool
+	}{{
+		status: "not_affected",
+		want:   model.VexStatusNotAffected,
+	}, {
+		status: "affected",
+		want:   model.VexStatusAffected,
+	}, {
+		status: "fixed",
+		want:   model.VexStatusFixed,
+	}, {
+		status: "underInvestigation",
+		want:   model.VexStatusUnderInvestigation,
+	}, {
+		status:  "known_affected",
+		wantErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.status, func(t *testing.T) {
+			got, err := VexStatus(tt.status)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("VexStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("VexStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVexJustification(t *testing.T) {
+	testCases := []struct {
+		justification string
+		want          model.VexJustification
+	}{{
+		justification: "vulnerable_code_not_present",
+		want:          model.VexJustificationVulnerableCodeNotPresent,
+	}, {
+		justification: "componentNotPresent",
+		want:          model.VexJustificationComponentNotPresent,
+	}, {
+		justification: "inline_mitigations_already_exist",
+		want:          model.VexJustificationInlineMitigationsAlreadyExist,
+	}, {
+		justification: "",
+		want:          model.VexJustificationNotProvided,
+	}, {
+		justification: "requires_configuration",
+		want:          model.VexJustificationNotProvided,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.justification, func(t *testing.T) {
+			if got := VexJustification(tt.justification); got != tt.want {
+				t.Errorf("VexJustification() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"strings"
+
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+// IsCVE returns true if the vulnerability ID is of the form CVE-<year>-<id>
+func IsCVE(id string) bool {
+	return CveYear(id) != ""
+}
+
+// IsGHSA returns true if the vulnerability ID is a GitHub security advisory ID
+func IsGHSA(id string) bool {
+	return strings.HasPrefix(strings.ToUpper(id), "GHSA-")
+}
+
+// CveYear returns the year component of a CVE ID or an empty string if the
+// ID is not a CVE.
+func CveYear(id string) string {
+	parts := strings.Split(id, "-")
+	if len(parts) != 3 || !strings.EqualFold(parts[0], "CVE") || len(parts[1]) != 4 {
+		return ""
+	}
+	for _, c := range parts[1] {
+		if c < '0' || c > '9' {
+			return ""
+		}
+	}
+	return parts[1]
+}
+
+// OsvCveOrGhsa classifies a vulnerability ID. CVE and GHSA IDs are returned
+// as their respective input specs, any other ID is treated as an OSV ID.
+// Exactly one of the returned values is non-nil.
+func OsvCveOrGhsa(id string) (*model.OSVInputSpec, *model.CVEInputSpec, *model.GHSAInputSpec) {
+	switch {
+	case IsCVE(id):
+		return nil, &model.CVEInputSpec{Year: CveYear(id), CveId: id}, nil
+	case IsGHSA(id):
+		return nil, nil, &model.GHSAInputSpec{GhsaId: id}
+	default:
+		return &model.OSVInputSpec{OsvId: id}, nil, nil
+	}
+}
@@ -0,0 +1,61 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+)
+
+func TestOsvCveOrGhsa(t *testing.T) {
+	testCases := []struct {
+		id       string
+		wantOSV  *model.OSVInputSpec
+		wantCVE  *model.CVEInputSpec
+		wantGHSA *model.GHSAInputSpec
+	}{{
+		id:      "CVE-2021-44228",
+		wantCVE: &model.CVEInputSpec{Year: "2021", CveId: "CVE-2021-44228"},
+	}, {
+		id:      "cve-2019-13110",
+		wantCVE: &model.CVEInputSpec{Year: "2019", CveId: "cve-2019-13110"},
+	}, {
+		id:       "GHSA-7rjr-3q55-vv33",
+		wantGHSA: &model.GHSAInputSpec{GhsaId: "GHSA-7rjr-3q55-vv33"},
+	}, {
+		id:      "GO-2022-0001",
+		wantOSV: &model.OSVInputSpec{OsvId: "GO-2022-0001"},
+	}, {
+		id:      "CVE-20x1-1234",
+		wantOSV: &model.OSVInputSpec{OsvId: "CVE-20x1-1234"},
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.id, func(t *testing.T) {
+			osv, cve, ghsa := OsvCveOrGhsa(tt.id)
+			if d := cmp.Diff(tt.wantOSV, osv); d != "" {
+				t.Errorf("unexpected osv (+got, -expected): %s", d)
+			}
+			if d := cmp.Diff(tt.wantCVE, cve); d != "" {
+				t.Errorf("unexpected cve (+got, -expected): %s", d)
+			}
+			if d := cmp.Diff(tt.wantGHSA, ghsa); d != "" {
+				t.Errorf("unexpected ghsa (+got, -expected): %s", d)
+			}
+		})
+	}
+}
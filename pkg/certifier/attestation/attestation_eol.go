@@ -0,0 +1,72 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation_vuln
+
+import (
+	"time"
+
+	intoto "github.com/in-toto/in-toto-golang/in_toto"
+)
+
+// PredicateEOL is the predicate type used by the certifier to attest the
+// support status of the release cycle of a product an artifact belongs to.
+// Like PredicateVuln, it is defined here until upstreamed.
+const (
+	PredicateEOL = "https://in-toto.io/attestation/eol/v0.1"
+)
+
+// Support status of a release cycle reported in an EOLResult
+const (
+	EOLStatusSupported    = "SUPPORTED"
+	EOLStatusSecurityOnly = "SECURITY_ONLY"
+	EOLStatusEOL          = "EOL"
+)
+
+// EOLStatement defines the statement header and the end of life predicate
+type EOLStatement struct {
+	intoto.StatementHeader
+	// Predicate contains type specific metadata.
+	Predicate EOLPredicate `json:"predicate"`
+}
+
+// EOLResult defines the release cycle of the product the artifact belongs
+// to and its support status at the time of the scan, one of the EOLStatus
+// constants. The EOL and Support dates are not set when the dataset does not
+// provide one.
+type EOLResult struct {
+	Product string     `json:"product,omitempty"`
+	Cycle   string     `json:"cycle,omitempty"`
+	Status  string     `json:"status,omitempty"`
+	EOL     *time.Time `json:"eol,omitempty"`
+	Support *time.Time `json:"support,omitempty"`
+	Latest  string     `json:"latest,omitempty"`
+}
+
+// EOLScanner defines the scanner that checked the artifacts, the dataset
+// it used and the matching release cycles
+type EOLScanner struct {
+	Uri      string      `json:"uri,omitempty"`
+	Version  string      `json:"version,omitempty"`
+	Database DB          `json:"database,omitempty"`
+	Result   []EOLResult `json:"result,omitempty"`
+}
+
+// EOLPredicate defines predicate definition of the end of life attestation
+type EOLPredicate struct {
+	Invocation Invocation `json:"invocation,omitempty"`
+	Scanner    EOLScanner `json:"scanner,omitempty"`
+	Metadata   Metadata   `json:"metadata,omitempty"`
+}
@@ -0,0 +1,59 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation_vuln
+
+import (
+	intoto "github.com/in-toto/in-toto-golang/in_toto"
+)
+
+// PredicateMalicious is the predicate type used by the certifier to attest
+// that an artifact is a known malicious package, as reported by a feed.
+// Like PredicateVuln, it is defined here until upstreamed.
+const (
+	PredicateMalicious = "https://in-toto.io/attestation/malicious/v0.1"
+)
+
+// MaliciousStatement defines the statement header and the malicious package predicate
+type MaliciousStatement struct {
+	intoto.StatementHeader
+	// Predicate contains type specific metadata.
+	Predicate MaliciousPredicate `json:"predicate"`
+}
+
+// MaliciousResult defines the feed entry that reported the artifact as
+// malicious. Digest is set when the entry matched one of the digests of the
+// artifact rather than its purl.
+type MaliciousResult struct {
+	Id      string `json:"id,omitempty"`
+	Summary string `json:"summary,omitempty"`
+	Digest  string `json:"digest,omitempty"`
+}
+
+// MaliciousScanner defines the scanner that checked the artifacts, the feed
+// it used and the matching feed entries
+type MaliciousScanner struct {
+	Uri     string            `json:"uri,omitempty"`
+	Version string            `json:"version,omitempty"`
+	Feed    DB                `json:"feed,omitempty"`
+	Result  []MaliciousResult `json:"result,omitempty"`
+}
+
+// MaliciousPredicate defines predicate definition of the malicious package attestation
+type MaliciousPredicate struct {
+	Invocation Invocation       `json:"invocation,omitempty"`
+	Scanner    MaliciousScanner `json:"scanner,omitempty"`
+	Metadata   Metadata         `json:"metadata,omitempty"`
+}
@@ -43,10 +43,24 @@ type Metadata struct {
 }
 
 // Result defines the Vulnerability ID and its alias. There can be multiple
-// results per artifact
+// results per artifact. The remaining fields are optional metadata about the
+// vulnerability, when known to the scanner.
 type Result struct {
-	VulnerabilityId string   `json:"vulnerability_id,omitempty"`
-	Aliases         []string `json:"aliases,omitempty"`
+	VulnerabilityId string     `json:"vulnerability_id,omitempty"`
+	Aliases         []string   `json:"aliases,omitempty"`
+	Summary         string     `json:"summary,omitempty"`
+	Severity        []Severity `json:"severity,omitempty"`
+	CWEIds          []string   `json:"cwe_ids,omitempty"`
+	References      []string   `json:"references,omitempty"`
+	Published       *time.Time `json:"published,omitempty"`
+	Modified        *time.Time `json:"modified,omitempty"`
+}
+
+// Severity defines a score of the vulnerability in a scoring system, e.g.
+// CVSS_V3 and its vector string
+type Severity struct {
+	Method string `json:"method"`
+	Score  string `json:"score"`
 }
 
 // DB defines the scanner database used at the time of scan
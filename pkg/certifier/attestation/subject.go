@@ -0,0 +1,49 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation_vuln
+
+import (
+	"strings"
+
+	intoto "github.com/in-toto/in-toto-golang/in_toto"
+	"github.com/in-toto/in-toto-golang/in_toto/slsa_provenance/common"
+)
+
+// PackageSubjects returns the subjects of an attestation about a package:
+// one subject per digest, given as "algorithm:value", named by the purl of
+// the package, or a subject with the purl only if no digest is known.
+// Malformed digests are skipped.
+func PackageSubjects(packageURL string, digests []string) []intoto.Subject {
+	var subjects []intoto.Subject
+	for _, digest := range digests {
+		algorithm, value, ok := strings.Cut(digest, ":")
+		if !ok {
+			continue
+		}
+		subjects = append(subjects, intoto.Subject{
+			Name: packageURL,
+			Digest: common.DigestSet{
+				algorithm: value,
+			},
+		})
+	}
+	if len(subjects) == 0 {
+		subjects = append(subjects, intoto.Subject{
+			Name: packageURL,
+		})
+	}
+	return subjects
+}
@@ -17,6 +17,7 @@ package certifier
 
 import (
 	"context"
+	"time"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
 )
@@ -36,6 +37,16 @@ type QueryComponents interface {
 	GetComponents(ctx context.Context, compChan chan<- interface{}) error
 }
 
+// ScanTracker tells when the components returned by a QueryComponents were last certified, so that
+// only the stale ones get certified again
+type ScanTracker interface {
+	// ComponentKeys returns the keys of everything certified along with the component. The component
+	// is as stale as its least recently certified key
+	ComponentKeys(component interface{}) ([]string, error)
+	// LastScanned returns the last time each key was certified according to the GUAC graph
+	LastScanned(ctx context.Context) (map[string]time.Time, error)
+}
+
 // Emitter processes a document
 type Emitter func(*processor.Document) error
 
@@ -49,4 +60,6 @@ type CertifierType string
 const (
 	CertifierOSV       CertifierType = "OSV"
 	CertifierScorecard CertifierType = "scorecard"
+	CertifierMalicious CertifierType = "malicious"
+	CertifierEOL       CertifierType = "eol"
 )
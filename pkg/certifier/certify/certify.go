@@ -19,6 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/guacsec/guac/pkg/certifier"
 	"github.com/guacsec/guac/pkg/emitter"
@@ -50,9 +51,32 @@ func RegisterCertifier(c func() certifier.Certifier, certifierType certifier.Cer
 	return nil
 }
 
+// CertifierTypes returns the types of the registered certifiers, sorted
+func CertifierTypes() []certifier.CertifierType {
+	var types []certifier.CertifierType
+	for certifierType := range documentCertifier {
+		types = append(types, certifierType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
 // Certify queries the graph DB to get the components to scan. Utilizing the registered certifiers,
 // it generates new nodes and attestations.
 func Certify(ctx context.Context, query certifier.QueryComponents, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
+	return certify(ctx, documentCertifier, query, emitter, handleErr)
+}
+
+// CertifyWithType is Certify utilizing only the registered certifier of the given type
+func CertifyWithType(ctx context.Context, certifierType certifier.CertifierType, query certifier.QueryComponents, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
+	c, ok := documentCertifier[certifierType]
+	if !ok {
+		return fmt.Errorf("no certifier registered for type: %s", certifierType)
+	}
+	return certify(ctx, map[certifier.CertifierType]func() certifier.Certifier{certifierType: c}, query, emitter, handleErr)
+}
+
+func certify(ctx context.Context, certifiers map[certifier.CertifierType]func() certifier.Certifier, query certifier.QueryComponents, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
 
 	// docChan to collect artifacts
 	compChan := make(chan interface{}, BufferChannelSize)
@@ -69,7 +93,7 @@ func Certify(ctx context.Context, query certifier.QueryComponents, emitter certi
 	for !componentsCaptured {
 		select {
 		case d := <-compChan:
-			if err := generateDocuments(ctx, d, emitter, handleErr); err != nil {
+			if err := generateDocuments(ctx, certifiers, d, emitter, handleErr); err != nil {
 				return fmt.Errorf("generate certifier documents error: %w", err)
 			}
 		case err := <-errChan:
@@ -81,7 +105,7 @@ func Certify(ctx context.Context, query certifier.QueryComponents, emitter certi
 	}
 	for len(compChan) > 0 {
 		d := <-compChan
-		if err := generateDocuments(ctx, d, emitter, handleErr); err != nil {
+		if err := generateDocuments(ctx, certifiers, d, emitter, handleErr); err != nil {
 			logger.Errorf("generate certifier documents error: %w", err)
 		}
 	}
@@ -91,23 +115,23 @@ func Certify(ctx context.Context, query certifier.QueryComponents, emitter certi
 
 // generateDocuments runs CertifyVulns as a goroutine to scan and generates attestations that
 // are emitted as processor documents to be ingested
-func generateDocuments(ctx context.Context, collectedComponent interface{}, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
+func generateDocuments(ctx context.Context, certifiers map[certifier.CertifierType]func() certifier.Certifier, collectedComponent interface{}, emitter certifier.Emitter, handleErr certifier.ErrHandler) error {
 
 	// docChan to collect artifacts
 	docChan := make(chan *processor.Document, BufferChannelSize)
 	// errChan to receive error from collectors
-	errChan := make(chan error, len(documentCertifier))
+	errChan := make(chan error, len(certifiers))
 	// logger
 	logger := logging.FromContext(ctx)
 
-	for _, certifier := range documentCertifier {
+	for _, certifier := range certifiers {
 		c := certifier()
 		go func() {
 			errChan <- c.CertifyComponent(ctx, collectedComponent, docChan)
 		}()
 	}
 
-	numCertifiers := len(documentCertifier)
+	numCertifiers := len(certifiers)
 	certifiersDone := 0
 	for certifiersDone < numCertifiers {
 		select {
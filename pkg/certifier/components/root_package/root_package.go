@@ -17,12 +17,14 @@ package root_package
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"sort"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/assembler"
-	"github.com/guacsec/guac/pkg/assembler/graphdb"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/certifier"
-	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
 )
 
 // PackageComponent represents the top level package node and its dependencies
@@ -31,82 +33,218 @@ type PackageComponent struct {
 	DepPackages []*PackageComponent
 }
 
+// Walk calls visit on the component and each of its transitive
+// dependencies, dependencies first. Each package is visited once, even when
+// the dependencies form a cycle. Walking stops at the first error.
+func (c *PackageComponent) Walk(visit func(*PackageComponent) error) error {
+	return c.walk(visit, map[string]bool{})
+}
+
+func (c *PackageComponent) walk(visit func(*PackageComponent) error, visited map[string]bool) error {
+	if visited[c.Package.Purl] {
+		return nil
+	}
+	visited[c.Package.Purl] = true
+	for _, dep := range c.DepPackages {
+		if err := dep.walk(visit, visited); err != nil {
+			return err
+		}
+	}
+	return visit(c)
+}
+
 type packageQuery struct {
-	client graphdb.Client
+	client graphql.Client
 }
 
-// NewPackageQuery initializes the packageQuery to query from the graph database
-func NewPackageQuery(client graphdb.Client) certifier.QueryComponents {
+// NewPackageQuery initializes the packageQuery to query from the GraphQL server
+func NewPackageQuery(client graphql.Client) certifier.QueryComponents {
 	return &packageQuery{
 		client: client,
 	}
 }
 
+// packageGraph is the dependency graph of the package versions, identified
+// by their purl
+type packageGraph struct {
+	// versions lists the versions of each package name
+	versions map[string][]pkgVersion
+	// deps lists the purls of the dependencies of each package version
+	deps map[string][]string
+	// dependedOnBy lists the purls of the package versions depending on
+	// each package version
+	dependedOnBy map[string][]string
+	// digests lists the digests, as "algorithm:digest", of the artifacts
+	// each package version occurs as
+	digests map[string][]string
+}
+
+type pkgVersion struct {
+	purl    string
+	version string
+}
+
 // GetComponents runs as a goroutine to query for root level and dependent packages to scan and passes them
 // to the compChan as they are found. The interface will be type "*PackageComponent"
+//
+// The root level packages are the package versions no other package depends
+// on. Dependencies are recorded on package names: the dependency is on the
+// versions satisfying the version range, if any, or else on all the known
+// versions of the package. Package versions only depended on through a
+// dependency cycle are passed as root level packages too, so that they are
+// scanned. The digests of the artifacts the packages occur as are attached to
+// the components.
 func (q *packageQuery) GetComponents(ctx context.Context, compChan chan<- interface{}) error {
-	// Get top level package MATCH (p:Package) WHERE NOT (p)<-[:DependsOn]-() return p
-	// Get all packages that the top level package depends on MATCH (p:Package) WHERE NOT (p)<-[:DependsOn]-() WITH p MATCH (p)-[:DependsOn]->(p2:Package) return p2
-	// MATCH (p:Package) WHERE p.purl = "pkg:oci/vul-image-latest?repository_url=ppatel1989" WITH p MATCH (p)-[:DependsOn]->(p2:Package) return p2
+	if compChan == nil {
+		return fmt.Errorf("compChan cannot be nil")
+	}
 
-	roots, err := graphdb.ReadQuery(q.client, "MATCH (p:Package) WHERE NOT (p)<-[:DependsOn]-() return p", nil)
+	pkgs, err := generated.Packages(ctx, q.client, generated.PkgSpec{})
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to query packages: %w", err)
 	}
-	for _, result := range roots {
-		foundNode, ok := result.(dbtype.Node)
-		if !ok {
-			return errors.New("failed to cast to node type")
-		}
-		visited := map[int64]bool{foundNode.Id: true}
-		rootPackage := assembler.PackageNode{}
-		rootPackage.Purl, ok = foundNode.Props["purl"].(string)
-		if !ok {
-			return errors.New("failed to cast purl property to string type")
+	graph := &packageGraph{
+		versions:     map[string][]pkgVersion{},
+		deps:         map[string][]string{},
+		dependedOnBy: map[string][]string{},
+		digests:      map[string][]string{},
+	}
+	var purls []string
+	for _, pkg := range pkgs.Packages {
+		for _, ns := range pkg.Namespaces {
+			for _, name := range ns.Names {
+				key := helpers.PackageKey(pkg.Type, ns.Namespace, name.Name)
+				for _, v := range name.Versions {
+					purl := helpers.PkgVersionToPurl(pkg.Type, ns.Namespace, name.Name, &v)
+					graph.versions[key] = append(graph.versions[key], pkgVersion{purl: purl, version: v.Version})
+					purls = append(purls, purl)
+				}
+			}
 		}
-		deps, err := getCompHelper(ctx, q.client, rootPackage.Purl, visited)
-		if err != nil {
-			return err
+	}
+
+	dependencies, err := generated.Dependencies(ctx, q.client, generated.IsDependencySpec{})
+	if err != nil {
+		return fmt.Errorf("failed to query dependencies: %w", err)
+	}
+	for _, dep := range dependencies.IsDependency {
+		var dependents []string
+		depPkg := dep.DependentPackage
+		for _, ns := range depPkg.Namespaces {
+			for _, name := range ns.Names {
+				dependents = append(dependents, graph.resolve(depPkg.Type, helpers.PackageKey(depPkg.Type, ns.Namespace, name.Name), dep.VersionRange)...)
+			}
 		}
-		rootComponent := &PackageComponent{
-			Package:     rootPackage,
-			DepPackages: deps,
+		for _, purl := range helpers.AllPkgTreeToPurls(&dep.Package.AllPkgTree) {
+			graph.addDependencies(purl, dependents)
 		}
-		compChan <- rootComponent
 	}
-	return nil
-}
 
-func getCompHelper(ctx context.Context, client graphdb.Client, parentPurl string, visited map[int64]bool) ([]*PackageComponent, error) {
-	dependencies, err := graphdb.ReadQuery(client, "MATCH (p:Package) WHERE p.purl = $rootPurl WITH p MATCH (p)-[:DependsOn]->(p2:Package) return p2",
-		map[string]any{"rootPurl": parentPurl})
+	occurrences, err := generated.Occurrences(ctx, q.client, generated.IsOccurrenceSpec{})
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to query occurrences: %w", err)
 	}
-	depPackages := []*PackageComponent{}
-	for _, dep := range dependencies {
-		foundDep, ok := dep.(dbtype.Node)
+	for _, occurrence := range occurrences.IsOccurrence {
+		pkg, ok := occurrence.Subject.(*generated.OccurrencesIsOccurrenceSubjectPackage)
 		if !ok {
-			return nil, errors.New("failed to cast to node type")
+			continue
+		}
+		digest := occurrence.Artifact.Algorithm + ":" + occurrence.Artifact.Digest
+		for _, purl := range helpers.AllPkgTreeToPurls(&pkg.AllPkgTree) {
+			graph.digests[purl] = append(graph.digests[purl], digest)
 		}
-		if visited[foundDep.Id] {
+	}
+
+	sort.Strings(purls)
+	reached := map[string]bool{}
+	emit := func(root string) {
+		visited := map[string]bool{root: true}
+		component := &PackageComponent{
+			Package:     assembler.PackageNode{Purl: root, Digest: graph.digests[root]},
+			DepPackages: graph.getCompHelper(root, visited),
+		}
+		for purl := range visited {
+			reached[purl] = true
+		}
+		compChan <- component
+	}
+	for _, purl := range purls {
+		if len(graph.dependedOnBy[purl]) == 0 {
+			emit(purl)
+		}
+	}
+	// the package versions only depended on through a dependency cycle are
+	// not reached from the root level packages
+	for _, purl := range purls {
+		if !reached[purl] {
+			emit(graph.cycleRoot(purl))
+		}
+	}
+	return nil
+}
+
+// resolve returns the purls of the versions of the package name satisfying
+// the version range. All the versions are returned if the range is empty or
+// cannot be parsed, or if none of the versions satisfies it.
+func (g *packageGraph) resolve(pkgType, key string, versionRange string) []string {
+	var purls, matching []string
+	for _, v := range g.versions[key] {
+		purls = append(purls, v.purl)
+		if versionRange == "" {
 			continue
 		}
-		visited[foundDep.Id] = true
+		if match, ok := helpers.MatchVersionRange(pkgType, v.version, versionRange); ok && match {
+			matching = append(matching, v.purl)
+		}
+	}
+	if len(matching) > 0 {
+		purls = matching
+	}
+	sort.Strings(purls)
+	return purls
+}
 
-		foundDepPack := assembler.PackageNode{}
-		foundDepPack.Purl, ok = foundDep.Props["purl"].(string)
-		if !ok {
-			return nil, errors.New("failed to cast purl property to string type")
+func (g *packageGraph) addDependencies(purl string, dependents []string) {
+	seen := map[string]bool{}
+	for _, d := range g.deps[purl] {
+		seen[d] = true
+	}
+	for _, d := range dependents {
+		if d == purl || seen[d] {
+			continue
 		}
-		deps, err := getCompHelper(ctx, client, foundDepPack.Purl, visited)
-		if err != nil {
-			return nil, err
+		seen[d] = true
+		g.deps[purl] = append(g.deps[purl], d)
+		g.dependedOnBy[d] = append(g.dependedOnBy[d], purl)
+	}
+}
+
+// cycleRoot returns a package version of a dependency cycle the package
+// version is reached from, following the package versions depending on it
+// until one repeats. Only called for package versions not reached from a
+// root level package, which are all depended on.
+func (g *packageGraph) cycleRoot(purl string) string {
+	seen := map[string]bool{}
+	for !seen[purl] {
+		seen[purl] = true
+		dependedOnBy := g.dependedOnBy[purl]
+		sort.Strings(dependedOnBy)
+		purl = dependedOnBy[0]
+	}
+	return purl
+}
+
+func (g *packageGraph) getCompHelper(parentPurl string, visited map[string]bool) []*PackageComponent {
+	depPackages := []*PackageComponent{}
+	for _, dep := range g.deps[parentPurl] {
+		if visited[dep] {
+			continue
 		}
+		visited[dep] = true
 		depPackages = append(depPackages, &PackageComponent{
-			Package:     foundDepPack,
-			DepPackages: deps,
+			Package:     assembler.PackageNode{Purl: dep, Digest: g.digests[dep]},
+			DepPackages: g.getCompHelper(dep, visited),
 		})
 	}
-	return depPackages, nil
+	return depPackages
 }
@@ -0,0 +1,269 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package root_package
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/pkg/assembler"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	gqlgenerated "github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// newGraphQLClient starts a GraphQL server over an empty in-memory backend
+func newGraphQLClient(t *testing.T) graphql.Client {
+	backend, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("GetEmptyBackend() error = %v", err)
+	}
+	config := gqlgenerated.Config{Resolvers: &resolvers.Resolver{Backend: backend}}
+	srv := httptest.NewServer(handler.NewDefaultServer(gqlgenerated.NewExecutableSchema(config)))
+	t.Cleanup(srv.Close)
+	return graphql.NewClient(srv.URL, http.DefaultClient)
+}
+
+func pkgSpec(name, version string) generated.PkgInputSpec {
+	ns := ""
+	return generated.PkgInputSpec{Type: "npm", Namespace: &ns, Name: name, Version: &version}
+}
+
+func TestPackageQuery_GetComponents(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := newGraphQLClient(t)
+
+	dependencies := []struct {
+		pkg, depPkg  generated.PkgInputSpec
+		versionRange string
+	}{{
+		pkg:          pkgSpec("app", "1.0"),
+		depPkg:       pkgSpec("lib", "1.2.0"),
+		versionRange: "1.2.0",
+	}, {
+		// SBOMs do not record the version range
+		pkg:    pkgSpec("lib", "1.2.0"),
+		depPkg: pkgSpec("base", "1.0"),
+	}, {
+		pkg:          pkgSpec("other", "1.0"),
+		depPkg:       pkgSpec("base", "2.0"),
+		versionRange: "2.0",
+	}, {
+		// cycles are only visited once
+		pkg:          pkgSpec("base", "2.0"),
+		depPkg:       pkgSpec("lib", "1.2.0"),
+		versionRange: "1.2.0",
+	}}
+	for _, d := range dependencies {
+		_, err := generated.IsDependency(ctx, client, d.pkg, d.depPkg, generated.IsDependencyInputSpec{
+			VersionRange:  d.versionRange,
+			Justification: "test",
+			Origin:        "test",
+			Collector:     "test",
+		})
+		if err != nil {
+			t.Fatalf("IsDependency() error = %v", err)
+		}
+	}
+
+	compChan := make(chan interface{}, 10)
+	if err := NewPackageQuery(client).GetComponents(ctx, compChan); err != nil {
+		t.Fatalf("GetComponents() error = %v", err)
+	}
+	close(compChan)
+
+	var got []*PackageComponent
+	for c := range compChan {
+		got = append(got, c.(*PackageComponent))
+	}
+
+	node := func(purl string, deps ...*PackageComponent) *PackageComponent {
+		if deps == nil {
+			deps = []*PackageComponent{}
+		}
+		return &PackageComponent{Package: assembler.PackageNode{Purl: purl}, DepPackages: deps}
+	}
+	want := []*PackageComponent{
+		node("pkg:npm/app@1.0",
+			node("pkg:npm/lib@1.2.0",
+				node("pkg:npm/base@1.0"),
+				node("pkg:npm/base@2.0"))),
+		node("pkg:npm/other@1.0",
+			node("pkg:npm/base@2.0",
+				node("pkg:npm/lib@1.2.0",
+					node("pkg:npm/base@1.0")))),
+	}
+	if d := cmp.Diff(want, got, cmpopts.IgnoreFields(assembler.PackageNode{}, "NodeData")); d != "" {
+		t.Errorf("GetComponents() mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestPackageQuery_GetComponents_digests(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := newGraphQLClient(t)
+
+	_, err := generated.IsDependency(ctx, client, pkgSpec("app", "1.0"), pkgSpec("lib", "1.2.0"), generated.IsDependencyInputSpec{
+		VersionRange:  "1.2.0",
+		Justification: "test",
+		Origin:        "test",
+		Collector:     "test",
+	})
+	if err != nil {
+		t.Fatalf("IsDependency() error = %v", err)
+	}
+	_, err = generated.IsOccurrencePkg(ctx, client, pkgSpec("lib", "1.2.0"), generated.ArtifactInputSpec{
+		Algorithm: "sha256",
+		Digest:    "6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf",
+	}, generated.IsOccurrenceInputSpec{
+		Justification: "test",
+		Origin:        "test",
+		Collector:     "test",
+	})
+	if err != nil {
+		t.Fatalf("IsOccurrencePkg() error = %v", err)
+	}
+
+	compChan := make(chan interface{}, 10)
+	if err := NewPackageQuery(client).GetComponents(ctx, compChan); err != nil {
+		t.Fatalf("GetComponents() error = %v", err)
+	}
+	close(compChan)
+
+	var got []*PackageComponent
+	for c := range compChan {
+		got = append(got, c.(*PackageComponent))
+	}
+	want := []*PackageComponent{{
+		Package: assembler.PackageNode{Purl: "pkg:npm/app@1.0"},
+		DepPackages: []*PackageComponent{{
+			Package: assembler.PackageNode{
+				Purl:   "pkg:npm/lib@1.2.0",
+				Digest: []string{"sha256:6bbb0da1891646e58eb3e6a63af3a6fc3c8eb5a0d44824cba581d2e14a0450cf"},
+			},
+			DepPackages: []*PackageComponent{},
+		}},
+	}}
+	if d := cmp.Diff(want, got, cmpopts.IgnoreFields(assembler.PackageNode{}, "NodeData")); d != "" {
+		t.Errorf("GetComponents() mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestPackageQuery_GetComponents_versionRanges(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := newGraphQLClient(t)
+
+	dependencies := []struct {
+		pkg, depPkg  generated.PkgInputSpec
+		versionRange string
+	}{{
+		pkg:          pkgSpec("app", "1.0"),
+		depPkg:       pkgSpec("lib", "1.2.0"),
+		versionRange: "^1.1.0",
+	}, {
+		pkg:          pkgSpec("other", "1.0"),
+		depPkg:       pkgSpec("lib", "2.0.0"),
+		versionRange: ">=2.0.0 <3.0.0",
+	}, {
+		// a pure dependency cycle, with no root level package
+		pkg:          pkgSpec("ping", "1.0"),
+		depPkg:       pkgSpec("pong", "1.0"),
+		versionRange: "1.0",
+	}, {
+		pkg:          pkgSpec("pong", "1.0"),
+		depPkg:       pkgSpec("ping", "1.0"),
+		versionRange: "~1.0",
+	}}
+	for _, d := range dependencies {
+		_, err := generated.IsDependency(ctx, client, d.pkg, d.depPkg, generated.IsDependencyInputSpec{
+			VersionRange:  d.versionRange,
+			Justification: "test",
+			Origin:        "test",
+			Collector:     "test",
+		})
+		if err != nil {
+			t.Fatalf("IsDependency() error = %v", err)
+		}
+	}
+
+	compChan := make(chan interface{}, 10)
+	if err := NewPackageQuery(client).GetComponents(ctx, compChan); err != nil {
+		t.Fatalf("GetComponents() error = %v", err)
+	}
+	close(compChan)
+
+	var got []*PackageComponent
+	for c := range compChan {
+		got = append(got, c.(*PackageComponent))
+	}
+
+	node := func(purl string, deps ...*PackageComponent) *PackageComponent {
+		if deps == nil {
+			deps = []*PackageComponent{}
+		}
+		return &PackageComponent{Package: assembler.PackageNode{Purl: purl}, DepPackages: deps}
+	}
+	want := []*PackageComponent{
+		node("pkg:npm/app@1.0",
+			node("pkg:npm/lib@1.2.0")),
+		node("pkg:npm/other@1.0",
+			node("pkg:npm/lib@2.0.0")),
+		node("pkg:npm/ping@1.0",
+			node("pkg:npm/pong@1.0")),
+	}
+	if d := cmp.Diff(want, got, cmpopts.IgnoreFields(assembler.PackageNode{}, "NodeData")); d != "" {
+		t.Errorf("GetComponents() mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestPackageComponent_Walk(t *testing.T) {
+	component := func(purl string) *PackageComponent {
+		return &PackageComponent{Package: assembler.PackageNode{Purl: purl}}
+	}
+	a, b, c := component("pkg:npm/a"), component("pkg:npm/b"), component("pkg:npm/c")
+	a.DepPackages = []*PackageComponent{b, c}
+	b.DepPackages = []*PackageComponent{c, a}
+	c.DepPackages = []*PackageComponent{a}
+
+	var got []string
+	if err := a.Walk(func(p *PackageComponent) error {
+		got = append(got, p.Package.Purl)
+		return nil
+	}); err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+	if want := []string{"pkg:npm/c", "pkg:npm/b", "pkg:npm/a"}; !cmp.Equal(want, got) {
+		t.Errorf("Walk() visited %v, want %v", got, want)
+	}
+
+	errStop := errors.New("stop")
+	visits := 0
+	err := a.Walk(func(p *PackageComponent) error {
+		visits++
+		return errStop
+	})
+	if err != errStop || visits != 1 {
+		t.Errorf("Walk() = %v after %d visits, want %v after 1", err, visits, errStop)
+	}
+}
@@ -0,0 +1,88 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package root_package
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/certifier"
+)
+
+type packageScanTracker struct{}
+
+// NewPackageScanTracker returns a ScanTracker of the package components for
+// the certifiers that only record in the GUAC graph the packages they find
+// something about, such as the malicious and end of life certifiers. Their
+// scan times are only known from the state of the scheduler.
+func NewPackageScanTracker() certifier.ScanTracker {
+	return packageScanTracker{}
+}
+
+// ComponentKeys returns the purls of the package and of all its dependencies,
+// as they are all certified along with the package
+func (packageScanTracker) ComponentKeys(component interface{}) ([]string, error) {
+	pkg, ok := component.(*PackageComponent)
+	if !ok {
+		return nil, fmt.Errorf("component type is not *root_package.PackageComponent")
+	}
+	var keys []string
+	_ = pkg.Walk(func(p *PackageComponent) error {
+		keys = append(keys, p.Package.Purl)
+		return nil
+	})
+	return keys, nil
+}
+
+// LastScanned returns no scan times, none being recorded in the GUAC graph
+func (packageScanTracker) LastScanned(ctx context.Context) (map[string]time.Time, error) {
+	return map[string]time.Time{}, nil
+}
+
+type vulnScanTracker struct {
+	packageScanTracker
+	client graphql.Client
+}
+
+// NewVulnScanTracker returns a ScanTracker of the package components, based on
+// the time the packages were last scanned for vulnerabilities
+func NewVulnScanTracker(client graphql.Client) certifier.ScanTracker {
+	return &vulnScanTracker{
+		client: client,
+	}
+}
+
+// LastScanned returns the time of the latest vulnerability scan of each package purl
+func (t *vulnScanTracker) LastScanned(ctx context.Context) (map[string]time.Time, error) {
+	resp, err := generated.CertifyVulns(ctx, t.client, generated.CertifyVulnSpec{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vulnerability certifications: %w", err)
+	}
+	lastScanned := map[string]time.Time{}
+	for _, certifyVuln := range resp.CertifyVuln {
+		scanned := certifyVuln.Metadata.TimeScanned
+		for _, purl := range helpers.AllPkgTreeToPurls(&certifyVuln.Package.AllPkgTree) {
+			if scanned.After(lastScanned[purl]) {
+				lastScanned[purl] = scanned
+			}
+		}
+	}
+	return lastScanned, nil
+}
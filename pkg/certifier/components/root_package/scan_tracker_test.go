@@ -0,0 +1,107 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package root_package
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func TestVulnScanTracker(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := newGraphQLClient(t)
+
+	older := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	scans := []struct {
+		pkg     generated.PkgInputSpec
+		scanned time.Time
+	}{
+		{pkg: pkgSpec("app", "1.0"), scanned: older},
+		{pkg: pkgSpec("app", "1.0"), scanned: newer},
+		{pkg: pkgSpec("lib", "1.2.0"), scanned: older},
+	}
+	for _, s := range scans {
+		_, err := generated.CertifyNoKnownVuln(ctx, client, s.pkg, generated.VulnerabilityMetaDataInput{
+			TimeScanned: s.scanned,
+			Origin:      "test",
+			Collector:   "test",
+		})
+		if err != nil {
+			t.Fatalf("CertifyNoKnownVuln() error = %v", err)
+		}
+	}
+
+	tracker := NewVulnScanTracker(client)
+	got, err := tracker.LastScanned(ctx)
+	if err != nil {
+		t.Fatalf("LastScanned() error = %v", err)
+	}
+	want := map[string]time.Time{
+		"pkg:npm/app@1.0":   newer,
+		"pkg:npm/lib@1.2.0": older,
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("LastScanned() mismatch (-want +got):\n%s", d)
+	}
+
+	lib := &PackageComponent{Package: assembler.PackageNode{Purl: "pkg:npm/lib@1.2.0"}}
+	component := &PackageComponent{
+		Package:     assembler.PackageNode{Purl: "pkg:npm/app@1.0"},
+		DepPackages: []*PackageComponent{lib, {Package: assembler.PackageNode{Purl: "pkg:npm/other@1.0"}, DepPackages: []*PackageComponent{lib}}},
+	}
+	keys, err := tracker.ComponentKeys(component)
+	if err != nil {
+		t.Fatalf("ComponentKeys() error = %v", err)
+	}
+	if d := cmp.Diff([]string{"pkg:npm/lib@1.2.0", "pkg:npm/other@1.0", "pkg:npm/app@1.0"}, keys); d != "" {
+		t.Errorf("ComponentKeys() mismatch (-want +got):\n%s", d)
+	}
+	if _, err := tracker.ComponentKeys(&assembler.ArtifactNode{}); err == nil {
+		t.Errorf("ComponentKeys() expected an error for an artifact component")
+	}
+}
+
+func TestPackageScanTracker(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tracker := NewPackageScanTracker()
+
+	got, err := tracker.LastScanned(ctx)
+	if err != nil {
+		t.Fatalf("LastScanned() error = %v", err)
+	}
+	if len(got) > 0 {
+		t.Errorf("LastScanned() = %v, want no scan times", got)
+	}
+
+	component := &PackageComponent{
+		Package:     assembler.PackageNode{Purl: "pkg:npm/app@1.0"},
+		DepPackages: []*PackageComponent{{Package: assembler.PackageNode{Purl: "pkg:npm/lib@1.2.0"}}},
+	}
+	keys, err := tracker.ComponentKeys(component)
+	if err != nil {
+		t.Fatalf("ComponentKeys() error = %v", err)
+	}
+	if d := cmp.Diff([]string{"pkg:npm/lib@1.2.0", "pkg:npm/app@1.0"}, keys); d != "" {
+		t.Errorf("ComponentKeys() mismatch (-want +got):\n%s", d)
+	}
+}
@@ -0,0 +1,81 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source_artifact
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/certifier"
+)
+
+type scorecardScanTracker struct {
+	client graphql.Client
+}
+
+// NewScorecardScanTracker returns a ScanTracker of the source components, based
+// on the time the sources were last evaluated by scorecard
+func NewScorecardScanTracker(client graphql.Client) certifier.ScanTracker {
+	return &scorecardScanTracker{
+		client: client,
+	}
+}
+
+// ComponentKeys returns the repository and commit of the source. Sources at
+// the head of their default branch are identified by their repository only, as
+// their scorecards record the commit that was evaluated.
+func (t *scorecardScanTracker) ComponentKeys(component interface{}) ([]string, error) {
+	artifactNode, ok := component.(*assembler.ArtifactNode)
+	if !ok {
+		return nil, fmt.Errorf("component type is not *assembler.ArtifactNode")
+	}
+	if artifactNode.Digest == headCommit {
+		return []string{artifactNode.Name}, nil
+	}
+	return []string{artifactNode.Name + "@" + artifactNode.Digest}, nil
+}
+
+// LastScanned returns the time of the latest scorecard of each repository and
+// of each of its commits
+func (t *scorecardScanTracker) LastScanned(ctx context.Context) (map[string]time.Time, error) {
+	resp, err := generated.Scorecards(ctx, t.client, generated.CertifyScorecardSpec{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scorecards: %w", err)
+	}
+	lastScanned := map[string]time.Time{}
+	update := func(key string, scanned time.Time) {
+		if scanned.After(lastScanned[key]) {
+			lastScanned[key] = scanned
+		}
+	}
+	for _, scorecard := range resp.Scorecards {
+		scanned := scorecard.Scorecard.TimeScanned
+		for _, namespace := range scorecard.Source.Namespaces {
+			for _, name := range namespace.Names {
+				repo := repoName(namespace.Namespace, name.Name)
+				update(repo, scanned)
+				if name.Commit != nil && *name.Commit != "" {
+					update(repo+"@"+*name.Commit, scanned)
+				}
+			}
+		}
+	}
+	return lastScanned, nil
+}
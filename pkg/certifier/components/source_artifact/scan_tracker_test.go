@@ -0,0 +1,102 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source_artifact
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func TestScorecardScanTracker(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := newGraphQLClient(t)
+
+	older := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	first := "4e3f1a0c"
+	second := "9b2d7e51"
+	scans := []struct {
+		commit  string
+		scanned time.Time
+	}{
+		{commit: first, scanned: older},
+		{commit: second, scanned: newer},
+	}
+	for _, s := range scans {
+		commit := s.commit
+		_, err := generated.Scorecard(ctx, client,
+			generated.SourceInputSpec{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Commit: &commit},
+			generated.ScorecardInputSpec{
+				Checks:      []generated.ScorecardCheckInputSpec{},
+				TimeScanned: s.scanned,
+				Origin:      "test",
+				Collector:   "test",
+			})
+		if err != nil {
+			t.Fatalf("Scorecard() error = %v", err)
+		}
+	}
+
+	tracker := NewScorecardScanTracker(client)
+	got, err := tracker.LastScanned(ctx)
+	if err != nil {
+		t.Fatalf("LastScanned() error = %v", err)
+	}
+	want := map[string]time.Time{
+		"git+https://github.com/guacsec/guac":           newer,
+		"git+https://github.com/guacsec/guac@" + first:  older,
+		"git+https://github.com/guacsec/guac@" + second: newer,
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Errorf("LastScanned() mismatch (-want +got):\n%s", d)
+	}
+
+	tests := []struct {
+		name      string
+		component interface{}
+		want      []string
+		wantErr   bool
+	}{{
+		name:      "commit",
+		component: &assembler.ArtifactNode{Name: "git+https://github.com/guacsec/guac", Digest: first},
+		want:      []string{"git+https://github.com/guacsec/guac@" + first},
+	}, {
+		name:      "head of the default branch",
+		component: &assembler.ArtifactNode{Name: "git+https://github.com/guacsec/guac", Digest: "HEAD"},
+		want:      []string{"git+https://github.com/guacsec/guac"},
+	}, {
+		name:      "unknown component",
+		component: &assembler.PackageNode{},
+		wantErr:   true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tracker.ComponentKeys(tt.component)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ComponentKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if d := cmp.Diff(tt.want, got); d != "" {
+				t.Errorf("ComponentKeys() mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+}
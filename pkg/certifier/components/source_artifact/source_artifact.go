@@ -18,48 +18,68 @@ package source_artifact
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/guacsec/guac/pkg/assembler"
-	"github.com/guacsec/guac/pkg/assembler/graphdb"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/certifier"
-	"github.com/neo4j/neo4j-go-driver/v4/neo4j/dbtype"
+)
+
+// scorecard can only evaluate repositories hosted on GitHub, at a commit or
+// at the head of the default branch
+const (
+	githubNamespace = "github.com"
+	headCommit      = "HEAD"
 )
 
 type sourceArtifacts struct {
-	client graphdb.Client
+	client graphql.Client
 }
 
-func (s sourceArtifacts) GetComponents(_ context.Context, compChan chan<- interface{}) error {
-	// TODO: Add integration tests, have not added it yet because the code needs data to be present in the graphdb to test it
+func (s sourceArtifacts) GetComponents(ctx context.Context, compChan chan<- interface{}) error {
 	if compChan == nil {
 		return fmt.Errorf("compChan cannot be nil")
 	}
-	roots, err := graphdb.ReadQuery(s.client, "MATCH (n:Artifact) WHERE n.name CONTAINS 'git+' RETURN n", nil)
+	gitType := "git"
+	response, err := generated.Sources(ctx, s.client, generated.SourceSpec{Type: &gitType})
 	if err != nil {
-		return fmt.Errorf("failed to read query: %w", err)
+		return fmt.Errorf("failed to query sources: %w", err)
 	}
 
-	for _, result := range roots {
-		foundNode, ok := result.(dbtype.Node)
-		if !ok {
-			return fmt.Errorf("failed to cast to node type")
-		}
-		artifactNode := assembler.ArtifactNode{}
-		artifactNode.Name, ok = foundNode.Props["name"].(string)
-		if !ok {
-			return fmt.Errorf("failed to cast name property to string type")
+	seen := map[string]bool{}
+	for _, src := range response.Sources {
+		for _, namespace := range src.Namespaces {
+			if namespace.Namespace != githubNamespace && !strings.HasPrefix(namespace.Namespace, githubNamespace+"/") {
+				continue
+			}
+			for _, name := range namespace.Names {
+				artifactNode := assembler.ArtifactNode{
+					Name:   repoName(namespace.Namespace, name.Name),
+					Digest: headCommit,
+				}
+				if name.Commit != nil && *name.Commit != "" {
+					artifactNode.Digest = *name.Commit
+				}
+				key := artifactNode.Name + "@" + artifactNode.Digest
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				compChan <- &artifactNode
+			}
 		}
-		artifactNode.Digest, ok = foundNode.Props["digest"].(string)
-		if !ok {
-			return fmt.Errorf("failed to cast digest property to string type")
-		}
-		compChan <- &artifactNode
 	}
 	return nil
 }
 
+// repoName returns the name of the artifact representing a source repository
+func repoName(namespace, name string) string {
+	return "git+https://" + namespace + "/" + name
+}
+
 // NewCertifier returns a new sourceArtifacts certifier
-func NewCertifier(client graphdb.Client) (certifier.QueryComponents, error) {
+func NewCertifier(client graphql.Client) (certifier.QueryComponents, error) {
 	if client == nil {
 		return nil, fmt.Errorf("client cannot be nil")
 	}
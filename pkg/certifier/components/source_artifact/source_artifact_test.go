@@ -0,0 +1,95 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package source_artifact
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/pkg/assembler"
+	inmem "github.com/guacsec/guac/pkg/assembler/backends/testing"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	gqlgenerated "github.com/guacsec/guac/pkg/assembler/graphql/generated"
+	"github.com/guacsec/guac/pkg/assembler/graphql/resolvers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// newGraphQLClient starts a GraphQL server over an empty in-memory backend
+func newGraphQLClient(t *testing.T) graphql.Client {
+	backend, err := inmem.GetEmptyBackend(&inmem.DemoCredentials{})
+	if err != nil {
+		t.Fatalf("GetEmptyBackend() error = %v", err)
+	}
+	config := gqlgenerated.Config{Resolvers: &resolvers.Resolver{Backend: backend}}
+	srv := httptest.NewServer(handler.NewDefaultServer(gqlgenerated.NewExecutableSchema(config)))
+	t.Cleanup(srv.Close)
+	return graphql.NewClient(srv.URL, http.DefaultClient)
+}
+
+func TestSourceArtifacts_GetComponents(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	client := newGraphQLClient(t)
+
+	commit := "c7e1a6ac7d09b8ea1b61e1b8b4b3c2a1e3f5a7d9"
+	tag := "v1.0.0"
+	sources := []generated.SourceInputSpec{
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Commit: &commit},
+		{Type: "git", Namespace: "github.com/guacsec", Name: "guac", Tag: &tag},
+		// not hosted on GitHub
+		{Type: "git", Namespace: "gitlab.com/example", Name: "project"},
+		// not a git repository
+		{Type: "svn", Namespace: "github.com/example", Name: "project"},
+	}
+	for _, src := range sources {
+		_, err := generated.HasSBOMSrc(ctx, client, src, generated.HasSBOMInputSpec{
+			Uri:       "test",
+			Origin:    "test",
+			Collector: "test",
+		})
+		if err != nil {
+			t.Fatalf("HasSBOMSrc() error = %v", err)
+		}
+	}
+
+	qc, err := NewCertifier(client)
+	if err != nil {
+		t.Fatalf("NewCertifier() error = %v", err)
+	}
+	compChan := make(chan interface{}, 10)
+	if err := qc.GetComponents(ctx, compChan); err != nil {
+		t.Fatalf("GetComponents() error = %v", err)
+	}
+	close(compChan)
+
+	var got []*assembler.ArtifactNode
+	for c := range compChan {
+		got = append(got, c.(*assembler.ArtifactNode))
+	}
+	want := []*assembler.ArtifactNode{
+		{Name: "git+https://github.com/guacsec/guac", Digest: commit},
+		{Name: "git+https://github.com/guacsec/guac", Digest: "HEAD"},
+	}
+	less := func(a, b *assembler.ArtifactNode) bool { return a.Digest < b.Digest }
+	if d := cmp.Diff(want, got, cmpopts.SortSlices(less), cmpopts.IgnoreFields(assembler.ArtifactNode{}, "NodeData")); d != "" {
+		t.Errorf("GetComponents() mismatch (-want +got):\n%s", d)
+	}
+}
@@ -0,0 +1,331 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eol
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const (
+	// ociNamespace is the namespace prefix of the guac purls created for
+	// container images, e.g. pkg:guac/oci/docker.io/library/python:3.7
+	ociNamespace = "oci"
+	dateLayout   = "2006-01-02"
+)
+
+// Dataset is a local dataset of release cycles in the format of the
+// https://endoflife.date API.
+type Dataset struct {
+	path     string
+	products []*product
+	// identifiers indexes the products declaring identifiers by the type,
+	// namespace and name of the package
+	identifiers map[string][]*product
+	// modified is the last time any file of the dataset was modified
+	modified time.Time
+}
+
+// product is a product of the dataset. Products without identifiers match
+// the packages, or the container images, named after them.
+type product struct {
+	name        string
+	identifiers []string
+	cycles      []*releaseCycle
+}
+
+// releaseCycle is a release cycle as returned by the endoflife.date API,
+// such as https://endoflife.date/api/python.json
+type releaseCycle struct {
+	Cycle    cycleName  `json:"cycle"`
+	Codename string     `json:"codename"`
+	EOL      dateOrBool `json:"eol"`
+	Support  dateOrBool `json:"support"`
+	Latest   cycleName  `json:"latest"`
+}
+
+// productEntry is the value of a product in a dataset file mapping the
+// product names to either their release cycles or to a productEntry. The
+// identifiers are purls without version, e.g. pkg:pypi/django or
+// pkg:guac/oci/docker.io/library/python.
+type productEntry struct {
+	Identifiers []string        `json:"identifiers"`
+	Cycles      []*releaseCycle `json:"cycles"`
+}
+
+// cycleName is a name which the dataset gives either as a string or as a
+// number, e.g. 3.10 or "3.10"
+type cycleName string
+
+func (c *cycleName) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = cycleName(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("expected a string or a number, got %s", data)
+	}
+	*c = cycleName(n)
+	return nil
+}
+
+// dateOrBool is a date of the dataset which is either a date or a boolean
+// when the date is not known
+type dateOrBool struct {
+	date  *time.Time
+	value *bool
+}
+
+func (d *dateOrBool) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		d.value = &b
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("expected a date or a boolean, got %s", data)
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return err
+	}
+	d.date = &t
+	return nil
+}
+
+// LoadDataset loads the dataset found under path. The directory is walked
+// recursively and each JSON file is either the array of release cycles of
+// the product named after the file, as returned by the endoflife.date API,
+// or an object mapping product names to their release cycles.
+func LoadDataset(path string) (*Dataset, error) {
+	dataset := &Dataset{
+		path:        path,
+		identifiers: map[string][]*product{},
+	}
+	err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.ToLower(filepath.Ext(file)) != ".json" {
+			return nil
+		}
+		blob, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if err := dataset.loadJSON(file, blob); err != nil {
+			return fmt.Errorf("unable to load %s: %w", file, err)
+		}
+		if info.ModTime().After(dataset.modified) {
+			dataset.modified = info.ModTime()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load end of life dataset from %s: %w", path, err)
+	}
+	if len(dataset.products) == 0 {
+		return nil, fmt.Errorf("no end of life products found in %s", path)
+	}
+	return dataset, nil
+}
+
+func (ds *Dataset) loadJSON(file string, blob []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(blob), []byte("[")) {
+		var cycles []*releaseCycle
+		if err := json.Unmarshal(blob, &cycles); err != nil {
+			return err
+		}
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		return ds.addProduct(name, nil, cycles)
+	}
+
+	var products map[string]json.RawMessage
+	if err := json.Unmarshal(blob, &products); err != nil {
+		return err
+	}
+	for name, raw := range products {
+		var entry productEntry
+		if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+			if err := json.Unmarshal(raw, &entry.Cycles); err != nil {
+				return fmt.Errorf("invalid product %q: %w", name, err)
+			}
+		} else if err := json.Unmarshal(raw, &entry); err != nil {
+			return fmt.Errorf("invalid product %q: %w", name, err)
+		}
+		if err := ds.addProduct(name, entry.Identifiers, entry.Cycles); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ds *Dataset) addProduct(name string, identifiers []string, cycles []*releaseCycle) error {
+	p := &product{name: strings.ToLower(name), identifiers: identifiers}
+	for _, c := range cycles {
+		if c.Cycle == "" {
+			return fmt.Errorf("release cycle of product %q has no cycle", name)
+		}
+		p.cycles = append(p.cycles, c)
+	}
+	for _, id := range identifiers {
+		key, err := identifierKey(id)
+		if err != nil {
+			return fmt.Errorf("invalid identifier %q of product %q: %w", id, name, err)
+		}
+		ds.identifiers[key] = append(ds.identifiers[key], p)
+	}
+	ds.products = append(ds.products, p)
+	return nil
+}
+
+// Version returns the version of the dataset, which is the last time any
+// of its files was modified
+func (ds *Dataset) Version() string {
+	return ds.modified.UTC().Format(time.RFC3339)
+}
+
+// DB describes the dataset as the database of the attestations
+func (ds *Dataset) DB() attestation_vuln.DB {
+	return attestation_vuln.DB{
+		Uri:     ds.path,
+		Version: ds.Version(),
+	}
+}
+
+// Match returns the release cycles the package belongs to, with their
+// support status at the given time. Container images are matched by their
+// repository and tag, other packages by their name and version. Packages
+// without a version match no cycle.
+func (ds *Dataset) Match(packageURL string, now time.Time) []attestation_vuln.EOLResult {
+	results := []attestation_vuln.EOLResult{}
+
+	p, err := purl.FromString(packageURL)
+	if err != nil {
+		return results
+	}
+	pkgType, namespace, name, version := p.Type, p.Namespace, p.Name, p.Version
+	if pkgType == "guac" {
+		if namespace == ociNamespace || strings.HasPrefix(namespace, ociNamespace+"/") {
+			// the tag of the image is part of the name
+			name, version, _ = strings.Cut(name, ":")
+		}
+	}
+	version = strings.TrimPrefix(version, "v")
+	if version == "" {
+		return results
+	}
+
+	products := ds.identifiers[packageKey(pkgType, namespace, name)]
+	if len(products) == 0 {
+		for _, prod := range ds.products {
+			if len(prod.identifiers) == 0 && prod.name == strings.ToLower(name) {
+				products = append(products, prod)
+			}
+		}
+	}
+
+	for _, prod := range products {
+		c := prod.matchCycle(version)
+		if c == nil {
+			continue
+		}
+		results = append(results, c.result(prod.name, now))
+	}
+	return results
+}
+
+// matchCycle returns the release cycle of the version: the cycle equal to
+// the version or, for the longest matching cycle, the cycle followed by a
+// "." or a "-" (e.g. 3.7.16 or 3.7-slim for the 3.7 cycle). Debian-like
+// codenames match the same way (e.g. bullseye-slim).
+func (p *product) matchCycle(version string) *releaseCycle {
+	version = strings.ToLower(version)
+	var best *releaseCycle
+	bestLen := 0
+	for _, c := range p.cycles {
+		for _, prefix := range []string{strings.ToLower(string(c.Cycle)), strings.ToLower(c.Codename)} {
+			if prefix == "" || len(prefix) <= bestLen {
+				continue
+			}
+			if version == prefix || strings.HasPrefix(version, prefix+".") || strings.HasPrefix(version, prefix+"-") {
+				best = c
+				bestLen = len(prefix)
+			}
+		}
+	}
+	return best
+}
+
+// result returns the release cycle with its support status at the given
+// time. A cycle is EOL once its end of life date is reached, and only
+// receives security fixes once its end of active support is reached.
+func (c *releaseCycle) result(productName string, now time.Time) attestation_vuln.EOLResult {
+	status := attestation_vuln.EOLStatusSupported
+	if c.Support.reached(now, false) {
+		status = attestation_vuln.EOLStatusSecurityOnly
+	}
+	if c.EOL.reached(now, true) {
+		status = attestation_vuln.EOLStatusEOL
+	}
+	return attestation_vuln.EOLResult{
+		Product: productName,
+		Cycle:   string(c.Cycle),
+		Status:  status,
+		EOL:     c.EOL.date,
+		Support: c.Support.date,
+		Latest:  string(c.Latest),
+	}
+}
+
+// reached returns true if the date is reached at the given time. When the
+// dataset only gives a boolean, reachedValue is the value meaning the date
+// is reached: true for the eol field, false for the support field, which
+// tells whether the cycle is actively supported.
+func (d dateOrBool) reached(now time.Time, reachedValue bool) bool {
+	if d.date != nil {
+		return !now.Before(*d.date)
+	}
+	return d.value != nil && *d.value == reachedValue
+}
+
+func identifierKey(identifier string) (string, error) {
+	p, err := purl.FromString(identifier)
+	if err != nil {
+		return "", err
+	}
+	return packageKey(p.Type, p.Namespace, p.Name), nil
+}
+
+func packageKey(pkgType, namespace, name string) string {
+	return strings.ToLower(strings.Join([]string{pkgType, namespace, name}, "/"))
+}
@@ -0,0 +1,195 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eol
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+)
+
+const (
+	pythonJSON = `[
+  {"cycle": "3.12", "eol": "2028-10-02", "support": "2025-04-02", "latest": "3.12.0"},
+  {"cycle": "3.10", "eol": "2026-10-04", "support": "2023-04-05", "latest": "3.10.13"},
+  {"cycle": "3.7", "eol": "2023-06-27", "support": "2020-06-27", "latest": "3.7.17"}
+]`
+	productsJSON = `{
+  "debian": [
+    {"cycle": "12", "codename": "Bookworm", "eol": "2026-06-10", "latest": "12.2"},
+    {"cycle": "11", "codename": "Bullseye", "eol": "2024-07-01", "latest": "11.8"}
+  ],
+  "django": {
+    "identifiers": ["pkg:pypi/django"],
+    "cycles": [
+      {"cycle": 4.2, "eol": "2026-04-01", "support": "2023-12-04", "latest": "4.2.7"},
+      {"cycle": "1.11", "eol": true, "support": false, "latest": "1.11.29"}
+    ]
+  },
+  "nodejs": {
+    "identifiers": ["pkg:guac/oci/docker.io/library/node"],
+    "cycles": [
+      {"cycle": "18", "eol": "2025-04-30", "support": "2023-10-18", "latest": "18.18.2"}
+    ]
+  }
+}`
+)
+
+func writeDataset(t *testing.T) string {
+	dir := t.TempDir()
+	files := map[string]string{
+		"python.json":       pythonJSON,
+		"products/all.json": productsJSON,
+		"README.md":         "not loaded",
+	}
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func date(s string) *time.Time {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		panic(err)
+	}
+	return &t
+}
+
+func TestDataset_Match(t *testing.T) {
+	dataset, err := LoadDataset(writeDataset(t))
+	if err != nil {
+		t.Fatalf("LoadDataset() error = %v", err)
+	}
+	now := *date("2023-11-01")
+
+	python37 := attestation_vuln.EOLResult{Product: "python", Cycle: "3.7", Status: attestation_vuln.EOLStatusEOL,
+		EOL: date("2023-06-27"), Support: date("2020-06-27"), Latest: "3.7.17"}
+	bullseye := attestation_vuln.EOLResult{Product: "debian", Cycle: "11", Status: attestation_vuln.EOLStatusSupported,
+		EOL: date("2024-07-01"), Latest: "11.8"}
+	tests := []struct {
+		name string
+		purl string
+		want []attestation_vuln.EOLResult
+	}{{
+		name: "image tag",
+		purl: "pkg:guac/oci/docker.io/library/python:3.7",
+		want: []attestation_vuln.EOLResult{python37},
+	}, {
+		name: "image tag with variant",
+		purl: "pkg:guac/oci/library/python:3.7-slim",
+		want: []attestation_vuln.EOLResult{python37},
+	}, {
+		name: "longest cycle wins",
+		purl: "pkg:guac/oci/docker.io/library/python:3.10.13",
+		want: []attestation_vuln.EOLResult{{Product: "python", Cycle: "3.10", Status: attestation_vuln.EOLStatusSecurityOnly,
+			EOL: date("2026-10-04"), Support: date("2023-04-05"), Latest: "3.10.13"}},
+	}, {
+		name: "image without tag",
+		purl: "pkg:guac/oci/docker.io/library/python",
+		want: []attestation_vuln.EOLResult{},
+	}, {
+		name: "codename",
+		purl: "pkg:guac/oci/docker.io/library/debian:bullseye-slim",
+		want: []attestation_vuln.EOLResult{bullseye},
+	}, {
+		name: "package named after product",
+		purl: "pkg:deb/debian/debian@11.7",
+		want: []attestation_vuln.EOLResult{bullseye},
+	}, {
+		name: "numeric cycle and identifier",
+		purl: "pkg:pypi/django@4.2.1",
+		want: []attestation_vuln.EOLResult{{Product: "django", Cycle: "4.2", Status: attestation_vuln.EOLStatusSupported,
+			EOL: date("2026-04-01"), Support: date("2023-12-04"), Latest: "4.2.7"}},
+	}, {
+		name: "boolean dates",
+		purl: "pkg:pypi/django@1.11.29",
+		want: []attestation_vuln.EOLResult{{Product: "django", Cycle: "1.11", Status: attestation_vuln.EOLStatusEOL, Latest: "1.11.29"}},
+	}, {
+		name: "products with identifiers only match them",
+		purl: "pkg:npm/django@4.2.1",
+		want: []attestation_vuln.EOLResult{},
+	}, {
+		name: "image identifier",
+		purl: "pkg:guac/oci/docker.io/library/node:18-alpine",
+		want: []attestation_vuln.EOLResult{{Product: "nodejs", Cycle: "18", Status: attestation_vuln.EOLStatusSecurityOnly,
+			EOL: date("2025-04-30"), Support: date("2023-10-18"), Latest: "18.18.2"}},
+	}, {
+		name: "unknown cycle",
+		purl: "pkg:pypi/django@5.0",
+		want: []attestation_vuln.EOLResult{},
+	}, {
+		name: "unknown product",
+		purl: "pkg:npm/lodash@4.17.21",
+		want: []attestation_vuln.EOLResult{},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dataset.Match(tt.purl, now)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Match() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadDataset(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{{
+		name:    "empty dataset",
+		files:   map[string]string{"README.md": "nothing"},
+		wantErr: true,
+	}, {
+		name:    "cycle without name",
+		files:   map[string]string{"python.json": `[{"eol": true}]`},
+		wantErr: true,
+	}, {
+		name:    "invalid date",
+		files:   map[string]string{"python.json": `[{"cycle": "3.7", "eol": "June 2023"}]`},
+		wantErr: true,
+	}, {
+		name:    "invalid identifier",
+		files:   map[string]string{"products.json": `{"django": {"identifiers": ["django"], "cycles": []}}`},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := LoadDataset(dir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadDataset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/certifier"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	intoto "github.com/in-toto/in-toto-golang/in_toto"
+)
+
+const (
+	URI         string = "guac/eol"
+	VERSION     string = "0.0.1"
+	INVOC_URI   string = "guac"
+	PRODUCER_ID string = "guacsec/guac"
+)
+
+var ErrEOLComponentTypeMismatch error = fmt.Errorf("rootComponent type is not *root_package.PackageComponent")
+
+type eolCertifier struct {
+	dataset *Dataset
+	// now is the time the support status is computed at
+	now func() time.Time
+}
+
+// NewEOLCertifier initializes a certifier checking the support status of
+// the packages against the given end of life dataset
+func NewEOLCertifier(dataset *Dataset) certifier.Certifier {
+	return &eolCertifier{dataset: dataset, now: time.Now}
+}
+
+// CertifyComponent takes in the root component from the guac database and
+// checks it and all its dependencies against the dataset. An attestation is
+// generated for each package belonging to a release cycle of the dataset,
+// whatever its support status, so that the status is updated as the cycle
+// reaches its end of life.
+func (e *eolCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	component, ok := rootComponent.(*root_package.PackageComponent)
+	if !ok {
+		return ErrEOLComponentTypeMismatch
+	}
+	now := e.now()
+	return component.Walk(func(c *root_package.PackageComponent) error {
+		results := e.dataset.Match(c.Package.Purl, now)
+		if len(results) == 0 {
+			return nil
+		}
+		doc, err := generateDocument(c.Package.Purl, c.Package.Digest, results, e.dataset.DB(), now)
+		if err != nil {
+			return err
+		}
+		docChannel <- doc
+		return nil
+	})
+}
+
+func generateDocument(purl string, digests []string, results []attestation_vuln.EOLResult, dataset attestation_vuln.DB, scannedOn time.Time) (*processor.Document, error) {
+	payload, err := json.Marshal(createAttestation(purl, digests, results, dataset, scannedOn))
+	if err != nil {
+		return nil, err
+	}
+	doc := &processor.Document{
+		Blob:   payload,
+		Type:   processor.DocumentITE6EOL,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: INVOC_URI,
+			Source:    INVOC_URI,
+		},
+	}
+	return doc, nil
+}
+
+func createAttestation(packageURL string, digests []string, results []attestation_vuln.EOLResult, dataset attestation_vuln.DB, scannedOn time.Time) *attestation_vuln.EOLStatement {
+	attestation := &attestation_vuln.EOLStatement{
+		StatementHeader: intoto.StatementHeader{
+			Type:          intoto.StatementInTotoV01,
+			PredicateType: attestation_vuln.PredicateEOL,
+		},
+		Predicate: attestation_vuln.EOLPredicate{
+			Invocation: attestation_vuln.Invocation{
+				Uri:        INVOC_URI,
+				ProducerID: PRODUCER_ID,
+			},
+			Scanner: attestation_vuln.EOLScanner{
+				Uri:      URI,
+				Version:  VERSION,
+				Database: dataset,
+				Result:   results,
+			},
+			Metadata: attestation_vuln.Metadata{
+				ScannedOn: &scannedOn,
+			},
+		},
+	}
+
+	attestation.StatementHeader.Subject = attestation_vuln.PackageSubjects(packageURL, digests)
+	return attestation
+}
@@ -0,0 +1,120 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eol
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func TestEOLCertifier_CertifyComponent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	dataset, err := LoadDataset(writeDataset(t))
+	if err != nil {
+		t.Fatalf("LoadDataset() error = %v", err)
+	}
+
+	// node 18 is actively supported until 2023-10-18 and reaches its end
+	// of life on 2025-04-30, django 4.2 until 2023-12-04 and 2026-04-01
+	rootComponent := &root_package.PackageComponent{
+		Package: assembler.PackageNode{
+			Purl:   "pkg:guac/oci/docker.io/library/node:18.18.2",
+			Digest: []string{"sha256:abcdef0123"},
+		},
+		DepPackages: []*root_package.PackageComponent{{
+			Package: assembler.PackageNode{Purl: "pkg:pypi/django@4.2.7"},
+		}, {
+			Package: assembler.PackageNode{Purl: "pkg:npm/lodash@4.17.21"},
+		}},
+	}
+
+	tests := []struct {
+		name string
+		now  string
+		want map[string]string
+	}{{
+		name: "supported cycles are attested",
+		now:  "2023-06-01",
+		want: map[string]string{
+			"pkg:guac/oci/docker.io/library/node:18.18.2": "nodejs@18:" + attestation_vuln.EOLStatusSupported,
+			"pkg:pypi/django@4.2.7":                       "django@4.2:" + attestation_vuln.EOLStatusSupported,
+		},
+	}, {
+		name: "cycles past their end of active support",
+		now:  "2024-01-01",
+		want: map[string]string{
+			"pkg:guac/oci/docker.io/library/node:18.18.2": "nodejs@18:" + attestation_vuln.EOLStatusSecurityOnly,
+			"pkg:pypi/django@4.2.7":                       "django@4.2:" + attestation_vuln.EOLStatusSecurityOnly,
+		},
+	}, {
+		name: "cycle past its end of life",
+		now:  "2025-06-01",
+		want: map[string]string{
+			"pkg:guac/oci/docker.io/library/node:18.18.2": "nodejs@18:" + attestation_vuln.EOLStatusEOL,
+			"pkg:pypi/django@4.2.7":                       "django@4.2:" + attestation_vuln.EOLStatusSecurityOnly,
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := *date(tt.now)
+			e := &eolCertifier{dataset: dataset, now: func() time.Time { return now }}
+
+			docChan := make(chan *processor.Document, 10)
+			if err := e.CertifyComponent(ctx, rootComponent, docChan); err != nil {
+				t.Fatalf("CertifyComponent() error = %v", err)
+			}
+			close(docChan)
+
+			got := map[string]string{}
+			for doc := range docChan {
+				if doc.Type != processor.DocumentITE6EOL {
+					t.Errorf("unexpected document type %v", doc.Type)
+				}
+				var statement attestation_vuln.EOLStatement
+				if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+					t.Fatal(err)
+				}
+				if scannedOn := statement.Predicate.Metadata.ScannedOn; scannedOn == nil || !scannedOn.Equal(now) {
+					t.Errorf("ScannedOn = %v, want %v", scannedOn, now)
+				}
+				for _, r := range statement.Predicate.Scanner.Result {
+					got[statement.Subject[0].Name] = r.Product + "@" + r.Cycle + ":" + r.Status
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CertifyComponent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEOLCertifier_CertifyComponent_typeMismatch(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	e := NewEOLCertifier(&Dataset{})
+	if err := e.CertifyComponent(ctx, "not a component", make(chan *processor.Document)); err != ErrEOLComponentTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrEOLComponentTypeMismatch)
+	}
+}
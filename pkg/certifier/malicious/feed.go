@@ -0,0 +1,307 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package malicious
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	osv_processor "github.com/guacsec/guac/pkg/handler/processor/osv"
+	purl "github.com/package-url/packageurl-go"
+)
+
+const maliciousPrefix = "MAL-"
+
+// Feed is a local feed of known malicious packages. It is loaded from OSV
+// MAL- entries, such as the ones of https://github.com/ossf/malicious-packages,
+// and from denylists of purls and digests.
+type Feed struct {
+	path string
+	// packages indexes the entries by the type, namespace and name of the
+	// package
+	packages map[string][]*feedEntry
+	// digests indexes the entries by artifact digest, as "algorithm:value"
+	digests map[string][]*feedEntry
+	// modified is the last time any entry or denylist was modified
+	modified time.Time
+}
+
+// feedEntry is a package or artifact reported as malicious. Package entries
+// without versions nor ranges apply to all the versions of the package.
+type feedEntry struct {
+	id       string
+	summary  string
+	versions []string
+	ranges   []osv_processor.Range
+}
+
+// denylistEntry is an entry of a JSON or CSV denylist, which reports either
+// a purl or a digest. A purl without version reports all the versions of the
+// package.
+type denylistEntry struct {
+	ID      string `json:"id"`
+	Purl    string `json:"purl"`
+	Digest  string `json:"digest"`
+	Summary string `json:"summary"`
+}
+
+// LoadFeed loads the feed found under path. The directory is walked
+// recursively: JSON files are either OSV entries, of which only MAL- entries
+// are kept, or arrays of denylist entries, and CSV files are denylists with
+// a header naming the id, purl, digest and summary columns.
+func LoadFeed(path string) (*Feed, error) {
+	feed := &Feed{
+		path:     path,
+		packages: map[string][]*feedEntry{},
+		digests:  map[string][]*feedEntry{},
+	}
+	err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(file))
+		if ext != ".json" && ext != ".csv" {
+			return nil
+		}
+		blob, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if ext == ".csv" {
+			err = feed.loadCSV(blob, info.ModTime())
+		} else {
+			err = feed.loadJSON(blob, info.ModTime())
+		}
+		if err != nil {
+			return fmt.Errorf("unable to load %s: %w", file, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load malicious package feed from %s: %w", path, err)
+	}
+	if len(feed.packages) == 0 && len(feed.digests) == 0 {
+		return nil, fmt.Errorf("no malicious package entries found in %s", path)
+	}
+	return feed, nil
+}
+
+func (f *Feed) loadJSON(blob []byte, modified time.Time) error {
+	if bytes.HasPrefix(bytes.TrimSpace(blob), []byte("[")) {
+		var entries []denylistEntry
+		if err := json.Unmarshal(blob, &entries); err != nil {
+			return err
+		}
+		return f.addDenylist(entries, modified)
+	}
+
+	vuln, err := osv_processor.LoadDocument(blob, processor.FormatJSON)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(vuln.ID, maliciousPrefix) || vuln.Withdrawn != nil {
+		return nil
+	}
+	summary := vuln.Summary
+	if summary == "" {
+		summary = vuln.Details
+	}
+	for _, affected := range vuln.Affected {
+		key, err := helpers.OSVPackageKey(affected.Package.Ecosystem, affected.Package.Name, affected.Package.Purl)
+		if err != nil {
+			// unknown ecosystem
+			continue
+		}
+		f.packages[key] = append(f.packages[key], &feedEntry{
+			id:       vuln.ID,
+			summary:  summary,
+			versions: affected.Versions,
+			ranges:   affected.Ranges,
+		})
+	}
+	if vuln.Modified.After(f.modified) {
+		f.modified = vuln.Modified
+	}
+	return nil
+}
+
+func (f *Feed) loadCSV(blob []byte, modified time.Time) error {
+	r := csv.NewReader(bytes.NewReader(blob))
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return fmt.Errorf("unable to read header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	_, hasPurl := columns["purl"]
+	_, hasDigest := columns["digest"]
+	if !hasPurl && !hasDigest {
+		return errors.New("header has neither a purl nor a digest column")
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var entries []denylistEntry
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		entries = append(entries, denylistEntry{
+			ID:      field(record, "id"),
+			Purl:    field(record, "purl"),
+			Digest:  field(record, "digest"),
+			Summary: field(record, "summary"),
+		})
+	}
+	return f.addDenylist(entries, modified)
+}
+
+// addDenylist adds the entries of a denylist, modified is the modification
+// time of the denylist as its entries do not carry one
+func (f *Feed) addDenylist(entries []denylistEntry, modified time.Time) error {
+	for _, e := range entries {
+		switch {
+		case e.Purl != "":
+			p, err := purl.FromString(e.Purl)
+			if err != nil {
+				return fmt.Errorf("invalid purl %q: %w", e.Purl, err)
+			}
+			entry := &feedEntry{id: e.ID, summary: e.Summary}
+			if entry.id == "" {
+				entry.id = e.Purl
+			}
+			if p.Version != "" {
+				entry.versions = []string{p.Version}
+			}
+			key := helpers.PackageKey(p.Type, p.Namespace, p.Name)
+			f.packages[key] = append(f.packages[key], entry)
+		case e.Digest != "":
+			digest, err := normalizeDigest(e.Digest)
+			if err != nil {
+				return err
+			}
+			entry := &feedEntry{id: e.ID, summary: e.Summary}
+			if entry.id == "" {
+				entry.id = digest
+			}
+			f.digests[digest] = append(f.digests[digest], entry)
+		default:
+			return fmt.Errorf("denylist entry %q has neither a purl nor a digest", e.ID)
+		}
+	}
+	if len(entries) > 0 && modified.After(f.modified) {
+		f.modified = modified
+	}
+	return nil
+}
+
+// Version returns the version of the feed, which is the last time any entry
+// or denylist was modified
+func (f *Feed) Version() string {
+	return f.modified.UTC().Format(time.RFC3339)
+}
+
+// DB describes the feed as the database of the attestations
+func (f *Feed) DB() attestation_vuln.DB {
+	return attestation_vuln.DB{
+		Uri:     f.path,
+		Version: f.Version(),
+	}
+}
+
+// Match returns the entries reporting the package, or one of its digests,
+// as malicious. Versioned entries do not match packages without a version.
+func (f *Feed) Match(packageURL string, digests []string) []attestation_vuln.MaliciousResult {
+	results := []attestation_vuln.MaliciousResult{}
+	seen := map[attestation_vuln.MaliciousResult]bool{}
+	add := func(result attestation_vuln.MaliciousResult) {
+		if !seen[result] {
+			seen[result] = true
+			results = append(results, result)
+		}
+	}
+
+	if p, err := purl.FromString(packageURL); err == nil {
+		for _, entry := range f.packages[helpers.PackageKey(p.Type, p.Namespace, p.Name)] {
+			if entry.affects(p.Type, p.Version) {
+				add(attestation_vuln.MaliciousResult{Id: entry.id, Summary: entry.summary})
+			}
+		}
+	}
+	for _, d := range digests {
+		digest, err := normalizeDigest(d)
+		if err != nil {
+			continue
+		}
+		for _, entry := range f.digests[digest] {
+			add(attestation_vuln.MaliciousResult{Id: entry.id, Summary: entry.summary, Digest: digest})
+		}
+	}
+	return results
+}
+
+// affects returns true if the entry applies to all versions, lists the
+// version or has a SEMVER or ECOSYSTEM range including it
+func (e *feedEntry) affects(pkgType, version string) bool {
+	if len(e.versions) == 0 && len(e.ranges) == 0 {
+		return true
+	}
+	if version == "" {
+		return false
+	}
+	return helpers.IsOSVVersionAffected(pkgType, version, e.versions, e.ranges)
+}
+
+func normalizeDigest(digest string) (string, error) {
+	algorithm, value, ok := strings.Cut(strings.TrimSpace(digest), ":")
+	if !ok || algorithm == "" || value == "" {
+		return "", fmt.Errorf("invalid digest %q, expected algorithm:value", digest)
+	}
+	return strings.ToLower(algorithm) + ":" + strings.ToLower(value), nil
+}
@@ -0,0 +1,160 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package malicious
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+)
+
+const (
+	denylistJSON = `[
+  {"id": "EXAMPLE-1", "purl": "pkg:pypi/Evil_Package", "summary": "Exfiltrates credentials"},
+  {"purl": "pkg:npm/colors@1.4.44-liberty-2"}
+]`
+	denylistCSV = `# known bad artifacts
+id,digest,summary
+EXAMPLE-2, SHA256:ABCDEF0123, Backdoored build
+`
+)
+
+func writeFeed(t *testing.T) string {
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"osv/npm/ua-parser-js/MAL-2022-4691.json": testdata.OsvMaliciousExample,
+		"osv/maven/GHSA-7rjr-3q55-vv33.json":      testdata.OsvGhsaExample,
+		"denylist.json":                           []byte(denylistJSON),
+		"denylist.csv":                            []byte(denylistCSV),
+		"README.md":                               []byte("not loaded"),
+	}
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, content, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestFeed_Match(t *testing.T) {
+	feed, err := LoadFeed(writeFeed(t))
+	if err != nil {
+		t.Fatalf("LoadFeed() error = %v", err)
+	}
+
+	uaParser := attestation_vuln.MaliciousResult{Id: "MAL-2022-4691", Summary: "Malicious code in ua-parser-js (npm)"}
+	tests := []struct {
+		name    string
+		purl    string
+		digests []string
+		want    []attestation_vuln.MaliciousResult
+	}{{
+		name: "listed version",
+		purl: "pkg:npm/ua-parser-js@0.7.29",
+		want: []attestation_vuln.MaliciousResult{uaParser},
+	}, {
+		name: "unlisted version",
+		purl: "pkg:npm/ua-parser-js@0.7.30",
+		want: []attestation_vuln.MaliciousResult{},
+	}, {
+		name: "package without version and versioned entry",
+		purl: "pkg:npm/ua-parser-js",
+		want: []attestation_vuln.MaliciousResult{},
+	}, {
+		name: "range of all versions",
+		purl: "pkg:npm/%40evil/typosquat@2.0.0",
+		want: []attestation_vuln.MaliciousResult{uaParser},
+	}, {
+		name: "entries other than MAL- are not loaded",
+		purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+		want: []attestation_vuln.MaliciousResult{},
+	}, {
+		name: "denylisted name, normalized",
+		purl: "pkg:pypi/evil-package@1.0",
+		want: []attestation_vuln.MaliciousResult{{Id: "EXAMPLE-1", Summary: "Exfiltrates credentials"}},
+	}, {
+		name: "denylisted version without id",
+		purl: "pkg:npm/colors@1.4.44-liberty-2",
+		want: []attestation_vuln.MaliciousResult{{Id: "pkg:npm/colors@1.4.44-liberty-2"}},
+	}, {
+		name: "other version of denylisted version",
+		purl: "pkg:npm/colors@1.4.0",
+		want: []attestation_vuln.MaliciousResult{},
+	}, {
+		name:    "denylisted digest",
+		purl:    "pkg:generic/app@1.0",
+		digests: []string{"sha256:abcdef0123", "sha1:abc"},
+		want:    []attestation_vuln.MaliciousResult{{Id: "EXAMPLE-2", Summary: "Backdoored build", Digest: "sha256:abcdef0123"}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := feed.Match(tt.purl, tt.digests)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadFeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr bool
+	}{{
+		name:    "empty feed",
+		files:   map[string]string{"README.md": "nothing"},
+		wantErr: true,
+	}, {
+		name:    "CSV without purl nor digest column",
+		files:   map[string]string{"denylist.csv": "id,summary\nEXAMPLE-1,bad\n"},
+		wantErr: true,
+	}, {
+		name:    "denylist entry without purl nor digest",
+		files:   map[string]string{"denylist.json": `[{"id": "EXAMPLE-1"}]`},
+		wantErr: true,
+	}, {
+		name:    "invalid digest",
+		files:   map[string]string{"denylist.json": `[{"digest": "abcdef"}]`},
+		wantErr: true,
+	}, {
+		name:    "not an OSV entry",
+		files:   map[string]string{"entry.json": `{"name": "example"}`},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := LoadFeed(dir)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadFeed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
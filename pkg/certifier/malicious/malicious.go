@@ -0,0 +1,117 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package malicious
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/certifier"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	intoto "github.com/in-toto/in-toto-golang/in_toto"
+)
+
+const (
+	URI         string = "guac/malicious"
+	VERSION     string = "0.0.1"
+	INVOC_URI   string = "guac"
+	PRODUCER_ID string = "guacsec/guac"
+)
+
+var ErrMaliciousComponentTypeMismatch error = fmt.Errorf("rootComponent type is not *root_package.PackageComponent")
+
+type maliciousCertifier struct {
+	feed *Feed
+}
+
+// NewMaliciousCertifier initializes a certifier checking the packages
+// against the given feed of known malicious packages
+func NewMaliciousCertifier(feed *Feed) certifier.Certifier {
+	return &maliciousCertifier{feed: feed}
+}
+
+// CertifyComponent takes in the root component from the guac database and
+// checks it and all its dependencies against the feed. An attestation is
+// generated for each package reported as malicious, clean packages produce
+// no document.
+func (m *maliciousCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
+	component, ok := rootComponent.(*root_package.PackageComponent)
+	if !ok {
+		return ErrMaliciousComponentTypeMismatch
+	}
+	return component.Walk(func(c *root_package.PackageComponent) error {
+		results := m.feed.Match(c.Package.Purl, c.Package.Digest)
+		if len(results) == 0 {
+			return nil
+		}
+		doc, err := generateDocument(c.Package.Purl, c.Package.Digest, results, m.feed.DB())
+		if err != nil {
+			return err
+		}
+		docChannel <- doc
+		return nil
+	})
+}
+
+func generateDocument(purl string, digests []string, results []attestation_vuln.MaliciousResult, feed attestation_vuln.DB) (*processor.Document, error) {
+	payload, err := json.Marshal(createAttestation(purl, digests, results, feed))
+	if err != nil {
+		return nil, err
+	}
+	doc := &processor.Document{
+		Blob:   payload,
+		Type:   processor.DocumentITE6Malicious,
+		Format: processor.FormatJSON,
+		SourceInformation: processor.SourceInformation{
+			Collector: INVOC_URI,
+			Source:    INVOC_URI,
+		},
+	}
+	return doc, nil
+}
+
+func createAttestation(packageURL string, digests []string, results []attestation_vuln.MaliciousResult, feed attestation_vuln.DB) *attestation_vuln.MaliciousStatement {
+	currentTime := time.Now()
+
+	attestation := &attestation_vuln.MaliciousStatement{
+		StatementHeader: intoto.StatementHeader{
+			Type:          intoto.StatementInTotoV01,
+			PredicateType: attestation_vuln.PredicateMalicious,
+		},
+		Predicate: attestation_vuln.MaliciousPredicate{
+			Invocation: attestation_vuln.Invocation{
+				Uri:        INVOC_URI,
+				ProducerID: PRODUCER_ID,
+			},
+			Scanner: attestation_vuln.MaliciousScanner{
+				Uri:     URI,
+				Version: VERSION,
+				Feed:    feed,
+				Result:  results,
+			},
+			Metadata: attestation_vuln.Metadata{
+				ScannedOn: &currentTime,
+			},
+		},
+	}
+
+	attestation.StatementHeader.Subject = attestation_vuln.PackageSubjects(packageURL, digests)
+	return attestation
+}
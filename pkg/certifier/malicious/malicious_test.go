@@ -0,0 +1,88 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package malicious
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func TestMaliciousCertifier_CertifyComponent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	feed, err := LoadFeed(writeFeed(t))
+	if err != nil {
+		t.Fatalf("LoadFeed() error = %v", err)
+	}
+
+	rootComponent := &root_package.PackageComponent{
+		Package: assembler.PackageNode{
+			Purl:   "pkg:generic/app@1.0",
+			Digest: []string{"sha256:abcdef0123"},
+		},
+		DepPackages: []*root_package.PackageComponent{{
+			Package: assembler.PackageNode{Purl: "pkg:npm/ua-parser-js@1.0.0"},
+		}, {
+			Package: assembler.PackageNode{Purl: "pkg:npm/lodash@4.17.21"},
+		}},
+	}
+
+	docChan := make(chan *processor.Document, 10)
+	m := NewMaliciousCertifier(feed)
+	if err := m.CertifyComponent(ctx, rootComponent, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+
+	got := map[string][]string{}
+	for doc := range docChan {
+		if doc.Type != processor.DocumentITE6Malicious {
+			t.Errorf("unexpected document type %v", doc.Type)
+		}
+		var statement attestation_vuln.MaliciousStatement
+		if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+			t.Fatal(err)
+		}
+		if statement.PredicateType != attestation_vuln.PredicateMalicious {
+			t.Errorf("unexpected predicate type %q", statement.PredicateType)
+		}
+		ids := []string{}
+		for _, r := range statement.Predicate.Scanner.Result {
+			ids = append(ids, r.Id)
+		}
+		got[statement.Subject[0].Name] = ids
+	}
+
+	want := map[string][]string{
+		"pkg:generic/app@1.0":        {"EXAMPLE-2"},
+		"pkg:npm/ua-parser-js@1.0.0": {"MAL-2022-4691"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CertifyComponent() = %v, want %v", got, want)
+	}
+
+	if err := m.CertifyComponent(ctx, "not a component", docChan); err != ErrMaliciousComponentTypeMismatch {
+		t.Errorf("CertifyComponent() error = %v, want %v", err, ErrMaliciousComponentTypeMismatch)
+	}
+}
@@ -0,0 +1,239 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	osv_scanner "github.com/google/osv-scanner/pkg/osv"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	osv_processor "github.com/guacsec/guac/pkg/handler/processor/osv"
+	purl "github.com/package-url/packageurl-go"
+)
+
+// OSVDatabase is a local copy of the OSV database, loaded from the data dumps
+// (https://google.github.io/osv.dev/data/#data-dumps), which answers the
+// batched purl queries without access to osv.dev.
+type OSVDatabase struct {
+	// vulns indexes the entries by the type, namespace and name of the
+	// affected packages
+	vulns map[string][]*osv_processor.Vulnerability
+	// entries indexes the entries by ID
+	entries map[string]*osv_processor.Vulnerability
+	// modified is the last time any entry was modified
+	modified time.Time
+}
+
+// LoadOSVDatabase loads the OSV entries found under path. The directory is
+// walked recursively, the per-ecosystem all.zip archives are read as well
+// as loose JSON entries. When an entry is present several times its newest
+// revision is kept, and withdrawn entries affect no package.
+func LoadOSVDatabase(path string) (*OSVDatabase, error) {
+	db := &OSVDatabase{
+		vulns:   map[string][]*osv_processor.Vulnerability{},
+		entries: map[string]*osv_processor.Vulnerability{},
+	}
+	err := filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(file)) {
+		case ".zip":
+			return db.loadArchive(file)
+		case ".json":
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			return db.loadEntry(file, f)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load OSV database from %s: %w", path, err)
+	}
+	if len(db.entries) == 0 {
+		return nil, fmt.Errorf("no OSV entries found in %s", path)
+	}
+	return db, nil
+}
+
+func (db *OSVDatabase) loadArchive(file string) error {
+	r, err := zip.OpenReader(file)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if !strings.HasSuffix(strings.ToLower(f.Name), ".json") {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+		err = db.loadEntry(file+"/"+f.Name, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *OSVDatabase) loadEntry(name string, r io.Reader) error {
+	var vuln osv_processor.Vulnerability
+	if err := json.NewDecoder(r).Decode(&vuln); err != nil {
+		return fmt.Errorf("unable to decode OSV entry %s: %w", name, err)
+	}
+	if vuln.ID == "" {
+		return fmt.Errorf("OSV entry %s has no id", name)
+	}
+
+	entry, ok := db.entries[vuln.ID]
+	if ok {
+		// the same entry can be present in several dumps, keep the newest
+		if !vuln.Modified.After(entry.Modified) {
+			return nil
+		}
+		db.unindex(entry)
+		*entry = vuln
+	} else {
+		entry = &vuln
+		db.entries[vuln.ID] = entry
+	}
+	// withdrawn entries are kept so that older revisions are not loaded
+	// again, but they no longer affect any package
+	if entry.Withdrawn == nil {
+		db.index(entry)
+	}
+
+	if vuln.Modified.After(db.modified) {
+		db.modified = vuln.Modified
+	}
+	return nil
+}
+
+// index adds the entry to the entries of the packages it affects
+func (db *OSVDatabase) index(entry *osv_processor.Vulnerability) {
+	for _, affected := range entry.Affected {
+		key, err := helpers.OSVPackageKey(affected.Package.Ecosystem, affected.Package.Name, affected.Package.Purl)
+		if err != nil || containsEntry(db.vulns[key], entry) {
+			continue
+		}
+		db.vulns[key] = append(db.vulns[key], entry)
+	}
+}
+
+// unindex removes the entry from the entries of the packages it affects
+func (db *OSVDatabase) unindex(entry *osv_processor.Vulnerability) {
+	for _, affected := range entry.Affected {
+		key, err := helpers.OSVPackageKey(affected.Package.Ecosystem, affected.Package.Name, affected.Package.Purl)
+		if err != nil {
+			continue
+		}
+		entries := db.vulns[key][:0]
+		for _, e := range db.vulns[key] {
+			if e != entry {
+				entries = append(entries, e)
+			}
+		}
+		if len(entries) == 0 {
+			delete(db.vulns, key)
+		} else {
+			db.vulns[key] = entries
+		}
+	}
+}
+
+func containsEntry(entries []*osv_processor.Vulnerability, entry *osv_processor.Vulnerability) bool {
+	for _, e := range entries {
+		if e == entry {
+			return true
+		}
+	}
+	return false
+}
+
+// Version returns the version of the database, which is the last time any
+// entry was modified
+func (db *OSVDatabase) Version() string {
+	return db.modified.UTC().Format(time.RFC3339)
+}
+
+// Get returns the entry with the given ID, or nil if it is unknown or
+// withdrawn
+func (db *OSVDatabase) Get(id string) *osv_processor.Vulnerability {
+	if entry, ok := db.entries[id]; ok && entry.Withdrawn == nil {
+		return entry
+	}
+	return nil
+}
+
+// Query answers a batched purl query as osv.dev would, returning the
+// vulnerabilities affecting each package in the order of the queries.
+// Queries that are not purl queries, or that refer to packages of unknown
+// ecosystems, have no vulnerabilities.
+func (db *OSVDatabase) Query(query osv_scanner.BatchedQuery) (*osv_scanner.BatchedResponse, error) {
+	resp := &osv_scanner.BatchedResponse{}
+	for _, q := range query.Queries {
+		resp.Results = append(resp.Results, osv_scanner.MinimalResponse{
+			Vulns: db.queryPurl(q.Package.PURL),
+		})
+	}
+	return resp, nil
+}
+
+func (db *OSVDatabase) queryPurl(purlString string) []osv_scanner.MinimalVulnerability {
+	vulns := []osv_scanner.MinimalVulnerability{}
+	if purlString == "" {
+		return vulns
+	}
+	p, err := purl.FromString(purlString)
+	if err != nil {
+		return vulns
+	}
+	key := helpers.PackageKey(p.Type, p.Namespace, p.Name)
+
+	for _, vuln := range db.vulns[key] {
+		for _, affected := range vuln.Affected {
+			affectedKey, err := helpers.OSVPackageKey(affected.Package.Ecosystem, affected.Package.Name, affected.Package.Purl)
+			if err != nil || affectedKey != key {
+				continue
+			}
+			// osv.dev returns all the vulnerabilities of a package when
+			// the version is not known
+			if p.Version == "" || helpers.IsOSVVersionAffected(p.Type, p.Version, affected.Versions, affected.Ranges) {
+				vulns = append(vulns, osv_scanner.MinimalVulnerability{ID: vuln.ID})
+				break
+			}
+		}
+	}
+	return vulns
+}
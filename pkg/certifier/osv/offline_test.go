@@ -0,0 +1,330 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	osv_scanner "github.com/google/osv-scanner/pkg/osv"
+	"github.com/guacsec/guac/pkg/assembler"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	osv_processor "github.com/guacsec/guac/pkg/handler/processor/osv"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	log4ShellEntry = `{
+  "id": "GHSA-jfh8-c2jp-5v3q",
+  "modified": "2023-03-01T10:00:00Z",
+  "published": "2021-12-10T00:40:56Z",
+  "aliases": ["CVE-2021-44228"],
+  "summary": "Remote code injection in Log4j",
+  "affected": [{
+    "package": {"ecosystem": "Maven", "name": "org.apache.logging.log4j:log4j-core"},
+    "ranges": [{
+      "type": "ECOSYSTEM",
+      "events": [{"introduced": "2.13.0"}, {"fixed": "2.15.0"}, {"introduced": "2.0-beta9"}, {"fixed": "2.12.2"}]
+    }]
+  }]
+}`
+	lodashEntry = `{
+  "id": "GHSA-35jh-r3h4-6jhm",
+  "modified": "2023-04-11T08:00:00Z",
+  "published": "2021-05-06T16:05:51Z",
+  "aliases": ["CVE-2021-23337"],
+  "summary": "Command Injection in lodash",
+  "affected": [{
+    "package": {"ecosystem": "npm", "name": "lodash"},
+    "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]
+  }]
+}`
+	djangoEntry = `{
+  "id": "PYSEC-2019-11",
+  "modified": "2021-01-21T00:00:00Z",
+  "affected": [{
+    "package": {"ecosystem": "PyPI", "name": "Django"},
+    "versions": ["1.11.1", "1.11.2"]
+  }]
+}`
+)
+
+func writeOSVDatabase(t *testing.T) string {
+	dir := t.TempDir()
+
+	mavenDir := filepath.Join(dir, "Maven")
+	if err := os.Mkdir(mavenDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Create(filepath.Join(mavenDir, "all.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := zip.NewWriter(f)
+	for name, entry := range map[string]string{
+		"GHSA-jfh8-c2jp-5v3q.json": log4ShellEntry,
+		"GHSA-35jh-r3h4-6jhm.json": lodashEntry,
+	} {
+		zf, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := zf.Write([]byte(entry)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "PYSEC-2019-11.json"), []byte(djangoEntry), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestOSVDatabase_Query(t *testing.T) {
+	db, err := LoadOSVDatabase(writeOSVDatabase(t))
+	if err != nil {
+		t.Fatalf("LoadOSVDatabase() error = %v", err)
+	}
+	if got, want := db.Version(), "2023-04-11T08:00:00Z"; got != want {
+		t.Errorf("Version() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		purl string
+		want []string
+	}{
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1", want: []string{"GHSA-jfh8-c2jp-5v3q"}},
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.12.1", want: []string{"GHSA-jfh8-c2jp-5v3q"}},
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.12.2", want: []string{}},
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.15.0", want: []string{}},
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core", want: []string{"GHSA-jfh8-c2jp-5v3q"}},
+		{purl: "pkg:npm/lodash@4.17.20", want: []string{"GHSA-35jh-r3h4-6jhm"}},
+		{purl: "pkg:npm/lodash@4.17.21", want: []string{}},
+		{purl: "pkg:pypi/django@1.11.1", want: []string{"PYSEC-2019-11"}},
+		{purl: "pkg:pypi/django@1.11.3", want: []string{}},
+		{purl: "pkg:golang/golang.org/x/net@v0.7.0", want: []string{}},
+		{purl: "pkg:example.com/A", want: []string{}},
+	}
+	query := osv_scanner.BatchedQuery{}
+	for _, tt := range tests {
+		query.Queries = append(query.Queries, osv_scanner.MakePURLRequest(tt.purl))
+	}
+	resp, err := db.Query(query)
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	for i, tt := range tests {
+		got := []string{}
+		for _, v := range resp.Results[i].Vulns {
+			got = append(got, v.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Query(%s) = %v, want %v", tt.purl, got, tt.want)
+		}
+	}
+}
+
+func TestOSVDatabase_newerRevision(t *testing.T) {
+	dir := t.TempDir()
+	older := `{
+  "id": "GHSA-35jh-r3h4-6jhm",
+  "modified": "2023-01-01T00:00:00Z",
+  "affected": [{
+    "package": {"ecosystem": "npm", "name": "lodash"},
+    "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]
+  }]
+}`
+	newer := `{
+  "id": "GHSA-35jh-r3h4-6jhm",
+  "modified": "2023-04-11T08:00:00Z",
+  "affected": [{
+    "package": {"ecosystem": "npm", "name": "lodash"},
+    "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]
+  }, {
+    "package": {"ecosystem": "npm", "name": "lodash-es"},
+    "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]
+  }]
+}`
+	// files are loaded in lexical order, the older revision first
+	for name, entry := range map[string]string{"a.json": older, "b.json": newer} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(entry), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db, err := LoadOSVDatabase(dir)
+	if err != nil {
+		t.Fatalf("LoadOSVDatabase() error = %v", err)
+	}
+
+	query := osv_scanner.BatchedQuery{}
+	purls := []string{"pkg:npm/lodash@4.17.20", "pkg:npm/lodash-es@4.17.20"}
+	for _, p := range purls {
+		query.Queries = append(query.Queries, osv_scanner.MakePURLRequest(p))
+	}
+	resp, err := db.Query(query)
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	for i, p := range purls {
+		got := []string{}
+		for _, v := range resp.Results[i].Vulns {
+			got = append(got, v.ID)
+		}
+		if want := []string{"GHSA-35jh-r3h4-6jhm"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Query(%s) = %v, want %v", p, got, want)
+		}
+	}
+}
+
+func TestOSVDatabase_withdrawn(t *testing.T) {
+	revision := `{
+  "id": "GHSA-35jh-r3h4-6jhm",
+  "modified": "2023-01-01T00:00:00Z",
+  "affected": [{
+    "package": {"ecosystem": "npm", "name": "lodash"},
+    "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]
+  }]
+}`
+	withdrawn := `{
+  "id": "GHSA-35jh-r3h4-6jhm",
+  "modified": "2023-04-11T08:00:00Z",
+  "withdrawn": "2023-04-11T08:00:00Z",
+  "affected": [{
+    "package": {"ecosystem": "npm", "name": "lodash"},
+    "ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]
+  }]
+}`
+	tests := []struct {
+		name  string
+		files map[string]string
+	}{{
+		// files are loaded in lexical order
+		name:  "newer revision withdrawn",
+		files: map[string]string{"a.json": revision, "b.json": withdrawn},
+	}, {
+		name:  "older revision loaded after the withdrawn one",
+		files: map[string]string{"a.json": withdrawn, "b.json": revision},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, entry := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(entry), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			db, err := LoadOSVDatabase(dir)
+			if err != nil {
+				t.Fatalf("LoadOSVDatabase() error = %v", err)
+			}
+
+			query := osv_scanner.BatchedQuery{}
+			query.Queries = append(query.Queries, osv_scanner.MakePURLRequest("pkg:npm/lodash@4.17.20"))
+			resp, err := db.Query(query)
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			if got := resp.Results[0].Vulns; len(got) != 0 {
+				t.Errorf("Query() = %v, want no vulnerabilities", got)
+			}
+			if got := db.Get("GHSA-35jh-r3h4-6jhm"); got != nil {
+				t.Errorf("Get() = %v, want nil", got)
+			}
+		})
+	}
+}
+
+func TestLoadOSVDatabase_empty(t *testing.T) {
+	if _, err := LoadOSVDatabase(t.TempDir()); err == nil {
+		t.Errorf("LoadOSVDatabase() expected an error for an empty directory")
+	}
+}
+
+func TestOfflineOSVCertifier_CertifyComponent(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	// details must come from the local database
+	oldGetVulnDetails := getVulnDetails
+	getVulnDetails = func(_ context.Context, id string) (*osv_processor.Vulnerability, error) {
+		t.Errorf("getVulnDetails(%s) called in offline mode", id)
+		return nil, nil
+	}
+	defer func() { getVulnDetails = oldGetVulnDetails }()
+
+	db, err := LoadOSVDatabase(writeOSVDatabase(t))
+	if err != nil {
+		t.Fatalf("LoadOSVDatabase() error = %v", err)
+	}
+
+	rootComponent := &root_package.PackageComponent{
+		Package: assembler.PackageNode{Purl: "pkg:maven/org.example/app@1.0.0"},
+		DepPackages: []*root_package.PackageComponent{{
+			Package: assembler.PackageNode{Purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+		}, {
+			Package: assembler.PackageNode{Purl: "pkg:npm/lodash@4.17.21"},
+		}},
+	}
+
+	docChan := make(chan *processor.Document, 10)
+	o := NewOfflineOSVCertificationParser(db)
+	if err := o.CertifyComponent(ctx, rootComponent, docChan); err != nil {
+		t.Fatalf("CertifyComponent() error = %v", err)
+	}
+	close(docChan)
+
+	got := map[string][]string{}
+	for doc := range docChan {
+		var statement attestation_vuln.VulnerabilityStatement
+		if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+			t.Fatal(err)
+		}
+		if statement.Predicate.Scanner.Database.Version != "2023-04-11T08:00:00Z" {
+			t.Errorf("unexpected database version %q", statement.Predicate.Scanner.Database.Version)
+		}
+		ids := []string{}
+		for _, r := range statement.Predicate.Scanner.Result {
+			ids = append(ids, r.VulnerabilityId)
+			if r.Summary == "" {
+				t.Errorf("vulnerability %s has no details", r.VulnerabilityId)
+			}
+		}
+		sort.Strings(ids)
+		got[statement.Subject[0].Name] = ids
+	}
+
+	want := map[string][]string{
+		"pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1": {"GHSA-jfh8-c2jp-5v3q"},
+		"pkg:npm/lodash@4.17.21":                               {},
+		"pkg:maven/org.example/app@1.0.0":                      {"GHSA-jfh8-c2jp-5v3q"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CertifyComponent() = %v, want %v", got, want)
+	}
+}
@@ -19,7 +19,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
+	"sync"
 	"time"
 
 	osv_scanner "github.com/google/osv-scanner/pkg/osv"
@@ -28,8 +28,9 @@ import (
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	osv_processor "github.com/guacsec/guac/pkg/handler/processor/osv"
+	"github.com/guacsec/guac/pkg/logging"
 	intoto "github.com/in-toto/in-toto-golang/in_toto"
-	"github.com/in-toto/in-toto-golang/in_toto/slsa_provenance/common"
 )
 
 const (
@@ -43,6 +44,10 @@ var ErrOSVComponenetTypeMismatch error = fmt.Errorf("rootComponent type is not *
 
 type osvCertifier struct {
 	rootComponents *root_package.PackageComponent
+	// db is the local OSV database, osv.dev is queried when it is nil
+	db *OSVDatabase
+	// vulnDetails caches the OSV entries fetched while certifying a component
+	vulnDetails map[string]*osv_processor.Vulnerability
 }
 
 // NewOSVCertificationParser initializes the OSVCertifier
@@ -50,6 +55,12 @@ func NewOSVCertificationParser() certifier.Certifier {
 	return &osvCertifier{}
 }
 
+// NewOfflineOSVCertificationParser initializes an OSVCertifier answering the
+// queries from a local copy of the OSV database instead of osv.dev
+func NewOfflineOSVCertificationParser(db *OSVDatabase) certifier.Certifier {
+	return &osvCertifier{db: db}
+}
+
 // CertifyComponent takes in the root component from the gauc database and does a recursive scan
 // to generate vulnerability attestations
 func (o *osvCertifier) CertifyComponent(ctx context.Context, rootComponent interface{}, docChannel chan<- *processor.Document) error {
@@ -58,6 +69,7 @@ func (o *osvCertifier) CertifyComponent(ctx context.Context, rootComponent inter
 	} else {
 		return ErrOSVComponenetTypeMismatch
 	}
+	o.vulnDetails = map[string]*osv_processor.Vulnerability{}
 	m := make(map[string]bool)
 	_, err := o.certifyHelper(ctx, o.rootComponents, docChannel, m)
 	if err != nil {
@@ -106,7 +118,7 @@ func (o *osvCertifier) certifyHelper(ctx context.Context, topLevel *root_package
 	i := 0
 	for i < len(packNodes) {
 		query, lastIndex := getQuery(i, packNodes, packDigest)
-		vulns, err := getVulnerabilities(query, packDigest, topLevelPurl, docChannel)
+		vulns, err := o.getVulnerabilities(ctx, query, packDigest, topLevelPurl, docChannel)
 		if err != nil {
 			return nil, err
 		}
@@ -114,7 +126,7 @@ func (o *osvCertifier) certifyHelper(ctx context.Context, topLevel *root_package
 		totalDepVul = append(totalDepVul, vulns...)
 	}
 
-	doc, err := generateDocument(topLevel.Package.Purl, topLevel.Package.Digest, totalDepVul)
+	doc, err := generateDocument(topLevel.Package.Purl, topLevel.Package.Digest, totalDepVul, o.vulnDetails, o.database())
 	if err != nil {
 		return nil, err
 	}
@@ -143,9 +155,15 @@ func getQuery(lastIndex int, packNodes []assembler.PackageNode, packDigest map[s
 	return query, stoppedIndex
 }
 
-func getVulnerabilities(query osv_scanner.BatchedQuery, packDigest map[string][]string, topLevelPurl string, docChannel chan<- *processor.Document) ([]osv_scanner.MinimalVulnerability, error) {
+func (o *osvCertifier) getVulnerabilities(ctx context.Context, query osv_scanner.BatchedQuery, packDigest map[string][]string, topLevelPurl string, docChannel chan<- *processor.Document) ([]osv_scanner.MinimalVulnerability, error) {
 
-	resp, err := osv_scanner.MakeRequest(query)
+	var resp *osv_scanner.BatchedResponse
+	var err error
+	if o.db != nil {
+		resp, err = o.db.Query(query)
+	} else {
+		resp, err = osv_scanner.MakeRequest(query)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("scan failed: %v", err)
 	}
@@ -153,8 +171,9 @@ func getVulnerabilities(query osv_scanner.BatchedQuery, packDigest map[string][]
 	for i, query := range query.Queries {
 		response := resp.Results[i]
 		totalDepVul = append(totalDepVul, response.Vulns...)
+		o.hydrate(ctx, response.Vulns)
 		purl := query.Package.PURL
-		doc, err := generateDocument(purl, packDigest[purl], response.Vulns)
+		doc, err := generateDocument(purl, packDigest[purl], response.Vulns, o.vulnDetails, o.database())
 		if err != nil {
 			return nil, err
 		}
@@ -166,8 +185,62 @@ func getVulnerabilities(query osv_scanner.BatchedQuery, packDigest map[string][]
 	return totalDepVul, nil
 }
 
-func generateDocument(purl string, digest []string, vulns []osv_scanner.MinimalVulnerability) (*processor.Document, error) {
-	payload, err := json.Marshal(createAttestation(purl, digest, vulns))
+// hydrate fetches the details of the vulnerabilities not seen yet, at most
+// vulnDetailsWorkers at a time. Failing to fetch the details is not fatal,
+// the vulnerability is then attested without metadata.
+func (o *osvCertifier) hydrate(ctx context.Context, vulns []osv_scanner.MinimalVulnerability) {
+	logger := logging.FromContext(ctx)
+
+	var ids []string
+	for _, vuln := range vulns {
+		if _, ok := o.vulnDetails[vuln.ID]; ok {
+			continue
+		}
+		if o.db != nil {
+			o.vulnDetails[vuln.ID] = o.db.Get(vuln.ID)
+			continue
+		}
+		// reserve the entry so that repeated IDs are only fetched once
+		o.vulnDetails[vuln.ID] = nil
+		ids = append(ids, vuln.ID)
+	}
+
+	details := make([]*osv_processor.Vulnerability, len(ids))
+	sem := make(chan struct{}, vulnDetailsWorkers)
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			var err error
+			details[i], err = getVulnDetails(ctx, id)
+			if err != nil {
+				logger.Warnf("unable to get details of vulnerability %s: %v", id, err)
+			}
+		}(i, id)
+	}
+	wg.Wait()
+
+	for i, id := range ids {
+		o.vulnDetails[id] = details[i]
+	}
+}
+
+// database describes the OSV database used, only known for a local database
+func (o *osvCertifier) database() attestation_vuln.DB {
+	if o.db == nil {
+		return attestation_vuln.DB{}
+	}
+	return attestation_vuln.DB{
+		Uri:     URI,
+		Version: o.db.Version(),
+	}
+}
+
+func generateDocument(purl string, digest []string, vulns []osv_scanner.MinimalVulnerability, vulnDetails map[string]*osv_processor.Vulnerability, database attestation_vuln.DB) (*processor.Document, error) {
+	payload, err := json.Marshal(createAttestation(purl, digest, vulns, vulnDetails, database))
 	if err != nil {
 		return nil, err
 	}
@@ -183,9 +256,8 @@ func generateDocument(purl string, digest []string, vulns []osv_scanner.MinimalV
 	return doc, nil
 }
 
-func createAttestation(packageURL string, digests []string, vulns []osv_scanner.MinimalVulnerability) *attestation_vuln.VulnerabilityStatement {
+func createAttestation(packageURL string, digests []string, vulns []osv_scanner.MinimalVulnerability, vulnDetails map[string]*osv_processor.Vulnerability, database attestation_vuln.DB) *attestation_vuln.VulnerabilityStatement {
 	currentTime := time.Now()
-	var subjects []intoto.Subject
 
 	attestation := &attestation_vuln.VulnerabilityStatement{
 		StatementHeader: intoto.StatementHeader{
@@ -198,8 +270,9 @@ func createAttestation(packageURL string, digests []string, vulns []osv_scanner.
 				ProducerID: PRODUCER_ID,
 			},
 			Scanner: attestation_vuln.Scanner{
-				Uri:     URI,
-				Version: VERSION,
+				Uri:      URI,
+				Version:  VERSION,
+				Database: database,
 			},
 			Metadata: attestation_vuln.Metadata{
 				ScannedOn: &currentTime,
@@ -207,27 +280,10 @@ func createAttestation(packageURL string, digests []string, vulns []osv_scanner.
 		},
 	}
 
-	for _, digest := range digests {
-		digestSplit := strings.Split(digest, ":")
-		subjects = append(subjects, intoto.Subject{
-			Name: packageURL,
-			Digest: common.DigestSet{
-				digestSplit[0]: digestSplit[1],
-			},
-		})
-	}
-	if len(digests) == 0 {
-		subjects = append(subjects, intoto.Subject{
-			Name: packageURL,
-		})
-	}
-
-	attestation.StatementHeader.Subject = subjects
+	attestation.StatementHeader.Subject = attestation_vuln.PackageSubjects(packageURL, digests)
 
 	for _, vuln := range vulns {
-		attestation.Predicate.Scanner.Result = append(attestation.Predicate.Scanner.Result, attestation_vuln.Result{
-			VulnerabilityId: vuln.ID,
-		})
+		attestation.Predicate.Scanner.Result = append(attestation.Predicate.Scanner.Result, createResult(vuln.ID, vulnDetails[vuln.ID]))
 	}
 	return attestation
 }
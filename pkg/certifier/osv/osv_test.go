@@ -18,7 +18,9 @@ package osv
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,6 +29,7 @@ import (
 	osv_scanner "github.com/google/osv-scanner/pkg/osv"
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
 	"github.com/guacsec/guac/pkg/certifier/components/root_package"
+	osv_processor "github.com/guacsec/guac/pkg/handler/processor/osv"
 	intoto "github.com/in-toto/in-toto-golang/in_toto"
 	"github.com/in-toto/in-toto-golang/in_toto/slsa_provenance/common"
 
@@ -39,6 +42,11 @@ import (
 func TestOSVCertifier_CertifyVulns(t *testing.T) {
 	ctx := logging.WithLogger(context.Background())
 
+	// the expected attestations do not contain vulnerability metadata
+	oldGetVulnDetails := getVulnDetails
+	getVulnDetails = func(_ context.Context, id string) (*osv_processor.Vulnerability, error) { return nil, nil }
+	defer func() { getVulnDetails = oldGetVulnDetails }()
+
 	tests := []struct {
 		name          string
 		rootComponent interface{}
@@ -199,10 +207,22 @@ func TestOSVCertifier_CertifyVulns(t *testing.T) {
 
 func Test_createAttestation(t *testing.T) {
 	currentTime := time.Now()
+	published := time.Date(2021, 12, 10, 0, 0, 0, 0, time.UTC)
+	details := &osv_processor.Vulnerability{
+		ID:               "GHSA-jfh8-c2jp-5v3q",
+		Summary:          "Remote code injection in Log4j",
+		Aliases:          []string{"CVE-2021-44228"},
+		Published:        published,
+		Severity:         []osv_processor.Severity{{Type: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"}},
+		References:       []osv_processor.Reference{{Type: "ADVISORY", URL: "https://nvd.nist.gov/vuln/detail/CVE-2021-44228"}},
+		DatabaseSpecific: osv_processor.DatabaseSpecific{CWEIds: []string{"CWE-502"}},
+	}
+
 	type args struct {
-		packageURL string
-		digests    []string
-		vulns      []osv_scanner.MinimalVulnerability
+		packageURL  string
+		digests     []string
+		vulns       []osv_scanner.MinimalVulnerability
+		vulnDetails map[string]*osv_processor.Vulnerability
 	}
 	tests := []struct {
 		name string
@@ -240,6 +260,46 @@ func Test_createAttestation(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with vulnerability details",
+			args: args{
+				vulns: []osv_scanner.MinimalVulnerability{
+					{
+						ID: "GHSA-jfh8-c2jp-5v3q",
+					},
+				},
+				vulnDetails: map[string]*osv_processor.Vulnerability{"GHSA-jfh8-c2jp-5v3q": details},
+			},
+			want: &attestation_vuln.VulnerabilityStatement{
+				StatementHeader: intoto.StatementHeader{
+					Type:          intoto.StatementInTotoV01,
+					PredicateType: attestation_vuln.PredicateVuln,
+					Subject:       []intoto.Subject{{Name: ""}},
+				},
+				Predicate: attestation_vuln.VulnerabilityPredicate{
+					Invocation: attestation_vuln.Invocation{
+						Uri:        INVOC_URI,
+						ProducerID: PRODUCER_ID,
+					},
+					Scanner: attestation_vuln.Scanner{
+						Uri:     URI,
+						Version: VERSION,
+						Result: []attestation_vuln.Result{{
+							VulnerabilityId: "GHSA-jfh8-c2jp-5v3q",
+							Aliases:         []string{"CVE-2021-44228"},
+							Summary:         "Remote code injection in Log4j",
+							Severity:        []attestation_vuln.Severity{{Method: "CVSS_V3", Score: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"}},
+							CWEIds:          []string{"CWE-502"},
+							References:      []string{"https://nvd.nist.gov/vuln/detail/CVE-2021-44228"},
+							Published:       &published,
+						}},
+					},
+					Metadata: attestation_vuln.Metadata{
+						ScannedOn: &currentTime,
+					},
+				},
+			},
+		},
 		{
 			name: "has digests",
 			args: args{
@@ -276,7 +336,7 @@ func Test_createAttestation(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := createAttestation(test.args.packageURL, test.args.digests, test.args.vulns)
+			got := createAttestation(test.args.packageURL, test.args.digests, test.args.vulns, test.args.vulnDetails, attestation_vuln.DB{})
 			if !deepEqualIgnoreTimestamp(got, test.want) {
 				t.Errorf("createAttestation() = %v, want %v", got, test.want)
 			}
@@ -332,3 +392,54 @@ func TestCertifyHelperStackOverflow(t *testing.T) {
 		t.Errorf("Function did not return an error, but it took too long to execute, which indicates stack overflow")
 	}
 }
+
+func TestOSVCertifier_hydrate(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	var mu sync.Mutex
+	fetched := map[string]int{}
+	oldGetVulnDetails := getVulnDetails
+	getVulnDetails = func(_ context.Context, id string) (*osv_processor.Vulnerability, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		fetched[id]++
+		if id == "GHSA-fail" {
+			return nil, errors.New("unavailable")
+		}
+		return &osv_processor.Vulnerability{ID: id}, nil
+	}
+	defer func() { getVulnDetails = oldGetVulnDetails }()
+
+	seen := &osv_processor.Vulnerability{ID: "GHSA-seen"}
+	o := &osvCertifier{vulnDetails: map[string]*osv_processor.Vulnerability{"GHSA-seen": seen}}
+	var vulns []osv_scanner.MinimalVulnerability
+	for i := 0; i < 2*vulnDetailsWorkers; i++ {
+		vulns = append(vulns, osv_scanner.MinimalVulnerability{ID: fmt.Sprintf("GHSA-%d", i)})
+	}
+	vulns = append(vulns,
+		osv_scanner.MinimalVulnerability{ID: "GHSA-0"},
+		osv_scanner.MinimalVulnerability{ID: "GHSA-seen"},
+		osv_scanner.MinimalVulnerability{ID: "GHSA-fail"})
+	o.hydrate(ctx, vulns)
+
+	for id, n := range fetched {
+		if n != 1 {
+			t.Errorf("%s fetched %d times, want once", id, n)
+		}
+	}
+	if _, ok := fetched["GHSA-seen"]; ok {
+		t.Errorf("GHSA-seen fetched again")
+	}
+	if len(fetched) != 2*vulnDetailsWorkers+1 {
+		t.Errorf("fetched %d vulnerabilities, want %d", len(fetched), 2*vulnDetailsWorkers+1)
+	}
+	if o.vulnDetails["GHSA-seen"] != seen {
+		t.Errorf("details of GHSA-seen replaced")
+	}
+	if got := o.vulnDetails["GHSA-3"]; got == nil || got.ID != "GHSA-3" {
+		t.Errorf("details of GHSA-3 = %v", got)
+	}
+	if details, ok := o.vulnDetails["GHSA-fail"]; !ok || details != nil {
+		t.Errorf("details of GHSA-fail = %v, %v, want recorded without details", details, ok)
+	}
+}
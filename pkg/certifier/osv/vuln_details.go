@@ -0,0 +1,97 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	osv_scanner "github.com/google/osv-scanner/pkg/osv"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	osv_processor "github.com/guacsec/guac/pkg/handler/processor/osv"
+)
+
+const (
+	// vulnDetailsTimeout bounds the time spent fetching an OSV entry
+	vulnDetailsTimeout = 30 * time.Second
+	// vulnDetailsWorkers bounds the number of OSV entries fetched at once
+	vulnDetailsWorkers = 8
+)
+
+var vulnDetailsClient = &http.Client{Timeout: vulnDetailsTimeout}
+
+// getVulnDetails fetches the full OSV entry of a vulnerability. It is a
+// variable so that tests can avoid querying osv.dev.
+var getVulnDetails = func(ctx context.Context, id string) (*osv_processor.Vulnerability, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, osv_scanner.GetEndpoint+"/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := vulnDetailsClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get vulnerability %s: %s", id, resp.Status)
+	}
+
+	var vuln osv_processor.Vulnerability
+	if err := json.NewDecoder(resp.Body).Decode(&vuln); err != nil {
+		return nil, err
+	}
+	return &vuln, nil
+}
+
+// createResult builds the attestation result for a vulnerability, adding its
+// metadata when the details are known
+func createResult(id string, details *osv_processor.Vulnerability) attestation_vuln.Result {
+	result := attestation_vuln.Result{
+		VulnerabilityId: id,
+	}
+	if details == nil {
+		return result
+	}
+
+	result.Aliases = details.Aliases
+	result.Summary = details.Summary
+	if result.Summary == "" {
+		result.Summary = details.Details
+	}
+	for _, s := range details.Severity {
+		result.Severity = append(result.Severity, attestation_vuln.Severity{
+			Method: s.Type,
+			Score:  s.Score,
+		})
+	}
+	result.CWEIds = details.DatabaseSpecific.CWEIds
+	for _, r := range details.References {
+		result.References = append(result.References, r.URL)
+	}
+	if !details.Published.IsZero() {
+		published := details.Published
+		result.Published = &published
+	}
+	if !details.Modified.IsZero() {
+		modified := details.Modified
+		result.Modified = &modified
+	}
+	return result
+}
@@ -0,0 +1,296 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// Options configures which components are certified again and when
+type Options struct {
+	// TTL is how long a certification stays fresh. Components last certified
+	// longer ago, or never, are certified again.
+	TTL time.Duration
+	// BatchSize is the number of components certified at once, all the stale
+	// components are certified at once if it is not positive
+	BatchSize int
+	// Interval is the time waited between batches and, when polling, between
+	// the checks for stale components
+	Interval time.Duration
+	// Poll keeps checking for stale components instead of returning once they
+	// have all been certified
+	Poll bool
+	// StatePath is the file persisting when components were certified, so
+	// that restarts do not certify them again. The progress is not persisted
+	// if it is empty.
+	StatePath string
+}
+
+// state records when the keys of the components were last certified by the
+// scheduler, with each type of certifier
+type state struct {
+	LastCertified map[certifier.CertifierType]map[string]time.Time `json:"lastCertified"`
+}
+
+type component struct {
+	value         interface{}
+	keys          []string
+	lastCertified time.Time
+}
+
+// batchQuery returns a batch of components already queried
+type batchQuery []*component
+
+func (b batchQuery) GetComponents(ctx context.Context, compChan chan<- interface{}) error {
+	if compChan == nil {
+		return fmt.Errorf("compChan cannot be nil")
+	}
+	for _, c := range b {
+		compChan <- c.value
+	}
+	return nil
+}
+
+// Schedule certifies the components of the query that are stale, either
+// according to the scan times recorded in the GUAC graph by the trackers or to
+// the state of previous runs, the least recently certified first. The
+// freshness of the components is tracked for each registered certifier, with
+// the tracker of its type, and the certifiers only certify the components
+// that are stale for them. Only the components certified without error are
+// recorded as certified. The work is spread over batches separated by the
+// interval of the options.
+func Schedule(ctx context.Context, query certifier.QueryComponents, trackers map[certifier.CertifierType]certifier.ScanTracker, emitter certifier.Emitter, handleErr certifier.ErrHandler, opts Options) error {
+	logger := logging.FromContext(ctx)
+	certifierTypes := certify.CertifierTypes()
+	for _, certifierType := range certifierTypes {
+		if trackers[certifierType] == nil {
+			return fmt.Errorf("no tracker for certifier type: %s", certifierType)
+		}
+	}
+
+	s, err := loadState(opts.StatePath)
+	if err != nil {
+		return err
+	}
+
+	for {
+		values, err := getComponents(ctx, query)
+		if err != nil {
+			return err
+		}
+		for _, certifierType := range certifierTypes {
+			if s.LastCertified[certifierType] == nil {
+				s.LastCertified[certifierType] = map[string]time.Time{}
+			}
+			lastCertified := s.LastCertified[certifierType]
+			stale, err := staleComponents(ctx, values, trackers[certifierType], lastCertified, time.Now().Add(-opts.TTL))
+			if err != nil {
+				return err
+			}
+			if len(stale) > 0 {
+				logger.Infof("%d components to certify with the %s certifier", len(stale), certifierType)
+			}
+
+			for len(stale) > 0 {
+				batch := stale
+				if opts.BatchSize > 0 && len(batch) > opts.BatchSize {
+					batch = stale[:opts.BatchSize]
+				}
+				stale = stale[len(batch):]
+
+				failed := 0
+				for _, c := range batch {
+					ok, err := certifyComponent(ctx, certifierType, c, emitter, handleErr)
+					if err != nil {
+						return err
+					}
+					if !ok {
+						failed++
+						continue
+					}
+					certified := time.Now()
+					for _, key := range c.keys {
+						lastCertified[key] = certified
+					}
+				}
+				if err := s.save(opts.StatePath); err != nil {
+					return err
+				}
+				logger.Infof("certified %d components with the %s certifier, %d failed, %d remaining", len(batch)-failed, certifierType, failed, len(stale))
+
+				if len(stale) > 0 {
+					if err := wait(ctx, opts.Interval); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if !opts.Poll {
+			return nil
+		}
+		if err := wait(ctx, opts.Interval); err != nil {
+			return err
+		}
+	}
+}
+
+// certifyComponent certifies the component with the certifier of the given
+// type, and reports whether it did so without error
+func certifyComponent(ctx context.Context, certifierType certifier.CertifierType, c *component, emitter certifier.Emitter, handleErr certifier.ErrHandler) (bool, error) {
+	ok := true
+	errHandler := func(err error) bool {
+		if err != nil {
+			ok = false
+		}
+		return handleErr(err)
+	}
+	if err := certify.CertifyWithType(ctx, certifierType, batchQuery{c}, emitter, errHandler); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+// staleComponents returns the components last certified before the deadline,
+// sorted from the least recently certified
+func staleComponents(ctx context.Context, values []interface{}, tracker certifier.ScanTracker, lastCertified map[string]time.Time, deadline time.Time) ([]*component, error) {
+	lastScanned, err := tracker.LastScanned(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the last scan times: %w", err)
+	}
+
+	var stale []*component
+	for _, v := range values {
+		keys, err := tracker.ComponentKeys(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the component keys: %w", err)
+		}
+		c := &component{value: v, keys: keys}
+		// a component is as stale as its least recently certified key
+		for i, key := range c.keys {
+			last := lastScanned[key]
+			if lastCertified[key].After(last) {
+				last = lastCertified[key]
+			}
+			if i == 0 || last.Before(c.lastCertified) {
+				c.lastCertified = last
+			}
+		}
+		if c.lastCertified.Before(deadline) {
+			stale = append(stale, c)
+		}
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return stale[i].lastCertified.Before(stale[j].lastCertified)
+	})
+	return stale, nil
+}
+
+// getComponents collects all the components returned by the query
+func getComponents(ctx context.Context, query certifier.QueryComponents) ([]interface{}, error) {
+	compChan := make(chan interface{}, certify.BufferChannelSize)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- query.GetComponents(ctx, compChan)
+	}()
+
+	var components []interface{}
+	for {
+		select {
+		case c := <-compChan:
+			components = append(components, c)
+		case err := <-errChan:
+			if err != nil {
+				return nil, fmt.Errorf("failed to get components: %w", err)
+			}
+			for len(compChan) > 0 {
+				components = append(components, <-compChan)
+			}
+			return components, nil
+		}
+	}
+}
+
+func wait(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func loadState(path string) (*state, error) {
+	s := &state{LastCertified: map[certifier.CertifierType]map[string]time.Time{}}
+	if path == "" {
+		return s, nil
+	}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the scheduler state: %w", err)
+	}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, fmt.Errorf("failed to parse the scheduler state %s: %w", path, err)
+	}
+	if s.LastCertified == nil {
+		s.LastCertified = map[certifier.CertifierType]map[string]time.Time{}
+	}
+	return s, nil
+}
+
+// save writes the state to a temporary file first, so that an interrupted
+// write does not lose the previous state
+func (s *state) save(path string) error {
+	if path == "" {
+		return nil
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the scheduler state: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write the scheduler state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write the scheduler state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write the scheduler state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write the scheduler state: %w", err)
+	}
+	return nil
+}
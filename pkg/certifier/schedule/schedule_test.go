@@ -0,0 +1,225 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/pkg/certifier"
+	"github.com/guacsec/guac/pkg/certifier/certify"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+type mockQuery struct {
+	components []string
+}
+
+// GetComponents returns components for test
+func (q *mockQuery) GetComponents(ctx context.Context, compChan chan<- interface{}) error {
+	for _, c := range q.components {
+		compChan <- c
+	}
+	return nil
+}
+
+type mockTracker struct {
+	lastScanned map[string]time.Time
+}
+
+func (t *mockTracker) ComponentKeys(component interface{}) ([]string, error) {
+	s, ok := component.(string)
+	if !ok {
+		return nil, fmt.Errorf("component type is not string")
+	}
+	return []string{s}, nil
+}
+
+func (t *mockTracker) LastScanned(ctx context.Context) (map[string]time.Time, error) {
+	return t.lastScanned, nil
+}
+
+type mockCertifier struct{}
+
+// CertifyComponent emits a document named after the component
+func (m *mockCertifier) CertifyComponent(ctx context.Context, component interface{}, docChannel chan<- *processor.Document) error {
+	docChannel <- &processor.Document{
+		SourceInformation: processor.SourceInformation{Source: component.(string)},
+	}
+	return nil
+}
+
+// flakyCertifier fails to certify the "bad" component
+type flakyCertifier struct {
+	attempts *[]string
+}
+
+// CertifyComponent emits a document named after the certifier and the component
+func (f *flakyCertifier) CertifyComponent(ctx context.Context, component interface{}, docChannel chan<- *processor.Document) error {
+	*f.attempts = append(*f.attempts, component.(string))
+	if component == "bad" {
+		return fmt.Errorf("failed to certify %v", component)
+	}
+	docChannel <- &processor.Document{
+		SourceInformation: processor.SourceInformation{Source: "flaky/" + component.(string)},
+	}
+	return nil
+}
+
+func TestSchedule(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	err := certify.RegisterCertifier(func() certifier.Certifier { return &mockCertifier{} }, "mock")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	now := time.Now()
+	query := &mockQuery{components: []string{"fresh", "stale", "never", "staler"}}
+	tracker := &mockTracker{lastScanned: map[string]time.Time{
+		"fresh":  now.Add(-time.Hour),
+		"stale":  now.Add(-48 * time.Hour),
+		"staler": now.Add(-72 * time.Hour),
+	}}
+	trackers := map[certifier.CertifierType]certifier.ScanTracker{"mock": tracker}
+	opts := Options{
+		TTL:       24 * time.Hour,
+		BatchSize: 2,
+		Interval:  time.Millisecond,
+		StatePath: filepath.Join(t.TempDir(), "state.json"),
+	}
+	errHandler := func(err error) bool {
+		return err == nil
+	}
+
+	var certified []string
+	emitter := func(d *processor.Document) error {
+		certified = append(certified, d.SourceInformation.Source)
+		return nil
+	}
+
+	if err := Schedule(ctx, query, trackers, emitter, errHandler, opts); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	// the least recently certified first
+	if d := cmp.Diff([]string{"never", "staler", "stale"}, certified); d != "" {
+		t.Errorf("Schedule() certified mismatch (-want +got):\n%s", d)
+	}
+
+	// the progress persisted by the previous run is taken into account
+	certified = nil
+	if err := Schedule(ctx, query, trackers, emitter, errHandler, opts); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	if len(certified) > 0 {
+		t.Errorf("Schedule() certified %v again", certified)
+	}
+
+	// everything is certified again once the TTL is over
+	certified = nil
+	opts.TTL = 0
+	opts.BatchSize = 0
+	if err := Schedule(ctx, query, trackers, emitter, errHandler, opts); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	if d := cmp.Diff([]string{"fresh", "stale", "never", "staler"}, certified, cmpopts.SortSlices(func(a, b string) bool { return a < b })); d != "" {
+		t.Errorf("Schedule() certified mismatch (-want +got):\n%s", d)
+	}
+
+	// polling runs until the context is done
+	certified = nil
+	opts.TTL = 2 * time.Hour
+	opts.StatePath = ""
+	opts.Poll = true
+	pollCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	err = Schedule(pollCtx, query, trackers, emitter, errHandler, opts)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Schedule() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if d := cmp.Diff([]string{"never", "staler", "stale"}, certified); d != "" {
+		t.Errorf("Schedule() certified mismatch (-want +got):\n%s", d)
+	}
+}
+
+func TestSchedule_certifierFreshness(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	var attempts []string
+	err := certify.RegisterCertifier(func() certifier.Certifier { return &flakyCertifier{attempts: &attempts} }, "flaky")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	now := time.Now()
+	query := &mockQuery{components: []string{"good", "bad"}}
+	// the components were scanned by the mock certifier, not the flaky one
+	trackers := map[certifier.CertifierType]certifier.ScanTracker{
+		"mock": &mockTracker{lastScanned: map[string]time.Time{
+			"good": now.Add(-time.Hour),
+			"bad":  now.Add(-time.Hour),
+		}},
+		"flaky": &mockTracker{},
+	}
+	opts := Options{
+		TTL:       24 * time.Hour,
+		Interval:  time.Millisecond,
+		StatePath: filepath.Join(t.TempDir(), "state.json"),
+	}
+	// keep certifying the other components on errors
+	errHandler := func(err error) bool {
+		return true
+	}
+
+	var certified []string
+	emitter := func(d *processor.Document) error {
+		certified = append(certified, d.SourceInformation.Source)
+		return nil
+	}
+
+	if err := Schedule(ctx, query, trackers, emitter, errHandler, opts); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	if d := cmp.Diff([]string{"flaky/good"}, certified); d != "" {
+		t.Errorf("Schedule() certified mismatch (-want +got):\n%s", d)
+	}
+	if d := cmp.Diff([]string{"good", "bad"}, attempts); d != "" {
+		t.Errorf("Schedule() attempts mismatch (-want +got):\n%s", d)
+	}
+
+	// the component that failed is not recorded as certified
+	certified, attempts = nil, nil
+	if err := Schedule(ctx, query, trackers, emitter, errHandler, opts); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	if len(certified) > 0 {
+		t.Errorf("Schedule() certified %v again", certified)
+	}
+	if d := cmp.Diff([]string{"bad"}, attempts); d != "" {
+		t.Errorf("Schedule() attempts mismatch (-want +got):\n%s", d)
+	}
+
+	// all the registered certifiers need a tracker
+	delete(trackers, "flaky")
+	if err := Schedule(ctx, query, trackers, emitter, errHandler, opts); err == nil {
+		t.Errorf("Schedule() expected an error without a tracker for the flaky certifier")
+	}
+}
@@ -57,7 +57,7 @@ func (s scorecard) CertifyComponent(_ context.Context, rootComponent interface{}
 	}
 
 	// Remove the git+ prefix from the artifact name
-	repoName := strings.TrimLeft(artifactNode.Name, "git+")
+	repoName := strings.TrimPrefix(artifactNode.Name, "git+")
 
 	// s.artifact.Digest is the commit SHA
 	if artifactNode.Digest == "" {
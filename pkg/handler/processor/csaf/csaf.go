@@ -0,0 +1,49 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"fmt"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// CSAFProcessor processes CSAF 2.0 security advisories and VEX documents.
+type CSAFProcessor struct {
+}
+
+func (p *CSAFProcessor) ValidateSchema(d *processor.Document) error {
+	if d.Type != processor.DocumentCSAF {
+		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentCSAF, d.Type)
+	}
+
+	_, err := LoadDocument(d.Blob, d.Format)
+	return err
+}
+
+// Unpack takes in the document and tries to unpack it
+// if there is a valid decomposition of sub-documents.
+//
+// Returns empty list and nil error if nothing to unpack
+// Returns unpacked list and nil error if successfully unpacked
+func (p *CSAFProcessor) Unpack(d *processor.Document) ([]*processor.Document, error) {
+	if d.Type != processor.DocumentCSAF {
+		return nil, fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentCSAF, d.Type)
+	}
+
+	// CSAF doesn't unpack into additional documents at the moment.
+	return []*processor.Document{}, nil
+}
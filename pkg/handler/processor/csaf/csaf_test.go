@@ -0,0 +1,157 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestCSAFProcessor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "CSAF document",
+		doc: processor.Document{
+			Blob:              testdata.CsafVexExample,
+			Format:            processor.FormatJSON,
+			Type:              processor.DocumentCSAF,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expected:  []*processor.Document{},
+		expectErr: false,
+	}, {
+		name: "Incorrect type",
+		doc: processor.Document{
+			Blob:              testdata.CsafVexExample,
+			Format:            processor.FormatJSON,
+			Type:              processor.DocumentUnknown,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expected:  nil,
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := CSAFProcessor{}
+			actual, err := d.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("CSAFProcessor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("CSAFProcessor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCSAFProcessor_ValidateSchema(t *testing.T) {
+	document := func(version string, vulnerabilities string) []byte {
+		return []byte(`{
+			"document": {
+				"category": "csaf_vex",
+				"csaf_version": "` + version + `",
+				"publisher": {"category": "vendor", "name": "Example", "namespace": "https://psirt.example.com"},
+				"title": "Example",
+				"tracking": {
+					"id": "EXAMPLE-1",
+					"status": "final",
+					"version": "1",
+					"initial_release_date": "2023-04-01T00:00:00Z",
+					"current_release_date": "2023-04-01T00:00:00Z"
+				}
+			},
+			"product_tree": {"full_product_names": [{"name": "example", "product_id": "example-1"}]},
+			"vulnerabilities": ` + vulnerabilities + `
+		}`)
+	}
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expectErr bool
+	}{{
+		name: "valid CSAF document",
+		doc: processor.Document{
+			Blob:   testdata.CsafVexExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCSAF,
+		},
+		expectErr: false,
+	}, {
+		name: "minimal CSAF document",
+		doc: processor.Document{
+			Blob:   document("2.0", `[{"cve": "CVE-2023-0286", "product_status": {"fixed": ["example-1"]}}]`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCSAF,
+		},
+		expectErr: false,
+	}, {
+		name: "undefined product",
+		doc: processor.Document{
+			Blob:   document("2.0", `[{"cve": "CVE-2023-0286", "product_status": {"fixed": ["example-2"]}}]`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCSAF,
+		},
+		expectErr: true,
+	}, {
+		name: "unsupported CSAF version",
+		doc: processor.Document{
+			Blob:   document("1.2", `[]`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCSAF,
+		},
+		expectErr: true,
+	}, {
+		name: "not a CSAF document",
+		doc: processor.Document{
+			Blob:   testdata.OpenVEXExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCSAF,
+		},
+		expectErr: true,
+	}, {
+		name: "invalid format supported",
+		doc: processor.Document{
+			Blob:   testdata.CsafVexExample,
+			Format: processor.FormatYAML,
+			Type:   processor.DocumentCSAF,
+		},
+		expectErr: true,
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:   testdata.CsafVexExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := CSAFProcessor{}
+			err := d.ValidateSchema(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("CSAFProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
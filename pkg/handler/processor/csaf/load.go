@@ -0,0 +1,320 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+const csafVersion = "2.0"
+
+// Document is a CSAF 2.0 document. Only the parts of the specification that
+// are ingested by GUAC are modelled.
+type Document struct {
+	Document        DocumentMetadata `json:"document"`
+	ProductTree     *ProductTree     `json:"product_tree,omitempty"`
+	Vulnerabilities []Vulnerability  `json:"vulnerabilities,omitempty"`
+}
+
+// DocumentMetadata describes the document itself
+type DocumentMetadata struct {
+	Category    string    `json:"category"`
+	CSAFVersion string    `json:"csaf_version"`
+	Publisher   Publisher `json:"publisher"`
+	Title       string    `json:"title"`
+	Tracking    Tracking  `json:"tracking"`
+}
+
+// Publisher is the vendor or coordinator publishing the document
+type Publisher struct {
+	Category  string `json:"category"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// Tracking holds the identity and the release information of the document
+type Tracking struct {
+	ID                 string     `json:"id"`
+	Status             string     `json:"status"`
+	Version            string     `json:"version"`
+	InitialReleaseDate *time.Time `json:"initial_release_date"`
+	CurrentReleaseDate *time.Time `json:"current_release_date"`
+	Generator          *Generator `json:"generator,omitempty"`
+}
+
+// Generator is the tool that generated the document
+type Generator struct {
+	Engine struct {
+		Name    string `json:"name"`
+		Version string `json:"version,omitempty"`
+	} `json:"engine"`
+}
+
+// ProductTree holds the products the document refers to
+type ProductTree struct {
+	Branches         []Branch          `json:"branches,omitempty"`
+	FullProductNames []FullProductName `json:"full_product_names,omitempty"`
+	Relationships    []Relationship    `json:"relationships,omitempty"`
+	ProductGroups    []ProductGroup    `json:"product_groups,omitempty"`
+}
+
+// Branch is a node of the hierarchy of vendors, product families, versions
+// etc. The leaves of the hierarchy are products.
+type Branch struct {
+	Category string           `json:"category"`
+	Name     string           `json:"name"`
+	Branches []Branch         `json:"branches,omitempty"`
+	Product  *FullProductName `json:"product,omitempty"`
+}
+
+// FullProductName identifies a product
+type FullProductName struct {
+	Name                        string                       `json:"name"`
+	ProductID                   string                       `json:"product_id"`
+	ProductIdentificationHelper *ProductIdentificationHelper `json:"product_identification_helper,omitempty"`
+}
+
+// ProductIdentificationHelper holds the software identifiers of a product
+type ProductIdentificationHelper struct {
+	CPE    string        `json:"cpe,omitempty"`
+	PURL   string        `json:"purl,omitempty"`
+	Hashes []ProductHash `json:"hashes,omitempty"`
+}
+
+// ProductHash holds the hashes of a file of a product
+type ProductHash struct {
+	FileHashes []FileHash `json:"file_hashes"`
+	Filename   string     `json:"filename"`
+}
+
+// FileHash is a hash of a file
+type FileHash struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"value"`
+}
+
+// Relationship defines a product by combining two products, e.g. a package
+// installed on a platform
+type Relationship struct {
+	Category                  string          `json:"category"`
+	FullProductName           FullProductName `json:"full_product_name"`
+	ProductReference          string          `json:"product_reference"`
+	RelatesToProductReference string          `json:"relates_to_product_reference"`
+}
+
+// ProductGroup names a group of products
+type ProductGroup struct {
+	GroupID    string   `json:"group_id"`
+	ProductIDs []string `json:"product_ids"`
+}
+
+// Vulnerability describes a vulnerability and its impact on the products
+type Vulnerability struct {
+	CVE           string         `json:"cve,omitempty"`
+	CWE           *CWE           `json:"cwe,omitempty"`
+	IDs           []ID           `json:"ids,omitempty"`
+	Title         string         `json:"title,omitempty"`
+	Notes         []Note         `json:"notes,omitempty"`
+	References    []Reference    `json:"references,omitempty"`
+	DiscoveryDate *time.Time     `json:"discovery_date,omitempty"`
+	ReleaseDate   *time.Time     `json:"release_date,omitempty"`
+	ProductStatus *ProductStatus `json:"product_status,omitempty"`
+	Scores        []Score        `json:"scores,omitempty"`
+	Flags         []Flag         `json:"flags,omitempty"`
+	Threats       []Threat       `json:"threats,omitempty"`
+	Remediations  []Remediation  `json:"remediations,omitempty"`
+}
+
+// CWE is the weakness the vulnerability belongs to
+type CWE struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Note is a piece of text describing the vulnerability, e.g. its summary
+type Note struct {
+	Category string `json:"category"`
+	Text     string `json:"text"`
+	Title    string `json:"title,omitempty"`
+}
+
+// Reference is a link to further information about the vulnerability
+type Reference struct {
+	Category string `json:"category,omitempty"`
+	Summary  string `json:"summary"`
+	URL      string `json:"url"`
+}
+
+// Score holds the CVSS scores of the vulnerability for some products
+type Score struct {
+	CVSSV2   *CVSS    `json:"cvss_v2,omitempty"`
+	CVSSV3   *CVSS    `json:"cvss_v3,omitempty"`
+	Products []string `json:"products"`
+}
+
+// CVSS is a CVSS score. Only the base score and the vector are modelled.
+type CVSS struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString"`
+	BaseScore    float64 `json:"baseScore"`
+}
+
+// ID is an identifier of the vulnerability in a tracking system
+type ID struct {
+	SystemName string `json:"system_name"`
+	Text       string `json:"text"`
+}
+
+// ProductStatus lists the products by their status regarding the
+// vulnerability
+type ProductStatus struct {
+	FirstAffected      []string `json:"first_affected,omitempty"`
+	FirstFixed         []string `json:"first_fixed,omitempty"`
+	Fixed              []string `json:"fixed,omitempty"`
+	KnownAffected      []string `json:"known_affected,omitempty"`
+	KnownNotAffected   []string `json:"known_not_affected,omitempty"`
+	LastAffected       []string `json:"last_affected,omitempty"`
+	Recommended        []string `json:"recommended,omitempty"`
+	UnderInvestigation []string `json:"under_investigation,omitempty"`
+}
+
+// Flag is a machine readable justification of why products are not affected
+type Flag struct {
+	Label      string     `json:"label"`
+	Date       *time.Time `json:"date,omitempty"`
+	GroupIDs   []string   `json:"group_ids,omitempty"`
+	ProductIDs []string   `json:"product_ids,omitempty"`
+}
+
+// Threat describes the kind of threat the vulnerability poses to products
+type Threat struct {
+	Category   string     `json:"category"`
+	Details    string     `json:"details"`
+	Date       *time.Time `json:"date,omitempty"`
+	GroupIDs   []string   `json:"group_ids,omitempty"`
+	ProductIDs []string   `json:"product_ids,omitempty"`
+}
+
+// Remediation describes how to remediate the vulnerability in products
+type Remediation struct {
+	Category   string     `json:"category"`
+	Details    string     `json:"details"`
+	Date       *time.Time `json:"date,omitempty"`
+	URL        string     `json:"url,omitempty"`
+	GroupIDs   []string   `json:"group_ids,omitempty"`
+	ProductIDs []string   `json:"product_ids,omitempty"`
+}
+
+// LoadDocument decodes a CSAF document and validates the properties
+// required by the specification
+func LoadDocument(blob []byte, format processor.FormatType) (*Document, error) {
+	if format != processor.FormatJSON {
+		return nil, fmt.Errorf("unable to support parsing of CSAF document format: %v", format)
+	}
+
+	doc := &Document{}
+	if err := json.Unmarshal(blob, doc); err != nil {
+		return nil, err
+	}
+	if err := validate(doc); err != nil {
+		return nil, fmt.Errorf("invalid CSAF document: %w", err)
+	}
+	return doc, nil
+}
+
+func validate(doc *Document) error {
+	d := doc.Document
+	if d.CSAFVersion != csafVersion {
+		return fmt.Errorf("unsupported csaf_version %q", d.CSAFVersion)
+	}
+	switch {
+	case d.Category == "":
+		return fmt.Errorf("missing document category")
+	case d.Title == "":
+		return fmt.Errorf("missing document title")
+	case d.Publisher.Name == "" || d.Publisher.Namespace == "":
+		return fmt.Errorf("missing publisher name or namespace")
+	case d.Tracking.ID == "" || d.Tracking.Status == "" || d.Tracking.Version == "":
+		return fmt.Errorf("missing tracking id, status or version")
+	case d.Tracking.InitialReleaseDate == nil || d.Tracking.CurrentReleaseDate == nil:
+		return fmt.Errorf("missing tracking release dates")
+	}
+
+	// every product referenced by a vulnerability has to be defined in
+	// the product tree
+	products := doc.ProductIDs()
+	for i, v := range doc.Vulnerabilities {
+		if v.ProductStatus == nil {
+			continue
+		}
+		for _, id := range v.ProductStatus.All() {
+			if !products[id] {
+				return fmt.Errorf("vulnerability %d references undefined product %q", i, id)
+			}
+		}
+	}
+	return nil
+}
+
+// ProductIDs returns the IDs of all the products defined in the product tree
+func (doc *Document) ProductIDs() map[string]bool {
+	ids := map[string]bool{}
+	if doc.ProductTree == nil {
+		return ids
+	}
+	doc.ProductTree.Walk(func(p *FullProductName) {
+		ids[p.ProductID] = true
+	})
+	return ids
+}
+
+// Walk calls fn for every product of the product tree: the leaves of the
+// branches, the full product names and the products defined by
+// relationships.
+func (t *ProductTree) Walk(fn func(p *FullProductName)) {
+	var walkBranches func(branches []Branch)
+	walkBranches = func(branches []Branch) {
+		for i := range branches {
+			if branches[i].Product != nil {
+				fn(branches[i].Product)
+			}
+			walkBranches(branches[i].Branches)
+		}
+	}
+	walkBranches(t.Branches)
+	for i := range t.FullProductNames {
+		fn(&t.FullProductNames[i])
+	}
+	for i := range t.Relationships {
+		fn(&t.Relationships[i].FullProductName)
+	}
+}
+
+// All returns the IDs of the products of every status
+func (s *ProductStatus) All() []string {
+	var all []string
+	for _, ids := range [][]string{
+		s.FirstAffected, s.FirstFixed, s.Fixed, s.KnownAffected,
+		s.KnownNotAffected, s.LastAffected, s.Recommended, s.UnderInvestigation,
+	} {
+		all = append(all, ids...)
+	}
+	return all
+}
@@ -0,0 +1,63 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+var tagValueLine = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]*:\s`)
+
+type tagValueFormatGuesser struct{}
+
+// GuessFormat expects every line of the blob, outside of <text> blocks and
+// comments, to be a "Tag: Value" pair as used by SPDX tag-value documents
+func (_ *tagValueFormatGuesser) GuessFormat(blob []byte) processor.FormatType {
+	if isTagValue(blob) {
+		return processor.FormatTagValue
+	}
+	return processor.FormatUnknown
+}
+
+func isTagValue(blob []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(blob))
+	scanner.Buffer(nil, len(blob)+1)
+	inText := false
+	pairs := 0
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if inText {
+			inText = !strings.Contains(line, "</text>")
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !tagValueLine.MatchString(line) {
+			return false
+		}
+		pairs++
+		if strings.Contains(line, "<text>") && !strings.Contains(line, "</text>") {
+			inText = true
+		}
+	}
+	return scanner.Err() == nil && pairs > 0
+}
@@ -0,0 +1,63 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package guesser
+
+import (
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func Test_TagValueGuesser(t *testing.T) {
+	testCases := []struct {
+		name     string
+		blob     []byte
+		expected processor.FormatType
+	}{{
+		name:     "SPDX tag-value",
+		blob:     testdata.SpdxExampleAlpineTagValue,
+		expected: processor.FormatTagValue,
+	}, {
+		name: "multi-line text value",
+		blob: []byte(`SPDXVersion: SPDX-2.3
+# a comment
+PackageComment: <text>first line
+second line
+</text>
+PackageName: foo
+`),
+		expected: processor.FormatTagValue,
+	}, {
+		name:     "YAML",
+		blob:     []byte("a:\n  b: value\n"),
+		expected: processor.FormatUnknown,
+	}, {
+		name:     "JSON",
+		blob:     []byte(`{ "abc": "def"}`),
+		expected: processor.FormatUnknown,
+	}}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			guesser := &tagValueFormatGuesser{}
+			f := guesser.GuessFormat(tt.blob)
+			if f != tt.expected {
+				t.Errorf("got the wrong format, got %v, expected %v", f, tt.expected)
+			}
+		})
+	}
+}
@@ -79,6 +79,46 @@ func Test_GuessDocument(t *testing.T) {
 		},
 		expectedType:   processor.DocumentSPDX,
 		expectedFormat: processor.FormatJSON,
+	}, {
+		name: "valid SPDX 3 Document",
+		document: &processor.Document{
+			Blob:              testdata.Spdx3Example,
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectedType:   processor.DocumentSPDX3,
+		expectedFormat: processor.FormatJSON,
+	}, {
+		name: "valid OpenVEX Document",
+		document: &processor.Document{
+			Blob:              testdata.OpenVEXExample,
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectedType:   processor.DocumentOpenVEX,
+		expectedFormat: processor.FormatJSON,
+	}, {
+		name: "valid CSAF Document",
+		document: &processor.Document{
+			Blob:              testdata.CsafVexExample,
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectedType:   processor.DocumentCSAF,
+		expectedFormat: processor.FormatJSON,
+	}, {
+		name: "valid OSV Document",
+		document: &processor.Document{
+			Blob:              testdata.OsvGhsaExample,
+			Type:              processor.DocumentUnknown,
+			Format:            processor.FormatUnknown,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectedType:   processor.DocumentOSV,
+		expectedFormat: processor.FormatJSON,
 	}, {
 		name: "valid DSSE Document",
 		document: &processor.Document{
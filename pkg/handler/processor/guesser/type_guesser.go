@@ -25,8 +25,12 @@ func init() {
 	_ = RegisterDocumentTypeGuesser(&ite6TypeGuesser{}, "ite6")
 	_ = RegisterDocumentTypeGuesser(&dsseTypeGuesser{}, "dsse")
 	_ = RegisterDocumentTypeGuesser(&spdxTypeGuesser{}, "spdx")
+	_ = RegisterDocumentTypeGuesser(&spdx3TypeGuesser{}, "spdx3")
 	_ = RegisterDocumentTypeGuesser(&scorecardTypeGuesser{}, "scorecard")
 	_ = RegisterDocumentTypeGuesser(&cycloneDXTypeGuesser{}, "cyclonedx")
+	_ = RegisterDocumentTypeGuesser(&openVEXTypeGuesser{}, "openvex")
+	_ = RegisterDocumentTypeGuesser(&csafTypeGuesser{}, "csaf")
+	_ = RegisterDocumentTypeGuesser(&osvTypeGuesser{}, "osv")
 }
 
 // DocumentTypeGuesser guesses the document type based on the blob and format given
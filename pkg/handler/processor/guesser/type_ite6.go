@@ -37,6 +37,10 @@ func (_ *ite6TypeGuesser) GuessDocumentType(blob []byte, format processor.Format
 				return processor.DocumentITE6Generic
 			} else if strings.HasPrefix(statement.PredicateType, "https://in-toto.io/attestation/vuln/v0.1") {
 				return processor.DocumentITE6Vul
+			} else if strings.HasPrefix(statement.PredicateType, "https://in-toto.io/attestation/malicious/v0.1") {
+				return processor.DocumentITE6Malicious
+			} else if strings.HasPrefix(statement.PredicateType, "https://in-toto.io/attestation/eol/v0.1") {
+				return processor.DocumentITE6EOL
 			}
 			return processor.DocumentITE6Generic
 		}
@@ -43,6 +43,10 @@ func Test_Ite6TypeGuesser(t *testing.T) {
 		name:     "valid SLSA ITE6 Document with different versions",
 		blob:     []byte(`{"_type": "https://in-toto.io/Statement/v1.1", "predicateType": "https://slsa.dev/provenance/v1.0"}`),
 		expected: processor.DocumentITE6SLSA,
+	}, {
+		name:     "valid SLSA v1 ITE6 Document",
+		blob:     []byte(`{"_type": "https://in-toto.io/Statement/v1", "predicateType": "https://slsa.dev/provenance/v1"}`),
+		expected: processor.DocumentITE6SLSA,
 	}, {
 		name:     "valid CREV ITE6 Document",
 		blob:     testdata.ITE6CREVExample,
@@ -55,6 +59,14 @@ func Test_Ite6TypeGuesser(t *testing.T) {
 		name:     "valid Vuln ITE6 Document",
 		blob:     testdata.ITE6VulnExample,
 		expected: processor.DocumentITE6Vul,
+	}, {
+		name:     "valid Malicious ITE6 Document",
+		blob:     []byte(`{"_type": "https://in-toto.io/Statement/v0.1", "predicateType": "https://in-toto.io/attestation/malicious/v0.1"}`),
+		expected: processor.DocumentITE6Malicious,
+	}, {
+		name:     "valid EOL ITE6 Document",
+		blob:     []byte(`{"_type": "https://in-toto.io/Statement/v0.1", "predicateType": "https://in-toto.io/attestation/eol/v0.1"}`),
+		expected: processor.DocumentITE6EOL,
 	}}
 
 	for _, tt := range testCases {
@@ -16,19 +16,16 @@
 package guesser
 
 import (
-	"bytes"
-
 	"github.com/guacsec/guac/pkg/handler/processor"
-	spdx_json "github.com/spdx/tools-golang/json"
+	"github.com/guacsec/guac/pkg/handler/processor/spdx"
 )
 
 type spdxTypeGuesser struct{}
 
 func (_ *spdxTypeGuesser) GuessDocumentType(blob []byte, format processor.FormatType) processor.DocumentType {
-	reader := bytes.NewReader(blob)
 	switch format {
-	case processor.FormatJSON:
-		spdxDoc, err := spdx_json.Load2_2(reader)
+	case processor.FormatJSON, processor.FormatYAML, processor.FormatTagValue:
+		spdxDoc, err := spdx.LoadDocument(blob, format)
 		if err == nil {
 			if spdxDoc.DocumentName != "" {
 				return processor.DocumentSPDX
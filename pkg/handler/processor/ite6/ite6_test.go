@@ -125,6 +125,30 @@ func TestITE6Processor_ValidateSchema(t *testing.T) {
 			},
 		},
 		wantErr: false,
+	}, {
+		name: "ITE6 Malicious with valid payload",
+		args: &processor.Document{
+			Blob:   []byte(`{"_type": "https://in-toto.io/Statement/v0.1", "predicateType": "https://in-toto.io/attestation/malicious/v0.1", "subject": [{"name": "pkg:npm/ua-parser-js@0.7.29"}]}`),
+			Type:   processor.DocumentITE6Malicious,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantErr: false,
+	}, {
+		name: "ITE6 EOL with valid payload",
+		args: &processor.Document{
+			Blob:   []byte(`{"_type": "https://in-toto.io/Statement/v0.1", "predicateType": "https://in-toto.io/attestation/eol/v0.1", "subject": [{"name": "pkg:guac/oci/docker.io/library/python:3.7"}]}`),
+			Type:   processor.DocumentITE6EOL,
+			Format: processor.FormatJSON,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantErr: false,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,160 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openvex
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// contextPrefix is the prefix of the JSON-LD contexts of the OpenVEX
+// releases, e.g. https://openvex.dev/ns/v0.2.0
+const contextPrefix = "https://openvex.dev/ns"
+
+// Status is the impact status of a vulnerability on a product
+type Status string
+
+// Status* are the statuses defined by the VEX specification
+const (
+	StatusNotAffected        Status = "not_affected"
+	StatusAffected           Status = "affected"
+	StatusFixed              Status = "fixed"
+	StatusUnderInvestigation Status = "under_investigation"
+)
+
+// Document is an OpenVEX document
+type Document struct {
+	Context    string      `json:"@context"`
+	ID         string      `json:"@id"`
+	Author     string      `json:"author"`
+	Role       string      `json:"role,omitempty"`
+	Timestamp  *time.Time  `json:"timestamp"`
+	LastUpdate *time.Time  `json:"last_updated,omitempty"`
+	Version    json.Number `json:"version"`
+	Tooling    string      `json:"tooling,omitempty"`
+	Statements []Statement `json:"statements"`
+}
+
+// Statement asserts the status of a vulnerability in a list of products
+type Statement struct {
+	ID                       string        `json:"@id,omitempty"`
+	Vulnerability            Vulnerability `json:"vulnerability"`
+	Timestamp                *time.Time    `json:"timestamp,omitempty"`
+	LastUpdated              *time.Time    `json:"last_updated,omitempty"`
+	Products                 []Product     `json:"products,omitempty"`
+	Status                   Status        `json:"status"`
+	StatusNotes              string        `json:"status_notes,omitempty"`
+	Justification            string        `json:"justification,omitempty"`
+	ImpactStatement          string        `json:"impact_statement,omitempty"`
+	ActionStatement          string        `json:"action_statement,omitempty"`
+	ActionStatementTimestamp *time.Time    `json:"action_statement_timestamp,omitempty"`
+}
+
+// Vulnerability identifies the vulnerability of a statement. OpenVEX 0.0.1
+// documents name the vulnerability with a plain string.
+type Vulnerability struct {
+	ID      string   `json:"@id,omitempty"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+func (v *Vulnerability) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*v = Vulnerability{Name: name}
+		return nil
+	}
+	type vulnerability Vulnerability
+	return json.Unmarshal(data, (*vulnerability)(v))
+}
+
+// Product identifies a product (or a subcomponent of a product) by its IRI,
+// its software identifiers and its hashes. OpenVEX 0.0.1 documents list the
+// products as plain IRIs.
+type Product struct {
+	ID            string            `json:"@id,omitempty"`
+	Identifiers   map[string]string `json:"identifiers,omitempty"`
+	Hashes        map[string]string `json:"hashes,omitempty"`
+	Subcomponents []Product         `json:"subcomponents,omitempty"`
+}
+
+func (p *Product) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err == nil {
+		*p = Product{ID: id}
+		return nil
+	}
+	type product Product
+	return json.Unmarshal(data, (*product)(p))
+}
+
+// LoadDocument decodes an OpenVEX document and validates it against the
+// requirements of the specification
+func LoadDocument(blob []byte, format processor.FormatType) (*Document, error) {
+	if format != processor.FormatJSON {
+		return nil, fmt.Errorf("unable to support parsing of OpenVEX document format: %v", format)
+	}
+
+	doc := &Document{}
+	if err := json.Unmarshal(blob, doc); err != nil {
+		return nil, err
+	}
+	if err := validate(doc); err != nil {
+		return nil, fmt.Errorf("invalid OpenVEX document: %w", err)
+	}
+	return doc, nil
+}
+
+func validate(doc *Document) error {
+	if !strings.HasPrefix(doc.Context, contextPrefix) {
+		return fmt.Errorf("unknown context %q", doc.Context)
+	}
+	if doc.ID == "" {
+		return fmt.Errorf("missing @id")
+	}
+	if doc.Author == "" {
+		return fmt.Errorf("missing author")
+	}
+	if doc.Timestamp == nil {
+		return fmt.Errorf("missing timestamp")
+	}
+	if doc.Version == "" {
+		return fmt.Errorf("missing version")
+	}
+	for i, s := range doc.Statements {
+		if s.Vulnerability.Name == "" {
+			return fmt.Errorf("statement %d: missing vulnerability name", i)
+		}
+		switch s.Status {
+		case StatusNotAffected:
+			if s.Justification == "" && s.ImpactStatement == "" {
+				return fmt.Errorf("statement %d: not_affected status requires a justification or an impact statement", i)
+			}
+		case StatusAffected:
+			if s.ActionStatement == "" {
+				return fmt.Errorf("statement %d: affected status requires an action statement", i)
+			}
+		case StatusFixed, StatusUnderInvestigation:
+		default:
+			return fmt.Errorf("statement %d: unknown status %q", i, s.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openvex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+func TestOpenVEXProcessor_Unpack(t *testing.T) {
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expected  []*processor.Document
+		expectErr bool
+	}{{
+		name: "OpenVEX document",
+		doc: processor.Document{
+			Blob:              testdata.OpenVEXExample,
+			Format:            processor.FormatJSON,
+			Type:              processor.DocumentOpenVEX,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expected:  []*processor.Document{},
+		expectErr: false,
+	}, {
+		name: "Incorrect type",
+		doc: processor.Document{
+			Blob:              testdata.OpenVEXExample,
+			Format:            processor.FormatJSON,
+			Type:              processor.DocumentUnknown,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expected:  nil,
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := OpenVEXProcessor{}
+			actual, err := d.Unpack(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("OpenVEXProcessor.Unpack() error = %v, expectErr %v", err, tt.expectErr)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("OpenVEXProcessor.Unpack() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOpenVEXProcessor_ValidateSchema(t *testing.T) {
+	document := func(statement string) []byte {
+		return []byte(`{
+			"@context": "https://openvex.dev/ns/v0.2.0",
+			"@id": "https://example.com/vex/1",
+			"author": "Example",
+			"timestamp": "2023-01-08T18:02:03Z",
+			"version": 1,
+			"statements": [` + statement + `]
+		}`)
+	}
+	testCases := []struct {
+		name      string
+		doc       processor.Document
+		expectErr bool
+	}{{
+		name: "valid OpenVEX document",
+		doc: processor.Document{
+			Blob:   testdata.OpenVEXExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: false,
+	}, {
+		name: "OpenVEX 0.0.1 statement",
+		doc: processor.Document{
+			Blob:   document(`{"vulnerability": "CVE-2023-1255", "products": ["pkg:apk/wolfi/openssl@3.0.8-r3"], "status": "fixed"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: false,
+	}, {
+		name: "unknown status",
+		doc: processor.Document{
+			Blob:   document(`{"vulnerability": {"name": "CVE-2023-1255"}, "status": "patched"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: true,
+	}, {
+		name: "not_affected without justification",
+		doc: processor.Document{
+			Blob:   document(`{"vulnerability": {"name": "CVE-2023-1255"}, "status": "not_affected"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: true,
+	}, {
+		name: "affected without action statement",
+		doc: processor.Document{
+			Blob:   document(`{"vulnerability": {"name": "CVE-2023-1255"}, "status": "affected"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: true,
+	}, {
+		name: "missing vulnerability",
+		doc: processor.Document{
+			Blob:   document(`{"status": "fixed"}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: true,
+	}, {
+		name: "missing author",
+		doc: processor.Document{
+			Blob:   []byte(`{"@context": "https://openvex.dev/ns/v0.2.0", "@id": "https://example.com/vex/1", "timestamp": "2023-01-08T18:02:03Z", "version": 1}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: true,
+	}, {
+		name: "not an OpenVEX document",
+		doc: processor.Document{
+			Blob:   testdata.SpdxExampleAlpine23,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: true,
+	}, {
+		name: "invalid format supported",
+		doc: processor.Document{
+			Blob:   testdata.OpenVEXExample,
+			Format: processor.FormatYAML,
+			Type:   processor.DocumentOpenVEX,
+		},
+		expectErr: true,
+	}, {
+		name: "incorrect type",
+		doc: processor.Document{
+			Blob:   testdata.OpenVEXExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSPDX,
+		},
+		expectErr: true,
+	}}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := OpenVEXProcessor{}
+			err := d.ValidateSchema(&tt.doc)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("OpenVEXProcessor.ValidateSchema() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// Vulnerability is an OSV entry (https://ossf.github.io/osv-schema/). Only
+// the parts of the schema that are used by GUAC are modelled, it is shared
+// by the OSV parser, the OSV certifier and the malicious packages feed.
+type Vulnerability struct {
+	SchemaVersion    string           `json:"schema_version,omitempty"`
+	ID               string           `json:"id"`
+	Modified         time.Time        `json:"modified"`
+	Published        time.Time        `json:"published,omitempty"`
+	Withdrawn        *time.Time       `json:"withdrawn,omitempty"`
+	Aliases          []string         `json:"aliases,omitempty"`
+	Summary          string           `json:"summary,omitempty"`
+	Details          string           `json:"details,omitempty"`
+	Severity         []Severity       `json:"severity,omitempty"`
+	Affected         []Affected       `json:"affected,omitempty"`
+	References       []Reference      `json:"references,omitempty"`
+	DatabaseSpecific DatabaseSpecific `json:"database_specific,omitempty"`
+}
+
+// Severity is a severity score of the vulnerability, e.g. a CVSS vector
+type Severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+// Affected lists the versions of a package affected by the vulnerability
+type Affected struct {
+	Package  Package  `json:"package"`
+	Ranges   []Range  `json:"ranges,omitempty"`
+	Versions []string `json:"versions,omitempty"`
+}
+
+// Package identifies a package in its ecosystem
+type Package struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	Purl      string `json:"purl,omitempty"`
+}
+
+// Range is a range of affected versions, described by a list of events
+type Range = helpers.AffectedRange
+
+// Reference is a link to more information about the vulnerability
+type Reference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// DatabaseSpecific holds the database specific fields that are ingested,
+// the CWEs are set by the GitHub advisory database
+type DatabaseSpecific struct {
+	CWEIds []string `json:"cwe_ids,omitempty"`
+}
+
+// OSV IDs are a database prefix followed by the ID in the database, e.g.
+// GHSA-xxxx-xxxx-xxxx, PYSEC-2021-1 or MAL-2022-1
+var idRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9.]*-\S+$`)
+
+// LoadDocument parses an OSV entry
+func LoadDocument(blob []byte, format processor.FormatType) (*Vulnerability, error) {
+	if format != processor.FormatJSON {
+		return nil, fmt.Errorf("unable to support parsing of OSV document format: %v", format)
+	}
+
+	vuln := &Vulnerability{}
+	if err := json.Unmarshal(blob, vuln); err != nil {
+		return nil, err
+	}
+	if err := validate(vuln); err != nil {
+		return nil, fmt.Errorf("invalid OSV document: %w", err)
+	}
+	return vuln, nil
+}
+
+func validate(vuln *Vulnerability) error {
+	switch {
+	case !idRegexp.MatchString(vuln.ID):
+		return fmt.Errorf("invalid id %q", vuln.ID)
+	case vuln.Modified.IsZero():
+		return fmt.Errorf("missing modified time")
+	}
+	for i, affected := range vuln.Affected {
+		if affected.Package.Purl == "" && (affected.Package.Ecosystem == "" || affected.Package.Name == "") {
+			return fmt.Errorf("affected package %d has no ecosystem and name or purl", i)
+		}
+		for _, r := range affected.Ranges {
+			switch r.Type {
+			case "SEMVER", "ECOSYSTEM", "GIT":
+			default:
+				return fmt.Errorf("affected package %d has an unknown range type %q", i, r.Type)
+			}
+		}
+	}
+	return nil
+}
@@ -52,15 +52,21 @@ type DocumentType string
 
 // Document* is the enumerables of DocumentType
 const (
-	DocumentITE6SLSA    DocumentType = "SLSA"
-	DocumentITE6Generic DocumentType = "ITE6"
-	DocumentITE6Vul     DocumentType = "ITE6VUL"
-	DocumentDSSE        DocumentType = "DSSE"
-	DocumentSPDX        DocumentType = "SPDX"
-	DocumentJsonLines   DocumentType = "JSON_LINES"
-	DocumentScorecard   DocumentType = "SCORECARD"
-	DocumentCycloneDX   DocumentType = "CycloneDX"
-	DocumentUnknown     DocumentType = "UNKNOWN"
+	DocumentITE6SLSA      DocumentType = "SLSA"
+	DocumentITE6Generic   DocumentType = "ITE6"
+	DocumentITE6Vul       DocumentType = "ITE6VUL"
+	DocumentITE6Malicious DocumentType = "ITE6MALICIOUS"
+	DocumentITE6EOL       DocumentType = "ITE6EOL"
+	DocumentDSSE          DocumentType = "DSSE"
+	DocumentSPDX          DocumentType = "SPDX"
+	DocumentSPDX3         DocumentType = "SPDX3"
+	DocumentJsonLines     DocumentType = "JSON_LINES"
+	DocumentScorecard     DocumentType = "SCORECARD"
+	DocumentCycloneDX     DocumentType = "CycloneDX"
+	DocumentOpenVEX       DocumentType = "OpenVEX"
+	DocumentCSAF          DocumentType = "CSAF"
+	DocumentOSV           DocumentType = "OSV"
+	DocumentUnknown       DocumentType = "UNKNOWN"
 )
 
 // FormatType describes the document format for malform checks
@@ -71,6 +77,8 @@ const (
 	FormatJSON      FormatType = "JSON"
 	FormatJSONLines FormatType = "JSON_LINES"
 	FormatXML       FormatType = "XML"
+	FormatYAML      FormatType = "YAML"
+	FormatTagValue  FormatType = "TAG_VALUE"
 	FormatUnknown   FormatType = "UNKNOWN"
 )
 
@@ -0,0 +1,140 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+	spdx_json "github.com/spdx/tools-golang/json"
+	spdx_common "github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2_3"
+	"github.com/spdx/tools-golang/tvloader"
+	"github.com/spdx/tools-golang/tvloader/reader"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	spdxVersion22 = "SPDX-2.2"
+	spdxVersion23 = "SPDX-2.3"
+)
+
+// LoadDocument decodes an SPDX 2.2 or 2.3 document serialised as JSON, YAML
+// or tag-value into the SPDX 2.3 model. SPDX 2.3 is a superset of 2.2, so
+// every consumer only has to deal with a single version of the document.
+func LoadDocument(blob []byte, format processor.FormatType) (*v2_3.Document, error) {
+	switch format {
+	case processor.FormatJSON:
+		return loadJSON(blob)
+	case processor.FormatYAML:
+		j, err := yamlToJSON(blob)
+		if err != nil {
+			return nil, err
+		}
+		return loadJSON(j)
+	case processor.FormatTagValue:
+		return loadTagValue(blob)
+	}
+	return nil, fmt.Errorf("unable to support parsing of SPDX document format: %v", format)
+}
+
+// documentDescribes is the deprecated document level field listing the
+// elements described by the document, which the SPDX model does not keep.
+type documentDescribes struct {
+	DocumentDescribes []string `json:"documentDescribes"`
+}
+
+func loadJSON(blob []byte) (*v2_3.Document, error) {
+	doc, err := spdx_json.Load2_3(bytes.NewReader(blob))
+	if err != nil {
+		return nil, err
+	}
+	var describes documentDescribes
+	if err := json.Unmarshal(blob, &describes); err != nil {
+		return nil, err
+	}
+	addDescribesRelationships(doc, describes.DocumentDescribes)
+	return doc, nil
+}
+
+// addDescribesRelationships turns the documentDescribes field into the
+// equivalent DESCRIBES relationships, unless the document already has them.
+func addDescribesRelationships(doc *v2_3.Document, described []string) {
+	existing := map[spdx_common.ElementID]bool{}
+	for _, rel := range doc.Relationships {
+		if rel.Relationship == spdx_common.TypeRelationshipDescribe && rel.RefA.ElementRefID == doc.SPDXIdentifier {
+			existing[rel.RefB.ElementRefID] = true
+		}
+	}
+	for _, id := range described {
+		elementID := spdx_common.ElementID(strings.TrimPrefix(id, "SPDXRef-"))
+		if existing[elementID] {
+			continue
+		}
+		existing[elementID] = true
+		doc.Relationships = append(doc.Relationships, &v2_3.Relationship{
+			RefA:         spdx_common.MakeDocElementID("", string(doc.SPDXIdentifier)),
+			RefB:         spdx_common.MakeDocElementID("", string(elementID)),
+			Relationship: spdx_common.TypeRelationshipDescribe,
+		})
+	}
+}
+
+// yamlToJSON converts a YAML document to JSON so that it can be decoded using
+// the JSON tags of the SPDX model.
+func yamlToJSON(blob []byte) ([]byte, error) {
+	var m map[string]interface{}
+	if err := yaml.Unmarshal(blob, &m); err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// loadTagValue picks the tag-value parser from the SPDXVersion of the
+// document as the parsers of each version reject any other version.
+func loadTagValue(blob []byte) (*v2_3.Document, error) {
+	pairs, err := reader.ReadTagValues(bytes.NewReader(blob))
+	if err != nil {
+		return nil, err
+	}
+	var version string
+	for _, pair := range pairs {
+		if pair.Tag == "SPDXVersion" {
+			version = pair.Value
+			break
+		}
+	}
+
+	switch version {
+	case spdxVersion23:
+		return tvloader.Load2_3(bytes.NewReader(blob))
+	case spdxVersion22:
+		doc, err := tvloader.Load2_2(bytes.NewReader(blob))
+		if err != nil {
+			return nil, err
+		}
+		// convert through the JSON serialisation which is shared by both versions
+		var buf bytes.Buffer
+		if err := spdx_json.Save2_2(doc, &buf); err != nil {
+			return nil, err
+		}
+		return spdx_json.Load2_3(&buf)
+	}
+	return nil, fmt.Errorf("unsupported SPDX tag-value version: %q", version)
+}
@@ -16,15 +16,13 @@
 package spdx
 
 import (
-	"bytes"
 	"fmt"
 
 	"github.com/guacsec/guac/pkg/handler/processor"
-	spdx_json "github.com/spdx/tools-golang/json"
 )
 
-// SPDXProcessor processes SPDX documents.
-// Currently only supports JSON SPDX documents
+// SPDXProcessor processes SPDX 2.2 and 2.3 documents.
+// Supports JSON, YAML and tag-value SPDX documents
 type SPDXProcessor struct {
 }
 
@@ -33,14 +31,8 @@ func (p *SPDXProcessor) ValidateSchema(d *processor.Document) error {
 		return fmt.Errorf("expected document type: %v, actual document type: %v", processor.DocumentSPDX, d.Type)
 	}
 
-	switch d.Format {
-	case processor.FormatJSON:
-		reader := bytes.NewReader(d.Blob)
-		_, err := spdx_json.Load2_2(reader)
-		return err
-	}
-
-	return fmt.Errorf("unable to support parsing of SPDX document format: %v", d.Format)
+	_, err := LoadDocument(d.Blob, d.Format)
+	return err
 }
 
 // Unpack takes in the document and tries to unpack it
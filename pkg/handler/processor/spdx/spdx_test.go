@@ -93,6 +93,33 @@ func TestSPDXProcessor_ValidateSchema(t *testing.T) {
 			SourceInformation: processor.SourceInformation{},
 		},
 		expectErr: false,
+	}, {
+		name: "valid SPDX 2.3 document",
+		doc: processor.Document{
+			Blob:              testdata.SpdxExampleAlpine23,
+			Format:            processor.FormatJSON,
+			Type:              processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectErr: false,
+	}, {
+		name: "valid YAML SPDX document",
+		doc: processor.Document{
+			Blob:              testdata.SpdxExampleAlpineYAML,
+			Format:            processor.FormatYAML,
+			Type:              processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectErr: false,
+	}, {
+		name: "valid tag-value SPDX document",
+		doc: processor.Document{
+			Blob:              testdata.SpdxExampleAlpineTagValue,
+			Format:            processor.FormatTagValue,
+			Type:              processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{},
+		},
+		expectErr: false,
 	}, {
 		name: "invalid SPDX document",
 		doc: processor.Document{
@@ -0,0 +1,150 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx3
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/handler/processor"
+)
+
+// spdx3ContextPrefix is the prefix of the JSON-LD contexts published for
+// the SPDX 3 releases, e.g. https://spdx.org/rdf/3.0.1/spdx-context.jsonld
+const spdx3ContextPrefix = "https://spdx.org/rdf/3."
+
+// Document is an SPDX 3 document serialised as JSON-LD. The elements of the
+// document are kept as a flat graph and reference each other by ID.
+type Document struct {
+	Context interface{} `json:"@context"`
+	Graph   []*Element  `json:"@graph"`
+}
+
+// Element holds the properties of the SPDX 3 classes that are ingested by
+// GUAC. Properties that do not apply to the type of the element are left
+// empty.
+type Element struct {
+	Type         string          `json:"type"`
+	SpdxID       string          `json:"spdxId,omitempty"`
+	ID           string          `json:"@id,omitempty"`
+	Name         string          `json:"name,omitempty"`
+	Comment      string          `json:"comment,omitempty"`
+	CreationInfo json.RawMessage `json:"creationInfo,omitempty"`
+
+	VerifiedUsing      []IntegrityMethod    `json:"verifiedUsing,omitempty"`
+	ExternalIdentifier []ExternalIdentifier `json:"externalIdentifier,omitempty"`
+
+	// CreationInfo
+	Created     string `json:"created,omitempty"`
+	SpecVersion string `json:"specVersion,omitempty"`
+
+	// SpdxDocument and software_Sbom
+	RootElement []string `json:"rootElement,omitempty"`
+
+	// Relationship
+	From             string   `json:"from,omitempty"`
+	To               []string `json:"to,omitempty"`
+	RelationshipType string   `json:"relationshipType,omitempty"`
+	Scope            string   `json:"scope,omitempty"`
+
+	// software profile
+	PackageVersion   string `json:"software_packageVersion,omitempty"`
+	PackageURL       string `json:"software_packageUrl,omitempty"`
+	DownloadLocation string `json:"software_downloadLocation,omitempty"`
+	SourceInfo       string `json:"software_sourceInfo,omitempty"`
+
+	// build profile
+	BuildType              string            `json:"build_buildType,omitempty"`
+	BuildID                string            `json:"build_buildId,omitempty"`
+	BuildStartTime         string            `json:"build_buildStartTime,omitempty"`
+	BuildEndTime           string            `json:"build_buildEndTime,omitempty"`
+	ConfigSourceURI        []string          `json:"build_configSourceUri,omitempty"`
+	ConfigSourceEntrypoint []string          `json:"build_configSourceEntrypoint,omitempty"`
+	ConfigSourceDigest     []IntegrityMethod `json:"build_configSourceDigest,omitempty"`
+	Parameter              []DictionaryEntry `json:"build_parameter,omitempty"`
+	Environment            []DictionaryEntry `json:"build_environment,omitempty"`
+
+	// security profile
+	PublishedTime     string `json:"security_publishedTime,omitempty"`
+	JustificationType string `json:"security_justificationType,omitempty"`
+	ImpactStatement   string `json:"security_impactStatement,omitempty"`
+	ActionStatement   string `json:"security_actionStatement,omitempty"`
+	StatusNotes       string `json:"security_statusNotes,omitempty"`
+}
+
+// IntegrityMethod is a hash of an element
+type IntegrityMethod struct {
+	Type      string `json:"type"`
+	Algorithm string `json:"algorithm,omitempty"`
+	HashValue string `json:"hashValue,omitempty"`
+}
+
+// ExternalIdentifier is an identifier of an element in another system,
+// e.g. a purl, a CVE or a GHSA ID
+type ExternalIdentifier struct {
+	Type                   string `json:"type"`
+	ExternalIdentifierType string `json:"externalIdentifierType"`
+	Identifier             string `json:"identifier"`
+}
+
+// DictionaryEntry is a key-value pair
+type DictionaryEntry struct {
+	Type  string `json:"type"`
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// LoadDocument decodes an SPDX 3 JSON-LD document. Documents using a
+// context other than the SPDX 3 context are rejected.
+func LoadDocument(blob []byte, format processor.FormatType) (*Document, error) {
+	if format != processor.FormatJSON {
+		return nil, fmt.Errorf("unable to support parsing of SPDX 3 document format: %v", format)
+	}
+
+	doc := &Document{}
+	if err := json.Unmarshal(blob, doc); err != nil {
+		return nil, err
+	}
+	if !isSPDX3Context(doc.Context) {
+		return nil, fmt.Errorf("document does not use the SPDX 3 JSON-LD context: %v", doc.Context)
+	}
+	if len(doc.Graph) == 0 {
+		return nil, fmt.Errorf("SPDX 3 document has no elements")
+	}
+	for _, e := range doc.Graph {
+		if e == nil || e.Type == "" {
+			return nil, fmt.Errorf("SPDX 3 document has an element without type")
+		}
+	}
+	return doc, nil
+}
+
+// isSPDX3Context returns true if the JSON-LD context, which is either a
+// single IRI or a list of them, includes an SPDX 3 context.
+func isSPDX3Context(context interface{}) bool {
+	switch c := context.(type) {
+	case string:
+		return strings.HasPrefix(c, spdx3ContextPrefix)
+	case []interface{}:
+		for _, e := range c {
+			if isSPDX3Context(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
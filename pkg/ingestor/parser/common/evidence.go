@@ -0,0 +1,146 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// IsDependencies returns an IsDependency for each package depending on each
+// of the dependency packages.
+func IsDependencies(pkgs, depPkgs []model.PkgInputSpec, justification string) []assembler.IsDependencyIngest {
+	var isDeps []assembler.IsDependencyIngest
+	for i := range pkgs {
+		pkg := pkgs[i]
+		for j := range depPkgs {
+			depPkg := depPkgs[j]
+			isDeps = append(isDeps, assembler.IsDependencyIngest{
+				Pkg:    &pkg,
+				DepPkg: &depPkg,
+				IsDependency: &model.IsDependencyInputSpec{
+					Justification: justification,
+				},
+			})
+		}
+	}
+	return isDeps
+}
+
+// IsOccurrences returns an IsOccurence for each artifact being an occurrence
+// of each of the packages.
+func IsOccurrences(arts []model.ArtifactInputSpec, pkgs []model.PkgInputSpec, justification string) []assembler.IsOccurenceIngest {
+	var isOccs []assembler.IsOccurenceIngest
+	for i := range arts {
+		art := arts[i]
+		for j := range pkgs {
+			pkg := pkgs[j]
+			isOccs = append(isOccs, assembler.IsOccurenceIngest{
+				Pkg:      &pkg,
+				Artifact: &art,
+				IsOccurence: &model.IsOccurrenceInputSpec{
+					Justification: justification,
+				},
+			})
+		}
+	}
+	return isOccs
+}
+
+// HashEquals returns a HashEqual for each artifact and each of the equal
+// artifacts, skipping an artifact being equal to itself.
+func HashEquals(arts, equalArts []model.ArtifactInputSpec, justification string) []assembler.HashEqualIngest {
+	var hashEquals []assembler.HashEqualIngest
+	for i := range arts {
+		art := arts[i]
+		for j := range equalArts {
+			equalArt := equalArts[j]
+			if art == equalArt {
+				continue
+			}
+			hashEquals = append(hashEquals, assembler.HashEqualIngest{
+				Artifact:      &art,
+				EqualArtifact: &equalArt,
+				HashEqual: &model.HashEqualInputSpec{
+					Justification: justification,
+				},
+			})
+		}
+	}
+	return hashEquals
+}
+
+// CertifyPkgs returns a CertifyPkg for each package and each of its variants.
+func CertifyPkgs(pkgs, variants []model.PkgInputSpec, justification string) []assembler.CertifyPkgIngest {
+	var certifyPkgs []assembler.CertifyPkgIngest
+	for i := range pkgs {
+		pkg := pkgs[i]
+		for j := range variants {
+			variant := variants[j]
+			certifyPkgs = append(certifyPkgs, assembler.CertifyPkgIngest{
+				Pkg:    &pkg,
+				DepPkg: &variant,
+				CertifyPkg: &model.CertifyPkgInputSpec{
+					Justification: justification,
+				},
+			})
+		}
+	}
+	return certifyPkgs
+}
+
+// SpdxPackageSources returns a HasSourceAt for each of the packages of an
+// SPDX package and each of its download location and source info that is a
+// VCS repository.
+func SpdxPackageSources(ctx context.Context, pkgs []model.PkgInputSpec, downloadLocation, sourceInfo string, knownSince time.Time) []assembler.HasSourceAtIngest {
+	logger := logging.FromContext(ctx)
+
+	var hasSourceAts []assembler.HasSourceAtIngest
+	for _, location := range []struct {
+		uri           string
+		justification string
+	}{
+		{downloadLocation, "spdx package download location"},
+		{sourceInfo, "spdx package source info"},
+	} {
+		if !asmhelpers.IsVcs(location.uri) {
+			continue
+		}
+		src, err := asmhelpers.VcsToSrc(location.uri)
+		if err != nil {
+			logger.Errorf("error parsing spdx vcs location %v", err)
+			continue
+		}
+		for i := range pkgs {
+			pkg := pkgs[i]
+			hasSourceAts = append(hasSourceAts, assembler.HasSourceAtIngest{
+				Pkg:          &pkg,
+				PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+				Src:          src,
+				HasSourceAt: &model.HasSourceAtInputSpec{
+					KnownSince:    knownSince,
+					Justification: location.justification,
+				},
+			})
+		}
+	}
+	return hasSourceAts
+}
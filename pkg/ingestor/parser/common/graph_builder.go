@@ -77,4 +77,67 @@ func addMetadata(predicates *assembler.IngestPredicates, foundIdentities []Trust
 		v.IsOccurence.Collector = srcInfo.Collector
 		v.IsOccurence.Origin = srcInfo.Source
 	}
+
+	for _, v := range predicates.HasSlsa {
+		v.HasSlsa.Collector = srcInfo.Collector
+		v.HasSlsa.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.CertifyVuln {
+		v.VulnData.Collector = srcInfo.Collector
+		// advisories record themselves as the origin of their certifications
+		if v.VulnData.Origin == "" {
+			v.VulnData.Origin = srcInfo.Source
+		}
+	}
+
+	for _, v := range predicates.IsVuln {
+		v.IsVuln.Collector = srcInfo.Collector
+		v.IsVuln.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.HasSourceAt {
+		v.HasSourceAt.Collector = srcInfo.Collector
+		v.HasSourceAt.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.CertifyBad {
+		v.CertifyBad.Collector = srcInfo.Collector
+		v.CertifyBad.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.HasSBOM {
+		v.HasSBOM.Collector = srcInfo.Collector
+		v.HasSBOM.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.HashEqual {
+		v.HashEqual.Collector = srcInfo.Collector
+		v.HashEqual.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.CertifyPkg {
+		v.CertifyPkg.Collector = srcInfo.Collector
+		v.CertifyPkg.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.Vex {
+		v.VexData.Collector = srcInfo.Collector
+		v.VexData.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.VulnMetadata {
+		v.VulnMetadata.Collector = srcInfo.Collector
+		v.VulnMetadata.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.VulnAffected {
+		v.VulnAffected.Collector = srcInfo.Collector
+		v.VulnAffected.Origin = srcInfo.Source
+	}
+
+	for _, v := range predicates.CertifyEOL {
+		v.CertifyEOL.Collector = srcInfo.Collector
+		v.CertifyEOL.Origin = srcInfo.Source
+	}
 }
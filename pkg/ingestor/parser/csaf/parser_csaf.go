@@ -0,0 +1,441 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	csaf_processor "github.com/guacsec/guac/pkg/handler/processor/csaf"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const (
+	threatCategoryImpact = "impact"
+	aliasJustification   = "from CSAF vulnerability ids"
+	hashJustification    = "csaf product with hash"
+)
+
+type csafParser struct {
+	doc     *processor.Document
+	csafDoc *csaf_processor.Document
+
+	productPackages  map[string][]model.PkgInputSpec
+	productArtifacts map[string][]model.ArtifactInputSpec
+	productGroups    map[string][]string
+
+	identifierStrings *common.IdentifierStrings
+}
+
+// NewCSAFParser initializes the csafParser
+func NewCSAFParser() common.DocumentParser {
+	return &csafParser{
+		productPackages:   map[string][]model.PkgInputSpec{},
+		productArtifacts:  map[string][]model.ArtifactInputSpec{},
+		productGroups:     map[string][]string{},
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (c *csafParser) Parse(ctx context.Context, doc *processor.Document) error {
+	c.doc = doc
+	csafDoc, err := csaf_processor.LoadDocument(doc.Blob, doc.Format)
+	if err != nil {
+		return fmt.Errorf("failed to parse CSAF document: %w", err)
+	}
+	c.csafDoc = csafDoc
+	if csafDoc.ProductTree == nil {
+		return nil
+	}
+	return c.getProducts(csafDoc.ProductTree)
+}
+
+// getProducts resolves the products of the product tree into packages (for
+// purls) and artifacts (for file hashes). A product defined by a
+// relationship, e.g. a package installed on a platform, is identified by
+// the product it references.
+func (c *csafParser) getProducts(tree *csaf_processor.ProductTree) error {
+	var err error
+	tree.Walk(func(p *csaf_processor.FullProductName) {
+		if err != nil || p.ProductIdentificationHelper == nil {
+			return
+		}
+		helper := p.ProductIdentificationHelper
+		if helper.PURL != "" {
+			pkg, purlErr := asmhelpers.PurlToPkg(helper.PURL)
+			if purlErr != nil {
+				err = fmt.Errorf("failed to parse CSAF product purl %s: %w", helper.PURL, purlErr)
+				return
+			}
+			c.identifierStrings.UnclassifiedStrings = append(c.identifierStrings.UnclassifiedStrings, helper.PURL)
+			c.productPackages[p.ProductID] = append(c.productPackages[p.ProductID], *pkg)
+		}
+		for _, h := range helper.Hashes {
+			for _, fh := range h.FileHashes {
+				c.productArtifacts[p.ProductID] = append(c.productArtifacts[p.ProductID], model.ArtifactInputSpec{
+					Algorithm: strings.ToLower(fh.Algorithm),
+					Digest:    strings.ToLower(fh.Value),
+				})
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range tree.Relationships {
+		id := rel.FullProductName.ProductID
+		c.productPackages[id] = append(c.productPackages[id], c.productPackages[rel.ProductReference]...)
+		c.productArtifacts[id] = append(c.productArtifacts[id], c.productArtifacts[rel.ProductReference]...)
+	}
+
+	for _, group := range tree.ProductGroups {
+		c.productGroups[group.GroupID] = group.ProductIDs
+	}
+	return nil
+}
+
+func (c *csafParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	logger := logging.FromContext(ctx)
+	preds := &assembler.IngestPredicates{}
+
+	for id, pkgs := range c.productPackages {
+		for _, pkg := range pkgs {
+			pkg := pkg
+			for _, art := range c.productArtifacts[id] {
+				art := art
+				preds.IsOccurence = append(preds.IsOccurence, assembler.IsOccurenceIngest{
+					Pkg:      &pkg,
+					Artifact: &art,
+					IsOccurence: &model.IsOccurrenceInputSpec{
+						Justification: hashJustification,
+					},
+				})
+			}
+		}
+	}
+
+	tracking := c.csafDoc.Document.Tracking
+	knownSince := tracking.CurrentReleaseDate.UTC()
+	// the products are certified by the advisory rather than by a scanner,
+	// the advisory is recorded as the origin of the certification
+	vulnData := &model.VulnerabilityMetaDataInput{
+		TimeScanned: knownSince,
+		Origin:      c.getAdvisoryID(),
+	}
+
+	for _, v := range c.csafDoc.Vulnerabilities {
+		id := getVulnerabilityID(&v)
+		if id == "" {
+			logger.Debugf("skipping CSAF vulnerability %q without identifier", v.Title)
+			continue
+		}
+		osv, cve, ghsa := asmhelpers.OsvCveOrGhsa(id)
+
+		preds.IsVuln = append(preds.IsVuln, getIsVulns(&v, cve)...)
+
+		if metadata := getVulnMetadata(&v, tracking.CurrentReleaseDate); metadata != nil {
+			preds.VulnMetadata = append(preds.VulnMetadata, assembler.VulnMetadataIngest{
+				OSV:          osv,
+				CVE:          cve,
+				GHSA:         ghsa,
+				VulnMetadata: metadata,
+			})
+		}
+
+		if v.ProductStatus == nil {
+			continue
+		}
+		status := v.ProductStatus
+
+		// affected packages are certified vulnerable, affected products only
+		// identified by their hashes get an affected VEX statement as only
+		// packages can be certified vulnerable
+		for _, productID := range dedup(status.KnownAffected, status.FirstAffected, status.LastAffected) {
+			for _, pkg := range c.productPackages[productID] {
+				pkg := pkg
+				preds.CertifyVuln = append(preds.CertifyVuln, assembler.CertifyVulnIngest{
+					Pkg:      &pkg,
+					OSV:      osv,
+					CVE:      cve,
+					GHSA:     ghsa,
+					VulnData: vulnData,
+				})
+			}
+			if len(c.productPackages[productID]) > 0 {
+				continue
+			}
+			for _, art := range c.productArtifacts[productID] {
+				art := art
+				vexData := c.getVexStatement(&v, model.VexStatusAffected, productID)
+				vexData.KnownSince = knownSince
+				preds.Vex = append(preds.Vex, assembler.VexIngest{
+					Artifact: &art,
+					OSV:      osv,
+					CVE:      cve,
+					GHSA:     ghsa,
+					VexData:  vexData,
+				})
+			}
+		}
+
+		// the other product statuses are ingested as VEX statements
+		for _, s := range []struct {
+			status   model.VexStatus
+			products []string
+		}{
+			{model.VexStatusNotAffected, dedup(status.KnownNotAffected)},
+			{model.VexStatusFixed, dedup(status.Fixed, status.FirstFixed)},
+			{model.VexStatusUnderInvestigation, dedup(status.UnderInvestigation)},
+		} {
+			for _, productID := range s.products {
+				vexData := c.getVexStatement(&v, s.status, productID)
+				vexData.KnownSince = knownSince
+				for _, pkg := range c.productPackages[productID] {
+					pkg := pkg
+					preds.Vex = append(preds.Vex, assembler.VexIngest{
+						Pkg:     &pkg,
+						OSV:     osv,
+						CVE:     cve,
+						GHSA:    ghsa,
+						VexData: vexData,
+					})
+				}
+				for _, art := range c.productArtifacts[productID] {
+					art := art
+					preds.Vex = append(preds.Vex, assembler.VexIngest{
+						Artifact: &art,
+						OSV:      osv,
+						CVE:      cve,
+						GHSA:     ghsa,
+						VexData:  vexData,
+					})
+				}
+			}
+		}
+	}
+	return preds
+}
+
+// getAdvisoryID returns the identity of the advisory, its tracking ID
+// within the namespace of its publisher
+func (c *csafParser) getAdvisoryID() string {
+	namespace := strings.TrimSuffix(c.csafDoc.Document.Publisher.Namespace, "/")
+	if namespace == "" {
+		return c.csafDoc.Document.Tracking.ID
+	}
+	return namespace + "/" + c.csafDoc.Document.Tracking.ID
+}
+
+// getVulnerabilityID returns the CVE of the vulnerability, falling back to
+// a GHSA and then to any other identifier
+func getVulnerabilityID(v *csaf_processor.Vulnerability) string {
+	if v.CVE != "" {
+		return v.CVE
+	}
+	for _, id := range v.IDs {
+		if asmhelpers.IsGHSA(id.Text) {
+			return id.Text
+		}
+	}
+	if len(v.IDs) > 0 {
+		return v.IDs[0].Text
+	}
+	return ""
+}
+
+// getIsVulns links the CVE of the vulnerability to the GHSA advisories
+// listed as its aliases
+func getIsVulns(v *csaf_processor.Vulnerability, cve *model.CVEInputSpec) []assembler.IsVulnIngest {
+	if cve == nil {
+		return nil
+	}
+	var isVulns []assembler.IsVulnIngest
+	for _, id := range v.IDs {
+		if !asmhelpers.IsGHSA(id.Text) {
+			continue
+		}
+		isVulns = append(isVulns, assembler.IsVulnIngest{
+			OSV: &model.OSVInputSpec{OsvId: id.Text},
+			CVE: cve,
+			IsVuln: &model.IsVulnerabilityInputSpec{
+				Justification: aliasJustification,
+			},
+		})
+	}
+	return isVulns
+}
+
+// getVulnMetadata collects the summary, CVSS scores, CWE and references of
+// the vulnerability. The summary is taken from the summary or description
+// notes, falling back to the title. Returns nil if the document has none of
+// them.
+func getVulnMetadata(v *csaf_processor.Vulnerability, modified *time.Time) *model.VulnMetadataInputSpec {
+	metadata := &model.VulnMetadataInputSpec{
+		Summary:    getNote(v.Notes, "summary", "description"),
+		Scores:     []model.VulnScoreInputSpec{},
+		CweIds:     []string{},
+		References: []string{},
+	}
+	if metadata.Summary == "" {
+		metadata.Summary = v.Title
+	}
+
+	// the same score is often repeated for several products
+	seen := map[model.VulnScoreInputSpec]bool{}
+	for _, score := range v.Scores {
+		for _, s := range []struct {
+			scoreType model.VulnScoreType
+			cvss      *csaf_processor.CVSS
+		}{
+			{model.VulnScoreTypeCvssV3, score.CVSSV3},
+			{model.VulnScoreTypeCvssV2, score.CVSSV2},
+		} {
+			if s.cvss == nil {
+				continue
+			}
+			vulnScore := model.VulnScoreInputSpec{
+				ScoreType: s.scoreType,
+				Vector:    s.cvss.VectorString,
+				Score:     s.cvss.BaseScore,
+			}
+			if !seen[vulnScore] {
+				seen[vulnScore] = true
+				metadata.Scores = append(metadata.Scores, vulnScore)
+			}
+		}
+	}
+	if v.CWE != nil && v.CWE.ID != "" {
+		metadata.CweIds = append(metadata.CweIds, v.CWE.ID)
+	}
+	for _, ref := range v.References {
+		metadata.References = append(metadata.References, ref.URL)
+	}
+
+	if metadata.Summary == "" && len(metadata.Scores) == 0 && len(metadata.CweIds) == 0 && len(metadata.References) == 0 {
+		return nil
+	}
+
+	published := v.ReleaseDate
+	if published == nil {
+		published = v.DiscoveryDate
+	}
+	if published != nil {
+		t := published.UTC()
+		metadata.Published = &t
+	}
+	if modified != nil {
+		t := modified.UTC()
+		metadata.Modified = &t
+	}
+	return metadata
+}
+
+// getNote returns the text of the first note of the first matching category
+func getNote(notes []csaf_processor.Note, categories ...string) string {
+	for _, category := range categories {
+		for _, note := range notes {
+			if note.Category == category && note.Text != "" {
+				return note.Text
+			}
+		}
+	}
+	return ""
+}
+
+// getVexStatement collects the flags, threats and remediations of the
+// vulnerability that apply to the product
+func (c *csafParser) getVexStatement(v *csaf_processor.Vulnerability, status model.VexStatus, productID string) *model.VexStatementInputSpec {
+	statement := &model.VexStatementInputSpec{
+		Status:           status,
+		VexJustification: model.VexJustificationNotProvided,
+	}
+	switch status {
+	case model.VexStatusNotAffected:
+		for _, flag := range v.Flags {
+			if c.appliesTo(productID, flag.ProductIDs, flag.GroupIDs) {
+				statement.VexJustification = asmhelpers.VexJustification(flag.Label)
+				break
+			}
+		}
+		var impacts []string
+		for _, threat := range v.Threats {
+			if threat.Category == threatCategoryImpact && c.appliesTo(productID, threat.ProductIDs, threat.GroupIDs) {
+				impacts = append(impacts, threat.Details)
+			}
+		}
+		statement.Statement = strings.Join(impacts, "; ")
+	case model.VexStatusFixed, model.VexStatusAffected:
+		var actions []string
+		for _, remediation := range v.Remediations {
+			if c.appliesTo(productID, remediation.ProductIDs, remediation.GroupIDs) {
+				actions = append(actions, remediation.Details)
+			}
+		}
+		statement.ActionStatement = strings.Join(actions, "; ")
+	}
+	return statement
+}
+
+// appliesTo returns true if the product is listed directly or through one
+// of the product groups
+func (c *csafParser) appliesTo(productID string, productIDs []string, groupIDs []string) bool {
+	for _, id := range productIDs {
+		if id == productID {
+			return true
+		}
+	}
+	for _, group := range groupIDs {
+		for _, id := range c.productGroups[group] {
+			if id == productID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dedup merges lists of product IDs, keeping the first occurrence of each
+func dedup(lists ...[]string) []string {
+	seen := map[string]bool{}
+	var ids []string
+	for _, list := range lists {
+		for _, id := range list {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (c *csafParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (c *csafParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return c.identifierStrings, nil
+}
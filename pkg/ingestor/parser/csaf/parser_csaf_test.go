@@ -0,0 +1,184 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csaf
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// csafAdvisory is a security advisory whose vulnerabilities are identified
+// by a GHSA and by an OSV ID instead of a CVE, one of its affected products
+// is only identified by its hash
+var csafAdvisory = []byte(`{
+	"document": {
+		"category": "csaf_security_advisory",
+		"csaf_version": "2.0",
+		"publisher": {"category": "vendor", "name": "Example", "namespace": "https://psirt.example.com"},
+		"title": "Example advisory",
+		"tracking": {
+			"id": "EXAMPLE-SA-2023-0002",
+			"status": "final",
+			"version": "1",
+			"initial_release_date": "2023-04-01T00:00:00Z",
+			"current_release_date": "2023-04-01T00:00:00Z"
+		}
+	},
+	"product_tree": {
+		"full_product_names": [{
+			"name": "example-lib 0.1.0",
+			"product_id": "lib-0.1.0",
+			"product_identification_helper": {"purl": "pkg:pypi/example-lib@0.1.0"}
+		}, {
+			"name": "example-firmware 1.0",
+			"product_id": "firmware-1.0",
+			"product_identification_helper": {
+				"hashes": [{"filename": "firmware.bin", "file_hashes": [{"algorithm": "sha256", "value": "ABCDEF"}]}]
+			}
+		}]
+	},
+	"vulnerabilities": [{
+		"ids": [{"system_name": "GitHub Advisory Database", "text": "GHSA-xvch-5gv4-984h"}],
+		"product_status": {"known_affected": ["lib-0.1.0", "firmware-1.0"], "last_affected": ["lib-0.1.0"]}
+	}, {
+		"ids": [{"system_name": "Python Packaging Advisory Database", "text": "PYSEC-2023-12"}],
+		"product_status": {"known_affected": ["lib-0.1.0"], "fixed": ["lib-0.1.0"]}
+	}]
+}`)
+
+func Test_csafParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	libPack, _ := asmhelpers.PurlToPkg("pkg:pypi/example-lib@0.1.0")
+	advisoryDate, _ := time.Parse(time.RFC3339, "2023-04-01T00:00:00Z")
+	advisoryVulnData := &model.VulnerabilityMetaDataInput{
+		TimeScanned: advisoryDate,
+		Origin:      "https://psirt.example.com/EXAMPLE-SA-2023-0002",
+	}
+
+	tests := []struct {
+		name            string
+		doc             *processor.Document
+		wantPredicates  *assembler.IngestPredicates
+		wantIdentifiers *common.IdentifierStrings
+		wantErr         bool
+	}{{
+		name: "CSAF VEX document",
+		doc: &processor.Document{
+			Blob:   testdata.CsafVexExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCSAF,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: &testdata.CsafVexIngestionPredicates,
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{
+				"pkg:rpm/example/openssl@3.0.7-1?arch=x86_64",
+				"pkg:rpm/example/openssl@3.0.7-2?arch=x86_64",
+				"pkg:golang/example.com/tls-proxy@v2.4.0",
+				"pkg:npm/example-mail@1.0.0",
+			},
+		},
+		wantErr: false,
+	}, {
+		name: "CSAF security advisory without CVEs",
+		doc: &processor.Document{
+			Blob:   csafAdvisory,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCSAF,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyVuln: []assembler.CertifyVulnIngest{{
+				Pkg:      libPack,
+				GHSA:     &model.GHSAInputSpec{GhsaId: "GHSA-xvch-5gv4-984h"},
+				VulnData: advisoryVulnData,
+			}, {
+				Pkg:      libPack,
+				OSV:      &model.OSVInputSpec{OsvId: "PYSEC-2023-12"},
+				VulnData: advisoryVulnData,
+			}},
+			Vex: []assembler.VexIngest{{
+				Artifact: &model.ArtifactInputSpec{Algorithm: "sha256", Digest: "abcdef"},
+				GHSA:     &model.GHSAInputSpec{GhsaId: "GHSA-xvch-5gv4-984h"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusAffected,
+					VexJustification: model.VexJustificationNotProvided,
+					KnownSince:       advisoryVulnData.TimeScanned,
+				},
+			}, {
+				Pkg: libPack,
+				OSV: &model.OSVInputSpec{OsvId: "PYSEC-2023-12"},
+				VexData: &model.VexStatementInputSpec{
+					Status:           model.VexStatusFixed,
+					VexJustification: model.VexJustificationNotProvided,
+					KnownSince:       advisoryVulnData.TimeScanned,
+				},
+			}},
+		},
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{"pkg:pypi/example-lib@0.1.0"},
+		},
+		wantErr: false,
+	}, {
+		name: "not a CSAF document",
+		doc: &processor.Document{
+			Blob:   testdata.OpenVEXExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCSAF,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCSAFParser()
+			err := c.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("csafParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			preds := c.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("csaf.GetPredicate mismatch values (+got, -expected): %s", d)
+			}
+
+			identifiers, err := c.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatalf("csafParser.GetIdentifiers() error = %v", err)
+			}
+			if d := cmp.Diff(tt.wantIdentifiers, identifiers, cmpopts.EquateEmpty()); len(d) != 0 {
+				t.Errorf("csaf.GetIdentifiers mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
@@ -24,56 +24,33 @@ import (
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
 )
 
-type cyclonedxParser struct {
-	doc           *processor.Document
-	rootComponent component
-	pkgMap        map[string]*component
-}
+const (
+	dependencyJustification = "CDX BOM Dependency"
+	topLevelJustification   = "top-level package GUAC heuristic connecting to each file/package"
+	checksumJustification   = "cdx package with checksum"
+)
 
-type component struct {
-	curPackage  assembler.PackageNode
-	depPackages []*component
+type cyclonedxParser struct {
+	doc               *processor.Document
+	rootRef           string
+	packagePackages   map[string][]model.PkgInputSpec
+	packageArtifacts  map[string][]model.ArtifactInputSpec
+	identifierStrings *common.IdentifierStrings
+	cdxBom            *cdx.BOM
 }
 
 func NewCycloneDXParser() common.DocumentParser {
 	return &cyclonedxParser{
-		rootComponent: component{},
-		pkgMap:        map[string]*component{},
-	}
-}
-
-// TODO(bulldozer): replace with GetPredicates
-// func (c *cyclonedxParser) CreateNodes(ctx context.Context) []assembler.GuacNode {
-// 	nodes := []assembler.GuacNode{}
-// 	nodes = append(nodes, c.rootComponent.curPackage)
-// 	for _, p := range c.rootComponent.depPackages {
-// 		nodes = append(nodes, p.curPackage)
-// 	}
-// 	return nodes
-// }
-
-func addEdges(curPkg component, edges *[]assembler.GuacEdge, visited map[string]bool) {
-	// this could happen if we image purl creation fails for rootPackage
-	// we need better solution to support different image name formats in SBOM
-	if curPkg.curPackage.Name == "" {
-		return
-	}
-	// Exit the function if the package has already been visited
-	if visited[curPkg.curPackage.Name] {
-		return
-	}
-	visited[curPkg.curPackage.Name] = true
-
-	for _, dep := range curPkg.depPackages {
-		// Append the dependency edge to the edges slice
-		*edges = append(*edges, assembler.DependsOnEdge{PackageNode: curPkg.curPackage, PackageDependency: dep.curPackage})
-
-		// Recursively call addEdges for each dependent package
-		addEdges(*dep, edges, visited)
+		packagePackages:   map[string][]model.PkgInputSpec{},
+		packageArtifacts:  map[string][]model.ArtifactInputSpec{},
+		identifierStrings: &common.IdentifierStrings{},
 	}
 }
 
@@ -84,9 +61,15 @@ func (c *cyclonedxParser) Parse(ctx context.Context, doc *processor.Document) er
 	if err != nil {
 		return fmt.Errorf("failed to parse cyclonedx BOM: %w", err)
 	}
-	c.addRootPackage(cdxBom)
-	c.addPackages(cdxBom)
-
+	c.cdxBom = cdxBom
+	if err := c.addRootPackage(cdxBom); err != nil {
+		return err
+	}
+	if cdxBom.Components != nil {
+		if err := c.addPackages(*cdxBom.Components); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -95,108 +78,144 @@ func (c *cyclonedxParser) GetIdentities(ctx context.Context) []common.TrustInfor
 	return nil
 }
 
-// TODO(bulldozer): replace with GetPredicates
-// func (c *cyclonedxParser) CreateEdges(ctx context.Context, foundIdentities []common.TrustInformation) []assembler.GuacEdge {
-// 	edges := []assembler.GuacEdge{}
-// 	visited := make(map[string]bool)
-// 	addEdges(c.rootComponent, &edges, visited)
-// 	return edges
-// }
+// componentRef returns the key used to refer to a component from the
+// dependency graph. Components without a bom-ref fall back to their purl.
+func componentRef(comp *cdx.Component) string {
+	if comp.BOMRef != "" {
+		return comp.BOMRef
+	}
+	if comp.PackageURL != "" {
+		return comp.PackageURL
+	}
+	return asmhelpers.GuacPkgPurl(comp.Name, &comp.Version)
+}
 
-func (c *cyclonedxParser) addRootPackage(cdxBom *cdx.BOM) {
+// rootPurl returns the purl of the component the BOM describes.
+func rootPurl(comp *cdx.Component) string {
 	// oci purl: pkg:oci/debian@sha256%3A244fd47e07d10?repository_url=ghcr.io/debian&tag=bullseye
-	if cdxBom.Metadata.Component != nil {
-		rootPackage := assembler.PackageNode{}
-		rootPackage.Name = cdxBom.Metadata.Component.Name
-		rootPackage.NodeData = *assembler.NewObjectMetadata(c.doc.SourceInformation)
-		if cdxBom.Metadata.Component.PackageURL != "" {
-			rootPackage.Purl = cdxBom.Metadata.Component.PackageURL
-			rootPackage.Version = cdxBom.Metadata.Component.Version
-			rootPackage.Tags = []string{string(cdxBom.Metadata.Component.Type)}
-		} else {
-			if cdxBom.Metadata.Component.Type == cdx.ComponentTypeContainer {
-				splitImage := strings.Split(cdxBom.Metadata.Component.Name, "/")
-				if len(splitImage) == 3 {
-					// example: gcr.io/distroless/static:nonroot
-					splitTag := strings.Split(splitImage[2], ":")
-					if len(splitTag) == 2 {
-						rootPackage.Purl = "pkg:oci/" + splitTag[0] + "@" + cdxBom.Metadata.Component.Version +
-							"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "/" + splitTag[0] + "&tag=" + splitTag[1]
-					} else {
-						// no tag specified
-						rootPackage.Purl = "pkg:oci/" + splitImage[2] + "@" + cdxBom.Metadata.Component.Version +
-							"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "/" + splitImage[2] + "&tag="
-					}
-				} else if len(splitImage) == 2 {
-					// example: library/debian:latest
-					splitTag := strings.Split(splitImage[1], ":")
-					if len(splitTag) == 2 {
-						rootPackage.Purl = "pkg:oci/" + splitTag[0] + "@" + cdxBom.Metadata.Component.Version +
-							"?repository_url=" + splitImage[0] + "/" + splitTag[0] + "&tag=" + splitTag[1]
-					} else {
-						// no tag specified
-						rootPackage.Purl = "pkg:oci/" + splitImage[1] + "@" + cdxBom.Metadata.Component.Version +
-							"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "&tag="
-					}
-				}
-			} else if cdxBom.Metadata.Component.Type == cdx.ComponentTypeFile {
-				// example: file type ("/home/work/test/build/webserver/")
-				rootPackage.Purl = "pkg:guac/file/" + cdxBom.Metadata.Component.Name + "&checksum=" + cdxBom.Metadata.Component.Version
+	if comp.PackageURL != "" {
+		return comp.PackageURL
+	}
+	switch comp.Type {
+	case cdx.ComponentTypeContainer:
+		splitImage := strings.Split(comp.Name, "/")
+		if len(splitImage) == 3 {
+			// example: gcr.io/distroless/static:nonroot
+			splitTag := strings.Split(splitImage[2], ":")
+			if len(splitTag) == 2 {
+				return "pkg:oci/" + splitTag[0] + "@" + comp.Version +
+					"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "/" + splitTag[0] + "&tag=" + splitTag[1]
 			}
-			rootPackage.Version = cdxBom.Metadata.Component.Version
-			rootPackage.Digest = append(rootPackage.Digest, cdxBom.Metadata.Component.Version)
-			rootPackage.Tags = []string{string(cdxBom.Metadata.Component.Type)}
+			// no tag specified
+			return "pkg:oci/" + splitImage[2] + "@" + comp.Version +
+				"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "/" + splitImage[2] + "&tag="
+		} else if len(splitImage) == 2 {
+			// example: library/debian:latest
+			splitTag := strings.Split(splitImage[1], ":")
+			if len(splitTag) == 2 {
+				return "pkg:oci/" + splitTag[0] + "@" + comp.Version +
+					"?repository_url=" + splitImage[0] + "/" + splitTag[0] + "&tag=" + splitTag[1]
+			}
+			// no tag specified
+			return "pkg:oci/" + splitImage[1] + "@" + comp.Version +
+				"?repository_url=" + splitImage[0] + "/" + splitImage[1] + "&tag="
 		}
-		c.rootComponent = component{
-			curPackage:  rootPackage,
-			depPackages: []*component{},
+	case cdx.ComponentTypeFile:
+		// example: file type ("/home/work/test/build/webserver/")
+		if alg, digest, ok := splitDigest(comp.Version); ok {
+			return asmhelpers.GuacFilePurl(alg, digest, &comp.Name)
 		}
 	}
+	return asmhelpers.GuacPkgPurl(strings.Trim(comp.Name, "/"), nil)
 }
 
-func (c *cyclonedxParser) addPackages(cdxBom *cdx.BOM) {
-	if cdxBom.Components != nil {
-		for _, comp := range *cdxBom.Components {
-			// skipping over the "operating-system" type as it does not contain
-			// the required purl for package node. Currently there is no use-case
-			// to capture OS for GUAC.
-			if comp.Type != cdx.ComponentTypeOS {
-				curPkg := assembler.PackageNode{
-					Name: comp.Name,
-					// Digest: []string{comp.Version},
-					Purl:     comp.PackageURL,
-					Version:  comp.Version,
-					NodeData: *assembler.NewObjectMetadata(c.doc.SourceInformation),
-				}
-				if comp.CPE != "" {
-					curPkg.CPEs = []string{comp.CPE}
-				}
-				parentPkg := component{
-					curPackage:  curPkg,
-					depPackages: []*component{},
-				}
-				c.rootComponent.depPackages = append(c.rootComponent.depPackages, &parentPkg)
-				c.pkgMap[comp.BOMRef] = &parentPkg
+// splitDigest splits a digest of the form <algorithm>:<value>
+func splitDigest(s string) (string, string, bool) {
+	alg, digest, found := strings.Cut(s, ":")
+	if !found || alg == "" || digest == "" {
+		return "", "", false
+	}
+	return strings.ToLower(alg), digest, true
+}
+
+func (c *cyclonedxParser) addRootPackage(cdxBom *cdx.BOM) error {
+	if cdxBom.Metadata == nil || cdxBom.Metadata.Component == nil {
+		return nil
+	}
+	root := cdxBom.Metadata.Component
+	c.rootRef = componentRef(root)
+
+	purl := rootPurl(root)
+	rootPackage, err := asmhelpers.PurlToPkg(purl)
+	if err != nil {
+		return fmt.Errorf("failed to parse root component purl %q: %w", purl, err)
+	}
+	c.packagePackages[c.rootRef] = append(c.packagePackages[c.rootRef], *rootPackage)
+	c.addArtifacts(c.rootRef, root)
+
+	// images can be identified by their version when it is a digest
+	if root.Type == cdx.ComponentTypeContainer {
+		c.packageArtifactsFromVersion(c.rootRef, root.Version)
+		c.identifierStrings.OciStrings = append(c.identifierStrings.OciStrings, root.Name)
+	}
+	if root.ExternalReferences != nil {
+		for _, ref := range *root.ExternalReferences {
+			if ref.Type == cdx.ERTypeVCS && ref.URL != "" {
+				c.identifierStrings.VcsStrings = append(c.identifierStrings.VcsStrings, ref.URL)
 			}
 		}
 	}
+	return nil
+}
 
-	if cdxBom.Dependencies == nil {
+func (c *cyclonedxParser) packageArtifactsFromVersion(ref string, version string) {
+	if alg, digest, ok := splitDigest(version); ok {
+		c.packageArtifacts[ref] = append(c.packageArtifacts[ref], model.ArtifactInputSpec{
+			Algorithm: alg,
+			Digest:    digest,
+		})
+	}
+}
+
+func (c *cyclonedxParser) addArtifacts(ref string, comp *cdx.Component) {
+	if comp.Hashes == nil {
 		return
 	}
-	for _, deps := range *cdxBom.Dependencies {
-		currPkg, found := c.pkgMap[deps.Ref]
-		if !found {
-			continue
+	for _, hash := range *comp.Hashes {
+		c.packageArtifacts[ref] = append(c.packageArtifacts[ref], model.ArtifactInputSpec{
+			Algorithm: strings.ToLower(string(hash.Algorithm)),
+			Digest:    hash.Value,
+		})
+	}
+}
+
+func (c *cyclonedxParser) addPackages(comps []cdx.Component) error {
+	for i := range comps {
+		comp := &comps[i]
+		// skipping over the "operating-system" type as it does not contain
+		// the required purl for package node. Currently there is no use-case
+		// to capture OS for GUAC.
+		if comp.Type != cdx.ComponentTypeOS {
+			purl := comp.PackageURL
+			if purl == "" {
+				purl = asmhelpers.GuacPkgPurl(comp.Name, &comp.Version)
+			}
+			pkg, err := asmhelpers.PurlToPkg(purl)
+			if err != nil {
+				return fmt.Errorf("failed to parse component purl %q: %w", purl, err)
+			}
+			ref := componentRef(comp)
+			c.packagePackages[ref] = append(c.packagePackages[ref], *pkg)
+			c.addArtifacts(ref, comp)
 		}
-		if deps.Dependencies != nil {
-			for _, depPkg := range *deps.Dependencies {
-				if depPkg, exist := c.pkgMap[depPkg]; exist {
-					currPkg.depPackages = append(currPkg.depPackages, depPkg)
-				}
+		// nested components (e.g. from cdxgen) are flattened
+		if comp.Components != nil {
+			if err := c.addPackages(*comp.Components); err != nil {
+				return err
 			}
 		}
 	}
+	return nil
 }
 
 func parseCycloneDXBOM(doc *processor.Document) (*cdx.BOM, error) {
@@ -217,9 +236,84 @@ func parseCycloneDXBOM(doc *processor.Document) (*cdx.BOM, error) {
 }
 
 func (c *cyclonedxParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
-	return nil, fmt.Errorf("not yet implemented")
+	return c.identifierStrings, nil
 }
 
 func (c *cyclonedxParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
-	return nil
+	logger := logging.FromContext(ctx)
+
+	preds := &assembler.IngestPredicates{}
+
+	// use the dependency graph of the BOM if there is one
+	linked := map[string]bool{}
+	if c.cdxBom.Dependencies != nil {
+		for _, deps := range *c.cdxBom.Dependencies {
+			if deps.Dependencies == nil {
+				continue
+			}
+			currPkgs, found := c.packagePackages[deps.Ref]
+			if !found {
+				logger.Debugf("cdx dependency ref %s not found in components", deps.Ref)
+				continue
+			}
+			for _, depRef := range *deps.Dependencies {
+				depPkgs, found := c.packagePackages[depRef]
+				if !found {
+					logger.Debugf("cdx dependency ref %s not found in components", depRef)
+					continue
+				}
+				preds.IsDependency = append(preds.IsDependency, createIsDeps(currPkgs, depPkgs, dependencyJustification)...)
+				linked[deps.Ref] = true
+				linked[depRef] = true
+			}
+		}
+	}
+
+	// fall back to linking the top level package to each component that
+	// appears in no dependency edge
+	if toplevel, ok := c.packagePackages[c.rootRef]; ok {
+		for ref, pkgs := range c.packagePackages {
+			if ref == c.rootRef || linked[ref] {
+				continue
+			}
+			preds.IsDependency = append(preds.IsDependency, createIsDeps(toplevel[:1], pkgs, topLevelJustification)...)
+		}
+	}
+
+	// Create predicates for IsOccurence for all artifacts found
+	for ref, arts := range c.packageArtifacts {
+		for i := range c.packagePackages[ref] {
+			pkg := c.packagePackages[ref][i]
+			for j := range arts {
+				art := arts[j]
+				preds.IsOccurence = append(preds.IsOccurence, assembler.IsOccurenceIngest{
+					Pkg:      &pkg,
+					Artifact: &art,
+					IsOccurence: &model.IsOccurrenceInputSpec{
+						Justification: checksumJustification,
+					},
+				})
+			}
+		}
+	}
+
+	return preds
+}
+
+func createIsDeps(pkgs []model.PkgInputSpec, depPkgs []model.PkgInputSpec, justification string) []assembler.IsDependencyIngest {
+	isDeps := []assembler.IsDependencyIngest{}
+	for i := range pkgs {
+		pkg := pkgs[i]
+		for j := range depPkgs {
+			depPkg := depPkgs[j]
+			isDeps = append(isDeps, assembler.IsDependencyIngest{
+				Pkg:    &pkg,
+				DepPkg: &depPkg,
+				IsDependency: &model.IsDependencyInputSpec{
+					Justification: justification,
+				},
+			})
+		}
+	}
+	return isDeps
 }
@@ -15,28 +15,28 @@
 
 package cyclonedx
 
-// TODO(bulldozer): freeze test
-/*
 import (
 	"context"
-	"reflect"
 	"testing"
 
 	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/testdata"
 	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/guacsec/guac/pkg/logging"
 )
 
 func Test_cyclonedxParser(t *testing.T) {
 	ctx := logging.WithLogger(context.Background())
 	tests := []struct {
-		name      string
-		doc       *processor.Document
-		wantNodes []assembler.GuacNode
-		wantEdges []assembler.GuacEdge
-		wantErr   bool
+		name           string
+		doc            *processor.Document
+		wantPredicates *assembler.IngestPredicates
+		wantErr        bool
 	}{{
 		name: "valid small CycloneDX document",
 		doc: &processor.Document{
@@ -48,9 +48,8 @@ func Test_cyclonedxParser(t *testing.T) {
 				Source:    "TestSource",
 			},
 		},
-		wantNodes: testdata.CycloneDXNodes,
-		wantEdges: testdata.CyloneDXEdges,
-		wantErr:   false,
+		wantPredicates: &testdata.CdxIngestionPredicates,
+		wantErr:        false,
 	}, {
 		name: "valid small CycloneDX document with package dependencies",
 		doc: &processor.Document{
@@ -62,9 +61,8 @@ func Test_cyclonedxParser(t *testing.T) {
 				Source:    "TestSource",
 			},
 		},
-		wantNodes: testdata.CycloneDXQuarkusNodes,
-		wantEdges: testdata.CyloneDXQuarkusEdges,
-		wantErr:   false,
+		wantPredicates: &testdata.CdxQuarkusIngestionPredicates,
+		wantErr:        false,
 	}, {
 		name: "valid CycloneDX document where dependencies are missing dependsOn properties",
 		doc: &processor.Document{
@@ -76,9 +74,8 @@ func Test_cyclonedxParser(t *testing.T) {
 				Source:    "TestSource",
 			},
 		},
-		wantNodes: testdata.NpmMissingDependsOnCycloneDXNodes,
-		wantEdges: testdata.NpmMissingDependsOnCycloneDXEdges,
-		wantErr:   false,
+		wantPredicates: &testdata.CdxNpmMissingDependsOnIngestionPredicates,
+		wantErr:        false,
 	}, {
 		name: "valid CycloneDX document with no package dependencies",
 		doc: &processor.Document{
@@ -90,11 +87,50 @@ func Test_cyclonedxParser(t *testing.T) {
 				Source:    "TestSource",
 			},
 		},
-		wantNodes: testdata.CycloneDXNoDependentComponentsNodes,
-		wantEdges: testdata.CyloneDXNoDependentComponentsEdges,
-		wantErr:   false,
-	},
-	}
+		wantPredicates: &testdata.CdxNoDependentComponentsIngestionPredicates,
+		wantErr:        false,
+	}, {
+		name: "components outside the dependency graph are linked to the top level package",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"bomFormat": "CycloneDX",
+				"specVersion": "1.4",
+				"version": 1,
+				"metadata": {
+					"component": {"bom-ref": "app", "type": "application", "name": "app", "version": "1.0.0", "purl": "pkg:npm/app@1.0.0"}
+				},
+				"components": [
+					{"bom-ref": "left-pad", "type": "library", "name": "left-pad", "version": "1.3.0", "purl": "pkg:npm/left-pad@1.3.0"},
+					{"bom-ref": "lodash", "type": "library", "name": "lodash", "version": "4.17.21", "purl": "pkg:npm/lodash@4.17.21"}
+				],
+				"dependencies": [
+					{"ref": "app", "dependsOn": ["left-pad"]}
+				]
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCycloneDX,
+		},
+		wantPredicates: func() *assembler.IngestPredicates {
+			app, _ := asmhelpers.PurlToPkg("pkg:npm/app@1.0.0")
+			leftPad, _ := asmhelpers.PurlToPkg("pkg:npm/left-pad@1.3.0")
+			lodash, _ := asmhelpers.PurlToPkg("pkg:npm/lodash@4.17.21")
+			return &assembler.IngestPredicates{
+				IsDependency: []assembler.IsDependencyIngest{
+					{Pkg: app, DepPkg: leftPad, IsDependency: &model.IsDependencyInputSpec{Justification: dependencyJustification}},
+					{Pkg: app, DepPkg: lodash, IsDependency: &model.IsDependencyInputSpec{Justification: topLevelJustification}},
+				},
+			}
+		}(),
+		wantErr: false,
+	}, {
+		name: "invalid CycloneDX document",
+		doc: &processor.Document{
+			Blob:   testdata.CycloneDXInvalidExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentCycloneDX,
+		},
+		wantErr: true,
+	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := NewCycloneDXParser()
@@ -106,60 +142,59 @@ func Test_cyclonedxParser(t *testing.T) {
 			if err != nil {
 				return
 			}
-			if nodes := s.CreateNodes(ctx); !testdata.GuacNodeSliceEqual(nodes, tt.wantNodes) {
-				t.Errorf("cyclonedxParser.CreateNodes() = %v, want %v", nodes, tt.wantNodes)
-			}
-			if edges := s.CreateEdges(ctx, nil); !testdata.GuacEdgeSliceEqual(edges, tt.wantEdges) {
-				t.Errorf("cyclonedxParser.CreateEdges() = %v, want %v", edges, tt.wantEdges)
+			preds := s.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("cdx.GetPredicate mismatch values (+got, -expected): %s", d)
 			}
 		})
 	}
 }
-func Test_addEdgesRecursive(t *testing.T) {
-	packageA := component{curPackage: assembler.PackageNode{Name: "A"}}
-	packageB := component{curPackage: assembler.PackageNode{Name: "B"}}
-	packageC := component{curPackage: assembler.PackageNode{Name: "C"}}
-	packageD := component{curPackage: assembler.PackageNode{Name: "D"}}
-
-	packageA.depPackages = []*component{&packageB}
-	packageB.depPackages = []*component{&packageC}
-	packageC.depPackages = []*component{&packageD}
-	packageD.depPackages = []*component{&packageA}
-	//
-	// 	  A -> B -> C -> D -> A
-	// 	  This should result in a cycle, and it shouldn't blow up the stack.
-	//
-	var edges []assembler.GuacEdge
-	visited := make(map[string]bool)
-	addEdges(packageA, &edges, visited)
 
-	packageE := component{curPackage: assembler.PackageNode{Name: "E"}}
-	packageF := component{curPackage: assembler.PackageNode{Name: "F"}}
-	packageG := component{curPackage: assembler.PackageNode{Name: "G"}}
-	//
-	// This test case creates seven packages: A, B, C, D, E, F, and G.
-	// It sets up a cycle in the dependencies such that D, E, F, and G depend on A, B and C depend on D, E, F, and G.
-	// Calling addEdges(packageA, &edges) should not cause the function to recursively call itself indefinitely,
-	// leading to a stack overflow.
-	//
-	packageA.depPackages = []*component{&packageB, &packageC}
-	packageB.depPackages = []*component{&packageD, &packageE}
-	packageC.depPackages = []*component{&packageF, &packageG}
-	packageD.depPackages = []*component{&packageA}
-	packageE.depPackages = []*component{&packageA}
-	packageF.depPackages = []*component{&packageA}
-	packageG.depPackages = []*component{&packageA}
-
-	var e []assembler.GuacEdge
-	visited = make(map[string]bool)
-	addEdges(packageA, &e, visited)
+func Test_cyclonedxParser_GetIdentifiers(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tests := []struct {
+		name string
+		blob []byte
+		want *common.IdentifierStrings
+	}{{
+		name: "container image",
+		blob: testdata.CycloneDXDistrolessExample,
+		want: &common.IdentifierStrings{
+			OciStrings: []string{"gcr.io/distroless/static:nonroot"},
+		},
+	}, {
+		name: "vcs reference",
+		blob: testdata.CycloneDXExampleNoDependentComponents,
+		want: &common.IdentifierStrings{
+			VcsStrings: []string{"https://github.com/quarkusio/quarkus"},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewCycloneDXParser()
+			doc := &processor.Document{
+				Blob:   tt.blob,
+				Format: processor.FormatJSON,
+				Type:   processor.DocumentCycloneDX,
+			}
+			if err := s.Parse(ctx, doc); err != nil {
+				t.Fatalf("cyclonedxParser.Parse() error = %v", err)
+			}
+			got, err := s.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatalf("cyclonedxParser.GetIdentifiers() error = %v", err)
+			}
+			if d := cmp.Diff(tt.want, got); len(d) != 0 {
+				t.Errorf("cdx.GetIdentifiers mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
 }
 
 func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 	tests := []struct {
 		name     string
 		cdxBom   *cdx.BOM
-		wantTag  string
 		wantPurl string
 	}{{
 		name: "purl provided",
@@ -173,7 +208,6 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 				},
 			},
 		},
-		wantTag:  "container",
 		wantPurl: "pkg:oci/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?repository_url=gcr.io/distroless/static&tag=nonroot",
 	}, {
 		name: "gcr.io/distroless/static:nonroot - purl not provided",
@@ -186,7 +220,6 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 				},
 			},
 		},
-		wantTag:  "container",
 		wantPurl: "pkg:oci/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?repository_url=gcr.io/distroless/static&tag=nonroot",
 	}, {
 		name: "gcr.io/distroless/static - purl not provided, tag not specified",
@@ -199,7 +232,6 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 				},
 			},
 		},
-		wantTag:  "container",
 		wantPurl: "pkg:oci/static@sha256:6ad5b696af3ca05a048bd29bf0f623040462638cb0b29c8d702cbb2805687388?repository_url=gcr.io/distroless/static&tag=",
 	}, {
 		name: "gcr.io/distroless/static - purl not provided, tag not specified, version not specified",
@@ -211,7 +243,6 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 				},
 			},
 		},
-		wantTag:  "container",
 		wantPurl: "pkg:oci/static@?repository_url=gcr.io/distroless/static&tag=",
 	}, {
 		name: "library/debian:latest - purl not provided, assume docker.io",
@@ -224,7 +255,6 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 				},
 			},
 		},
-		wantTag:  "container",
 		wantPurl: "pkg:oci/debian@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?repository_url=library/debian&tag=latest",
 	}, {
 		name: "library/debian - purl not provided, assume docker.io, tag not specified",
@@ -237,7 +267,6 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 				},
 			},
 		},
-		wantTag:  "container",
 		wantPurl: "pkg:oci/debian@sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870?repository_url=library/debian&tag=",
 	}, {
 		name: "file type - purl nor provided, version provided",
@@ -250,8 +279,7 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 				},
 			},
 		},
-		wantTag:  "file",
-		wantPurl: "pkg:guac/file//home/work/test/build/webserver/&checksum=sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870",
+		wantPurl: "pkg:guac/files/sha256:1304f174557314a7ed9eddb4eab12fed12cb0cd9809e4c28f29af86979a3c870#/home/work/test/build/webserver/",
 	}, {
 		name: "file type - purl nor provided, version not provided",
 		cdxBom: &cdx.BOM{
@@ -262,29 +290,25 @@ func Test_cyclonedxParser_addRootPackage(t *testing.T) {
 				},
 			},
 		},
-		wantTag:  "file",
-		wantPurl: "pkg:guac/file//home/work/test/build/webserver/&checksum=",
+		wantPurl: "pkg:guac/home/work/test/build/webserver",
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := &cyclonedxParser{
-				doc: &processor.Document{
-					SourceInformation: processor.SourceInformation{
-						Collector: "test",
-						Source:    "test",
-					},
-				},
-				rootComponent: component{},
-				pkgMap:        map[string]*component{},
+			c := NewCycloneDXParser().(*cyclonedxParser)
+			if err := c.addRootPackage(tt.cdxBom); err != nil {
+				t.Fatalf("addRootPackage() error = %v", err)
+			}
+			wantPkg, err := asmhelpers.PurlToPkg(tt.wantPurl)
+			if err != nil {
+				t.Fatalf("unable to parse expected purl: %v", err)
 			}
-			c.addRootPackage(tt.cdxBom)
-			if !reflect.DeepEqual(c.rootComponent.curPackage.Purl, tt.wantPurl) {
-				t.Errorf("addRootPackage failed to produce expected purl = %v, want %v", c.rootComponent.curPackage.Purl, tt.wantPurl)
+			got := c.packagePackages[c.rootRef]
+			if len(got) != 1 {
+				t.Fatalf("addRootPackage produced %d packages, want 1", len(got))
 			}
-			if !reflect.DeepEqual(c.rootComponent.curPackage.Tags[0], tt.wantTag) {
-				t.Errorf("addRootPackage failed to produce expected tag = %v, want %v", c.rootComponent.curPackage.Tags[0], tt.wantTag)
+			if d := cmp.Diff(*wantPkg, got[0], testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("addRootPackage mismatch values (+got, -expected): %s", d)
 			}
 		})
 	}
 }
-*/
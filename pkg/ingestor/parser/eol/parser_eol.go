@@ -0,0 +1,131 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The end of life attestation parser parses the attestation defined by the
+// certifier using the predicate type "https://in-toto.io/attestation/eol/v0.1".
+// The subjects of the statement are expected to be package purls, with the
+// digests of the package when known.
+//
+// For each package and each release cycle it belongs to, a CertifyEOL is
+// generated on the package version with the support status of the cycle at
+// the time of the scan.
+package eol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+type eolCertificationParser struct {
+	doc               *processor.Document
+	pkgs              []*generated.PkgInputSpec
+	results           []attestation_vuln.EOLResult
+	timeChecked       time.Time
+	identifierStrings *common.IdentifierStrings
+}
+
+// NewEOLCertificationParser initializes the eolCertificationParser
+func NewEOLCertificationParser() common.DocumentParser {
+	return &eolCertificationParser{
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (c *eolCertificationParser) Parse(ctx context.Context, doc *processor.Document) error {
+	c.doc = doc
+	statement := attestation_vuln.EOLStatement{}
+	if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+		return fmt.Errorf("failed to parse end of life predicate: %w", err)
+	}
+	seen := map[string]bool{}
+	for _, sub := range statement.StatementHeader.Subject {
+		// a subject is repeated for each digest of the package
+		if seen[sub.Name] {
+			continue
+		}
+		seen[sub.Name] = true
+		pkg, err := helpers.PurlToPkg(sub.Name)
+		if err != nil {
+			return fmt.Errorf("failed to parse subject purl %q: %w", sub.Name, err)
+		}
+		c.pkgs = append(c.pkgs, pkg)
+		c.identifierStrings.UnclassifiedStrings = append(c.identifierStrings.UnclassifiedStrings, sub.Name)
+	}
+	c.results = statement.Predicate.Scanner.Result
+	if statement.Predicate.Metadata.ScannedOn != nil {
+		c.timeChecked = statement.Predicate.Metadata.ScannedOn.UTC()
+	}
+	return nil
+}
+
+// GetPredicates returns a CertifyEOL for each package and release cycle it
+// belongs to. Results with an unknown support status are skipped.
+func (c *eolCertificationParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	preds := &assembler.IngestPredicates{}
+	for _, result := range c.results {
+		status := generated.SupportStatus(result.Status)
+		switch status {
+		case generated.SupportStatusSupported, generated.SupportStatusSecurityOnly, generated.SupportStatusEol:
+		default:
+			continue
+		}
+		if result.Product == "" || result.Cycle == "" {
+			continue
+		}
+		certifyEOL := &generated.CertifyEOLInputSpec{
+			Product:     result.Product,
+			Cycle:       result.Cycle,
+			Status:      status,
+			Eol:         utcOrNil(result.EOL),
+			Support:     utcOrNil(result.Support),
+			Latest:      result.Latest,
+			TimeChecked: c.timeChecked,
+		}
+		for _, pkg := range c.pkgs {
+			preds.CertifyEOL = append(preds.CertifyEOL, assembler.CertifyEOLIngest{
+				Pkg:        pkg,
+				CertifyEOL: certifyEOL,
+			})
+		}
+	}
+	return preds
+}
+
+func utcOrNil(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (c *eolCertificationParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (c *eolCertificationParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return c.identifierStrings, nil
+}
@@ -0,0 +1,147 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var eolAttestation = []byte(`{
+	"_type": "https://in-toto.io/Statement/v0.1",
+	"predicateType": "https://in-toto.io/attestation/eol/v0.1",
+	"subject": [
+		{"name": "pkg:guac/oci/docker.io/library/python:3.7", "digest": {"sha256": "abcdef0123"}},
+		{"name": "pkg:guac/oci/docker.io/library/python:3.7", "digest": {"sha1": "abc"}}
+	],
+	"predicate": {
+		"invocation": {"uri": "guac", "producer_id": "guacsec/guac"},
+		"scanner": {
+			"uri": "guac/eol",
+			"version": "0.0.1",
+			"database": {"uri": "endoflife", "version": "2023-10-01T00:00:00Z"},
+			"result": [
+				{"product": "python", "cycle": "3.7", "status": "EOL", "eol": "2023-06-27T00:00:00Z", "support": "2020-06-27T00:00:00Z", "latest": "3.7.17"},
+				{"product": "debian", "cycle": "12", "status": "SUPPORTED", "latest": "12.2"},
+				{"product": "unknown", "cycle": "1", "status": "RETIRED"}
+			]
+		},
+		"metadata": {"scannedOn": "2023-11-01T00:00:00Z"}
+	}
+}`)
+
+func Test_eolCertificationParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	python := &generated.PkgInputSpec{
+		Type:       "guac",
+		Namespace:  ptr("oci/docker.io/library"),
+		Name:       "python:3.7",
+		Version:    ptr(""),
+		Subpath:    ptr(""),
+		Qualifiers: []generated.PackageQualifierInputSpec{},
+	}
+	timeChecked := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		doc             *processor.Document
+		wantPredicates  *assembler.IngestPredicates
+		wantIdentifiers *common.IdentifierStrings
+		wantErr         bool
+	}{{
+		name: "end of life attestation",
+		doc: &processor.Document{
+			Blob:   eolAttestation,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6EOL,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyEOL: []assembler.CertifyEOLIngest{{
+				Pkg: python,
+				CertifyEOL: &generated.CertifyEOLInputSpec{
+					Product:     "python",
+					Cycle:       "3.7",
+					Status:      generated.SupportStatusEol,
+					Eol:         ptr(time.Date(2023, 6, 27, 0, 0, 0, 0, time.UTC)),
+					Support:     ptr(time.Date(2020, 6, 27, 0, 0, 0, 0, time.UTC)),
+					Latest:      "3.7.17",
+					TimeChecked: timeChecked,
+				},
+			}, {
+				Pkg: python,
+				CertifyEOL: &generated.CertifyEOLInputSpec{
+					Product:     "debian",
+					Cycle:       "12",
+					Status:      generated.SupportStatusSupported,
+					Latest:      "12.2",
+					TimeChecked: timeChecked,
+				},
+			}},
+		},
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{"pkg:guac/oci/docker.io/library/python:3.7"},
+		},
+	}, {
+		name: "not JSON",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxExampleAlpineTagValue,
+			Format: processor.FormatTagValue,
+			Type:   processor.DocumentITE6EOL,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewEOLCertificationParser()
+			err := c.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("eolCertificationParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			preds := c.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("eol.GetPredicate mismatch values (+got, -expected): %s", d)
+			}
+
+			identifiers, err := c.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatalf("eolCertificationParser.GetIdentifiers() error = %v", err)
+			}
+			if d := cmp.Diff(tt.wantIdentifiers, identifiers, cmpopts.EquateEmpty()); len(d) != 0 {
+				t.Errorf("eol.GetIdentifiers mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
+
+func ptr[T any](s T) *T {
+	return &s
+}
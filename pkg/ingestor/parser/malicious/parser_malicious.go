@@ -0,0 +1,137 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The malicious package attestation parser parses the attestation defined by
+// the certifier using the predicate type "https://in-toto.io/attestation/malicious/v0.1".
+// The subjects of the statement are expected to be package purls, with the
+// digests of the package when known.
+//
+// For each package and each feed entry reporting it, a CertifyBad is
+// generated on the package version, or on the artifact when the entry
+// reported one of its digests. The justification names the feed entry.
+package malicious
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
+	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+type maliciousCertificationParser struct {
+	doc               *processor.Document
+	pkgs              []*generated.PkgInputSpec
+	results           []attestation_vuln.MaliciousResult
+	identifierStrings *common.IdentifierStrings
+}
+
+// NewMaliciousCertificationParser initializes the maliciousCertificationParser
+func NewMaliciousCertificationParser() common.DocumentParser {
+	return &maliciousCertificationParser{
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (c *maliciousCertificationParser) Parse(ctx context.Context, doc *processor.Document) error {
+	c.doc = doc
+	statement := attestation_vuln.MaliciousStatement{}
+	if err := json.Unmarshal(doc.Blob, &statement); err != nil {
+		return fmt.Errorf("failed to parse malicious package predicate: %w", err)
+	}
+	seen := map[string]bool{}
+	for _, sub := range statement.StatementHeader.Subject {
+		// a subject is repeated for each digest of the package
+		if seen[sub.Name] {
+			continue
+		}
+		seen[sub.Name] = true
+		pkg, err := helpers.PurlToPkg(sub.Name)
+		if err != nil {
+			return fmt.Errorf("failed to parse subject purl %q: %w", sub.Name, err)
+		}
+		c.pkgs = append(c.pkgs, pkg)
+		c.identifierStrings.UnclassifiedStrings = append(c.identifierStrings.UnclassifiedStrings, sub.Name)
+	}
+	c.results = statement.Predicate.Scanner.Result
+	return nil
+}
+
+// GetPredicates returns a CertifyBad for each package, or artifact, and
+// feed entry reporting it as malicious
+func (c *maliciousCertificationParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	preds := &assembler.IngestPredicates{}
+	for _, result := range c.results {
+		if result.Id == "" {
+			continue
+		}
+		certifyBad := &generated.CertifyBadInputSpec{
+			Justification: justification(result),
+		}
+		if result.Digest != "" {
+			algorithm, digest, ok := strings.Cut(result.Digest, ":")
+			if !ok {
+				continue
+			}
+			preds.CertifyBad = append(preds.CertifyBad, assembler.CertifyBadIngest{
+				Artifact: &generated.ArtifactInputSpec{
+					Algorithm: strings.ToLower(algorithm),
+					Digest:    strings.ToLower(digest),
+				},
+				CertifyBad: certifyBad,
+			})
+			continue
+		}
+		for _, pkg := range c.pkgs {
+			matchFlag := generated.PkgMatchTypeSpecificVersion
+			if pkg.Version == nil || *pkg.Version == "" {
+				// only entries reporting all the versions match
+				// packages without version
+				matchFlag = generated.PkgMatchTypeAllVersions
+			}
+			preds.CertifyBad = append(preds.CertifyBad, assembler.CertifyBadIngest{
+				Pkg:          pkg,
+				PkgMatchFlag: generated.MatchFlags{Pkg: matchFlag},
+				CertifyBad:   certifyBad,
+			})
+		}
+	}
+	return preds
+}
+
+// justification names the feed entry, as the OSV parser does for MAL- entries
+func justification(result attestation_vuln.MaliciousResult) string {
+	j := fmt.Sprintf("malicious package (%s)", result.Id)
+	if result.Summary != "" {
+		j += ": " + result.Summary
+	}
+	return j
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (c *maliciousCertificationParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (c *maliciousCertificationParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return c.identifierStrings, nil
+}
@@ -0,0 +1,167 @@
+//
+// Copyright 2022 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package malicious
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+var maliciousAttestation = []byte(`{
+	"_type": "https://in-toto.io/Statement/v0.1",
+	"predicateType": "https://in-toto.io/attestation/malicious/v0.1",
+	"subject": [
+		{"name": "pkg:npm/ua-parser-js@0.7.29", "digest": {"sha256": "abcdef0123"}},
+		{"name": "pkg:npm/ua-parser-js@0.7.29", "digest": {"sha1": "abc"}}
+	],
+	"predicate": {
+		"invocation": {"uri": "guac", "producer_id": "guacsec/guac"},
+		"scanner": {
+			"uri": "guac/malicious",
+			"version": "0.0.1",
+			"feed": {"uri": "malicious-packages/osv", "version": "2023-05-17T10:12:02Z"},
+			"result": [
+				{"id": "MAL-2022-4691", "summary": "Malicious code in ua-parser-js (npm)"},
+				{"id": "EXAMPLE-2", "digest": "sha256:abcdef0123"}
+			]
+		},
+		"metadata": {"scannedOn": "2023-06-01T00:00:00Z"}
+	}
+}`)
+
+func Test_maliciousCertificationParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	uaParserVersion := "0.7.29"
+	uaParser := &generated.PkgInputSpec{
+		Type:       "npm",
+		Namespace:  ptr(""),
+		Name:       "ua-parser-js",
+		Version:    &uaParserVersion,
+		Subpath:    ptr(""),
+		Qualifiers: []generated.PackageQualifierInputSpec{},
+	}
+
+	tests := []struct {
+		name            string
+		doc             *processor.Document
+		wantPredicates  *assembler.IngestPredicates
+		wantIdentifiers *common.IdentifierStrings
+		wantErr         bool
+	}{{
+		name: "malicious package attestation",
+		doc: &processor.Document{
+			Blob:   maliciousAttestation,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Malicious,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyBad: []assembler.CertifyBadIngest{{
+				Pkg:          uaParser,
+				PkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeSpecificVersion},
+				CertifyBad: &generated.CertifyBadInputSpec{
+					Justification: "malicious package (MAL-2022-4691): Malicious code in ua-parser-js (npm)",
+				},
+			}, {
+				Artifact: &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: "abcdef0123"},
+				CertifyBad: &generated.CertifyBadInputSpec{
+					Justification: "malicious package (EXAMPLE-2)",
+				},
+			}},
+		},
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{"pkg:npm/ua-parser-js@0.7.29"},
+		},
+	}, {
+		name: "package without version",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"_type": "https://in-toto.io/Statement/v0.1",
+				"predicateType": "https://in-toto.io/attestation/malicious/v0.1",
+				"subject": [{"name": "pkg:pypi/evil-package"}],
+				"predicate": {"scanner": {"result": [{"id": "EXAMPLE-1"}]}}
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Malicious,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyBad: []assembler.CertifyBadIngest{{
+				Pkg: &generated.PkgInputSpec{
+					Type:       "pypi",
+					Namespace:  ptr(""),
+					Name:       "evil-package",
+					Version:    ptr(""),
+					Subpath:    ptr(""),
+					Qualifiers: []generated.PackageQualifierInputSpec{},
+				},
+				PkgMatchFlag: generated.MatchFlags{Pkg: generated.PkgMatchTypeAllVersions},
+				CertifyBad: &generated.CertifyBadInputSpec{
+					Justification: "malicious package (EXAMPLE-1)",
+				},
+			}},
+		},
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{"pkg:pypi/evil-package"},
+		},
+	}, {
+		name: "not JSON",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxExampleAlpineTagValue,
+			Format: processor.FormatTagValue,
+			Type:   processor.DocumentITE6Malicious,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMaliciousCertificationParser()
+			err := c.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("maliciousCertificationParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			preds := c.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("malicious.GetPredicate mismatch values (+got, -expected): %s", d)
+			}
+
+			identifiers, err := c.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatalf("maliciousCertificationParser.GetIdentifiers() error = %v", err)
+			}
+			if d := cmp.Diff(tt.wantIdentifiers, identifiers, cmpopts.EquateEmpty()); len(d) != 0 {
+				t.Errorf("malicious.GetIdentifiers mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
+
+func ptr[T any](s T) *T {
+	return &s
+}
@@ -0,0 +1,197 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openvex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	openvex_processor "github.com/guacsec/guac/pkg/handler/processor/openvex"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+const purlIdentifier = "purl"
+
+type openVEXParser struct {
+	doc               *processor.Document
+	vexes             []assembler.VexIngest
+	identifierStrings *common.IdentifierStrings
+}
+
+// NewOpenVEXParser initializes the openVEXParser
+func NewOpenVEXParser() common.DocumentParser {
+	return &openVEXParser{
+		vexes:             []assembler.VexIngest{},
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (o *openVEXParser) Parse(ctx context.Context, doc *processor.Document) error {
+	o.doc = doc
+
+	vexDoc, err := openvex_processor.LoadDocument(doc.Blob, doc.Format)
+	if err != nil {
+		return fmt.Errorf("failed to parse OpenVEX document: %w", err)
+	}
+
+	for _, s := range vexDoc.Statements {
+		osv, cve, ghsa := getVulnerability(s.Vulnerability)
+
+		status, err := asmhelpers.VexStatus(string(s.Status))
+		if err != nil {
+			return fmt.Errorf("failed to parse OpenVEX statement for %s: %w", s.Vulnerability.Name, err)
+		}
+		vexData := &model.VexStatementInputSpec{
+			Status:           status,
+			VexJustification: asmhelpers.VexJustification(s.Justification),
+			Statement:        s.ImpactStatement,
+			StatusNotes:      s.StatusNotes,
+			ActionStatement:  s.ActionStatement,
+			KnownSince:       getKnownSince(vexDoc, &s),
+		}
+
+		for _, product := range s.Products {
+			if err := o.addProductVexes(ctx, product, osv, cve, ghsa, vexData); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addProductVexes records the statement for the package and the artifacts
+// of the product, and for those of each of its subcomponents
+func (o *openVEXParser) addProductVexes(ctx context.Context, product openvex_processor.Product, osv *model.OSVInputSpec, cve *model.CVEInputSpec, ghsa *model.GHSAInputSpec, vexData *model.VexStatementInputSpec) error {
+	logger := logging.FromContext(ctx)
+
+	pkg, artifacts, err := o.getProduct(product)
+	if err != nil {
+		return err
+	}
+	if pkg == nil && len(artifacts) == 0 {
+		logger.Debugf("skipping OpenVEX product %q, it has neither a purl nor hashes", product.ID)
+	}
+	if pkg != nil {
+		o.vexes = append(o.vexes, assembler.VexIngest{
+			Pkg:     pkg,
+			OSV:     osv,
+			CVE:     cve,
+			GHSA:    ghsa,
+			VexData: vexData,
+		})
+	}
+	for i := range artifacts {
+		o.vexes = append(o.vexes, assembler.VexIngest{
+			Artifact: &artifacts[i],
+			OSV:      osv,
+			CVE:      cve,
+			GHSA:     ghsa,
+			VexData:  vexData,
+		})
+	}
+	for _, subcomponent := range product.Subcomponents {
+		if err := o.addProductVexes(ctx, subcomponent, osv, cve, ghsa, vexData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getVulnerability returns the vulnerability the statement is about. A CVE or
+// GHSA among the name and the aliases is preferred, otherwise the name is
+// used as an OSV ID.
+func getVulnerability(v openvex_processor.Vulnerability) (*model.OSVInputSpec, *model.CVEInputSpec, *model.GHSAInputSpec) {
+	for _, id := range append([]string{v.Name}, v.Aliases...) {
+		if _, cve, ghsa := asmhelpers.OsvCveOrGhsa(id); cve != nil || ghsa != nil {
+			return nil, cve, ghsa
+		}
+	}
+	return asmhelpers.OsvCveOrGhsa(v.Name)
+}
+
+// getKnownSince returns the time of the statement, which defaults to the
+// time of the document
+func getKnownSince(doc *openvex_processor.Document, s *openvex_processor.Statement) time.Time {
+	switch {
+	case s.LastUpdated != nil:
+		return s.LastUpdated.UTC()
+	case s.Timestamp != nil:
+		return s.Timestamp.UTC()
+	case doc.LastUpdate != nil:
+		return doc.LastUpdate.UTC()
+	}
+	return doc.Timestamp.UTC()
+}
+
+// getProduct returns the package of the product if it is identified by a
+// purl and an artifact for each of its hashes
+func (o *openVEXParser) getProduct(product openvex_processor.Product) (*model.PkgInputSpec, []model.ArtifactInputSpec, error) {
+	var pkg *model.PkgInputSpec
+	purl := product.Identifiers[purlIdentifier]
+	if purl == "" && strings.HasPrefix(product.ID, "pkg:") {
+		purl = product.ID
+	}
+	if purl != "" {
+		o.identifierStrings.UnclassifiedStrings = append(o.identifierStrings.UnclassifiedStrings, purl)
+		var err error
+		pkg, err = asmhelpers.PurlToPkg(purl)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse OpenVEX product purl %s: %w", purl, err)
+		}
+	}
+
+	var artifacts []model.ArtifactInputSpec
+	for alg, digest := range product.Hashes {
+		artifacts = append(artifacts, model.ArtifactInputSpec{
+			Algorithm: normalizeAlgorithm(alg),
+			Digest:    strings.ToLower(digest),
+		})
+	}
+	return pkg, artifacts, nil
+}
+
+// normalizeAlgorithm maps the OpenVEX hash algorithm names to the names
+// used by GUAC, e.g. sha-256 to sha256
+func normalizeAlgorithm(alg string) string {
+	alg = strings.ToLower(alg)
+	if strings.HasPrefix(alg, "sha-") {
+		return "sha" + strings.TrimPrefix(alg, "sha-")
+	}
+	return alg
+}
+
+func (o *openVEXParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	return &assembler.IngestPredicates{
+		Vex: o.vexes,
+	}
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (o *openVEXParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (o *openVEXParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return o.identifierStrings, nil
+}
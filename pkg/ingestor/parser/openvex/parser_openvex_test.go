@@ -0,0 +1,190 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openvex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_openVEXParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tests := []struct {
+		name            string
+		doc             *processor.Document
+		wantPredicates  *assembler.IngestPredicates
+		wantIdentifiers *common.IdentifierStrings
+		wantErr         bool
+	}{{
+		name: "valid OpenVEX document",
+		doc: &processor.Document{
+			Blob:   testdata.OpenVEXExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: &testdata.OpenVEXIngestionPredicates,
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{
+				"pkg:apk/wolfi/openssl@3.0.8-r3?arch=x86_64",
+				"pkg:oci/wolfi-base@sha256%3Ae4b9d8e3a7f0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a?repository_url=cgr.dev%2Fchainguard",
+				"pkg:golang/golang.org/x/net@v0.7.0",
+				"pkg:golang/golang.org/x/net@v0.7.0",
+			},
+		},
+		wantErr: false,
+	}, {
+		name: "OpenVEX 0.0.1 document",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"@context": "https://openvex.dev/ns",
+				"@id": "https://example.com/vex/1",
+				"author": "Example",
+				"timestamp": "2023-01-08T18:02:03Z",
+				"version": "1",
+				"statements": [{
+					"vulnerability": "CVE-2023-1255",
+					"products": ["pkg:apk/wolfi/openssl@3.0.8-r3?arch=x86_64"],
+					"status": "not_affected",
+					"justification": "vulnerable_code_not_in_execute_path",
+					"impact_statement": "the affected AES-XTS cipher is only available on 64 bit ARM",
+					"timestamp": "2023-04-20T10:00:00Z"
+				}]
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			Vex: testdata.OpenVEXIngestionPredicates.Vex[:1],
+		},
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{"pkg:apk/wolfi/openssl@3.0.8-r3?arch=x86_64"},
+		},
+		wantErr: false,
+	}, {
+		name: "statement about product subcomponents",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"@context": "https://openvex.dev/ns/v0.2.0",
+				"@id": "https://example.com/vex/2",
+				"author": "Example",
+				"timestamp": "2023-01-08T18:02:03Z",
+				"version": 1,
+				"statements": [{
+					"vulnerability": {"name": "CVE-2023-1255"},
+					"products": [{
+						"@id": "https://example.com/image",
+						"hashes": {"sha-256": "ABCDEF"},
+						"subcomponents": [{
+							"@id": "pkg:apk/wolfi/openssl@3.0.8-r3?arch=x86_64",
+							"subcomponents": [{"@id": "https://example.com/libcrypto", "hashes": {"sha-256": "012345"}}]
+						}]
+					}],
+					"status": "fixed"
+				}]
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		wantPredicates: func() *assembler.IngestPredicates {
+			openssl, _ := asmhelpers.PurlToPkg("pkg:apk/wolfi/openssl@3.0.8-r3?arch=x86_64")
+			cve := &generated.CVEInputSpec{Year: "2023", CveId: "CVE-2023-1255"}
+			vexData := &generated.VexStatementInputSpec{
+				Status:           generated.VexStatusFixed,
+				VexJustification: generated.VexJustificationNotProvided,
+				KnownSince:       time.Date(2023, 1, 8, 18, 2, 3, 0, time.UTC),
+			}
+			return &assembler.IngestPredicates{
+				Vex: []assembler.VexIngest{
+					{Artifact: &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: "abcdef"}, CVE: cve, VexData: vexData},
+					{Pkg: openssl, CVE: cve, VexData: vexData},
+					{Artifact: &generated.ArtifactInputSpec{Algorithm: "sha256", Digest: "012345"}, CVE: cve, VexData: vexData},
+				},
+			}
+		}(),
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{"pkg:apk/wolfi/openssl@3.0.8-r3?arch=x86_64"},
+		},
+		wantErr: false,
+	}, {
+		name: "invalid product purl",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"@context": "https://openvex.dev/ns/v0.2.0",
+				"@id": "https://example.com/vex/1",
+				"author": "Example",
+				"timestamp": "2023-01-08T18:02:03Z",
+				"version": 1,
+				"statements": [{
+					"vulnerability": {"name": "CVE-2023-1255"},
+					"products": [{"@id": "https://example.com/product", "identifiers": {"purl": "pkg:"}}],
+					"status": "fixed"
+				}]
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		wantErr: true,
+	}, {
+		name: "not an OpenVEX document",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxExampleAlpine23,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOpenVEX,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := NewOpenVEXParser()
+			err := o.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("openVEXParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			preds := o.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("openvex.GetPredicate mismatch values (+got, -expected): %s", d)
+			}
+
+			identifiers, err := o.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatalf("openVEXParser.GetIdentifiers() error = %v", err)
+			}
+			if d := cmp.Diff(tt.wantIdentifiers, identifiers, cmpopts.EquateEmpty()); len(d) != 0 {
+				t.Errorf("openvex.GetIdentifiers mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
@@ -0,0 +1,282 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	osv_processor "github.com/guacsec/guac/pkg/handler/processor/osv"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	aliasJustification = "from OSV aliases"
+	maliciousPrefix    = "MAL-"
+)
+
+type osvParser struct {
+	doc  *processor.Document
+	vuln *osv_processor.Vulnerability
+
+	// affectedPackages holds the package (without version) of each affected
+	// entry, nil when the ecosystem is not supported
+	affectedPackages []*model.PkgInputSpec
+
+	identifierStrings *common.IdentifierStrings
+}
+
+// NewOSVParser initializes the osvParser
+func NewOSVParser() common.DocumentParser {
+	return &osvParser{
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (c *osvParser) Parse(ctx context.Context, doc *processor.Document) error {
+	logger := logging.FromContext(ctx)
+	c.doc = doc
+	vuln, err := osv_processor.LoadDocument(doc.Blob, doc.Format)
+	if err != nil {
+		return fmt.Errorf("failed to parse OSV document: %w", err)
+	}
+	c.vuln = vuln
+
+	for _, affected := range vuln.Affected {
+		pkg, err := getPackage(affected.Package)
+		if err != nil {
+			logger.Debugf("skipping affected package of %s: %v", vuln.ID, err)
+		} else if affected.Package.Purl != "" {
+			c.identifierStrings.UnclassifiedStrings = append(c.identifierStrings.UnclassifiedStrings, affected.Package.Purl)
+		}
+		c.affectedPackages = append(c.affectedPackages, pkg)
+	}
+	return nil
+}
+
+// getPackage returns the package of an affected entry, from its purl if set
+// or else from its ecosystem and name. The version of the purl is dropped.
+func getPackage(p osv_processor.Package) (*model.PkgInputSpec, error) {
+	if p.Purl == "" {
+		return asmhelpers.OSVToPkg(p.Ecosystem, p.Name)
+	}
+	pkg, err := asmhelpers.PurlToPkg(p.Purl)
+	if err != nil {
+		return nil, err
+	}
+	return &model.PkgInputSpec{
+		Type:      pkg.Type,
+		Namespace: pkg.Namespace,
+		Name:      pkg.Name,
+	}, nil
+}
+
+func (c *osvParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	logger := logging.FromContext(ctx)
+	preds := &assembler.IngestPredicates{}
+
+	if c.vuln.Withdrawn != nil {
+		logger.Debugf("skipping withdrawn OSV entry %s", c.vuln.ID)
+		return preds
+	}
+
+	osv, cve, ghsa := asmhelpers.OsvCveOrGhsa(c.vuln.ID)
+
+	preds.IsVuln = getIsVulns(c.vuln)
+
+	if metadata := getVulnMetadata(c.vuln, logger); metadata != nil {
+		preds.VulnMetadata = append(preds.VulnMetadata, assembler.VulnMetadataIngest{
+			OSV:          osv,
+			CVE:          cve,
+			GHSA:         ghsa,
+			VulnMetadata: metadata,
+		})
+	}
+
+	for i, affected := range c.vuln.Affected {
+		pkg := c.affectedPackages[i]
+		if pkg == nil {
+			continue
+		}
+		preds.VulnAffected = append(preds.VulnAffected, assembler.VulnAffectedIngest{
+			Pkg:          pkg,
+			OSV:          osv,
+			CVE:          cve,
+			GHSA:         ghsa,
+			VulnAffected: getVulnAffected(affected),
+		})
+
+		if strings.HasPrefix(c.vuln.ID, maliciousPrefix) {
+			preds.CertifyBad = append(preds.CertifyBad, getCertifyBads(c.vuln, affected, pkg)...)
+		}
+	}
+	return preds
+}
+
+// getIsVulns links the OSV entry to its CVE and GHSA aliases. An entry
+// identified by a CVE or GHSA, e.g. from the GitHub advisory database, is
+// also linked to it.
+func getIsVulns(vuln *osv_processor.Vulnerability) []assembler.IsVulnIngest {
+	var isVulns []assembler.IsVulnIngest
+	seen := map[string]bool{}
+	for _, id := range append([]string{vuln.ID}, vuln.Aliases...) {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		_, cve, ghsa := asmhelpers.OsvCveOrGhsa(id)
+		if cve == nil && ghsa == nil {
+			continue
+		}
+		isVulns = append(isVulns, assembler.IsVulnIngest{
+			OSV:  &model.OSVInputSpec{OsvId: vuln.ID},
+			CVE:  cve,
+			GHSA: ghsa,
+			IsVuln: &model.IsVulnerabilityInputSpec{
+				Justification: aliasJustification,
+			},
+		})
+	}
+	return isVulns
+}
+
+// getVulnMetadata collects the summary, scores, CWEs and references of the
+// entry. The summary falls back to the details. Severities that cannot be
+// scored (e.g. CVSS v4) are skipped.
+func getVulnMetadata(vuln *osv_processor.Vulnerability, logger *zap.SugaredLogger) *model.VulnMetadataInputSpec {
+	metadata := &model.VulnMetadataInputSpec{
+		Summary:    vuln.Summary,
+		Scores:     []model.VulnScoreInputSpec{},
+		CweIds:     []string{},
+		References: []string{},
+	}
+	if metadata.Summary == "" {
+		metadata.Summary = vuln.Details
+	}
+	for _, severity := range vuln.Severity {
+		scoreType, score, err := asmhelpers.CvssScore(severity.Score)
+		if err != nil {
+			logger.Warnf("unable to score vulnerability %s: %v", vuln.ID, err)
+			continue
+		}
+		metadata.Scores = append(metadata.Scores, model.VulnScoreInputSpec{
+			ScoreType: scoreType,
+			Vector:    severity.Score,
+			Score:     score,
+		})
+	}
+	metadata.CweIds = append(metadata.CweIds, vuln.DatabaseSpecific.CWEIds...)
+	for _, ref := range vuln.References {
+		metadata.References = append(metadata.References, ref.URL)
+	}
+
+	if metadata.Summary == "" && len(metadata.Scores) == 0 && len(metadata.CweIds) == 0 && len(metadata.References) == 0 {
+		return nil
+	}
+
+	if !vuln.Published.IsZero() {
+		published := vuln.Published.UTC()
+		metadata.Published = &published
+	}
+	modified := vuln.Modified.UTC()
+	metadata.Modified = &modified
+	return metadata
+}
+
+// getVulnAffected converts the ranges and versions of an affected entry
+func getVulnAffected(affected osv_processor.Affected) *model.VulnAffectedInputSpec {
+	vulnAffected := &model.VulnAffectedInputSpec{
+		Ranges:   []model.AffectedRangeInputSpec{},
+		Versions: []string{},
+	}
+	for _, r := range affected.Ranges {
+		affectedRange := model.AffectedRangeInputSpec{
+			RangeType: model.AffectedRangeType(r.Type),
+			Repo:      r.Repo,
+			Events:    []model.AffectedEventInputSpec{},
+		}
+		for _, e := range r.Events {
+			var event model.AffectedEventInputSpec
+			switch {
+			case e.Introduced != "":
+				event = model.AffectedEventInputSpec{EventType: model.AffectedEventTypeIntroduced, Version: e.Introduced}
+			case e.Fixed != "":
+				event = model.AffectedEventInputSpec{EventType: model.AffectedEventTypeFixed, Version: e.Fixed}
+			case e.LastAffected != "":
+				event = model.AffectedEventInputSpec{EventType: model.AffectedEventTypeLastAffected, Version: e.LastAffected}
+			case e.Limit != "":
+				event = model.AffectedEventInputSpec{EventType: model.AffectedEventTypeLimit, Version: e.Limit}
+			default:
+				continue
+			}
+			affectedRange.Events = append(affectedRange.Events, event)
+		}
+		vulnAffected.Ranges = append(vulnAffected.Ranges, affectedRange)
+	}
+	vulnAffected.Versions = append(vulnAffected.Versions, affected.Versions...)
+	return vulnAffected
+}
+
+// getCertifyBads flags the package of a malicious package entry as bad. The
+// listed versions are flagged individually, ranges flag all the versions.
+func getCertifyBads(vuln *osv_processor.Vulnerability, affected osv_processor.Affected, pkg *model.PkgInputSpec) []assembler.CertifyBadIngest {
+	certifyBad := &model.CertifyBadInputSpec{
+		Justification: fmt.Sprintf("malicious package (%s)", vuln.ID),
+	}
+	if vuln.Summary != "" {
+		certifyBad.Justification += ": " + vuln.Summary
+	}
+
+	var certifyBads []assembler.CertifyBadIngest
+	for _, version := range affected.Versions {
+		version := version
+		certifyBads = append(certifyBads, assembler.CertifyBadIngest{
+			Pkg: &model.PkgInputSpec{
+				Type:      pkg.Type,
+				Namespace: pkg.Namespace,
+				Name:      pkg.Name,
+				Version:   &version,
+			},
+			PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeSpecificVersion},
+			CertifyBad:   certifyBad,
+		})
+	}
+	if len(affected.Ranges) > 0 || len(affected.Versions) == 0 {
+		certifyBads = append(certifyBads, assembler.CertifyBadIngest{
+			Pkg:          pkg,
+			PkgMatchFlag: model.MatchFlags{Pkg: model.PkgMatchTypeAllVersions},
+			CertifyBad:   certifyBad,
+		})
+	}
+	return certifyBads
+}
+
+// GetIdentities gets the identity node from the document if they exist
+func (c *osvParser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (c *osvParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return c.identifierStrings, nil
+}
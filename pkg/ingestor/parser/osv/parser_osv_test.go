@@ -0,0 +1,150 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_osvParser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+
+	pysecModified := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		doc             *processor.Document
+		wantPredicates  *assembler.IngestPredicates
+		wantIdentifiers *common.IdentifierStrings
+		wantErr         bool
+	}{{
+		name: "GitHub advisory database entry",
+		doc: &processor.Document{
+			Blob:   testdata.OsvGhsaExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOSV,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: &testdata.OsvGhsaIngestionPredicates,
+		wantIdentifiers: &common.IdentifierStrings{
+			UnclassifiedStrings: []string{"pkg:maven/org.ops4j.pax.logging/pax-logging-log4j2"},
+		},
+		wantErr: false,
+	}, {
+		name: "malicious package entry",
+		doc: &processor.Document{
+			Blob:   testdata.OsvMaliciousExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOSV,
+		},
+		wantPredicates:  &testdata.OsvMaliciousIngestionPredicates,
+		wantIdentifiers: &common.IdentifierStrings{},
+		wantErr:         false,
+	}, {
+		name: "entry with unknown ecosystem",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"id": "PYSEC-2023-1",
+				"modified": "2023-01-02T03:04:05Z",
+				"aliases": ["CVE-2023-1234", "BIT-2023-1"],
+				"details": "Example vulnerability",
+				"affected": [{"package": {"ecosystem": "Unknown", "name": "example"}, "versions": ["1.0"]}]
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOSV,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			IsVuln: []assembler.IsVulnIngest{{
+				OSV:    &model.OSVInputSpec{OsvId: "PYSEC-2023-1"},
+				CVE:    &model.CVEInputSpec{Year: "2023", CveId: "CVE-2023-1234"},
+				IsVuln: &model.IsVulnerabilityInputSpec{Justification: "from OSV aliases"},
+			}},
+			VulnMetadata: []assembler.VulnMetadataIngest{{
+				OSV: &model.OSVInputSpec{OsvId: "PYSEC-2023-1"},
+				VulnMetadata: &model.VulnMetadataInputSpec{
+					Summary:  "Example vulnerability",
+					Modified: &pysecModified,
+				},
+			}},
+		},
+		wantIdentifiers: &common.IdentifierStrings{},
+		wantErr:         false,
+	}, {
+		name: "withdrawn entry",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"id": "GHSA-xvch-5gv4-984h",
+				"modified": "2023-01-02T03:04:05Z",
+				"withdrawn": "2023-01-02T03:04:05Z",
+				"aliases": ["CVE-2023-1234"],
+				"summary": "Withdrawn vulnerability"
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOSV,
+		},
+		wantPredicates:  &assembler.IngestPredicates{},
+		wantIdentifiers: &common.IdentifierStrings{},
+		wantErr:         false,
+	}, {
+		name: "not an OSV document",
+		doc: &processor.Document{
+			Blob:   testdata.CsafVexExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentOSV,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewOSVParser()
+			err := c.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("osvParser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			preds := c.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("osv.GetPredicate mismatch values (+got, -expected): %s", d)
+			}
+
+			identifiers, err := c.GetIdentifiers(ctx)
+			if err != nil {
+				t.Fatalf("osvParser.GetIdentifiers() error = %v", err)
+			}
+			if d := cmp.Diff(tt.wantIdentifiers, identifiers, cmpopts.EquateEmpty()); len(d) != 0 {
+				t.Errorf("osv.GetIdentifiers mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
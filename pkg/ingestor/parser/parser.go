@@ -24,11 +24,17 @@ import (
 	"github.com/guacsec/guac/pkg/emitter"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/ingestor/parser/csaf"
 	"github.com/guacsec/guac/pkg/ingestor/parser/cyclonedx"
 	"github.com/guacsec/guac/pkg/ingestor/parser/dsse"
+	"github.com/guacsec/guac/pkg/ingestor/parser/eol"
+	"github.com/guacsec/guac/pkg/ingestor/parser/malicious"
+	"github.com/guacsec/guac/pkg/ingestor/parser/openvex"
+	"github.com/guacsec/guac/pkg/ingestor/parser/osv"
 	"github.com/guacsec/guac/pkg/ingestor/parser/scorecard"
 	"github.com/guacsec/guac/pkg/ingestor/parser/slsa"
 	"github.com/guacsec/guac/pkg/ingestor/parser/spdx"
+	"github.com/guacsec/guac/pkg/ingestor/parser/spdx3"
 	certify_vuln "github.com/guacsec/guac/pkg/ingestor/parser/vuln"
 	"github.com/guacsec/guac/pkg/logging"
 	uuid "github.com/satori/go.uuid"
@@ -38,9 +44,15 @@ func init() {
 	_ = RegisterDocumentParser(dsse.NewDSSEParser, processor.DocumentDSSE)
 	_ = RegisterDocumentParser(slsa.NewSLSAParser, processor.DocumentITE6SLSA)
 	_ = RegisterDocumentParser(certify_vuln.NewVulnCertificationParser, processor.DocumentITE6Vul)
+	_ = RegisterDocumentParser(malicious.NewMaliciousCertificationParser, processor.DocumentITE6Malicious)
+	_ = RegisterDocumentParser(eol.NewEOLCertificationParser, processor.DocumentITE6EOL)
 	_ = RegisterDocumentParser(spdx.NewSpdxParser, processor.DocumentSPDX)
+	_ = RegisterDocumentParser(spdx3.NewSpdx3Parser, processor.DocumentSPDX3)
 	_ = RegisterDocumentParser(cyclonedx.NewCycloneDXParser, processor.DocumentCycloneDX)
 	_ = RegisterDocumentParser(scorecard.NewScorecardParser, processor.DocumentScorecard)
+	_ = RegisterDocumentParser(openvex.NewOpenVEXParser, processor.DocumentOpenVEX)
+	_ = RegisterDocumentParser(csaf.NewCSAFParser, processor.DocumentCSAF)
+	_ = RegisterDocumentParser(osv.NewOSVParser, processor.DocumentOSV)
 }
 
 var (
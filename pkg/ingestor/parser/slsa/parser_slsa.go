@@ -17,38 +17,40 @@ package slsa
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
-	"github.com/in-toto/in-toto-golang/in_toto"
 )
 
 const (
-	algorithmSHA256 string = "sha256"
+	slsaMaterialJustification string = "from SLSA definition of materials"
 )
 
 type slsaParser struct {
 	doc               *processor.Document
-	subjects          []assembler.ArtifactNode
-	dependencies      []assembler.ArtifactNode
-	attestations      []assembler.AttestationNode
-	builders          []assembler.BuilderNode
+	subjects          []model.ArtifactInputSpec
+	materials         []model.PackageSourceOrArtifactInput
+	occurrences       []assembler.IsOccurenceIngest
+	builder           *model.BuilderInputSpec
+	slsaAttestation   *model.SLSAInputSpec
 	identifierStrings *common.IdentifierStrings
 }
 
 // NewSLSAParser initializes the slsaParser
 func NewSLSAParser() common.DocumentParser {
 	return &slsaParser{
-		subjects:          []assembler.ArtifactNode{},
-		dependencies:      []assembler.ArtifactNode{},
-		attestations:      []assembler.AttestationNode{},
-		builders:          []assembler.BuilderNode{},
+		subjects:          []model.ArtifactInputSpec{},
+		materials:         []model.PackageSourceOrArtifactInput{},
+		occurrences:       []assembler.IsOccurenceIngest{},
 		identifierStrings: &common.IdentifierStrings{},
 	}
 }
@@ -56,102 +58,170 @@ func NewSLSAParser() common.DocumentParser {
 // Parse breaks out the document into the graph components
 func (s *slsaParser) Parse(ctx context.Context, doc *processor.Document) error {
 	s.doc = doc
-	statement, err := parseSlsaPredicate(doc.Blob)
+	prov, err := parseSlsaPredicate(doc.Blob)
 	if err != nil {
 		return fmt.Errorf("failed to parse slsa predicate: %w", err)
 	}
-	s.getSubject(statement)
-	s.getDependency(statement)
-	s.getAttestation(doc.Blob)
-	s.getBuilder(statement)
+	predicate, err := flattenPredicate(doc.Blob)
+	if err != nil {
+		return fmt.Errorf("failed to flatten slsa predicate: %w", err)
+	}
+	s.getSubject(prov)
+	if err := s.getMaterials(prov); err != nil {
+		return err
+	}
+	s.getBuilder(prov)
+	s.getSLSA(prov, predicate)
 	return nil
 }
 
-func (s *slsaParser) getSubject(statement *in_toto.ProvenanceStatement) {
+func (s *slsaParser) getSubject(prov *provenance) {
 	// append artifact node for the subjects
-	for _, sub := range statement.Subject {
+	for _, sub := range prov.subjects {
 		for alg, ds := range sub.Digest {
-			s.subjects = append(s.subjects, assembler.ArtifactNode{
-				Name: sub.Name, Digest: alg + ":" + strings.Trim(ds, "'"), NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
-			s.identifierStrings.UnclassifiedStrings = append(s.identifierStrings.UnclassifiedStrings, sub.Name)
+			s.subjects = append(s.subjects, model.ArtifactInputSpec{
+				Algorithm: strings.ToLower(alg),
+				Digest:    strings.Trim(ds, "'"),
+			})
 		}
+		s.identifierStrings.UnclassifiedStrings = append(s.identifierStrings.UnclassifiedStrings, sub.Name)
 	}
 }
 
-func (s *slsaParser) getDependency(statement *in_toto.ProvenanceStatement) {
-	// append dependency nodes for the materials
-	for _, mat := range statement.Predicate.Materials {
+// getMaterials maps each material to a package (if the URI is a purl), a
+// source (if the URI is a VCS locator) or to artifacts (one per digest).
+// Packages and sources that carry digests are also linked to their artifacts
+// via IsOccurrence.
+func (s *slsaParser) getMaterials(prov *provenance) error {
+	for _, mat := range prov.materials {
+		var artifacts []model.ArtifactInputSpec
 		for alg, ds := range mat.Digest {
-			s.dependencies = append(s.dependencies, assembler.ArtifactNode{
-				Name: mat.URI, Digest: alg + ":" + strings.Trim(ds, "'"), NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
+			artifacts = append(artifacts, model.ArtifactInputSpec{
+				Algorithm: strings.ToLower(alg),
+				Digest:    strings.Trim(ds, "'"),
+			})
+		}
+
+		if strings.HasPrefix(mat.URI, "pkg:") {
 			s.identifierStrings.UnclassifiedStrings = append(s.identifierStrings.UnclassifiedStrings, mat.URI)
+			pkg, err := asmhelpers.PurlToPkg(mat.URI)
+			if err != nil {
+				return fmt.Errorf("failed to parse material purl %s: %w", mat.URI, err)
+			}
+			s.materials = append(s.materials, model.PackageSourceOrArtifactInput{Package: pkg})
+			for i := range artifacts {
+				s.occurrences = append(s.occurrences, assembler.IsOccurenceIngest{
+					Pkg:         pkg,
+					Artifact:    &artifacts[i],
+					IsOccurence: &model.IsOccurrenceInputSpec{Justification: slsaMaterialJustification},
+				})
+			}
+			continue
+		}
+
+		if src, err := asmhelpers.VcsToSrc(mat.URI); err == nil {
+			s.identifierStrings.VcsStrings = append(s.identifierStrings.VcsStrings, mat.URI)
+			s.materials = append(s.materials, model.PackageSourceOrArtifactInput{Source: src})
+			for i := range artifacts {
+				s.occurrences = append(s.occurrences, assembler.IsOccurenceIngest{
+					Src:         src,
+					Artifact:    &artifacts[i],
+					IsOccurence: &model.IsOccurrenceInputSpec{Justification: slsaMaterialJustification},
+				})
+			}
+			continue
+		}
+
+		s.identifierStrings.UnclassifiedStrings = append(s.identifierStrings.UnclassifiedStrings, mat.URI)
+		for i := range artifacts {
+			s.materials = append(s.materials, model.PackageSourceOrArtifactInput{Artifact: &artifacts[i]})
 		}
 	}
+	return nil
 }
 
-func (s *slsaParser) getAttestation(blob []byte) {
-	h := sha256.Sum256(blob)
-	s.attestations = append(s.attestations, assembler.AttestationNode{
-		FilePath: s.doc.SourceInformation.Source, Digest: algorithmSHA256 + ":" + hex.EncodeToString(h[:]), NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
+func (s *slsaParser) getBuilder(prov *provenance) {
+	s.builder = &model.BuilderInputSpec{
+		Uri: prov.builderID,
+	}
 }
 
-func (s *slsaParser) getBuilder(statement *in_toto.ProvenanceStatement) {
-	// append builder node for builder
-	s.builders = append(s.builders, assembler.BuilderNode{
-		BuilderType: statement.Predicate.BuildType, BuilderId: statement.Predicate.Builder.ID, NodeData: *assembler.NewObjectMetadata(s.doc.SourceInformation)})
+func (s *slsaParser) getSLSA(prov *provenance, predicate []model.SLSAPredicateInputSpec) {
+	var startedOn, finishedOn time.Time
+	if prov.startedOn != nil {
+		startedOn = prov.startedOn.UTC()
+	}
+	if prov.finishedOn != nil {
+		finishedOn = prov.finishedOn.UTC()
+	}
+
+	s.slsaAttestation = &model.SLSAInputSpec{
+		BuildType:     prov.buildType,
+		SlsaPredicate: predicate,
+		SlsaVersion:   prov.version,
+		StartedOn:     startedOn,
+		FinishedOn:    finishedOn,
+	}
 }
 
-func parseSlsaPredicate(p []byte) (*in_toto.ProvenanceStatement, error) {
-	predicate := in_toto.ProvenanceStatement{}
-	if err := json.Unmarshal(p, &predicate); err != nil {
+// flattenPredicate returns the predicate of the in-toto statement as a list of
+// key-value pairs, where the keys are the dot separated paths to every leaf
+// value (e.g., "builder.id" or "materials.0.uri"). The result is sorted by key.
+func flattenPredicate(p []byte) ([]model.SLSAPredicateInputSpec, error) {
+	statement := struct {
+		Predicate interface{} `json:"predicate"`
+	}{}
+	if err := json.Unmarshal(p, &statement); err != nil {
 		return nil, err
 	}
-	return &predicate, nil
+
+	predicate := []model.SLSAPredicateInputSpec{}
+	flatten("", statement.Predicate, &predicate)
+	sort.Slice(predicate, func(i, j int) bool {
+		return predicate[i].Key < predicate[j].Key
+	})
+	return predicate, nil
 }
 
-// TODO(bulldozer): replace with GetPredicates
-// // CreateNodes creates the GuacNode for the graph inputs
-// func (s *slsaParser) CreateNodes(ctx context.Context) []assembler.GuacNode {
-// 	nodes := []assembler.GuacNode{}
-// 	for _, sub := range s.subjects {
-// 		nodes = append(nodes, sub)
-// 	}
-// 	for _, a := range s.attestations {
-// 		nodes = append(nodes, a)
-// 	}
-// 	for _, d := range s.dependencies {
-// 		nodes = append(nodes, d)
-// 	}
-// 	for _, b := range s.builders {
-// 		nodes = append(nodes, b)
-// 	}
-// 	return nodes
-// }
-//
-// // CreateEdges creates the GuacEdges that form the relationship for the graph inputs
-// func (s *slsaParser) CreateEdges(ctx context.Context, foundIdentities []common.TrustInformation) []assembler.GuacEdge {
-// 	edges := []assembler.GuacEdge{}
-// 	for _, i := range foundIdentities {
-// 		for _, a := range s.attestations {
-// 			edges = append(edges, assembler.IdentityForEdge{IdentityNode: i, AttestationNode: a})
-// 		}
-// 	}
-// 	for _, sub := range s.subjects {
-// 		for _, build := range s.builders {
-// 			edges = append(edges, assembler.BuiltByEdge{ArtifactNode: sub, BuilderNode: build})
-// 		}
-// 		for _, a := range s.attestations {
-// 			edges = append(edges, assembler.AttestationForEdge{AttestationNode: a, ForArtifact: sub})
-// 		}
-// 		for _, d := range s.dependencies {
-// 			edges = append(edges, assembler.DependsOnEdge{ArtifactNode: sub, ArtifactDependency: d})
-// 		}
-// 	}
-// 	return edges
-// }
+func flatten(prefix string, value interface{}, out *[]model.SLSAPredicateInputSpec) {
+	join := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			flatten(join(k), e, out)
+		}
+	case []interface{}:
+		for i, e := range v {
+			flatten(join(strconv.Itoa(i)), e, out)
+		}
+	case string:
+		*out = append(*out, model.SLSAPredicateInputSpec{Key: prefix, Value: v})
+	case float64:
+		*out = append(*out, model.SLSAPredicateInputSpec{Key: prefix, Value: strconv.FormatFloat(v, 'f', -1, 64)})
+	case bool:
+		*out = append(*out, model.SLSAPredicateInputSpec{Key: prefix, Value: strconv.FormatBool(v)})
+	}
+}
 
 func (s *slsaParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
-	return nil
+	preds := &assembler.IngestPredicates{
+		IsOccurence: s.occurrences,
+	}
+	for i := range s.subjects {
+		preds.HasSlsa = append(preds.HasSlsa, assembler.HasSlsaIngest{
+			Artifact:  &s.subjects[i],
+			Materials: s.materials,
+			Builder:   s.builder,
+			HasSlsa:   s.slsaAttestation,
+		})
+	}
+	return preds
 }
 
 // GetIdentities gets the identity node from the document if they exist
@@ -0,0 +1,157 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slsa
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/in-toto/in-toto-golang/in_toto"
+	slsa_common "github.com/in-toto/in-toto-golang/in_toto/slsa_provenance/common"
+)
+
+const (
+	// PredicateSLSAProvenanceV1 is the predicate type of SLSA v1.0
+	// provenance. Drafts of v1 use the same prefix with a suffix (e.g.
+	// "?draft"), so this is matched as a prefix.
+	PredicateSLSAProvenanceV1 = "https://slsa.dev/provenance/v1"
+)
+
+// provenance is the version independent view of a SLSA provenance statement
+// that the parser maps onto the HasSLSA model.
+type provenance struct {
+	version    string
+	subjects   []in_toto.Subject
+	materials  []slsa_common.ProvenanceMaterial
+	builderID  string
+	buildType  string
+	startedOn  *time.Time
+	finishedOn *time.Time
+}
+
+// provenanceStatementV1 is the in-toto statement carrying a SLSA v1.0
+// provenance predicate.
+//
+// Ref: https://slsa.dev/spec/v1.0/provenance
+type provenanceStatementV1 struct {
+	in_toto.StatementHeader
+	Predicate provenancePredicateV1 `json:"predicate"`
+}
+
+type provenancePredicateV1 struct {
+	BuildDefinition buildDefinitionV1 `json:"buildDefinition"`
+	RunDetails      runDetailsV1      `json:"runDetails"`
+}
+
+type buildDefinitionV1 struct {
+	BuildType            string                 `json:"buildType"`
+	ExternalParameters   interface{}            `json:"externalParameters"`
+	InternalParameters   interface{}            `json:"internalParameters,omitempty"`
+	ResolvedDependencies []resourceDescriptorV1 `json:"resolvedDependencies,omitempty"`
+}
+
+type runDetailsV1 struct {
+	Builder    builderV1              `json:"builder"`
+	Metadata   buildMetadataV1        `json:"metadata,omitempty"`
+	Byproducts []resourceDescriptorV1 `json:"byproducts,omitempty"`
+}
+
+type builderV1 struct {
+	ID                  string                 `json:"id"`
+	Version             map[string]string      `json:"version,omitempty"`
+	BuilderDependencies []resourceDescriptorV1 `json:"builderDependencies,omitempty"`
+}
+
+type buildMetadataV1 struct {
+	InvocationID string     `json:"invocationId,omitempty"`
+	StartedOn    *time.Time `json:"startedOn,omitempty"`
+	FinishedOn   *time.Time `json:"finishedOn,omitempty"`
+}
+
+type resourceDescriptorV1 struct {
+	URI              string                 `json:"uri,omitempty"`
+	Digest           slsa_common.DigestSet  `json:"digest,omitempty"`
+	Name             string                 `json:"name,omitempty"`
+	DownloadLocation string                 `json:"downloadLocation,omitempty"`
+	MediaType        string                 `json:"mediaType,omitempty"`
+	Annotations      map[string]interface{} `json:"annotations,omitempty"`
+}
+
+// parseSlsaPredicate decodes the statement according to its predicate type.
+// SLSA v1 predicates are decoded from buildDefinition/runDetails, everything
+// else is treated as a v0.2 predicate.
+func parseSlsaPredicate(p []byte) (*provenance, error) {
+	header := in_toto.StatementHeader{}
+	if err := json.Unmarshal(p, &header); err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(header.PredicateType, PredicateSLSAProvenanceV1) {
+		return parseSlsaV1Predicate(p)
+	}
+	return parseSlsaV02Predicate(p)
+}
+
+func parseSlsaV02Predicate(p []byte) (*provenance, error) {
+	statement := in_toto.ProvenanceStatement{}
+	if err := json.Unmarshal(p, &statement); err != nil {
+		return nil, err
+	}
+
+	prov := &provenance{
+		version:   statement.PredicateType,
+		subjects:  statement.Subject,
+		materials: statement.Predicate.Materials,
+		builderID: statement.Predicate.Builder.ID,
+		buildType: statement.Predicate.BuildType,
+	}
+	if statement.Predicate.Metadata != nil {
+		prov.startedOn = statement.Predicate.Metadata.BuildStartedOn
+		prov.finishedOn = statement.Predicate.Metadata.BuildFinishedOn
+	}
+	return prov, nil
+}
+
+func parseSlsaV1Predicate(p []byte) (*provenance, error) {
+	statement := provenanceStatementV1{}
+	if err := json.Unmarshal(p, &statement); err != nil {
+		return nil, err
+	}
+
+	prov := &provenance{
+		version:    statement.PredicateType,
+		subjects:   statement.Subject,
+		builderID:  statement.Predicate.RunDetails.Builder.ID,
+		buildType:  statement.Predicate.BuildDefinition.BuildType,
+		startedOn:  statement.Predicate.RunDetails.Metadata.StartedOn,
+		finishedOn: statement.Predicate.RunDetails.Metadata.FinishedOn,
+	}
+	// resolvedDependencies are the v1 equivalent of the v0.2 materials.
+	// Byproducts are outputs of the build, so they are only kept as part of
+	// the flattened predicate.
+	for _, dep := range statement.Predicate.BuildDefinition.ResolvedDependencies {
+		uri := dep.URI
+		if uri == "" {
+			uri = dep.Name
+		}
+		prov.materials = append(prov.materials, slsa_common.ProvenanceMaterial{
+			URI:    uri,
+			Digest: dep.Digest,
+		})
+	}
+	return prov, nil
+}
@@ -16,21 +16,21 @@
 package spdx
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler"
 	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
 	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
 	"github.com/guacsec/guac/pkg/handler/processor"
+	spdx_processor "github.com/guacsec/guac/pkg/handler/processor/spdx"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
 	"github.com/guacsec/guac/pkg/logging"
-	spdx_json "github.com/spdx/tools-golang/json"
 	spdx_common "github.com/spdx/tools-golang/spdx/common"
-	"github.com/spdx/tools-golang/spdx/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2_3"
 )
 
 type spdxParser struct {
@@ -41,7 +41,7 @@ type spdxParser struct {
 	filePackages     map[string][]model.PkgInputSpec
 	fileArtifacts    map[string][]model.ArtifactInputSpec
 
-	spdxDoc *v2_2.Document
+	spdxDoc *v2_3.Document
 }
 
 func NewSpdxParser() common.DocumentParser {
@@ -55,7 +55,9 @@ func NewSpdxParser() common.DocumentParser {
 
 func (s *spdxParser) Parse(ctx context.Context, doc *processor.Document) error {
 	s.doc = doc
-	spdxDoc, err := parseSpdxBlob(doc.Blob)
+	// documents of all supported versions and encodings are normalised
+	// into the SPDX 2.3 model
+	spdxDoc, err := spdx_processor.LoadDocument(doc.Blob, doc.Format)
 	if err != nil {
 		return fmt.Errorf("failed to parse SPDX document: %w", err)
 	}
@@ -151,19 +153,10 @@ func (s *spdxParser) getFiles() error {
 	return nil
 }
 
-func getTags(f *v2_2.File) []string {
+func getTags(f *v2_3.File) []string {
 	return f.FileTypes
 }
 
-func parseSpdxBlob(p []byte) (*v2_2.Document, error) {
-	reader := bytes.NewReader(p)
-	spdx, err := spdx_json.Load2_2(reader)
-	if err != nil {
-		return nil, err
-	}
-	return spdx, nil
-}
-
 func (s *spdxParser) getPackageElement(elementID string) []model.PkgInputSpec {
 	if packNode, ok := s.packagePackages[string(elementID)]; ok {
 		return packNode
@@ -189,43 +182,26 @@ func (s *spdxParser) GetPredicates(ctx context.Context) *assembler.IngestPredica
 		preds.IsDependency = append(preds.IsDependency, createTopLevelIsDeps(toplevel[0], s.packagePackages, s.filePackages, "top-level package GUAC heuristic connecting to each file/package")...)
 	}
 	for _, rel := range s.spdxDoc.Relationships {
-
-		if !map[string]bool{
-			spdx_common.TypeRelationshipContains:  true,
-			spdx_common.TypeRelationshipDependsOn: true,
-		}[rel.Relationship] {
+		mapping, ok := relationshipMappings[rel.Relationship]
+		if !ok {
+			logger.Debugf("spdx relationship %s is not ingested", rel.Relationship)
 			continue
 		}
-
-		foundPackNodes := s.getPackageElement(string(rel.RefA.ElementRefID))
-		foundFileNodes := s.getFileElement(string(rel.RefA.ElementRefID))
-		relatedPackNodes := s.getPackageElement(string(rel.RefB.ElementRefID))
-		relatedFileNodes := s.getFileElement(string(rel.RefB.ElementRefID))
-
-		justification := getJustification(rel)
-
-		for _, packNode := range foundPackNodes {
-			p, err := getIsDep(packNode, relatedPackNodes, relatedFileNodes, justification)
-			if err != nil {
-				logger.Errorf("error generating spdx edge %v", err)
-				continue
-			}
-			if p != nil {
-				preds.IsDependency = append(preds.IsDependency, *p)
-			}
-		}
-		for _, fileNode := range foundFileNodes {
-			p, err := getIsDep(fileNode, relatedPackNodes, relatedFileNodes, justification)
-			if err != nil {
-				logger.Errorf("error generating spdx edge %v", err)
-				continue
-			}
-			if p != nil {
-				preds.IsDependency = append(preds.IsDependency, *p)
-			}
+		from, to := string(rel.RefA.ElementRefID), string(rel.RefB.ElementRefID)
+		if mapping.reverse {
+			from, to = to, from
 		}
+		mapping.evidence(s, preds, from, to, getJustification(rel))
+	}
+
+	// the SBOM is attributed to the top level package if the document does
+	// not say what it describes
+	if len(preds.HasSBOM) == 0 {
+		s.hasSBOM(preds, string(s.spdxDoc.SPDXIdentifier), string(s.spdxDoc.SPDXIdentifier), "")
 	}
 
+	preds.HasSourceAt = s.getHasSourceAt(ctx)
+
 	// Create predicates for IsOccurence for all artifacts found
 	for id := range s.fileArtifacts {
 		for _, pkg := range s.filePackages[id] {
@@ -291,31 +267,22 @@ func createTopLevelIsDeps(toplevel model.PkgInputSpec, packages map[string][]mod
 	return isDeps
 }
 
-func getIsDep(foundNode model.PkgInputSpec, relatedPackNodes []model.PkgInputSpec, relatedFileNodes []model.PkgInputSpec, justification string) (*assembler.IsDependencyIngest, error) {
-	if len(relatedFileNodes) > 0 {
-		for _, rfileNode := range relatedFileNodes {
-			// TODO: Check is this always just expected to be one?
-			return &assembler.IsDependencyIngest{
-				Pkg:    &foundNode,
-				DepPkg: &rfileNode,
-				IsDependency: &model.IsDependencyInputSpec{
-					Justification: justification,
-				},
-			}, nil
+// getHasSourceAt links the packages to the VCS repositories they were
+// downloaded from or built from.
+func (s *spdxParser) getHasSourceAt(ctx context.Context) []assembler.HasSourceAtIngest {
+	var knownSince time.Time
+	if s.spdxDoc.CreationInfo != nil {
+		if created, err := time.Parse(time.RFC3339, s.spdxDoc.CreationInfo.Created); err == nil {
+			knownSince = created.UTC()
 		}
-	} else if len(relatedPackNodes) > 0 {
-		for _, rpackNode := range relatedPackNodes {
-			return &assembler.IsDependencyIngest{
-				Pkg:    &foundNode,
-				DepPkg: &rpackNode,
-				IsDependency: &model.IsDependencyInputSpec{
-					Justification: justification,
-				},
-			}, nil
+	}
 
-		}
+	var hasSourceAts []assembler.HasSourceAtIngest
+	for _, pac := range s.spdxDoc.Packages {
+		pkgs := s.getPackageElement(string(pac.PackageSPDXIdentifier))
+		hasSourceAts = append(hasSourceAts, common.SpdxPackageSources(ctx, pkgs, pac.PackageDownloadLocation, pac.PackageSourceInfo, knownSince)...)
 	}
-	return nil, nil
+	return hasSourceAts
 }
 
 func (s *spdxParser) GetIdentities(ctx context.Context) []common.TrustInformation {
@@ -326,7 +293,7 @@ func (s *spdxParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStri
 	return nil, fmt.Errorf("not yet implemented")
 }
 
-func getJustification(r *v2_2.Relationship) string {
+func getJustification(r *v2_3.Relationship) string {
 	s := fmt.Sprintf("Derived from SPDX %s relationship", r.Relationship)
 	if len(r.RelationshipComment) > 0 {
 		s += fmt.Sprintf("with comment: %s", r.RelationshipComment)
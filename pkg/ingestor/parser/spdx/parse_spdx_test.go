@@ -46,6 +46,58 @@ func Test_spdxParser(t *testing.T) {
 		},
 		wantPredicates: &testdata.SpdxIngestionPredicates,
 		wantErr:        false,
+	}, {
+		name: "valid SPDX 2.3 document",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxExampleAlpine23,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: &testdata.SpdxIngestionPredicates,
+		wantErr:        false,
+	}, {
+		name: "valid YAML SPDX document",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxExampleAlpineYAML,
+			Format: processor.FormatYAML,
+			Type:   processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: &testdata.SpdxIngestionPredicates,
+		wantErr:        false,
+	}, {
+		name: "valid tag-value SPDX document",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxExampleAlpineTagValue,
+			Format: processor.FormatTagValue,
+			Type:   processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: &testdata.SpdxIngestionPredicates,
+		wantErr:        false,
+	}, {
+		name: "SPDX relationships mapped to evidence",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxRelationshipsExample,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSPDX,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: &testdata.SpdxRelationshipsIngestionPredicates,
+		wantErr:        false,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,122 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	spdx_common "github.com/spdx/tools-golang/spdx/common"
+)
+
+// relationshipEvidence creates the GUAC evidence for the relationship
+// "from <relationship> to" between two SPDX elements.
+type relationshipEvidence func(s *spdxParser, preds *assembler.IngestPredicates, from, to string, justification string)
+
+// relationshipMapping describes how an SPDX relationship is ingested.
+// Relationships that are expressed from the other end in GUAC terms are
+// reversed, e.g. "A DEPENDENCY_OF B" is ingested as B depends on A.
+type relationshipMapping struct {
+	evidence relationshipEvidence
+	reverse  bool
+}
+
+// relationshipMappings maps the SPDX relationship types to GUAC evidence.
+// Relationship types that are not listed are not ingested.
+var relationshipMappings = map[string]relationshipMapping{
+	// A depends on B
+	spdx_common.TypeRelationshipContains:             {evidence: (*spdxParser).isDependency},
+	spdx_common.TypeRelationshipDependsOn:            {evidence: (*spdxParser).isDependency},
+	spdx_common.TypeRelationshipHasPrerequisite:      {evidence: (*spdxParser).isDependency},
+	spdx_common.TypeRelationshipDynamicLink:          {evidence: (*spdxParser).isDependency},
+	spdx_common.TypeRelationshipStaticLink:           {evidence: (*spdxParser).isDependency},
+	spdx_common.TypeRelationshipContainedBy:          {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipDependencyOf:         {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipBuildDependencyOf:    {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipDevDependencyOf:      {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipOptionalDependencyOf: {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipProvidedDependencyOf: {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipTestDependencyOf:     {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipRuntimeDependencyOf:  {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipBuildToolOf:          {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipDevToolOf:            {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipTestToolOf:           {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipOptionalComponentOf:  {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipPackageOf:            {evidence: (*spdxParser).isDependency, reverse: true},
+	spdx_common.TypeRelationshipPrerequisiteFor:      {evidence: (*spdxParser).isDependency, reverse: true},
+
+	// the artifacts of A are occurrences of the package B
+	spdx_common.TypeRelationshipGeneratedFrom:        {evidence: (*spdxParser).isOccurrence},
+	spdx_common.TypeRelationshipGenerates:            {evidence: (*spdxParser).isOccurrence, reverse: true},
+	spdx_common.TypeRelationshipDistributionArtifact: {evidence: (*spdxParser).isOccurrence, reverse: true},
+
+	// the artifacts of A are the same as the artifacts of B
+	spdx_common.TypeRelationshipCopyOf: {evidence: (*spdxParser).hashEqual},
+
+	// the package A is a variant of the package B
+	spdx_common.TypeRelationshipVariantOf: {evidence: (*spdxParser).certifyPkg},
+
+	// the document A is an SBOM for B
+	spdx_common.TypeRelationshipDescribe:   {evidence: (*spdxParser).hasSBOM},
+	spdx_common.TypeRelationshipDescribeBy: {evidence: (*spdxParser).hasSBOM, reverse: true},
+}
+
+// getElementPackages returns the packages of an SPDX package or file
+func (s *spdxParser) getElementPackages(elementID string) []model.PkgInputSpec {
+	if pkgs := s.getPackageElement(elementID); pkgs != nil {
+		return pkgs
+	}
+	return s.getFileElement(elementID)
+}
+
+// getElementArtifacts returns the artifacts of an SPDX package or file
+func (s *spdxParser) getElementArtifacts(elementID string) []model.ArtifactInputSpec {
+	if arts, ok := s.packageArtifacts[elementID]; ok {
+		return arts
+	}
+	return s.fileArtifacts[elementID]
+}
+
+func (s *spdxParser) isDependency(preds *assembler.IngestPredicates, from, to string, justification string) {
+	preds.IsDependency = append(preds.IsDependency, common.IsDependencies(s.getElementPackages(from), s.getElementPackages(to), justification)...)
+}
+
+func (s *spdxParser) isOccurrence(preds *assembler.IngestPredicates, from, to string, justification string) {
+	preds.IsOccurence = append(preds.IsOccurence, common.IsOccurrences(s.getElementArtifacts(from), s.getElementPackages(to), justification)...)
+}
+
+func (s *spdxParser) hashEqual(preds *assembler.IngestPredicates, from, to string, justification string) {
+	preds.HashEqual = append(preds.HashEqual, common.HashEquals(s.getElementArtifacts(from), s.getElementArtifacts(to), justification)...)
+}
+
+func (s *spdxParser) certifyPkg(preds *assembler.IngestPredicates, from, to string, justification string) {
+	preds.CertifyPkg = append(preds.CertifyPkg, common.CertifyPkgs(s.getPackageElement(from), s.getPackageElement(to), justification)...)
+}
+
+func (s *spdxParser) hasSBOM(preds *assembler.IngestPredicates, from, to string, _ string) {
+	if from != string(s.spdxDoc.SPDXIdentifier) {
+		return
+	}
+	for _, pkg := range s.getPackageElement(to) {
+		pkg := pkg
+		preds.HasSBOM = append(preds.HasSBOM, assembler.HasSBOMIngest{
+			Pkg: &pkg,
+			HasSBOM: &model.HasSBOMInputSpec{
+				Uri: s.spdxDoc.DocumentNamespace,
+			},
+		})
+	}
+}
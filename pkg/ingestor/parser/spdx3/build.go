@@ -0,0 +1,174 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx3
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	spdx3_processor "github.com/guacsec/guac/pkg/handler/processor/spdx3"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// relationship types linking a build to its inputs, outputs and builder
+const (
+	relationshipHasInput  = "hasInput"
+	relationshipHasOutput = "hasOutput"
+	relationshipInvokedBy = "invokedBy"
+)
+
+// getHasSLSA maps the builds of the build profile to SLSA attestations of
+// their outputs. The inputs of a build are its materials and the agent that
+// invoked it is the builder.
+func (s *spdx3Parser) getHasSLSA(ctx context.Context) []assembler.HasSlsaIngest {
+	logger := logging.FromContext(ctx)
+
+	var hasSLSAs []assembler.HasSlsaIngest
+	for _, build := range s.spdxDoc.Graph {
+		if build.Type != typeBuild {
+			continue
+		}
+
+		var builder *model.BuilderInputSpec
+		materials := s.getBuildConfigSources(build)
+		var outputs []string
+		for _, rel := range s.relationships[build.SpdxID] {
+			switch rel.RelationshipType {
+			case relationshipInvokedBy:
+				if builder == nil && len(rel.To) > 0 {
+					builder = &model.BuilderInputSpec{Uri: rel.To[0]}
+				}
+			case relationshipHasInput:
+				for _, to := range rel.To {
+					materials = append(materials, s.getBuildMaterials(to)...)
+				}
+			case relationshipHasOutput:
+				outputs = append(outputs, rel.To...)
+			}
+		}
+		if builder == nil {
+			logger.Debugf("skipping SPDX 3 build %s, no agent invoked the build", build.SpdxID)
+			continue
+		}
+
+		slsa := &model.SLSAInputSpec{
+			BuildType:     build.BuildType,
+			SlsaPredicate: getBuildPredicate(build),
+			SlsaVersion:   fmt.Sprintf("https://spdx.org/rdf/%s/terms/Build/Build", s.specVersion),
+			StartedOn:     parseTime(build.BuildStartTime),
+			FinishedOn:    parseTime(build.BuildEndTime),
+		}
+
+		// the outputs are attested by their artifacts, or by their
+		// packages when no hashes are known
+		for _, output := range outputs {
+			if arts := s.getElementArtifacts(output); len(arts) > 0 {
+				for _, art := range arts {
+					art := art
+					hasSLSAs = append(hasSLSAs, assembler.HasSlsaIngest{
+						Artifact:  &art,
+						Materials: materials,
+						Builder:   builder,
+						HasSlsa:   slsa,
+					})
+				}
+				continue
+			}
+			for _, pkg := range s.getElementPackages(output) {
+				pkg := pkg
+				hasSLSAs = append(hasSLSAs, assembler.HasSlsaIngest{
+					Pkg:       &pkg,
+					Materials: materials,
+					Builder:   builder,
+					HasSlsa:   slsa,
+				})
+			}
+		}
+	}
+	return hasSLSAs
+}
+
+// getBuildConfigSources returns the VCS repositories holding the build
+// configuration as source materials.
+func (s *spdx3Parser) getBuildConfigSources(build *spdx3_processor.Element) []model.PackageSourceOrArtifactInput {
+	var materials []model.PackageSourceOrArtifactInput
+	for _, uri := range build.ConfigSourceURI {
+		if src, err := asmhelpers.VcsToSrc(uri); err == nil {
+			materials = append(materials, model.PackageSourceOrArtifactInput{Source: src})
+		}
+	}
+	return materials
+}
+
+// getBuildMaterials returns the artifacts of a build input, or its packages
+// when no hashes are known.
+func (s *spdx3Parser) getBuildMaterials(elementID string) []model.PackageSourceOrArtifactInput {
+	var materials []model.PackageSourceOrArtifactInput
+	if arts := s.getElementArtifacts(elementID); len(arts) > 0 {
+		for _, art := range arts {
+			art := art
+			materials = append(materials, model.PackageSourceOrArtifactInput{Artifact: &art})
+		}
+		return materials
+	}
+	for _, pkg := range s.getElementPackages(elementID) {
+		pkg := pkg
+		materials = append(materials, model.PackageSourceOrArtifactInput{Package: &pkg})
+	}
+	return materials
+}
+
+// getBuildPredicate returns the properties of the build as key-value pairs
+// keyed by the property name. List values are keyed by their index and
+// dictionary values by their key. The result is sorted by key.
+func getBuildPredicate(build *spdx3_processor.Element) []model.SLSAPredicateInputSpec {
+	predicate := []model.SLSAPredicateInputSpec{}
+	add := func(key, value string) {
+		if value != "" {
+			predicate = append(predicate, model.SLSAPredicateInputSpec{Key: key, Value: value})
+		}
+	}
+
+	add("build_buildType", build.BuildType)
+	add("build_buildId", build.BuildID)
+	add("build_buildStartTime", build.BuildStartTime)
+	add("build_buildEndTime", build.BuildEndTime)
+	for i, uri := range build.ConfigSourceURI {
+		add("build_configSourceUri."+strconv.Itoa(i), uri)
+	}
+	for i, entrypoint := range build.ConfigSourceEntrypoint {
+		add("build_configSourceEntrypoint."+strconv.Itoa(i), entrypoint)
+	}
+	for i, digest := range build.ConfigSourceDigest {
+		add("build_configSourceDigest."+strconv.Itoa(i)+"."+digest.Algorithm, digest.HashValue)
+	}
+	for _, param := range build.Parameter {
+		add("build_parameter."+param.Key, param.Value)
+	}
+	for _, env := range build.Environment {
+		add("build_environment."+env.Key, env.Value)
+	}
+
+	sort.Slice(predicate, func(i, j int) bool {
+		return predicate[i].Key < predicate[j].Key
+	})
+	return predicate
+}
@@ -0,0 +1,366 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	spdx3_processor "github.com/guacsec/guac/pkg/handler/processor/spdx3"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// SPDX 3 classes ingested by GUAC, as named by the JSON-LD context
+const (
+	typeCreationInfo                = "CreationInfo"
+	typeSpdxDocument                = "SpdxDocument"
+	typeRelationship                = "Relationship"
+	typeLifecycleScopedRelationship = "LifecycleScopedRelationship"
+	typeSbom                        = "software_Sbom"
+	typePackage                     = "software_Package"
+	typeFile                        = "software_File"
+	typeBuild                       = "build_Build"
+	typeVulnerability               = "security_Vulnerability"
+
+	integrityTypeHash        = "Hash"
+	identifierTypePackageURL = "packageUrl"
+)
+
+type spdx3Parser struct {
+	doc *processor.Document
+
+	// elements indexes the elements of the graph by their spdxId, or their
+	// @id for blank nodes
+	elements map[string]*spdx3_processor.Element
+	// relationships indexes the relationships by their from element
+	relationships map[string][]*spdx3_processor.Element
+
+	packagePackages  map[string][]model.PkgInputSpec
+	packageArtifacts map[string][]model.ArtifactInputSpec
+	filePackages     map[string][]model.PkgInputSpec
+	fileArtifacts    map[string][]model.ArtifactInputSpec
+
+	spdxDoc           *spdx3_processor.Document
+	document          *spdx3_processor.Element
+	created           time.Time
+	specVersion       string
+	identifierStrings *common.IdentifierStrings
+}
+
+// NewSpdx3Parser initializes the spdx3Parser
+func NewSpdx3Parser() common.DocumentParser {
+	return &spdx3Parser{
+		elements:          map[string]*spdx3_processor.Element{},
+		relationships:     map[string][]*spdx3_processor.Element{},
+		packagePackages:   map[string][]model.PkgInputSpec{},
+		packageArtifacts:  map[string][]model.ArtifactInputSpec{},
+		filePackages:      map[string][]model.PkgInputSpec{},
+		fileArtifacts:     map[string][]model.ArtifactInputSpec{},
+		identifierStrings: &common.IdentifierStrings{},
+	}
+}
+
+// Parse breaks out the document into the graph components
+func (s *spdx3Parser) Parse(ctx context.Context, doc *processor.Document) error {
+	s.doc = doc
+	spdxDoc, err := spdx3_processor.LoadDocument(doc.Blob, doc.Format)
+	if err != nil {
+		return fmt.Errorf("failed to parse SPDX 3 document: %w", err)
+	}
+	s.spdxDoc = spdxDoc
+
+	for _, e := range spdxDoc.Graph {
+		if id := elementID(e); id != "" {
+			s.elements[id] = e
+		}
+		switch e.Type {
+		case typeSpdxDocument:
+			if s.document == nil {
+				s.document = e
+			}
+		case typeRelationship, typeLifecycleScopedRelationship:
+			s.relationships[e.From] = append(s.relationships[e.From], e)
+		}
+	}
+	if s.document == nil {
+		return fmt.Errorf("SPDX 3 document has no SpdxDocument element")
+	}
+	if err := s.getCreationInfo(); err != nil {
+		return err
+	}
+	if err := s.getPackages(); err != nil {
+		return err
+	}
+	if err := s.getFiles(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func elementID(e *spdx3_processor.Element) string {
+	if e.SpdxID != "" {
+		return e.SpdxID
+	}
+	return e.ID
+}
+
+// getCreationInfo resolves the creation information of the document, which
+// is either inlined or a reference to a CreationInfo blank node.
+func (s *spdx3Parser) getCreationInfo() error {
+	if len(s.document.CreationInfo) == 0 {
+		return nil
+	}
+
+	creationInfo := &spdx3_processor.Element{}
+	var ref string
+	if err := json.Unmarshal(s.document.CreationInfo, &ref); err == nil {
+		e, ok := s.elements[ref]
+		if !ok || e.Type != typeCreationInfo {
+			return fmt.Errorf("SPDX 3 document references unknown creation info: %s", ref)
+		}
+		creationInfo = e
+	} else if err := json.Unmarshal(s.document.CreationInfo, creationInfo); err != nil {
+		return fmt.Errorf("failed to parse SPDX 3 creation info: %w", err)
+	}
+
+	s.specVersion = creationInfo.SpecVersion
+	s.created = parseTime(creationInfo.Created)
+	return nil
+}
+
+func (s *spdx3Parser) getPackages() error {
+	for _, e := range s.spdxDoc.Graph {
+		if e.Type != typePackage {
+			continue
+		}
+
+		purl := e.PackageURL
+		if purl == "" {
+			for _, ext := range e.ExternalIdentifier {
+				if ext.ExternalIdentifierType == identifierTypePackageURL {
+					purl = ext.Identifier
+					break
+				}
+			}
+		}
+		if purl == "" {
+			purl = asmhelpers.GuacPkgPurl(e.Name, &e.PackageVersion)
+		} else {
+			s.identifierStrings.UnclassifiedStrings = append(s.identifierStrings.UnclassifiedStrings, purl)
+		}
+
+		pkg, err := asmhelpers.PurlToPkg(purl)
+		if err != nil {
+			return err
+		}
+		s.packagePackages[e.SpdxID] = append(s.packagePackages[e.SpdxID], *pkg)
+
+		for _, location := range []string{e.DownloadLocation, e.SourceInfo} {
+			if asmhelpers.IsVcs(location) {
+				s.identifierStrings.VcsStrings = append(s.identifierStrings.VcsStrings, location)
+			}
+		}
+
+		// if hashes exist create an artifact for each of them
+		s.packageArtifacts[e.SpdxID] = append(s.packageArtifacts[e.SpdxID], getArtifacts(e)...)
+	}
+	return nil
+}
+
+func (s *spdx3Parser) getFiles() error {
+	for _, e := range s.spdxDoc.Graph {
+		if e.Type != typeFile {
+			continue
+		}
+
+		// for each file create a package for each of them so they can be referenced as a dependency
+		for _, artifact := range getArtifacts(e) {
+			purl := asmhelpers.GuacFilePurl(artifact.Algorithm, artifact.Digest, &e.Name)
+			pkg, err := asmhelpers.PurlToPkg(purl)
+			if err != nil {
+				return err
+			}
+			s.filePackages[e.SpdxID] = append(s.filePackages[e.SpdxID], *pkg)
+			s.fileArtifacts[e.SpdxID] = append(s.fileArtifacts[e.SpdxID], artifact)
+		}
+	}
+	return nil
+}
+
+func getArtifacts(e *spdx3_processor.Element) []model.ArtifactInputSpec {
+	var artifacts []model.ArtifactInputSpec
+	for _, method := range e.VerifiedUsing {
+		if method.Type != integrityTypeHash {
+			continue
+		}
+		artifacts = append(artifacts, model.ArtifactInputSpec{
+			Algorithm: strings.ToLower(method.Algorithm),
+			Digest:    method.HashValue,
+		})
+	}
+	return artifacts
+}
+
+// getElementPackages returns the packages of an SPDX package or file
+func (s *spdx3Parser) getElementPackages(elementID string) []model.PkgInputSpec {
+	if pkgs, ok := s.packagePackages[elementID]; ok {
+		return pkgs
+	}
+	return s.filePackages[elementID]
+}
+
+// getElementArtifacts returns the artifacts of an SPDX package or file
+func (s *spdx3Parser) getElementArtifacts(elementID string) []model.ArtifactInputSpec {
+	if arts, ok := s.packageArtifacts[elementID]; ok {
+		return arts
+	}
+	return s.fileArtifacts[elementID]
+}
+
+func (s *spdx3Parser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	logger := logging.FromContext(ctx)
+	preds := &assembler.IngestPredicates{}
+
+	for _, e := range s.spdxDoc.Graph {
+		if e.Type != typeRelationship && e.Type != typeLifecycleScopedRelationship {
+			continue
+		}
+		mapping, ok := relationshipMappings[e.RelationshipType]
+		if !ok {
+			logger.Debugf("skipping SPDX 3 relationship %s, not mapped to GUAC evidence", e.RelationshipType)
+			continue
+		}
+		for _, to := range e.To {
+			from := e.From
+			if mapping.reverse {
+				from, to = to, from
+			}
+			mapping.evidence(s, preds, from, to, getJustification(e))
+		}
+	}
+
+	s.getRootElements(preds)
+	preds.HasSourceAt = s.getHasSourceAt(ctx)
+	preds.HasSlsa = s.getHasSLSA(ctx)
+	preds.Vex = s.getVex(ctx)
+
+	// Create predicates for IsOccurence for all artifacts found
+	for id := range s.fileArtifacts {
+		for _, pkg := range s.filePackages[id] {
+			pkg := pkg
+			for _, art := range s.fileArtifacts[id] {
+				art := art
+				preds.IsOccurence = append(preds.IsOccurence, assembler.IsOccurenceIngest{
+					Pkg:      &pkg,
+					Artifact: &art,
+					IsOccurence: &model.IsOccurrenceInputSpec{
+						Justification: "spdx file with checksum",
+					},
+				})
+			}
+		}
+	}
+
+	for id := range s.packagePackages {
+		for _, pkg := range s.packagePackages[id] {
+			pkg := pkg
+			for _, art := range s.packageArtifacts[id] {
+				art := art
+				preds.IsOccurence = append(preds.IsOccurence, assembler.IsOccurenceIngest{
+					Pkg:      &pkg,
+					Artifact: &art,
+					IsOccurence: &model.IsOccurrenceInputSpec{
+						Justification: "spdx package with checksum",
+					},
+				})
+			}
+		}
+	}
+
+	return preds
+}
+
+// getRootElements attributes the SBOM to the root elements of the
+// document. The root elements of an SBOM nested in the document are the
+// packages the document describes.
+func (s *spdx3Parser) getRootElements(preds *assembler.IngestPredicates) {
+	var visit func(e *spdx3_processor.Element)
+	visited := map[string]bool{}
+	visit = func(e *spdx3_processor.Element) {
+		for _, root := range e.RootElement {
+			if visited[root] {
+				continue
+			}
+			visited[root] = true
+			if r, ok := s.elements[root]; ok && r.Type == typeSbom {
+				visit(r)
+				continue
+			}
+			s.hasSBOM(preds, s.document.SpdxID, root, "")
+		}
+	}
+	visit(s.document)
+}
+
+// getHasSourceAt links the packages to the VCS repositories they were
+// downloaded from or built from.
+func (s *spdx3Parser) getHasSourceAt(ctx context.Context) []assembler.HasSourceAtIngest {
+	var hasSourceAts []assembler.HasSourceAtIngest
+	for _, e := range s.spdxDoc.Graph {
+		if e.Type != typePackage {
+			continue
+		}
+		hasSourceAts = append(hasSourceAts, common.SpdxPackageSources(ctx, s.packagePackages[e.SpdxID], e.DownloadLocation, e.SourceInfo, s.created)...)
+	}
+	return hasSourceAts
+}
+
+func (s *spdx3Parser) GetIdentities(ctx context.Context) []common.TrustInformation {
+	return nil
+}
+
+func (s *spdx3Parser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
+	return s.identifierStrings, nil
+}
+
+func getJustification(r *spdx3_processor.Element) string {
+	s := fmt.Sprintf("Derived from SPDX %s relationship", r.RelationshipType)
+	if len(r.Scope) > 0 {
+		s += fmt.Sprintf(" with %s scope", r.Scope)
+	}
+	if len(r.Comment) > 0 {
+		s += fmt.Sprintf(" with comment: %s", r.Comment)
+	}
+	return s
+}
+
+// parseTime parses an SPDX 3 date time, returning the zero time if it is
+// missing or malformed
+func parseTime(t string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, t)
+	if err != nil {
+		return time.Time{}
+	}
+	return parsed.UTC()
+}
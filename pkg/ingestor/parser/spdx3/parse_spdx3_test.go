@@ -0,0 +1,124 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/guacsec/guac/internal/testing/testdata"
+	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/handler/processor"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+func Test_spdx3Parser(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	tests := []struct {
+		name           string
+		doc            *processor.Document
+		wantPredicates *assembler.IngestPredicates
+		wantErr        bool
+	}{{
+		name: "valid SPDX 3 document",
+		doc: &processor.Document{
+			Blob:   testdata.Spdx3Example,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSPDX3,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantPredicates: &testdata.Spdx3IngestionPredicates,
+		wantErr:        false,
+	}, {
+		name: "SPDX 2 document",
+		doc: &processor.Document{
+			Blob:   testdata.SpdxExampleAlpine23,
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSPDX3,
+			SourceInformation: processor.SourceInformation{
+				Collector: "TestCollector",
+				Source:    "TestSource",
+			},
+		},
+		wantErr: true,
+	}, {
+		name: "SPDX 3 document without SpdxDocument element",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+				"@graph": [{"type": "software_Package", "spdxId": "urn:pkg", "name": "pkg"}]
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentSPDX3,
+		},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSpdx3Parser()
+			err := s.Parse(ctx, tt.doc)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("spdx3Parser.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+
+			preds := s.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("spdx3.GetPredicate mismatch values (+got, -expected): %s", d)
+			}
+		})
+	}
+}
+
+func Test_spdx3Parser_GetIdentifiers(t *testing.T) {
+	ctx := logging.WithLogger(context.Background())
+	s := NewSpdx3Parser()
+	if err := s.Parse(ctx, &processor.Document{
+		Blob:   testdata.Spdx3Example,
+		Format: processor.FormatJSON,
+		Type:   processor.DocumentSPDX3,
+	}); err != nil {
+		t.Fatalf("spdx3Parser.Parse() error = %v", err)
+	}
+
+	got, err := s.GetIdentifiers(ctx)
+	if err != nil {
+		t.Fatalf("spdx3Parser.GetIdentifiers() error = %v", err)
+	}
+	want := &common.IdentifierStrings{
+		VcsStrings: []string{
+			"git+https://github.com/example/hello@v1.0.0",
+			"git+https://github.com/go-yaml/yaml@v3.0.1",
+		},
+		UnclassifiedStrings: []string{
+			"pkg:golang/github.com/example/hello@v1.0.0",
+			"pkg:golang/gopkg.in/yaml.v3@v3.0.1",
+			"pkg:golang/stdlib@1.22.2",
+		},
+	}
+	if d := cmp.Diff(want, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); len(d) != 0 {
+		t.Errorf("spdx3.GetIdentifiers mismatch values (+got, -expected): %s", d)
+	}
+}
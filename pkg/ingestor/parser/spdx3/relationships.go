@@ -0,0 +1,113 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx3
+
+import (
+	"reflect"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+)
+
+// relationshipEvidence creates the GUAC evidence for the relationship
+// "from <relationship> to" between two SPDX elements.
+type relationshipEvidence func(s *spdx3Parser, preds *assembler.IngestPredicates, from, to string, justification string)
+
+// relationshipMapping describes how an SPDX 3 relationship is ingested.
+// Relationships that are expressed from the other end in GUAC terms are
+// reversed, e.g. "A generates B" is ingested as B being an occurrence of A.
+type relationshipMapping struct {
+	evidence relationshipEvidence
+	reverse  bool
+}
+
+// relationshipMappings maps the SPDX 3 relationship types to GUAC evidence.
+// The build and security profile relationships are ingested with the
+// elements they belong to. Relationship types that are not listed are not
+// ingested.
+var relationshipMappings = map[string]relationshipMapping{
+	// A depends on B
+	"contains":              {evidence: (*spdx3Parser).isDependency},
+	"dependsOn":             {evidence: (*spdx3Parser).isDependency},
+	"hasDynamicLink":        {evidence: (*spdx3Parser).isDependency},
+	"hasStaticLink":         {evidence: (*spdx3Parser).isDependency},
+	"hasPrerequisite":       {evidence: (*spdx3Parser).isDependency},
+	"hasOptionalDependency": {evidence: (*spdx3Parser).isDependency},
+	"hasProvidedDependency": {evidence: (*spdx3Parser).isDependency},
+	"hasOptionalComponent":  {evidence: (*spdx3Parser).isDependency},
+	"usesTool":              {evidence: (*spdx3Parser).isDependency},
+
+	// the artifacts of A are occurrences of the package B
+	"generates":               {evidence: (*spdx3Parser).isOccurrence, reverse: true},
+	"hasDistributionArtifact": {evidence: (*spdx3Parser).isOccurrence, reverse: true},
+
+	// the artifacts of A are the same as the artifacts of B
+	"copiedTo": {evidence: (*spdx3Parser).hashEqual},
+
+	// the package A is a variant of the package B
+	"hasVariant": {evidence: (*spdx3Parser).certifyPkg, reverse: true},
+
+	// the document A is an SBOM for B
+	"describes": {evidence: (*spdx3Parser).hasSBOM},
+}
+
+func (s *spdx3Parser) isDependency(preds *assembler.IngestPredicates, from, to string, justification string) {
+	preds.IsDependency = append(preds.IsDependency, common.IsDependencies(s.getElementPackages(from), s.getElementPackages(to), justification)...)
+}
+
+func (s *spdx3Parser) isOccurrence(preds *assembler.IngestPredicates, from, to string, justification string) {
+	preds.IsOccurence = append(preds.IsOccurence, common.IsOccurrences(s.getElementArtifacts(from), s.getElementPackages(to), justification)...)
+}
+
+func (s *spdx3Parser) hashEqual(preds *assembler.IngestPredicates, from, to string, justification string) {
+	preds.HashEqual = append(preds.HashEqual, common.HashEquals(s.getElementArtifacts(from), s.getElementArtifacts(to), justification)...)
+}
+
+func (s *spdx3Parser) certifyPkg(preds *assembler.IngestPredicates, from, to string, justification string) {
+	preds.CertifyPkg = append(preds.CertifyPkg, common.CertifyPkgs(s.packagePackages[from], s.packagePackages[to], justification)...)
+}
+
+// hasSBOM attributes the document to the packages it describes. Both the
+// document and the SBOMs nested in it can describe packages, each package
+// is only attributed once.
+func (s *spdx3Parser) hasSBOM(preds *assembler.IngestPredicates, from, to string, _ string) {
+	if e, ok := s.elements[from]; !ok || (e.Type != typeSpdxDocument && e.Type != typeSbom) {
+		return
+	}
+	for _, pkg := range s.packagePackages[to] {
+		pkg := pkg
+		if hasSBOMFor(preds.HasSBOM, &pkg) {
+			continue
+		}
+		preds.HasSBOM = append(preds.HasSBOM, assembler.HasSBOMIngest{
+			Pkg: &pkg,
+			HasSBOM: &model.HasSBOMInputSpec{
+				Uri: s.document.SpdxID,
+			},
+		})
+	}
+}
+
+// hasSBOMFor returns true if the SBOM is already attributed to the package
+func hasSBOMFor(hasSBOMs []assembler.HasSBOMIngest, pkg *model.PkgInputSpec) bool {
+	for _, hasSBOM := range hasSBOMs {
+		if reflect.DeepEqual(hasSBOM.Pkg, pkg) {
+			return true
+		}
+	}
+	return false
+}
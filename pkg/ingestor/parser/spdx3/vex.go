@@ -0,0 +1,109 @@
+//
+// Copyright 2023 The GUAC Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx3
+
+import (
+	"context"
+
+	"github.com/guacsec/guac/pkg/assembler"
+	model "github.com/guacsec/guac/pkg/assembler/clients/generated"
+	asmhelpers "github.com/guacsec/guac/pkg/assembler/helpers"
+	"github.com/guacsec/guac/pkg/logging"
+)
+
+// vexStatuses maps the VEX relationships of the security profile to the
+// VEX status they assert
+var vexStatuses = map[string]model.VexStatus{
+	"security_VexAffectedVulnAssessmentRelationship":           model.VexStatusAffected,
+	"security_VexNotAffectedVulnAssessmentRelationship":        model.VexStatusNotAffected,
+	"security_VexFixedVulnAssessmentRelationship":              model.VexStatusFixed,
+	"security_VexUnderInvestigationVulnAssessmentRelationship": model.VexStatusUnderInvestigation,
+}
+
+// getVex maps the VEX relationships of the security profile, which assess
+// a vulnerability (from) for the products (to), to VEX statements. Files
+// are identified by their artifacts, anything else by its packages.
+func (s *spdx3Parser) getVex(ctx context.Context) []assembler.VexIngest {
+	var vexes []assembler.VexIngest
+	for _, e := range s.spdxDoc.Graph {
+		status, ok := vexStatuses[e.Type]
+		if !ok {
+			continue
+		}
+
+		vulnID := s.getVulnerabilityID(e.From)
+		if vulnID == "" {
+			logging.FromContext(ctx).Debugf("skipping SPDX 3 VEX relationship %s, vulnerability %s not found", e.SpdxID, e.From)
+			continue
+		}
+		osv, cve, ghsa := asmhelpers.OsvCveOrGhsa(vulnID)
+
+		knownSince := parseTime(e.PublishedTime)
+		if knownSince.IsZero() {
+			knownSince = s.created
+		}
+		vexData := &model.VexStatementInputSpec{
+			Status:           status,
+			VexJustification: asmhelpers.VexJustification(e.JustificationType),
+			Statement:        e.ImpactStatement,
+			StatusNotes:      e.StatusNotes,
+			ActionStatement:  e.ActionStatement,
+			KnownSince:       knownSince,
+		}
+
+		for _, to := range e.To {
+			if product, ok := s.elements[to]; ok && product.Type == typeFile {
+				for _, art := range s.fileArtifacts[to] {
+					art := art
+					vexes = append(vexes, newVexIngest(nil, &art, osv, cve, ghsa, vexData))
+				}
+				continue
+			}
+			for _, pkg := range s.packagePackages[to] {
+				pkg := pkg
+				vexes = append(vexes, newVexIngest(&pkg, nil, osv, cve, ghsa, vexData))
+			}
+		}
+	}
+	return vexes
+}
+
+func newVexIngest(pkg *model.PkgInputSpec, art *model.ArtifactInputSpec, osv *model.OSVInputSpec, cve *model.CVEInputSpec, ghsa *model.GHSAInputSpec,
+	vexData *model.VexStatementInputSpec) assembler.VexIngest {
+	return assembler.VexIngest{
+		Pkg:      pkg,
+		Artifact: art,
+		OSV:      osv,
+		CVE:      cve,
+		GHSA:     ghsa,
+		VexData:  vexData,
+	}
+}
+
+// getVulnerabilityID returns the CVE or GHSA ID of a vulnerability element,
+// falling back to its name
+func (s *spdx3Parser) getVulnerabilityID(elementID string) string {
+	vuln, ok := s.elements[elementID]
+	if !ok || vuln.Type != typeVulnerability {
+		return ""
+	}
+	for _, ext := range vuln.ExternalIdentifier {
+		if asmhelpers.IsCVE(ext.Identifier) || asmhelpers.IsGHSA(ext.Identifier) {
+			return ext.Identifier
+		}
+	}
+	return vuln.Name
+}
@@ -15,50 +15,51 @@
 
 // The Vulnerability attestation parser parses the attestation defined by
 // by the certifier using the predicate type"https://in-toto.io/attestation/vuln/v0.1"
-// Based on the information contained, a package node is generated with just purl and
-// digest (if found). This package node is merged into the graph database as it already exists.
+// The subjects of the statement are expected to be package purls.
 //
-// An attestation node is generated that contains the information stored in the attestation document.
-// The attestation node is linked to the package node via a "attestation" edge type.
-//
-// Depending on the number of vulnerabilities found, vulnerability nodes are generated that
-// contain just the vulnerability ID that can be used to query for more information as needed.
-// The vulnerability node is linked to the attestation node via a "vulnerable" edge type.
+// For each package and each vulnerability found by the scanner, a CertifyVuln
+// is generated carrying the scanner and database information of the attestation.
+// When the scanner found no vulnerability, each package is certified with
+// NoVuln instead, recording that it was scanned clean.
+// The aliases of each vulnerability (CVE or GHSA IDs) are linked back to the
+// vulnerability via IsVulnerability, and the metadata of each vulnerability
+// (summary, CVSS scores, CWE ids, ...), when present, generates a VulnMetadata.
 package certify_vuln
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
+	"github.com/guacsec/guac/pkg/assembler/helpers"
 	attestation_vuln "github.com/guacsec/guac/pkg/certifier/attestation"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/ingestor/parser/common"
+	"github.com/guacsec/guac/pkg/logging"
+	"go.uber.org/zap"
 )
 
 const (
-	algorithmSHA256 string = "sha256"
-	attestationType string = "CERTIFY_VULN"
+	aliasJustification = "from vulnerability attestation aliases"
 )
 
 type vulnCertificationParser struct {
-	doc         *processor.Document
-	packageNode []assembler.PackageNode
-	attestation assembler.AttestationNode
-	vulns       []assembler.VulnerabilityNode
+	doc               *processor.Document
+	pkgs              []*generated.PkgInputSpec
+	vulnData          *generated.VulnerabilityMetaDataInput
+	vulns             []attestation_vuln.Result
+	identifierStrings *common.IdentifierStrings
 }
 
 // NewVulnCertificationParser initializes the vulnCertificationParser
 func NewVulnCertificationParser() common.DocumentParser {
 	return &vulnCertificationParser{
-		packageNode: []assembler.PackageNode{},
-		attestation: assembler.AttestationNode{},
-		vulns:       []assembler.VulnerabilityNode{},
+		identifierStrings: &common.IdentifierStrings{},
 	}
 }
 
@@ -67,57 +68,45 @@ func (c *vulnCertificationParser) Parse(ctx context.Context, doc *processor.Docu
 	c.doc = doc
 	statement, err := parseVulnCertifyPredicate(doc.Blob)
 	if err != nil {
-		return fmt.Errorf("failed to parse slsa predicate: %w", err)
+		return fmt.Errorf("failed to parse vulnerability predicate: %w", err)
+	}
+	if err := c.getSubject(statement); err != nil {
+		return err
 	}
-	c.getSubject(statement)
-	c.getAttestation(doc.Blob, doc.SourceInformation.Source, statement)
-	c.getVulns(doc.Blob, doc.SourceInformation.Source, statement)
+	c.getVulnData(statement)
+	c.vulns = statement.Predicate.Scanner.Result
 	return nil
 }
 
-func (c *vulnCertificationParser) getSubject(statement *attestation_vuln.VulnerabilityStatement) {
-	currentPackage := assembler.PackageNode{}
+func (c *vulnCertificationParser) getSubject(statement *attestation_vuln.VulnerabilityStatement) error {
+	seen := map[string]bool{}
 	for _, sub := range statement.StatementHeader.Subject {
-		currentPackage.Purl = sub.Name
-		for alg, ds := range sub.Digest {
-			currentPackage.Digest = append(currentPackage.Digest, strings.ToLower(alg+":"+strings.Trim(ds, "'")))
+		// a subject is repeated for each digest of the package
+		if seen[sub.Name] {
+			continue
+		}
+		seen[sub.Name] = true
+		pkg, err := helpers.PurlToPkg(sub.Name)
+		if err != nil {
+			return fmt.Errorf("failed to parse subject purl %q: %w", sub.Name, err)
 		}
-		c.packageNode = append(c.packageNode, currentPackage)
+		c.pkgs = append(c.pkgs, pkg)
+		c.identifierStrings.UnclassifiedStrings = append(c.identifierStrings.UnclassifiedStrings, sub.Name)
 	}
+	return nil
 }
 
-func (c *vulnCertificationParser) getAttestation(blob []byte, source string, statement *attestation_vuln.VulnerabilityStatement) {
-	h := sha256.Sum256(blob)
-	attNode := assembler.AttestationNode{
-		FilePath:        source,
-		Digest:          algorithmSHA256 + ":" + hex.EncodeToString(h[:]),
-		AttestationType: attestationType,
-		Payload:         map[string]interface{}{},
-		NodeData:        *assembler.NewObjectMetadata(c.doc.SourceInformation),
-	}
-	attNode.Payload["invocation_parameters"] = statement.Predicate.Invocation.Parameters
-	attNode.Payload["invocation_uri"] = statement.Predicate.Invocation.Uri
-	attNode.Payload["invocation_eventID"] = statement.Predicate.Invocation.EventID
-	attNode.Payload["invocation_producerID"] = statement.Predicate.Invocation.ProducerID
-	attNode.Payload["scanner_uri"] = statement.Predicate.Scanner.Uri
-	attNode.Payload["scanner_version"] = statement.Predicate.Scanner.Version
-	attNode.Payload["scanner_db_uri"] = statement.Predicate.Scanner.Database.Uri
-	attNode.Payload["scanner_db_version"] = statement.Predicate.Scanner.Database.Version
-	attNode.Payload["metadata_scannedOn"] = statement.Predicate.Metadata.ScannedOn.String()
-	for i, result := range statement.Predicate.Scanner.Result {
-		attNode.Payload["result_vulnerabilityID_"+strconv.Itoa(i)] = result.VulnerabilityId
-		attNode.Payload["result_alias_"+strconv.Itoa(i)] = result.Aliases
-	}
-	c.attestation = attNode
-}
-
-func (c *vulnCertificationParser) getVulns(blob []byte, source string, statement *attestation_vuln.VulnerabilityStatement) {
-	for _, id := range statement.Predicate.Scanner.Result {
-		vulNode := assembler.VulnerabilityNode{
-			ID:       id.VulnerabilityId,
-			NodeData: *assembler.NewObjectMetadata(c.doc.SourceInformation),
-		}
-		c.vulns = append(c.vulns, vulNode)
+func (c *vulnCertificationParser) getVulnData(statement *attestation_vuln.VulnerabilityStatement) {
+	var timeScanned time.Time
+	if statement.Predicate.Metadata.ScannedOn != nil {
+		timeScanned = statement.Predicate.Metadata.ScannedOn.UTC()
+	}
+	c.vulnData = &generated.VulnerabilityMetaDataInput{
+		TimeScanned:    timeScanned,
+		DbUri:          statement.Predicate.Scanner.Database.Uri,
+		DbVersion:      statement.Predicate.Scanner.Database.Version,
+		ScannerUri:     statement.Predicate.Scanner.Uri,
+		ScannerVersion: statement.Predicate.Scanner.Version,
 	}
 }
 
@@ -129,38 +118,161 @@ func parseVulnCertifyPredicate(p []byte) (*attestation_vuln.VulnerabilityStateme
 	return &predicate, nil
 }
 
-// CreateNodes creates the GuacNode for the graph inputs
-func (c *vulnCertificationParser) CreateNodes(ctx context.Context) []assembler.GuacNode {
-	nodes := []assembler.GuacNode{}
-	for _, pack := range c.packageNode {
-		nodes = append(nodes, pack)
+// GetPredicates returns a CertifyVuln for each package and vulnerability
+// found, or with NoVuln if none was found, an IsVulnerability for each CVE or
+// GHSA alias of a vulnerability and a VulnMetadata for each vulnerability with
+// metadata.
+func (c *vulnCertificationParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
+	logger := logging.FromContext(ctx)
+	preds := &assembler.IngestPredicates{}
+	if !c.hasVulns() {
+		for _, pkg := range c.pkgs {
+			vulnData := *c.vulnData
+			preds.CertifyVuln = append(preds.CertifyVuln, assembler.CertifyVulnIngest{
+				Pkg:      pkg,
+				NoVuln:   true,
+				VulnData: &vulnData,
+			})
+		}
+		return preds
+	}
+	for _, result := range c.vulns {
+		if result.VulnerabilityId == "" {
+			continue
+		}
+		osv, cve, ghsa := helpers.OsvCveOrGhsa(result.VulnerabilityId)
+		for _, pkg := range c.pkgs {
+			vulnData := *c.vulnData
+			preds.CertifyVuln = append(preds.CertifyVuln, assembler.CertifyVulnIngest{
+				Pkg:      pkg,
+				OSV:      osv,
+				CVE:      cve,
+				GHSA:     ghsa,
+				VulnData: &vulnData,
+			})
+		}
+		preds.IsVuln = append(preds.IsVuln, getIsVulns(result)...)
+		if metadata := getVulnMetadata(result, logger); metadata != nil {
+			preds.VulnMetadata = append(preds.VulnMetadata, assembler.VulnMetadataIngest{
+				OSV:          osv,
+				CVE:          cve,
+				GHSA:         ghsa,
+				VulnMetadata: metadata,
+			})
+		}
 	}
-	for _, vuln := range c.vulns {
-		nodes = append(nodes, vuln)
+	return preds
+}
+
+// hasVulns returns true if the scanner reported at least one vulnerability
+func (c *vulnCertificationParser) hasVulns() bool {
+	for _, result := range c.vulns {
+		if result.VulnerabilityId != "" {
+			return true
+		}
 	}
-	nodes = append(nodes, c.attestation)
-	return nodes
+	return false
 }
 
-// TODO(bulldozer): replace with GetPredicate
-// // CreateEdges creates the GuacEdges that form the relationship for the graph inputs
-// func (c *vulnCertificationParser) CreateEdges(ctx context.Context, foundIdentities []common.TrustInformation) []assembler.GuacEdge {
-// 	edges := []assembler.GuacEdge{}
-// 	for _, i := range foundIdentities {
-// 		edges = append(edges, assembler.IdentityForEdge{IdentityNode: i, AttestationNode: c.attestation})
-// 	}
-// 	for _, pack := range c.packageNode {
-// 		edges = append(edges, assembler.AttestationForEdge{AttestationNode: c.attestation, ForPackage: pack})
-// 	}
-// 	for _, vuln := range c.vulns {
-// 		edges = append(edges, assembler.VulnerableEdge{VulnerabilityNode: vuln, AttestationNode: c.attestation})
-// 	}
-// 	return edges
-// }
-
-// TODO(bulldozer)
-func (c *vulnCertificationParser) GetPredicates(ctx context.Context) *assembler.IngestPredicates {
-	return nil
+// getIsVulns links the vulnerability ID, as reported by the OSV scanner, to
+// each of its CVE and GHSA aliases. IsVulnerability can only point from an OSV
+// to a CVE or GHSA, so when the ID is itself a CVE or GHSA, which is the node
+// CertifyVuln is attached to, that node is linked first so that the aliases
+// reach it through the same OSV. Aliases that are neither CVE nor GHSA are
+// dropped.
+func getIsVulns(result attestation_vuln.Result) []assembler.IsVulnIngest {
+	osv := &generated.OSVInputSpec{OsvId: result.VulnerabilityId}
+	isVuln := func(id string) *assembler.IsVulnIngest {
+		_, cve, ghsa := helpers.OsvCveOrGhsa(id)
+		if cve == nil && ghsa == nil {
+			return nil
+		}
+		return &assembler.IsVulnIngest{
+			OSV:  osv,
+			CVE:  cve,
+			GHSA: ghsa,
+			IsVuln: &generated.IsVulnerabilityInputSpec{
+				Justification: aliasJustification,
+			},
+		}
+	}
+
+	var isVulns []assembler.IsVulnIngest
+	for _, alias := range result.Aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" || strings.EqualFold(alias, result.VulnerabilityId) {
+			continue
+		}
+		if v := isVuln(alias); v != nil {
+			isVulns = append(isVulns, *v)
+		}
+	}
+	if len(isVulns) == 0 {
+		return nil
+	}
+	if v := isVuln(result.VulnerabilityId); v != nil {
+		isVulns = append([]assembler.IsVulnIngest{*v}, isVulns...)
+	}
+	return isVulns
+}
+
+// getVulnMetadata returns the metadata of the vulnerability, or nil if the
+// scanner did not report any. Severities that cannot be scored are dropped.
+func getVulnMetadata(result attestation_vuln.Result, logger *zap.SugaredLogger) *generated.VulnMetadataInputSpec {
+	if result.Summary == "" && len(result.Severity) == 0 && len(result.CWEIds) == 0 &&
+		len(result.References) == 0 && result.Published == nil && result.Modified == nil {
+		return nil
+	}
+
+	metadata := &generated.VulnMetadataInputSpec{
+		Summary:    result.Summary,
+		Scores:     []generated.VulnScoreInputSpec{},
+		CweIds:     []string{},
+		References: []string{},
+	}
+	for _, severity := range result.Severity {
+		score, err := getVulnScore(severity)
+		if err != nil {
+			logger.Warnf("unable to score vulnerability %s: %v", result.VulnerabilityId, err)
+			continue
+		}
+		metadata.Scores = append(metadata.Scores, *score)
+	}
+	metadata.CweIds = append(metadata.CweIds, result.CWEIds...)
+	metadata.References = append(metadata.References, result.References...)
+	if result.Published != nil {
+		published := result.Published.UTC()
+		metadata.Published = &published
+	}
+	if result.Modified != nil {
+		modified := result.Modified.UTC()
+		metadata.Modified = &modified
+	}
+	return metadata
+}
+
+// getVulnScore converts a severity to a score. EPSS severities carry the
+// probability as score, all others are expected to carry a CVSS vector.
+func getVulnScore(severity attestation_vuln.Severity) (*generated.VulnScoreInputSpec, error) {
+	if strings.EqualFold(severity.Method, string(generated.VulnScoreTypeEpss)) {
+		probability, err := strconv.ParseFloat(severity.Score, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EPSS score %q: %w", severity.Score, err)
+		}
+		return &generated.VulnScoreInputSpec{
+			ScoreType: generated.VulnScoreTypeEpss,
+			Score:     probability,
+		}, nil
+	}
+	scoreType, score, err := helpers.CvssScore(severity.Score)
+	if err != nil {
+		return nil, err
+	}
+	return &generated.VulnScoreInputSpec{
+		ScoreType: scoreType,
+		Vector:    severity.Score,
+		Score:     score,
+	}, nil
 }
 
 // GetIdentities gets the identity node from the document if they exist
@@ -169,5 +281,5 @@ func (c *vulnCertificationParser) GetIdentities(ctx context.Context) []common.Tr
 }
 
 func (c *vulnCertificationParser) GetIdentifiers(ctx context.Context) (*common.IdentifierStrings, error) {
-	return nil, fmt.Errorf("not yet implemented")
+	return c.identifierStrings, nil
 }
@@ -15,164 +15,56 @@
 
 package certify_vuln
 
-// TODO(bulldozer): freeze tests
-/*
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/guacsec/guac/internal/testing/testdata"
 	"github.com/guacsec/guac/pkg/assembler"
+	"github.com/guacsec/guac/pkg/assembler/clients/generated"
 	"github.com/guacsec/guac/pkg/handler/processor"
 	"github.com/guacsec/guac/pkg/logging"
 )
 
 var (
-	log4jPack = assembler.PackageNode{
-		Name:    "",
-		Digest:  nil,
-		Version: "",
-		Purl:    "pkg:maven/org.apache.logging.log4j/log4j-core@2.8.1",
-		Tags:    nil,
-		CPEs:    nil,
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "",
-				Source:    "",
-			},
-		),
+	log4jPkg = &generated.PkgInputSpec{
+		Type:      "maven",
+		Namespace: ptrfrom("org.apache.logging.log4j"),
+		Name:      "log4j-core",
+		Version:   ptrfrom("2.8.1"),
+		Subpath:   ptrfrom(""),
 	}
 
-	vulAttest = assembler.AttestationNode{
-		FilePath:        "TestSource",
-		Digest:          "sha256:8546e78671836aab74c2dd554b4939547ae950ff3ecc89fcbedf69a7b11bbe0c",
-		AttestationType: attestationType,
-		Payload: map[string]interface{}{
-			"invocation_parameters":    []string{""},
-			"invocation_eventID":       "",
-			"invocation_uri":           "guac",
-			"invocation_producerID":    "guac",
-			"scanner_uri":              "osv.dev",
-			"scanner_version":          "0.0.14",
-			"scanner_db_uri":           "",
-			"scanner_db_version":       "",
-			"metadata_scannedOn":       "2022-11-21 17:45:50.52 +0000 UTC",
-			"result_vulnerabilityID_0": "GHSA-7rjr-3q55-vv33",
-			"result_alias_0":           []string{""},
-			"result_vulnerabilityID_1": "GHSA-8489-44mv-ggj8",
-			"result_alias_1":           []string{""},
-			"result_vulnerabilityID_2": "GHSA-fxph-q3j8-mv87",
-			"result_alias_2":           []string{""},
-			"result_vulnerabilityID_3": "GHSA-jfh8-c2jp-5v3q",
-			"result_alias_3":           []string{""},
-			"result_vulnerabilityID_4": "GHSA-p6xc-xr62-6r2g",
-			"result_alias_4":           []string{""},
-			"result_vulnerabilityID_5": "GHSA-vwqq-5vrc-xw9h",
-			"result_alias_5":           []string{""},
-		},
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
-	}
+	timeScanned, _ = time.Parse(time.RFC3339, "2022-11-21T17:45:50.52Z")
 
-	vulNode1 = assembler.VulnerabilityNode{
-		ID: "GHSA-7rjr-3q55-vv33",
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
-	}
-	vulNode2 = assembler.VulnerabilityNode{
-		ID: "GHSA-8489-44mv-ggj8",
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
-	}
-	vulNode3 = assembler.VulnerabilityNode{
-		ID: "GHSA-fxph-q3j8-mv87",
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
-	}
-	vulNode4 = assembler.VulnerabilityNode{
-		ID: "GHSA-jfh8-c2jp-5v3q",
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
-	}
-	vulNode5 = assembler.VulnerabilityNode{
-		ID: "GHSA-p6xc-xr62-6r2g",
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
-	}
-	vulNode6 = assembler.VulnerabilityNode{
-		ID: "GHSA-vwqq-5vrc-xw9h",
-		NodeData: *assembler.NewObjectMetadata(
-			processor.SourceInformation{
-				Collector: "TestCollector",
-				Source:    "TestSource",
-			},
-		),
+	osvVulnData = &generated.VulnerabilityMetaDataInput{
+		TimeScanned:    timeScanned,
+		ScannerUri:     "osv.dev",
+		ScannerVersion: "0.0.14",
 	}
+)
 
-	vulnCertifierEdges = []assembler.GuacEdge{
-		assembler.AttestationForEdge{
-			AttestationNode: vulAttest,
-			ForPackage:      log4jPack,
-		},
-		assembler.VulnerableEdge{
-			AttestationNode:   vulAttest,
-			VulnerabilityNode: vulNode1,
-		},
-		assembler.VulnerableEdge{
-			AttestationNode:   vulAttest,
-			VulnerabilityNode: vulNode2,
-		},
-		assembler.VulnerableEdge{
-			AttestationNode:   vulAttest,
-			VulnerabilityNode: vulNode3,
-		},
-		assembler.VulnerableEdge{
-			AttestationNode:   vulAttest,
-			VulnerabilityNode: vulNode4,
-		},
-		assembler.VulnerableEdge{
-			AttestationNode:   vulAttest,
-			VulnerabilityNode: vulNode5,
-		},
-		assembler.VulnerableEdge{
-			AttestationNode:   vulAttest,
-			VulnerabilityNode: vulNode6,
-		},
+func ptrfrom[T any](s T) *T {
+	return &s
+}
+
+func ghsaCertifyVuln(id string) assembler.CertifyVulnIngest {
+	return assembler.CertifyVulnIngest{
+		Pkg:      log4jPkg,
+		GHSA:     &generated.GHSAInputSpec{GhsaId: id},
+		VulnData: osvVulnData,
 	}
-)
+}
 
 func Test_vulnCertificationParser(t *testing.T) {
 	ctx := logging.WithLogger(context.Background())
 	tests := []struct {
-		name      string
-		doc       *processor.Document
-		wantNodes []assembler.GuacNode
-		wantEdges []assembler.GuacEdge
-		wantErr   bool
+		name           string
+		doc            *processor.Document
+		wantPredicates *assembler.IngestPredicates
+		wantErr        bool
 	}{{
 		name: "valid vulnerability certifier document",
 		doc: &processor.Document{
@@ -184,9 +76,180 @@ func Test_vulnCertificationParser(t *testing.T) {
 				Source:    "TestSource",
 			},
 		},
-		wantNodes: []assembler.GuacNode{log4jPack, vulAttest, vulNode1, vulNode2, vulNode3, vulNode4, vulNode5, vulNode6},
-		wantEdges: vulnCertifierEdges,
-		wantErr:   false,
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyVuln: []assembler.CertifyVulnIngest{
+				ghsaCertifyVuln("GHSA-7rjr-3q55-vv33"),
+				ghsaCertifyVuln("GHSA-8489-44mv-ggj8"),
+				ghsaCertifyVuln("GHSA-fxph-q3j8-mv87"),
+				ghsaCertifyVuln("GHSA-jfh8-c2jp-5v3q"),
+				ghsaCertifyVuln("GHSA-p6xc-xr62-6r2g"),
+				ghsaCertifyVuln("GHSA-vwqq-5vrc-xw9h"),
+			},
+		},
+		wantErr: false,
+	}, {
+		name: "aliases become IsVulnerability",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"_type": "https://in-toto.io/Statement/v0.1",
+				"subject": [{"name": "pkg:maven/org.apache.logging.log4j/log4j-core@2.8.1"}],
+				"predicateType": "https://in-toto.io/attestation/vuln/v0.1",
+				"predicate": {
+					"scanner": {
+						"uri": "osv.dev",
+						"version": "0.0.14",
+						"result": [{
+							"vulnerability_id": "GO-2022-0001",
+							"aliases": ["CVE-2021-44228", "GHSA-jfh8-c2jp-5v3q", "GO-2022-0001", "PYSEC-2021-1"]
+						}]
+					},
+					"metadata": {"scannedOn": "2022-11-21T17:45:50.52Z"}
+				}
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Vul,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyVuln: []assembler.CertifyVulnIngest{{
+				Pkg:      log4jPkg,
+				OSV:      &generated.OSVInputSpec{OsvId: "GO-2022-0001"},
+				VulnData: osvVulnData,
+			}},
+			IsVuln: []assembler.IsVulnIngest{{
+				OSV:    &generated.OSVInputSpec{OsvId: "GO-2022-0001"},
+				CVE:    &generated.CVEInputSpec{Year: "2021", CveId: "CVE-2021-44228"},
+				IsVuln: &generated.IsVulnerabilityInputSpec{Justification: aliasJustification},
+			}, {
+				OSV:    &generated.OSVInputSpec{OsvId: "GO-2022-0001"},
+				GHSA:   &generated.GHSAInputSpec{GhsaId: "GHSA-jfh8-c2jp-5v3q"},
+				IsVuln: &generated.IsVulnerabilityInputSpec{Justification: aliasJustification},
+			}},
+		},
+		wantErr: false,
+	}, {
+		name: "aliases of a GHSA are linked through its own node",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"_type": "https://in-toto.io/Statement/v0.1",
+				"subject": [{"name": "pkg:maven/org.apache.logging.log4j/log4j-core@2.8.1"}],
+				"predicateType": "https://in-toto.io/attestation/vuln/v0.1",
+				"predicate": {
+					"scanner": {
+						"uri": "osv.dev",
+						"version": "0.0.14",
+						"result": [{
+							"vulnerability_id": "GHSA-jfh8-c2jp-5v3q",
+							"aliases": ["CVE-2021-44228"]
+						}]
+					},
+					"metadata": {"scannedOn": "2022-11-21T17:45:50.52Z"}
+				}
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Vul,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyVuln: []assembler.CertifyVulnIngest{
+				ghsaCertifyVuln("GHSA-jfh8-c2jp-5v3q"),
+			},
+			IsVuln: []assembler.IsVulnIngest{{
+				OSV:    &generated.OSVInputSpec{OsvId: "GHSA-jfh8-c2jp-5v3q"},
+				GHSA:   &generated.GHSAInputSpec{GhsaId: "GHSA-jfh8-c2jp-5v3q"},
+				IsVuln: &generated.IsVulnerabilityInputSpec{Justification: aliasJustification},
+			}, {
+				OSV:    &generated.OSVInputSpec{OsvId: "GHSA-jfh8-c2jp-5v3q"},
+				CVE:    &generated.CVEInputSpec{Year: "2021", CveId: "CVE-2021-44228"},
+				IsVuln: &generated.IsVulnerabilityInputSpec{Justification: aliasJustification},
+			}},
+		},
+		wantErr: false,
+	}, {
+		name: "vulnerability metadata becomes VulnMetadata",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"_type": "https://in-toto.io/Statement/v0.1",
+				"subject": [{"name": "pkg:maven/org.apache.logging.log4j/log4j-core@2.8.1"}],
+				"predicateType": "https://in-toto.io/attestation/vuln/v0.1",
+				"predicate": {
+					"scanner": {
+						"uri": "osv.dev",
+						"version": "0.0.14",
+						"result": [{
+							"vulnerability_id": "GHSA-jfh8-c2jp-5v3q",
+							"summary": "Remote code injection in Log4j",
+							"severity": [
+								{"method": "CVSS_V3", "score": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"},
+								{"method": "CVSS_V4", "score": "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:H/SI:H/SA:H"},
+								{"method": "EPSS", "score": "0.97565"}
+							],
+							"cwe_ids": ["CWE-502"],
+							"references": ["https://nvd.nist.gov/vuln/detail/CVE-2021-44228"],
+							"published": "2021-12-10T00:40:56Z"
+						}]
+					},
+					"metadata": {"scannedOn": "2022-11-21T17:45:50.52Z"}
+				}
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Vul,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyVuln: []assembler.CertifyVulnIngest{
+				ghsaCertifyVuln("GHSA-jfh8-c2jp-5v3q"),
+			},
+			VulnMetadata: []assembler.VulnMetadataIngest{{
+				GHSA: &generated.GHSAInputSpec{GhsaId: "GHSA-jfh8-c2jp-5v3q"},
+				VulnMetadata: &generated.VulnMetadataInputSpec{
+					Summary: "Remote code injection in Log4j",
+					Scores: []generated.VulnScoreInputSpec{{
+						ScoreType: generated.VulnScoreTypeCvssV3,
+						Vector:    "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H",
+						Score:     10,
+					}, {
+						ScoreType: generated.VulnScoreTypeEpss,
+						Score:     0.97565,
+					}},
+					CweIds:     []string{"CWE-502"},
+					References: []string{"https://nvd.nist.gov/vuln/detail/CVE-2021-44228"},
+					Published:  ptrfrom(time.Date(2021, 12, 10, 0, 40, 56, 0, time.UTC)),
+				},
+			}},
+		},
+		wantErr: false,
+	}, {
+		name: "no vulnerability found",
+		doc: &processor.Document{
+			Blob: []byte(`{
+				"_type": "https://in-toto.io/Statement/v0.1",
+				"subject": [{"name": "pkg:maven/org.apache.logging.log4j/log4j-core@2.8.1"}],
+				"predicateType": "https://in-toto.io/attestation/vuln/v0.1",
+				"predicate": {
+					"scanner": {
+						"uri": "osv.dev",
+						"version": "0.0.14"
+					},
+					"metadata": {"scannedOn": "2022-11-21T17:45:50.52Z"}
+				}
+			}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Vul,
+		},
+		wantPredicates: &assembler.IngestPredicates{
+			CertifyVuln: []assembler.CertifyVulnIngest{{
+				Pkg:      log4jPkg,
+				NoVuln:   true,
+				VulnData: osvVulnData,
+			}},
+		},
+		wantErr: false,
+	}, {
+		name: "invalid subject purl",
+		doc: &processor.Document{
+			Blob:   []byte(`{"subject": [{"name": "log4j-core"}]}`),
+			Format: processor.FormatJSON,
+			Type:   processor.DocumentITE6Vul,
+		},
+		wantErr: true,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -199,13 +262,10 @@ func Test_vulnCertificationParser(t *testing.T) {
 			if err != nil {
 				return
 			}
-			if nodes := s.CreateNodes(ctx); !testdata.GuacNodeSliceEqual(nodes, tt.wantNodes) {
-				t.Errorf("vulnCertificationParser.CreateNodes() = %v, want %v", nodes, tt.wantNodes)
-			}
-			if edges := s.CreateEdges(ctx, nil); !testdata.GuacEdgeSliceEqual(edges, tt.wantEdges) {
-				t.Errorf("vulnCertificationParser.CreateEdges() = %v, want %v", edges, tt.wantEdges)
+			preds := s.GetPredicates(ctx)
+			if d := cmp.Diff(tt.wantPredicates, preds, testdata.IngestPredicatesCmpOpts...); len(d) != 0 {
+				t.Errorf("vulnCertificationParser.GetPredicate mismatch values (+got, -expected): %s", d)
 			}
 		})
 	}
 }
-*/